@@ -257,7 +257,7 @@ func TestBuildNestedAcornWithLocalImage(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
-	err = c.ImageTag(helper.GetCTX(t), image, "localnginx:latest")
+	err = c.ImageTag(helper.GetCTX(t), image, "localnginx:latest", nil)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -479,7 +479,7 @@ func TestAppRunImageVariations(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	if err := c.ImageTag(ctx, imageID, "foo/bar:baz"); err != nil {
+	if err := c.ImageTag(ctx, imageID, "foo/bar:baz", nil); err != nil {
 		t.Fatal(err)
 	}
 
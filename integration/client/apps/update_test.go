@@ -25,7 +25,7 @@ func TestUpdatePull(t *testing.T) {
 	}
 
 	imageID := client2.NewImage(t, project.Name)
-	err = c.ImageTag(ctx, imageID, "foo")
+	err = c.ImageTag(ctx, imageID, "foo", nil)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -42,7 +42,7 @@ func TestUpdatePull(t *testing.T) {
 	assert.Equal(t, app.Status.AppImage.ID, imageID)
 
 	imageID2 := client2.NewImage2(t, project.Name)
-	err = c.ImageTag(ctx, imageID2, "foo:latest")
+	err = c.ImageTag(ctx, imageID2, "foo:latest", nil)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -115,7 +115,7 @@ func TestDependsOnAutoUpgrade(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	if err = c.ImageTag(helper.GetCTX(t), image.ID, imageTag); err != nil {
+	if err = c.ImageTag(helper.GetCTX(t), image.ID, imageTag, nil); err != nil {
 		t.Fatal(err)
 	}
 
@@ -137,7 +137,7 @@ func TestDependsOnAutoUpgrade(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	if err = c.ImageTag(helper.GetCTX(t), image.ID, imageTag); err != nil {
+	if err = c.ImageTag(helper.GetCTX(t), image.ID, imageTag, nil); err != nil {
 		t.Fatal(err)
 	}
 
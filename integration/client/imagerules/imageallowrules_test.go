@@ -38,7 +38,7 @@ func TestImageAllowRules(t *testing.T) {
 	id := client2.NewImage(t, project.Name)
 	tagName := registry + "/test:ci"
 
-	err = c.ImageTag(ctx, id, tagName)
+	err = c.ImageTag(ctx, id, tagName, nil)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -61,7 +61,7 @@ func TestImageRoleAuthorizations(t *testing.T) {
 
 	nestedImageTagName := registry + "/test:ci-nested"
 
-	err = c.ImageTag(ctx, nestedImageID, nestedImageTagName)
+	err = c.ImageTag(ctx, nestedImageID, nestedImageTagName, nil)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -103,7 +103,7 @@ func TestImageRoleAuthorizations(t *testing.T) {
 
 	tagName := registry + "/test:ci"
 
-	err = c.ImageTag(ctx, id, tagName)
+	err = c.ImageTag(ctx, id, tagName, nil)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -25,7 +25,7 @@ func TestImageSignVerify(t *testing.T) {
 	id := client2.NewImage(t, project.Name)
 	remoteTagName := registry + "/test:ci"
 
-	err := c.ImageTag(ctx, id, remoteTagName)
+	err := c.ImageTag(ctx, id, remoteTagName, nil)
 	if err != nil {
 		t.Fatal(err)
 	}
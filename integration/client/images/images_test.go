@@ -70,12 +70,12 @@ func TestImageTagMove(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	err = c.ImageTag(ctx, imageID, "foo")
+	err = c.ImageTag(ctx, imageID, "foo", nil)
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	err = c.ImageTag(ctx, image2.ID, "foo:latest")
+	err = c.ImageTag(ctx, image2.ID, "foo:latest", nil)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -119,22 +119,22 @@ func TestImageTag(t *testing.T) {
 
 	image := images[0]
 
-	err = c.ImageTag(ctx, image.Name, "foo:latest")
+	err = c.ImageTag(ctx, image.Name, "foo:latest", nil)
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	err = c.ImageTag(ctx, "foo:latest", "bar:latest")
+	err = c.ImageTag(ctx, "foo:latest", "bar:latest", nil)
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	err = c.ImageTag(ctx, "foo:latest", "ghcr.io/acorn-io/runtime/test:v0.0.0-abc")
+	err = c.ImageTag(ctx, "foo:latest", "ghcr.io/acorn-io/runtime/test:v0.0.0-abc", nil)
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	err = c.ImageTag(ctx, "ghcr.io/acorn-io/runtime/test:v0.0.0-abc", "ghcr.io/acorn-io/runtime/test:v0.0.0-def")
+	err = c.ImageTag(ctx, "ghcr.io/acorn-io/runtime/test:v0.0.0-abc", "ghcr.io/acorn-io/runtime/test:v0.0.0-def", nil)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -174,7 +174,7 @@ func TestImagePush(t *testing.T) {
 	image := images[0]
 	tagName := registry + "/test:ci"
 
-	err = c.ImageTag(ctx, image.Name, tagName)
+	err = c.ImageTag(ctx, image.Name, tagName, nil)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -209,7 +209,7 @@ func TestImagePull(t *testing.T) {
 	id := client2.NewImage(t, project.Name)
 	tagName := registry + "/test:ci"
 
-	err = c.ImageTag(ctx, id, tagName)
+	err = c.ImageTag(ctx, id, tagName, nil)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -284,7 +284,7 @@ func TestImageDetails(t *testing.T) {
 	id := client2.NewImage(t, project.Name)
 	remoteTagName := registry + "/test:ci"
 
-	err = c.ImageTag(ctx, id, remoteTagName)
+	err = c.ImageTag(ctx, id, remoteTagName, nil)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -309,7 +309,7 @@ func TestImageDetails(t *testing.T) {
 
 	imageID := client2.NewImage(t, project.Name)
 
-	err = c.ImageTag(ctx, imageID, "foo")
+	err = c.ImageTag(ctx, imageID, "foo", nil)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -373,11 +373,11 @@ func TestImageDeleteTwoTags(t *testing.T) {
 	assert.False(t, strings.HasPrefix(imageID, "sha256:"))
 	assert.Equal(t, "sha256:"+image.Name, image.Digest)
 
-	err = c.ImageTag(ctx, image.Name, "repo:tag1")
+	err = c.ImageTag(ctx, image.Name, "repo:tag1", nil)
 	if err != nil {
 		t.Fatal(err)
 	}
-	err = c.ImageTag(ctx, image.Name, "repo:tag2")
+	err = c.ImageTag(ctx, image.Name, "repo:tag2", nil)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -422,9 +422,9 @@ func TestImageBadTag(t *testing.T) {
 	assert.False(t, strings.HasPrefix(imageID, "sha256:"))
 	assert.Equal(t, "sha256:"+image.Name, image.Digest)
 
-	err = c.ImageTag(ctx, image.Name, "foo:a@badtag")
+	err = c.ImageTag(ctx, image.Name, "foo:a@badtag", nil)
 	assert.Equal(t, "could not parse reference: foo:a@badtag", err.Error())
 
-	err = c.ImageTag(ctx, image.Name, "foo@@:badtag")
+	err = c.ImageTag(ctx, image.Name, "foo@@:badtag", nil)
 	assert.Equal(t, "could not parse reference: foo@@:badtag", err.Error())
 }
@@ -1365,7 +1365,10 @@ func TestCrossProjectNetworkConnection(t *testing.T) {
 	}
 
 	// create two separate projects in which to run two Nginx apps
-	proj1, err := c.ProjectCreate(ctx, "proj1", apiv1.LocalRegion, []string{apiv1.LocalRegion})
+	proj1, err := c.ProjectCreate(ctx, "proj1", &client.ProjectCreateOptions{
+		DefaultRegion:    apiv1.LocalRegion,
+		SupportedRegions: []string{apiv1.LocalRegion},
+	})
 	if err != nil {
 		t.Fatal("error while creating project:", err)
 	}
@@ -1374,7 +1377,10 @@ func TestCrossProjectNetworkConnection(t *testing.T) {
 		t.Fatal("error creating client for proj1:", err)
 	}
 
-	proj2, err := c.ProjectCreate(ctx, "proj2", apiv1.LocalRegion, []string{apiv1.LocalRegion})
+	proj2, err := c.ProjectCreate(ctx, "proj2", &client.ProjectCreateOptions{
+		DefaultRegion:    apiv1.LocalRegion,
+		SupportedRegions: []string{apiv1.LocalRegion},
+	})
 	if err != nil {
 		t.Fatal("error while creating project:", err)
 	}
@@ -1533,7 +1539,10 @@ func TestProjectUpdate(t *testing.T) {
 	ctx := helper.GetCTX(t)
 	c, _ := helper.ClientAndProject(t)
 	projectName := uuid.New().String()[:8]
-	proj1, err := c.ProjectCreate(ctx, projectName, apiv1.LocalRegion, []string{apiv1.LocalRegion})
+	proj1, err := c.ProjectCreate(ctx, projectName, &client.ProjectCreateOptions{
+		DefaultRegion:    apiv1.LocalRegion,
+		SupportedRegions: []string{apiv1.LocalRegion},
+	})
 	if err != nil {
 		t.Fatal("error while creating project:", err)
 	}
@@ -1769,7 +1778,7 @@ func TestAutoUpgradeLocalImage(t *testing.T) {
 	}
 
 	// Tag the image
-	err = c.ImageTag(ctx, image.ID, "mylocalimage")
+	err = c.ImageTag(ctx, image.ID, "mylocalimage", nil)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -17,6 +17,10 @@ type ComputeClass struct {
 	Description      string                       `json:"description,omitempty"`
 	Default          bool                         `json:"default"`
 	SupportedRegions []string                     `json:"supportedRegions,omitempty"`
+	// CPUScaler is the number of CPUs a workload gets per Gi of memory
+	CPUScaler   float64             `json:"cpuScaler,omitempty"`
+	Affinity    *corev1.Affinity    `json:"affinity,omitempty"`
+	Tolerations []corev1.Toleration `json:"tolerations,omitempty"`
 }
 
 type ComputeClassMemory struct {
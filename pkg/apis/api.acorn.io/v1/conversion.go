@@ -2,7 +2,7 @@ package v1
 
 import (
 	"net/url"
-	"unsafe"
+	"strconv"
 
 	"k8s.io/apimachinery/pkg/conversion"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -10,7 +10,8 @@ import (
 
 func convert_url_Values_To__ContainerReplicaExecOptions(in *url.Values, out *ContainerReplicaExecOptions, s conversion.Scope) error {
 	if values, ok := map[string][]string(*in)["command"]; ok && len(values) > 0 {
-		out.Command = *(*[]string)(unsafe.Pointer(&values))
+		// Copy the arguments instead of sharing the backing array with the query values
+		out.Command = append([]string(nil), values...)
 	} else {
 		out.Command = nil
 	}
@@ -21,6 +22,13 @@ func convert_url_Values_To__ContainerReplicaExecOptions(in *url.Values, out *Con
 	} else {
 		out.TTY = false
 	}
+	if values, ok := map[string][]string(*in)["stdin"]; ok && len(values) > 0 {
+		if err := runtime.Convert_Slice_string_To_bool(&values, &out.Stdin, s); err != nil {
+			return err
+		}
+	} else {
+		out.Stdin = true
+	}
 	if values, ok := map[string][]string(*in)["debugImage"]; ok && len(values) > 0 {
 		if err := runtime.Convert_Slice_string_To_string(&values, &out.DebugImage, s); err != nil {
 			return err
@@ -28,6 +36,50 @@ func convert_url_Values_To__ContainerReplicaExecOptions(in *url.Values, out *Con
 	} else {
 		out.DebugImage = ""
 	}
+	if values, ok := map[string][]string(*in)["rows"]; ok && len(values) > 0 {
+		if err := convert_Slice_string_To_uint16(&values, &out.Rows); err != nil {
+			return err
+		}
+	} else {
+		out.Rows = 0
+	}
+	if values, ok := map[string][]string(*in)["cols"]; ok && len(values) > 0 {
+		if err := convert_Slice_string_To_uint16(&values, &out.Cols); err != nil {
+			return err
+		}
+	} else {
+		out.Cols = 0
+	}
+	if values, ok := map[string][]string(*in)["env"]; ok && len(values) > 0 {
+		out.Env = make([]string, len(values))
+		for i := range values {
+			if err := runtime.Convert_Slice_string_To_string(&[]string{values[i]}, &out.Env[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Env = nil
+	}
+	if values, ok := map[string][]string(*in)["workingDir"]; ok && len(values) > 0 {
+		if err := runtime.Convert_Slice_string_To_string(&values, &out.WorkingDir, s); err != nil {
+			return err
+		}
+	} else {
+		out.WorkingDir = ""
+	}
+	return nil
+}
+
+func convert_Slice_string_To_uint16(in *[]string, out *uint16) error {
+	if len(*in) == 0 || (*in)[0] == "" {
+		*out = 0
+		return nil
+	}
+	i, err := strconv.ParseUint((*in)[0], 10, 16)
+	if err != nil {
+		return err
+	}
+	*out = uint16(i)
 	return nil
 }
 
@@ -57,6 +109,31 @@ func convert_url_Values_To__LogOptions(in *url.Values, out *LogOptions, s conver
 			return err
 		}
 	}
+	if values, ok := map[string][]string(*in)["since"]; ok && len(values) > 0 {
+		if err := runtime.Convert_Slice_string_To_string(&values, &out.Since, s); err != nil {
+			return err
+		}
+	}
+	if values, ok := map[string][]string(*in)["until"]; ok && len(values) > 0 {
+		if err := runtime.Convert_Slice_string_To_string(&values, &out.Until, s); err != nil {
+			return err
+		}
+	}
+	if values, ok := map[string][]string(*in)["init"]; ok && len(values) > 0 {
+		if err := runtime.Convert_Slice_string_To_bool(&values, &out.Init, s); err != nil {
+			return err
+		}
+	}
+	if values, ok := map[string][]string(*in)["grep"]; ok && len(values) > 0 {
+		if err := runtime.Convert_Slice_string_To_string(&values, &out.Grep, s); err != nil {
+			return err
+		}
+	}
+	if values, ok := map[string][]string(*in)["grepInvert"]; ok && len(values) > 0 {
+		if err := runtime.Convert_Slice_string_To_bool(&values, &out.GrepInvert, s); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
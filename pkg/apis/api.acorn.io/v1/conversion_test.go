@@ -0,0 +1,151 @@
+package v1
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestContainerReplicaExecOptionsRoundTrip(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, AddToScheme(scheme))
+	codec := runtime.NewParameterCodec(scheme)
+
+	commands := [][]string{
+		{"ls"},
+		{"/bin/sh", "-c", "echo hello world && sleep 1"},
+		{"env", "FOO=bar=baz", "A=", "printenv"},
+		{"echo", "grüße", "日本語", "🚀"},
+		{"echo", "a&b", "c?d", "e#f", "g%20h", "+", ""},
+		{"echo", "line\nbreak", "  padded  "},
+	}
+
+	for _, command := range commands {
+		in := &ContainerReplicaExecOptions{
+			Command: command,
+			TTY:     true,
+			Stdin:   true,
+		}
+
+		values, err := codec.EncodeParameters(in, SchemeGroupVersion)
+		require.NoError(t, err)
+
+		// Go through the query string, like the request to the exec subresource does
+		query, err := url.ParseQuery(values.Encode())
+		require.NoError(t, err)
+
+		out := &ContainerReplicaExecOptions{}
+		require.NoError(t, codec.DecodeParameters(query, SchemeGroupVersion, out))
+		assert.Equal(t, command, out.Command)
+		assert.True(t, out.TTY)
+		assert.True(t, out.Stdin)
+	}
+}
+
+func TestConvertExecOptionsStdin(t *testing.T) {
+	for _, tc := range []struct {
+		query string
+		stdin bool
+	}{
+		{query: "", stdin: true},
+		{query: "stdin=true", stdin: true},
+		{query: "stdin=false", stdin: false},
+	} {
+		in, err := url.ParseQuery(tc.query)
+		require.NoError(t, err)
+
+		out := &ContainerReplicaExecOptions{}
+		require.NoError(t, convert_url_Values_To__ContainerReplicaExecOptions(&in, out, nil))
+		assert.Equal(t, tc.stdin, out.Stdin, tc.query)
+	}
+}
+
+func TestConvertExecOptionsCopiesCommand(t *testing.T) {
+	in := url.Values{"command": []string{"echo", "hello"}}
+	out := &ContainerReplicaExecOptions{}
+	require.NoError(t, convert_url_Values_To__ContainerReplicaExecOptions(&in, out, nil))
+
+	in["command"][1] = "changed"
+	assert.Equal(t, []string{"echo", "hello"}, out.Command)
+}
+
+func TestConvertExecOptionsTerminalSize(t *testing.T) {
+	for _, tc := range []struct {
+		query      string
+		rows, cols uint16
+		wantErr    bool
+	}{
+		{query: "", rows: 0, cols: 0},
+		{query: "rows=50&cols=120", rows: 50, cols: 120},
+		{query: "rows=&cols=", rows: 0, cols: 0},
+		{query: "rows=65535&cols=1", rows: 65535, cols: 1},
+		{query: "rows=65536", wantErr: true},
+		{query: "cols=-1", wantErr: true},
+		{query: "rows=tall", wantErr: true},
+	} {
+		in, err := url.ParseQuery(tc.query)
+		require.NoError(t, err)
+
+		out := &ContainerReplicaExecOptions{}
+		err = convert_url_Values_To__ContainerReplicaExecOptions(&in, out, nil)
+		if tc.wantErr {
+			assert.Error(t, err, tc.query)
+			continue
+		}
+		require.NoError(t, err, tc.query)
+		assert.Equal(t, tc.rows, out.Rows, tc.query)
+		assert.Equal(t, tc.cols, out.Cols, tc.query)
+	}
+}
+
+func TestContainerReplicaExecOptionsTerminalSizeRoundTrip(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, AddToScheme(scheme))
+	codec := runtime.NewParameterCodec(scheme)
+
+	in := &ContainerReplicaExecOptions{TTY: true, Stdin: true, Rows: 50, Cols: 120}
+	values, err := codec.EncodeParameters(in, SchemeGroupVersion)
+	require.NoError(t, err)
+
+	query, err := url.ParseQuery(values.Encode())
+	require.NoError(t, err)
+
+	out := &ContainerReplicaExecOptions{}
+	require.NoError(t, codec.DecodeParameters(query, SchemeGroupVersion, out))
+	assert.Equal(t, uint16(50), out.Rows)
+	assert.Equal(t, uint16(120), out.Cols)
+}
+
+func TestConvertExecOptionsEnv(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, AddToScheme(scheme))
+	codec := runtime.NewParameterCodec(scheme)
+
+	env := []string{"DEBUG=1", "EMPTY=", "QUERY=a=b&c=d", "GREETING=grüße welt"}
+	values, err := codec.EncodeParameters(&ContainerReplicaExecOptions{Env: env, WorkingDir: "/app"}, SchemeGroupVersion)
+	require.NoError(t, err)
+
+	query, err := url.ParseQuery(values.Encode())
+	require.NoError(t, err)
+
+	out := &ContainerReplicaExecOptions{}
+	require.NoError(t, codec.DecodeParameters(query, SchemeGroupVersion, out))
+	assert.Equal(t, env, out.Env)
+	assert.Equal(t, "/app", out.WorkingDir)
+
+	out = &ContainerReplicaExecOptions{}
+	require.NoError(t, convert_url_Values_To__ContainerReplicaExecOptions(&url.Values{}, out, nil))
+	assert.Nil(t, out.Env)
+	assert.Empty(t, out.WorkingDir)
+}
+
+func TestConvertLogOptionsTimeWindow(t *testing.T) {
+	in := url.Values{"since": []string{"1h"}, "until": []string{"2023-12-24T18:00:00Z"}}
+	out := &LogOptions{}
+	require.NoError(t, convert_url_Values_To__LogOptions(&in, out, nil))
+	assert.Equal(t, "1h", out.Since)
+	assert.Equal(t, "2023-12-24T18:00:00Z", out.Until)
+}
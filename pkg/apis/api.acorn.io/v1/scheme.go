@@ -26,6 +26,7 @@ func AddToSchemeWithGV(scheme *runtime.Scheme, schemeGroupVersion schema.GroupVe
 		&App{},
 		&AppList{},
 		&AppInfo{},
+		&AppMetrics{},
 		&Builder{},
 		&BuilderPortOptions{},
 		&BuilderList{},
@@ -39,11 +40,15 @@ func AddToSchemeWithGV(scheme *runtime.Scheme, schemeGroupVersion schema.GroupVe
 		&ImagePush{},
 		&ImagePull{},
 		&ImageSignature{},
+		&ImageSignatures{},
+		&ImageUnsign{},
 		&Info{},
 		&InfoList{},
 		&LogOptions{},
 		&Volume{},
 		&VolumeList{},
+		&VolumeSnapshot{},
+		&VolumeSnapshotList{},
 		&VolumeClass{},
 		&VolumeClassList{},
 		&Credential{},
@@ -57,6 +62,7 @@ func AddToSchemeWithGV(scheme *runtime.Scheme, schemeGroupVersion schema.GroupVe
 		&JobList{},
 		&Secret{},
 		&SecretList{},
+		&SecretRotate{},
 		&Service{},
 		&ServiceList{},
 		&Project{},
@@ -74,6 +80,7 @@ func AddToSchemeWithGV(scheme *runtime.Scheme, schemeGroupVersion schema.GroupVe
 		&DevSession{},
 		&DevSessionList{},
 		&IgnoreCleanup{},
+		&AppRestart{},
 	)
 
 	// Add common types
@@ -96,7 +103,8 @@ func AddToSchemeWithGV(scheme *runtime.Scheme, schemeGroupVersion schema.GroupVe
 		gvk := schemeGroupVersion.WithKind("Event")
 		flcf := func(label, value string) (string, string, error) {
 			switch label {
-			case "prefix", "since", "until", "details", "metadata.name", "metadata.namespace":
+			case "prefix", "since", "until", "details", "metadata.name", "metadata.namespace",
+				"involved.kind", "involved.name", "involved.uid", "type", "severity", "actor", "appName":
 				return label, value, nil
 			}
 			return "", "", fmt.Errorf("unsupported field selection [%s]", label)
@@ -189,8 +189,11 @@ type LogMessage struct {
 	Line          string      `json:"line,omitempty"`
 	AppName       string      `json:"appName,omitempty"`
 	ContainerName string      `json:"containerName,omitempty"`
+	Container     string      `json:"container,omitempty"` // name of the container, sidecar or job within the app
+	Init          bool        `json:"init,omitempty"`      // the line was logged by an init container
 	Time          metav1.Time `json:"time,omitempty"`
 	Error         string      `json:"error,omitempty"`
+	Reconnected   bool        `json:"reconnected,omitempty"` // set by the client on a message without line, after the stream was established again
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -208,7 +211,11 @@ type LogOptions struct {
 	Follow           bool   `json:"follow,omitempty"`
 	ContainerReplica string `json:"containerReplica,omitempty"`
 	Container        string `json:"container,omitempty"`
-	Since            string `json:"since,omitempty"`
+	Since            string `json:"since,omitempty"`      // duration before now (e.g. 42m) or RFC3339 timestamp
+	Until            string `json:"until,omitempty"`      // duration before now (e.g. 42m) or RFC3339 timestamp
+	Init             bool   `json:"init,omitempty"`       // also log the init containers acorn adds to pods, not only init sidecars
+	Grep             string `json:"grep,omitempty"`       // only stream lines matching this regular expression
+	GrepInvert       bool   `json:"grepInvert,omitempty"` // only stream lines not matching Grep
 }
 
 type PortForwardOptions struct {
@@ -238,6 +245,28 @@ type AppInfo struct {
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
+type AppMetrics struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	Containers []ContainerReplicaMetrics `json:"containers,omitempty"`
+}
+
+type ContainerReplicaMetrics struct {
+	// Name is the name of the container replica, with the name of the sidecar appended for sidecars
+	Name string `json:"name"`
+	// ContainerName is the name of the container or job in the app that the replica belongs to
+	ContainerName string `json:"containerName,omitempty"`
+	SidecarName   string `json:"sidecarName,omitempty"`
+	// Usage is the current CPU and memory usage reported by the metrics API, empty if it has no measurement of the
+	// replica yet
+	Usage    corev1.ResourceList `json:"usage,omitempty"`
+	Requests corev1.ResourceList `json:"requests,omitempty"`
+	Limits   corev1.ResourceList `json:"limits,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
 type ConfirmUpgrade struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
@@ -252,6 +281,16 @@ type IgnoreCleanup struct {
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
+type AppRestart struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	// Container restricts the restart to one container, otherwise all containers of the app are restarted
+	Container string `json:"container,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
 type ImageDetails struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
@@ -263,8 +302,12 @@ type ImageDetails struct {
 	Profiles      []string       `json:"profiles,omitempty"`
 	Auth          *RegistryAuth  `json:"auth,omitempty"`
 	IncludeNested bool           `json:"includeNested,omitempty"`
+	// ListImages - if true, Images lists the images contained in the app image and its nested images
+	ListImages bool `json:"listImages,omitempty"`
 	// NoDefaultRegistry - if true, do not assume a default registry on the image if none is specified
 	NoDefaultRegistry bool `json:"noDefaultRegistry,omitempty"`
+	// Local - if true, the image is only resolved from the local image store and never from a registry
+	Local bool `json:"local,omitempty"`
 
 	// Output Params
 	AppImage        v1.AppImage      `json:"appImage,omitempty"`
@@ -274,6 +317,7 @@ type ImageDetails struct {
 	SignatureDigest string           `json:"signatureDigest,omitempty"`
 	Readme          string           `json:"readme,omitempty"`
 	NestedImages    []NestedImage    `json:"nestedImages,omitempty"`
+	Images          []ContainedImage `json:"images,omitempty"`
 	ParseError      string           `json:"parseError,omitempty"`
 }
 
@@ -307,6 +351,17 @@ type NestedImage struct {
 	ParseError      string           `json:"parseError,omitempty"`
 }
 
+// ContainedImage is an image referenced by an app image, like the image of a container or a nested acorn
+type ContainedImage struct {
+	// Name is the path of the image in the app image, like web.proxy for a sidecar or db.server for a nested acorn
+	Name   string `json:"name,omitempty"`
+	Type   string `json:"type,omitempty"`
+	Repo   string `json:"repo,omitempty"`
+	Digest string `json:"digest,omitempty"`
+	// Size is the compressed size of the config and layers of the image, summed up over all of its platforms
+	Size int64 `json:"size,omitempty"`
+}
+
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 type ImageTag struct {
@@ -314,6 +369,8 @@ type ImageTag struct {
 	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
 
 	Tag string `json:"tag,omitempty"`
+	// CopySignatures also copies the cosign signatures of the image to the repository of the tag
+	CopySignatures bool `json:"copySignatures,omitempty"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -339,13 +396,64 @@ type ImageSignature struct {
 	PublicKey    string                  `json:"publicKeys,omitempty"` // either reference or PEM encoded key
 	Annotations  v1.SignatureAnnotations `json:"annotations,omitempty"`
 	NoVerifyName bool                    `json:"noVerifyName,omitempty"` // do not verify the image name in the signature
+	// keyless signatures are verified by the identity (SAN) and OIDC issuer of their signing certificate instead of a public key
+	CertIdentity   string `json:"certIdentity,omitempty"`
+	CertOidcIssuer string `json:"certOidcIssuer,omitempty"`
+	RequireTlog    bool   `json:"requireTlog,omitempty"` // only accept signatures with a valid transparency log bundle
+	// PredicateType verifies an attestation of this predicate type (e.g. slsaprovenance or an URI) instead of a signature
+	PredicateType string `json:"predicateType,omitempty"`
 
 	// - Signing
-	Payload      []byte `json:"payload,omitempty"`
-	SignatureB64 string `json:"signature,omitempty"`
+	Payload          []byte `json:"payload,omitempty"`
+	SignatureB64     string `json:"signature,omitempty"`
+	Certificate      []byte `json:"certificate,omitempty"`      // PEM encoded signing certificate (keyless signing)
+	CertificateChain []byte `json:"certificateChain,omitempty"` // PEM encoded certificate chain of the signing certificate
+	RekorBundle      []byte `json:"rekorBundle,omitempty"`      // JSON encoded transparency log bundle
+	Force            bool   `json:"force,omitempty"`            // replace an identical existing signature instead of skipping the new one
+	// Attestation is the JSON encoded DSSE envelope of an in-toto attestation to attach in addition to the signature
+	Attestation []byte `json:"attestation,omitempty"`
+
+	// Output
+	SignatureDigest   string               `json:"signatureDigest,omitempty"`
+	Duplicate         bool                 `json:"duplicate,omitempty"`         // an identical signature existed already, it was replaced if Force was set
+	VerifiedSignature *ImageSignatureEntry `json:"verifiedSignature,omitempty"` // the signature that matched during verification
+	// AttestationPredicate is the JSON encoded predicate of the attestation that matched during verification of PredicateType
+	AttestationPredicate []byte `json:"attestationPredicate,omitempty"`
+	// AttestationDigest is the digest of the attestations artifact the Attestation was written to
+	AttestationDigest string `json:"attestationDigest,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type ImageSignatures struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	// Input Params
+	Auth      *RegistryAuth `json:"auth,omitempty"`
+	PublicKey string        `json:"publicKey,omitempty"` // optional key reference used to identify signatures which don't carry a certificate
 
 	// Output
-	SignatureDigest string `json:"signatureDigest,omitempty"`
+	Signatures []ImageSignatureEntry `json:"signatures,omitempty"`
+}
+
+type ImageSignatureEntry struct {
+	Digest      string            `json:"digest,omitempty"`      // digest of the signature layer in the signature artifact
+	PublicKey   string            `json:"publicKey,omitempty"`   // PEM encoded public key, empty if the signer could not be identified
+	Fingerprint string            `json:"fingerprint,omitempty"` // SHA256 fingerprint of the PEM encoded public key
+	Certificate string            `json:"certificate,omitempty"` // PEM encoded signing certificate (keyless signing)
+	Annotations map[string]string `json:"annotations,omitempty"` // optional payload annotations
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type ImageUnsign struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	// Input Params
+	Auth            *RegistryAuth `json:"auth,omitempty"`
+	SignatureDigest string        `json:"signatureDigest,omitempty"` // digest of the signature layer to remove
 }
 
 type VolumeCreateOptions struct {
@@ -384,6 +492,7 @@ type VolumeStatus struct {
 	AppNamespace  string        `json:"appNamespace,omitempty"`
 	VolumeName    string        `json:"volumeName,omitempty"`
 	Status        string        `json:"status,omitempty"`
+	ReclaimPolicy string        `json:"reclaimPolicy,omitempty"` // Reclaim policy of the persistent volume, "delete" frees the storage when the volume is deleted, "retain" keeps it
 	Columns       VolumeColumns `json:"columns,omitempty"`
 }
 
@@ -412,15 +521,62 @@ func (in *Volume) GetRegion() string {
 	return in.Spec.Region
 }
 
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type VolumeSnapshot struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	Spec   VolumeSnapshotSpec   `json:"spec,omitempty"`
+	Status VolumeSnapshotStatus `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type VolumeSnapshotList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []VolumeSnapshot `json:"items"`
+}
+
+type VolumeSnapshotSpec struct {
+	// Volume is the name or public name of the volume the snapshot is taken of
+	Volume string `json:"volume,omitempty"`
+}
+
+type VolumeSnapshotStatus struct {
+	AppName string `json:"appName,omitempty"`
+	// VolumeName is the name of the volume in the app
+	VolumeName string `json:"volumeName,omitempty"`
+	// SnapshotNamespace is the namespace of the app, where the CSI VolumeSnapshot is stored
+	SnapshotNamespace string `json:"snapshotNamespace,omitempty"`
+	SnapshotClass     string `json:"snapshotClass,omitempty"`
+	// Ready is true once the snapshot is taken and can be restored
+	Ready bool `json:"ready,omitempty"`
+	// Size is the minimum size of a volume restored from the snapshot
+	Size  *resource.Quantity `json:"size,omitempty"`
+	Error string             `json:"error,omitempty"`
+}
+
 // +k8s:conversion-gen:explicit-from=net/url.Values
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 type ContainerReplicaExecOptions struct {
 	metav1.TypeMeta `json:",inline"`
 
-	Command    []string `json:"command,omitempty"`
-	TTY        bool     `json:"tty,omitempty"`
+	// Command to run instead of the default shell. It has no omitempty, so that empty arguments are passed on.
+	// +optional
+	Command []string `json:"command"`
+	TTY     bool     `json:"tty,omitempty"`
+	// Stdin attaches the stdin of the command, it defaults to true if not set. It has no omitempty, so that false is
+	// passed on as well.
+	// +optional
+	Stdin      bool     `json:"stdin"`
 	DebugImage string   `json:"debugImage,omitempty"`
+	Rows       uint16   `json:"rows,omitempty"` // initial terminal height, only used with TTY
+	Cols       uint16   `json:"cols,omitempty"` // initial terminal width, only used with TTY
+	Env        []string `json:"env,omitempty"`  // additional environment variables in KEY=VALUE format
+	WorkingDir string   `json:"workingDir,omitempty"`
 }
 
 // +k8s:conversion-gen:explicit-from=net/url.Values
@@ -486,6 +642,18 @@ type SecretList struct {
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
+type SecretRotate struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	// Keys are the keys of the secret that were regenerated
+	Keys []string `json:"keys,omitempty"`
+	// Apps are the apps that use the secret and roll out its new value
+	Apps []string `json:"apps,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
 type Info struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
@@ -5,6 +5,7 @@ package v1
 import (
 	"strings"
 
+	"golang.org/x/exp/slices"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -110,6 +111,25 @@ type AppInstanceSpec struct {
 	AutoUpgradeInterval     string           `json:"autoUpgradeInterval,omitempty"`
 	ComputeClasses          ComputeClassMap  `json:"computeClass,omitempty"`
 	Memory                  MemoryMap        `json:"memory,omitempty"`
+	CPU                     CPUMap           `json:"cpu,omitempty"`
+	Scale                   map[string]int32 `json:"scale,omitempty"`           // Overrides the number of replicas of the containers by name
+	SignaturePolicy         *SignaturePolicy `json:"signaturePolicy,omitempty"` // Enforced in addition to the default signature policy of the project
+	ServiceGrants           *ServiceGrants   `json:"serviceGrants,omitempty"`   // Restricts the apps that can link to the services of the app, any app can if not set
+}
+
+// ServiceGrants is the allow-list of the apps in the project that can link to the services of an app. An empty list
+// allows no app.
+type ServiceGrants struct {
+	Consumers []string `json:"consumers"`
+}
+
+// AllowsServiceConsumer returns whether the app consumer, or the app it is nested in, can link to the services of the app.
+func (in *AppInstanceSpec) AllowsServiceConsumer(consumer string) bool {
+	if in.ServiceGrants == nil {
+		return true
+	}
+	root, _, _ := strings.Cut(consumer, ".")
+	return slices.Contains(in.ServiceGrants.Consumers, consumer) || slices.Contains(in.ServiceGrants.Consumers, root)
 }
 
 // GetGrantedPermissions returns the permissions for the app as granted by the user or granted implicitly to the image.
@@ -162,6 +182,7 @@ type ServiceBindings []ServiceBinding
 type ServiceBinding struct {
 	Target  string `json:"target,omitempty"`
 	Service string `json:"service,omitempty"`
+	Address string `json:"address,omitempty"` // External hostname or IP address the target is linked to, instead of a service in the project
 }
 
 type SecretBindings []SecretBinding
@@ -181,6 +202,9 @@ type VolumeBinding struct {
 	Size        Quantity    `json:"size,omitempty"`
 	AccessModes AccessModes `json:"accessModes,omitempty"`
 	Class       string      `json:"class,omitempty"`
+	// Snapshot is the name of a CSI VolumeSnapshot in the namespace of the app to restore the volume from. The volume
+	// is replaced by a new one with the data of the snapshot, the previous one is kept under its own name.
+	Snapshot string `json:"snapshot,omitempty"`
 }
 
 type AppColumns struct {
@@ -207,6 +231,7 @@ type AppInstanceStatus struct {
 	AppImage                  AppImage                `json:"appImage,omitempty"`
 	AvailableAppImage         string                  `json:"availableAppImage,omitempty"`
 	ConfirmUpgradeAppImage    string                  `json:"confirmUpgradeAppImage,omitempty"`
+	ConfirmUpgradeDigest      string                  `json:"confirmUpgradeDigest,omitempty"` // Digest of ConfirmUpgradeAppImage when the upgrade became available
 	AppSpec                   AppSpec                 `json:"appSpec,omitempty"`
 	AppStatus                 AppStatus               `json:"appStatus,omitempty"`
 	Scheduling                map[string]Scheduling   `json:"scheduling,omitempty"`
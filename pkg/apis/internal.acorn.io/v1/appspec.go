@@ -791,6 +791,9 @@ type VolumeRequest struct {
 // Workload to its memory
 type MemoryMap map[string]*int64
 
+// Workload to its CPU request in millicores
+type CPUMap map[string]*int64
+
 // Workload to its class
 type ComputeClassMap map[string]string
 
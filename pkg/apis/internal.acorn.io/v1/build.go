@@ -98,6 +98,16 @@ type AcornImageBuildInstanceSpec struct {
 	Platforms       []Platform  `json:"platforms,omitempty"`
 	Args            *GenericMap `json:"args,omitempty"`
 	VCS             VCS         `json:"vcs,omitempty"`
+	// CacheFrom are the remote caches the image builds import layers from
+	CacheFrom []RemoteCache `json:"cacheFrom,omitempty"`
+	// CacheTo are the remote caches the image builds export layers to
+	CacheTo []RemoteCache `json:"cacheTo,omitempty"`
+}
+
+// RemoteCache is a BuildKit cache stored outside the builder, like type=registry,ref=ghcr.io/org/app:buildcache
+type RemoteCache struct {
+	Type  string            `json:"type,omitempty"`
+	Attrs map[string]string `json:"attrs,omitempty"`
 }
 
 type AcornImageBuildInstanceStatus struct {
@@ -0,0 +1,42 @@
+package v1
+
+import (
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// ParseCPU parses CPU requests in the format of workload=cpu, or only cpu to set all workloads, into millicores
+func ParseCPU(s []string) (CPUMap, error) {
+	result := CPUMap{}
+	for _, s := range s {
+		workload, cpu, specific := strings.Cut(s, "=")
+
+		// If setting all, swap workload and cpu
+		if !specific {
+			cpu = workload
+			workload = ""
+		}
+
+		quantity, err := resource.ParseQuantity(cpu)
+		if err != nil {
+			return CPUMap{}, fmt.Errorf("invalid cpu %q: %w", s, err)
+		}
+		if quantity.Sign() <= 0 {
+			return CPUMap{}, fmt.Errorf("invalid cpu %q: must be greater than 0", s)
+		}
+
+		milliCPU := quantity.MilliValue()
+		result[workload] = &milliCPU
+	}
+	return result, nil
+}
+
+// ForWorkload returns the CPU request in millicores set for the workload, or for all workloads, if any
+func (in CPUMap) ForWorkload(workload string) *int64 {
+	if cpu := in[workload]; cpu != nil {
+		return cpu
+	}
+	return in[""]
+}
@@ -0,0 +1,62 @@
+package v1
+
+import (
+	"testing"
+
+	"github.com/acorn-io/z"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCPU(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		want    CPUMap
+		wantErr string
+	}{
+		{
+			name: "all workloads",
+			args: []string{"500m"},
+			want: CPUMap{"": z.Pointer(int64(500))},
+		},
+		{
+			name: "specific workload in cores",
+			args: []string{"web=2"},
+			want: CPUMap{"web": z.Pointer(int64(2000))},
+		},
+		{
+			name: "all and specific",
+			args: []string{"250m", "web=1.5"},
+			want: CPUMap{"": z.Pointer(int64(250)), "web": z.Pointer(int64(1500))},
+		},
+		{
+			name:    "invalid quantity",
+			args:    []string{"web=lots"},
+			wantErr: `invalid cpu "web=lots": quantities must match the regular expression '^([+-]?[0-9.]+)([eEinumkKMGTP]*[-+]?[0-9]*)$'`,
+		},
+		{
+			name:    "zero",
+			args:    []string{"web=0"},
+			wantErr: `invalid cpu "web=0": must be greater than 0`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseCPU(tt.args)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestCPUForWorkload(t *testing.T) {
+	cpu := CPUMap{"": z.Pointer(int64(250)), "web": z.Pointer(int64(1000))}
+	assert.Equal(t, int64(1000), *cpu.ForWorkload("web"))
+	assert.Equal(t, int64(250), *cpu.ForWorkload("db"))
+	assert.Nil(t, CPUMap{"web": z.Pointer(int64(1000))}.ForWorkload("db"))
+}
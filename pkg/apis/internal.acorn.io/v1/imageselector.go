@@ -9,6 +9,10 @@ type SignedBy struct {
 	AllOf []string `json:"allOf,omitempty"`
 }
 
+// LabelSelectorOpGlob matches annotation values against shell glob patterns (e.g. 1.2.*), in addition to the
+// operators supported by label selectors
+const LabelSelectorOpGlob metav1.LabelSelectorOperator = "Glob"
+
 type SignatureAnnotations struct {
 	Match       map[string]string                 `json:"match,omitempty"`
 	Expressions []metav1.LabelSelectorRequirement `json:"expressions,omitempty"`
@@ -23,3 +27,12 @@ type ImageSelector struct {
 	NamePatterns []string         `json:"namePatterns,omitempty"`
 	Signatures   []SignatureRules `json:"signatures,omitempty"`
 }
+
+// SignaturePolicy requires the image of an app and all of its nested images to carry a signature matching either the
+// public key or the certificate identity, as well as the annotation rules
+type SignaturePolicy struct {
+	Key            string               `json:"key,omitempty"`
+	CertIdentity   string               `json:"certIdentity,omitempty"`
+	CertOidcIssuer string               `json:"certOidcIssuer,omitempty"`
+	Annotations    SignatureAnnotations `json:"annotations,omitempty"`
+}
@@ -2,9 +2,12 @@ package v1
 
 import (
 	"fmt"
+	"net"
 	"regexp"
 	"strconv"
 	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation"
 )
 
 var (
@@ -280,8 +283,25 @@ func ParsePortBindings(args []string) (result []PortBinding, _ error) {
 	return
 }
 
+// ParseLinks parses links in the form EXISTING:TARGET, linking a service of the project to TARGET, and in the form
+// TARGET=ADDRESS, linking TARGET to an external hostname or IP address.
 func ParseLinks(args []string) (result []ServiceBinding, _ error) {
 	for _, arg := range args {
+		if target, address, ok := strings.Cut(arg, "="); ok {
+			target, address = strings.TrimSpace(target), strings.TrimSpace(address)
+			if target == "" || address == "" {
+				return nil, fmt.Errorf("invalid service binding [%s] must not have zero length value", arg)
+			}
+			if err := ValidateLinkAddress(address); err != nil {
+				return nil, fmt.Errorf("invalid service binding [%s]: %w", arg, err)
+			}
+			result = append(result, ServiceBinding{
+				Target:  target,
+				Address: address,
+			})
+			continue
+		}
+
 		existing, secName, ok := strings.Cut(arg, ":")
 		if !ok {
 			secName = existing
@@ -299,6 +319,17 @@ func ParseLinks(args []string) (result []ServiceBinding, _ error) {
 	return
 }
 
+// ValidateLinkAddress returns an error if address is neither an IP address nor a DNS hostname
+func ValidateLinkAddress(address string) error {
+	if net.ParseIP(address) != nil {
+		return nil
+	}
+	if errs := validation.IsDNS1123Subdomain(address); len(errs) > 0 {
+		return fmt.Errorf("address %s must be an IP address or a hostname: %s", address, strings.Join(errs, ", "))
+	}
+	return nil
+}
+
 func ParseSecrets(args []string) (result []SecretBinding, _ error) {
 	for _, arg := range args {
 		existing, secName, ok := strings.Cut(arg, ":")
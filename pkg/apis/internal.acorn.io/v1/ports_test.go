@@ -299,3 +299,51 @@ func TestParsePortBindings(t *testing.T) {
 		})
 	}
 }
+
+func TestParseLinks(t *testing.T) {
+	tests := []struct {
+		link       string
+		wantResult ServiceBinding
+		wantErr    assert.ErrorAssertionFunc
+	}{
+		{
+			link:       "mydatabase:db",
+			wantResult: ServiceBinding{Target: "db", Service: "mydatabase"},
+			wantErr:    assert.NoError,
+		},
+		{
+			link:       "mydatabase",
+			wantResult: ServiceBinding{Target: "mydatabase", Service: "mydatabase"},
+			wantErr:    assert.NoError,
+		},
+		{
+			link:       "db=db.prod.example.com",
+			wantResult: ServiceBinding{Target: "db", Address: "db.prod.example.com"},
+			wantErr:    assert.NoError,
+		},
+		{
+			link:       "db=10.0.0.5",
+			wantResult: ServiceBinding{Target: "db", Address: "10.0.0.5"},
+			wantErr:    assert.NoError,
+		},
+		{
+			link:    "db=",
+			wantErr: assert.Error,
+		},
+		{
+			link:    "db=https://db.example.com",
+			wantErr: assert.Error,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.link, func(t *testing.T) {
+			gotResult, err := ParseLinks([]string{tt.link})
+			if !tt.wantErr(t, err, fmt.Sprintf("ParseLinks(%v)", tt.link)) {
+				return
+			}
+			if err == nil {
+				assert.Equalf(t, tt.wantResult, gotResult[0], "ParseLinks(%v)", tt.link)
+			}
+		})
+	}
+}
@@ -17,6 +17,14 @@ type ProjectInstance struct {
 type ProjectInstanceSpec struct {
 	DefaultRegion    string   `json:"defaultRegion,omitempty"`
 	SupportedRegions []string `json:"supportedRegions,omitempty"`
+	// DefaultSignaturePolicy is enforced for all apps in the project, in addition to their own signature policy
+	DefaultSignaturePolicy *SignaturePolicy `json:"defaultSignaturePolicy,omitempty"`
+	// DefaultComputeClass is used for all workloads in the project that don't specify a compute class. It takes
+	// precedence over project and cluster compute classes marked as default.
+	DefaultComputeClass string `json:"defaultComputeClass,omitempty"`
+	// DefaultVolumeClass is used for all volumes in the project that don't specify a volume class. It takes
+	// precedence over project and cluster volume classes marked as default.
+	DefaultVolumeClass string `json:"defaultVolumeClass,omitempty"`
 }
 
 type ProjectInstanceStatus struct {
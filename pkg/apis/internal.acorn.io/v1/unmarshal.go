@@ -1534,6 +1534,61 @@ func ParseNameValues(fillEnv bool, s ...string) (result []NameValue) {
 	return result
 }
 
+// ParseEnvFile parses dotenv style KEY=VALUE lines. Empty lines and lines starting with # are skipped, values may be
+// quoted and unquoted values may be followed by a # comment. Like docker's --env-file, a KEY without a value is read
+// from the current environment and skipped if it isn't set there.
+func ParseEnvFile(data string) (result []NameValue, _ error) {
+	for i, line := range strings.Split(data, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		k, v, hasValue := strings.Cut(strings.TrimPrefix(line, "export "), "=")
+		k = strings.TrimSpace(k)
+		if k == "" || strings.ContainsAny(k, " \t\"'") {
+			return nil, fmt.Errorf("line %d: invalid variable name %q", i+1, k)
+		}
+
+		if !hasValue {
+			if v, ok := os.LookupEnv(k); ok {
+				result = append(result, NameValue{Name: k, Value: v})
+			}
+			continue
+		}
+
+		v, err := parseEnvFileValue(strings.TrimSpace(v))
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", i+1, err)
+		}
+		result = append(result, NameValue{Name: k, Value: v})
+	}
+	return result, nil
+}
+
+func parseEnvFileValue(v string) (string, error) {
+	switch {
+	case strings.HasPrefix(v, `"`):
+		if len(v) < 2 || !strings.HasSuffix(v, `"`) {
+			return "", fmt.Errorf("unterminated quoted value %s", v)
+		}
+		unquoted, err := strconv.Unquote(v)
+		if err != nil {
+			return "", fmt.Errorf("invalid quoted value %s", v)
+		}
+		return unquoted, nil
+	case strings.HasPrefix(v, "'"):
+		if len(v) < 2 || !strings.HasSuffix(v, "'") {
+			return "", fmt.Errorf("unterminated quoted value %s", v)
+		}
+		return v[1 : len(v)-1], nil
+	}
+	if i := strings.Index(v, " #"); i != -1 {
+		v = strings.TrimSpace(v[:i])
+	}
+	return v, nil
+}
+
 func (in *Service) UnmarshalJSON(data []byte) error {
 	var a Service
 	type acorn Service
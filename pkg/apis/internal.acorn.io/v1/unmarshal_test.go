@@ -12,6 +12,37 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
+func TestParseEnvFile(t *testing.T) {
+	assert.Nil(t, os.Setenv("x444", "y444"))
+	assert.Nil(t, os.Unsetenv("x555"))
+
+	f, err := ParseEnvFile(`
+# comment
+a=1
+export b = two words # trailing comment
+c="quoted # not a comment\n"
+d='single $quoted'
+e=
+x444
+x555
+`)
+	require.NoError(t, err)
+	assert.Equal(t, []NameValue{
+		{Name: "a", Value: "1"},
+		{Name: "b", Value: "two words"},
+		{Name: "c", Value: "quoted # not a comment\n"},
+		{Name: "d", Value: "single $quoted"},
+		{Name: "e", Value: ""},
+		{Name: "x444", Value: "y444"},
+	}, f)
+
+	_, err = ParseEnvFile("a=1\nb=\"unterminated\n")
+	assert.EqualError(t, err, "line 2: unterminated quoted value \"unterminated")
+
+	_, err = ParseEnvFile("a=1\n\nmy var=1")
+	assert.EqualError(t, err, "line 3: invalid variable name \"my var\"")
+}
+
 func TestParseHostnameBinding(t *testing.T) {
 	p, err := ParsePortBindings([]string{"example.com:service"})
 	if err != nil {
@@ -257,6 +257,20 @@ func (in *AcornImageBuildInstanceSpec) DeepCopyInto(out *AcornImageBuildInstance
 		*out = &x
 	}
 	in.VCS.DeepCopyInto(&out.VCS)
+	if in.CacheFrom != nil {
+		in, out := &in.CacheFrom, &out.CacheFrom
+		*out = make([]RemoteCache, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.CacheTo != nil {
+		in, out := &in.CacheTo, &out.CacheTo
+		*out = make([]RemoteCache, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AcornImageBuildInstanceSpec.
@@ -546,6 +560,38 @@ func (in *AppInstanceSpec) DeepCopyInto(out *AppInstanceSpec) {
 			(*out)[key] = outVal
 		}
 	}
+	if in.CPU != nil {
+		in, out := &in.CPU, &out.CPU
+		*out = make(CPUMap, len(*in))
+		for key, val := range *in {
+			var outVal *int64
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				in, out := &val, &outVal
+				*out = new(int64)
+				**out = **in
+			}
+			(*out)[key] = outVal
+		}
+	}
+	if in.Scale != nil {
+		in, out := &in.Scale, &out.Scale
+		*out = make(map[string]int32, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.SignaturePolicy != nil {
+		in, out := &in.SignaturePolicy, &out.SignaturePolicy
+		*out = new(SignaturePolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ServiceGrants != nil {
+		in, out := &in.ServiceGrants, &out.ServiceGrants
+		*out = new(ServiceGrants)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AppInstanceSpec.
@@ -1130,6 +1176,35 @@ func (in *BuilderSpec) DeepCopy() *BuilderSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in CPUMap) DeepCopyInto(out *CPUMap) {
+	{
+		in := &in
+		*out = make(CPUMap, len(*in))
+		for key, val := range *in {
+			var outVal *int64
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				in, out := &val, &outVal
+				*out = new(int64)
+				**out = **in
+			}
+			(*out)[key] = outVal
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CPUMap.
+func (in CPUMap) DeepCopy() CPUMap {
+	if in == nil {
+		return nil
+	}
+	out := new(CPUMap)
+	in.DeepCopyInto(out)
+	return *out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in CommandSlice) DeepCopyInto(out *CommandSlice) {
 	{
@@ -2848,6 +2923,11 @@ func (in *ProjectInstanceSpec) DeepCopyInto(out *ProjectInstanceSpec) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.DefaultSignaturePolicy != nil {
+		in, out := &in.DefaultSignaturePolicy, &out.DefaultSignaturePolicy
+		*out = new(SignaturePolicy)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectInstanceSpec.
@@ -2899,6 +2979,28 @@ func (in Prompts) DeepCopy() Prompts {
 	return *out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RemoteCache) DeepCopyInto(out *RemoteCache) {
+	*out = *in
+	if in.Attrs != nil {
+		in, out := &in.Attrs, &out.Attrs
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RemoteCache.
+func (in *RemoteCache) DeepCopy() *RemoteCache {
+	if in == nil {
+		return nil
+	}
+	out := new(RemoteCache)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ReplicasSummary) DeepCopyInto(out *ReplicasSummary) {
 	*out = *in
@@ -3412,6 +3514,26 @@ func (in *ServiceConsumer) DeepCopy() *ServiceConsumer {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceGrants) DeepCopyInto(out *ServiceGrants) {
+	*out = *in
+	if in.Consumers != nil {
+		in, out := &in.Consumers, &out.Consumers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceGrants.
+func (in *ServiceGrants) DeepCopy() *ServiceGrants {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceGrants)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ServiceInstance) DeepCopyInto(out *ServiceInstance) {
 	*out = *in
@@ -3643,6 +3765,22 @@ func (in *SignatureAnnotations) DeepCopy() *SignatureAnnotations {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SignaturePolicy) DeepCopyInto(out *SignaturePolicy) {
+	*out = *in
+	in.Annotations.DeepCopyInto(&out.Annotations)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SignaturePolicy.
+func (in *SignaturePolicy) DeepCopy() *SignaturePolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(SignaturePolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SignatureRules) DeepCopyInto(out *SignatureRules) {
 	*out = *in
@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"sort"
 
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -60,7 +62,24 @@ func getCurrentProjectComputeClassDefault(ctx context.Context, c client.Client,
 	return defaultPCC, nil
 }
 
+// getProjectSpecComputeClassDefault returns the default compute class set on the project of the namespace, if any.
+func getProjectSpecComputeClassDefault(ctx context.Context, c client.Client, namespace string) (string, error) {
+	project := new(v1.ProjectInstance)
+	if err := c.Get(ctx, client.ObjectKey{Name: namespace}, project); apierrors.IsNotFound(err) {
+		return "", nil
+	} else if err != nil {
+		return "", err
+	}
+	return project.Spec.DefaultComputeClass, nil
+}
+
+// GetDefaultComputeClass returns the name of the compute class used for workloads in the namespace that don't specify
+// one. The default set on the project takes precedence over project and cluster compute classes marked as default.
 func GetDefaultComputeClass(ctx context.Context, c client.Client, namespace string) (string, error) {
+	if name, err := getProjectSpecComputeClassDefault(ctx, c, namespace); err != nil || name != "" {
+		return name, err
+	}
+
 	pcc, err := getCurrentProjectComputeClassDefault(ctx, c, namespace)
 	if err != nil {
 		return "", err
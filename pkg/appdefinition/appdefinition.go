@@ -442,6 +442,35 @@ func (a *AppDefinition) WatchFiles(cwd string) (result []string, _ error) {
 	return result, nil
 }
 
+// BuildContexts returns the build contexts of the images of the containers, sidecars and jobs that are built from a
+// Dockerfile
+func (a *AppDefinition) BuildContexts(cwd string) (result []string, _ error) {
+	spec, err := a.BuilderSpec()
+	if err != nil {
+		return nil, err
+	}
+
+	contextSet := map[string]bool{}
+	addBuildContexts(contextSet, spec.Containers, cwd)
+	addBuildContexts(contextSet, spec.Jobs, cwd)
+
+	for k := range contextSet {
+		result = append(result, k)
+	}
+	sort.Strings(result)
+	return result, nil
+}
+
+func addBuildContexts(contextSet map[string]bool, builds map[string]v1.ContainerImageBuilderSpec, cwd string) {
+	for _, build := range builds {
+		addBuildContexts(contextSet, build.Sidecars, cwd)
+		if build.Build == nil || build.Build.BaseImage != "" {
+			continue
+		}
+		contextSet[filepath.Join(cwd, build.Build.Context)] = true
+	}
+}
+
 func (a *AppDefinition) BuilderSpec() (*v1.BuilderSpec, error) {
 	spec := &v1.BuilderSpec{}
 	return spec, a.decode(spec)
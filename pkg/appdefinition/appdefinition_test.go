@@ -3090,3 +3090,54 @@ func TestUserContext(t *testing.T) {
 	}`))
 	require.Error(t, err)
 }
+
+func TestResolveProfiles(t *testing.T) {
+	def, err := NewAppDefinition([]byte(`
+profiles: one: {}
+profiles: two: {}
+profiles: three: {}
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	profiles, err := def.ResolveProfiles([]string{"three", "four?", "one", "three", "devMode?"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, []string{"one", "three", "devMode?", "four?"}, profiles)
+
+	_, err = def.ResolveProfiles([]string{"one", "four"})
+	assert.EqualError(t, err, "unknown profile four, available profiles are [one two three]")
+}
+
+func TestBuildContexts(t *testing.T) {
+	appImage, err := NewAppDefinition([]byte(`
+containers: {
+  web: {
+    build: "web"
+    sidecars: tools: build: context: "tools"
+  }
+  api: build: {
+    context: "api"
+    dockerfile: "api/Dockerfile.dev"
+  }
+  none: image: "done"
+}
+jobs: migrate: build: "api"
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	contexts, err := appImage.BuildContexts("root-path")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, []string{
+		filepath.Join("root-path", "api"),
+		filepath.Join("root-path", "tools"),
+		filepath.Join("root-path", "web"),
+	}, contexts)
+}
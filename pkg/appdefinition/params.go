@@ -2,6 +2,9 @@ package appdefinition
 
 import (
 	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
 
 	"github.com/acorn-io/aml/cli/pkg/flagargs"
 	"github.com/acorn-io/aml/pkg/value"
@@ -210,6 +213,57 @@ func (a *AppDefinition) ToFlags(programName, argsFile string, usage func()) (Fla
 	return args, nil
 }
 
+// ResolveProfiles checks that the profiles are declared by the Acornfile and orders them the way they're declared, which
+// is the order the profiles are merged in, no matter in which order they're passed. Optional profiles, with a "?"
+// suffix, don't have to be declared and are left at the end.
+func (a *AppDefinition) ResolveProfiles(profiles []string) ([]string, error) {
+	if len(profiles) == 0 {
+		return profiles, nil
+	}
+
+	var file value.FuncSchema
+	if err := a.decode(&file); err != nil {
+		return nil, err
+	}
+
+	declared := map[string]int{}
+	for i, name := range file.ProfileNames {
+		declared[name.Name] = i
+	}
+
+	var (
+		result []string
+		seen   = map[string]struct{}{}
+	)
+	for _, profile := range profiles {
+		name, optional := strings.CutSuffix(profile, "?")
+		if _, ok := declared[name]; !ok && !optional {
+			var available []string
+			for _, name := range dropHiddenProfiles(file.ProfileNames) {
+				available = append(available, name.Name)
+			}
+			return nil, fmt.Errorf("unknown profile %s, available profiles are %v", name, available)
+		}
+		if _, ok := seen[profile]; ok {
+			continue
+		}
+		seen[profile] = struct{}{}
+		result = append(result, profile)
+	}
+
+	sort.SliceStable(result, func(i, j int) bool {
+		return profileOrder(declared, result[i]) < profileOrder(declared, result[j])
+	})
+	return result, nil
+}
+
+func profileOrder(declared map[string]int, profile string) int {
+	if i, ok := declared[strings.TrimSuffix(profile, "?")]; ok {
+		return i
+	}
+	return len(declared)
+}
+
 func (a *AppDefinition) ToParamSpec() (*v1.ParamSpec, error) {
 	var file value.FuncSchema
 	err := a.decode(&file)
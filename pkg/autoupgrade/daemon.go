@@ -250,12 +250,20 @@ func (d *daemon) refreshImages(ctx context.Context, apps map[kclient.ObjectKey]v
 					}
 					app.Status.AvailableAppImage = nextAppImage
 					app.Status.ConfirmUpgradeAppImage = ""
+					app.Status.ConfirmUpgradeDigest = ""
 				case "notify":
-					if app.Status.ConfirmUpgradeAppImage == nextAppImage {
+					if updated && digest == "" {
+						// A new tag is found without resolving its digest, resolve it to show which image would be confirmed
+						if digest, err = d.client.imageDigest(ctx, app.Namespace, nextAppImage); err != nil {
+							logrus.Debugf("Problem getting digest of image %v for app %v: %v", nextAppImage, appKey, err)
+						}
+					}
+					if app.Status.ConfirmUpgradeAppImage == nextAppImage && app.Status.ConfirmUpgradeDigest == digest {
 						d.appKeysPrevCheck[appKey] = updateTime
 						continue
 					}
 					app.Status.ConfirmUpgradeAppImage = nextAppImage
+					app.Status.ConfirmUpgradeDigest = digest
 					app.Status.AvailableAppImage = ""
 				default:
 					logrus.Warnf("Unrecognized auto-upgrade mode %v for %v", mode, app.Name)
@@ -28,7 +28,9 @@ import (
 	"github.com/google/go-containerregistry/pkg/v1/remote"
 	"github.com/google/uuid"
 	client2 "github.com/moby/buildkit/client"
+	"github.com/moby/buildkit/session/secrets"
 	"github.com/opencontainers/go-digest"
+	ocispecs "github.com/opencontainers/image-spec/specs-go/v1"
 	kclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -49,12 +51,18 @@ type buildContext struct {
 	buildNamespace string
 	opts           v1.AcornImageBuildInstanceSpec
 	keychain       authn.Keychain
+	secrets        secrets.SecretStore
 	remoteOpts     []remote.Option
 	messages       buildclient.Messages
 }
 
 func Build(ctx context.Context, messages buildclient.Messages, pushRepo, buildNamespace string, opts v1.AcornImageBuildInstanceSpec, keychain authn.Keychain, remoteOpts ...remote.Option) (*v1.AppImage, error) {
 	remoteKc := NewRemoteKeyChain(ctx, messages, keychain)
+	if err := validateRemoteCaches(opts, remoteKc); err != nil {
+		return nil, err
+	}
+
+	ctx = buildkit.WithRemoteCaches(ctx, opts.CacheFrom, opts.CacheTo)
 	buildContext := &buildContext{
 		ctx:            buildkit.WithContextCacheKey(ctx, opts.ContextCacheKey),
 		cwd:            "",
@@ -62,6 +70,7 @@ func Build(ctx context.Context, messages buildclient.Messages, pushRepo, buildNa
 		buildNamespace: buildNamespace,
 		opts:           opts,
 		keychain:       remoteKc,
+		secrets:        newRemoteSecretStore(messages),
 		remoteOpts:     append(remoteOpts, remote.WithAuthFromKeychain(remoteKc), remote.WithContext(ctx)),
 		messages:       messages,
 	}
@@ -635,7 +644,7 @@ func fromBuild(ctx *buildContext, buildCache *buildCache, build v1.Build) (id st
 }
 
 func buildImageNoManifest(ctx *buildContext, cwd string, build v1.Build) (string, error) {
-	_, ids, err := buildkit.Build(ctx.ctx, ctx.pushRepo, true, cwd, nil, build, ctx.messages, ctx.keychain)
+	_, ids, err := buildkit.Build(ctx.ctx, ctx.pushRepo, true, cwd, nil, build, ctx.messages, ctx.keychain, ctx.secrets)
 	if err != nil {
 		return "", err
 	}
@@ -643,7 +652,7 @@ func buildImageNoManifest(ctx *buildContext, cwd string, build v1.Build) (string
 }
 
 func buildImageAndManifest(ctx *buildContext, build v1.Build) (string, error) {
-	platforms, ids, err := buildkit.Build(ctx.ctx, ctx.pushRepo, false, ctx.cwd, ctx.opts.Platforms, build, ctx.messages, ctx.keychain)
+	platforms, ids, err := buildkit.Build(ctx.ctx, ctx.pushRepo, false, ctx.cwd, ctx.opts.Platforms, build, ctx.messages, ctx.keychain, ctx.secrets)
 	if err != nil {
 		return "", err
 	}
@@ -652,7 +661,47 @@ func buildImageAndManifest(ctx *buildContext, build v1.Build) (string, error) {
 		return ids[0], nil
 	}
 
-	return createManifest(ids, platforms, ctx.remoteOpts)
+	manifest, err := createManifest(ids, platforms, ctx.remoteOpts)
+	if err != nil {
+		return "", err
+	}
+
+	reportPlatformDigests(ctx, ids, platforms)
+	return manifest, nil
+}
+
+// reportPlatformDigests sends a completed build step for each platform of a multi-platform build so that the
+// digest of every image in the manifest list shows up in the build output.
+func reportPlatformDigests(ctx *buildContext, ids []string, platforms []v1.Platform) {
+	var (
+		sessionid = uuid.New().String()
+		now       = time.Now()
+		vertexes  = make([]*client2.Vertex, 0, len(ids))
+	)
+
+	for i, id := range ids {
+		name := platformDigestLine(platforms[i], id)
+		vertexes = append(vertexes, &client2.Vertex{
+			Digest:    digest.FromString(name),
+			Name:      name,
+			Started:   &now,
+			Completed: &now,
+		})
+	}
+
+	_ = ctx.messages.Send(&buildclient.Message{
+		StatusSessionID: sessionid,
+		Status: &client2.SolveStatus{
+			Vertexes: vertexes,
+		},
+	})
+}
+
+func platformDigestLine(platform v1.Platform, id string) string {
+	if _, d, ok := strings.Cut(id, "@"); ok {
+		id = d
+	}
+	return fmt.Sprintf("[%s] digest %s", platforms.Format(ocispecs.Platform(platform)), id)
 }
 
 func buildWithContext(ctx *buildContext, build v1.Build) (string, error) {
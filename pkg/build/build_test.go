@@ -3,6 +3,7 @@ package build
 import (
 	"testing"
 
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -37,3 +38,33 @@ COPY --link "./files2" "/var/tmp2/"
 		})
 	}
 }
+
+func Test_platformDigestLine(t *testing.T) {
+	assert.Equal(t, "[linux/amd64] digest sha256:abc",
+		platformDigestLine(v1.Platform{OS: "linux", Architecture: "amd64"}, "registry/app@sha256:abc"))
+	assert.Equal(t, "[linux/arm64/v8] digest sha256:def",
+		platformDigestLine(v1.Platform{OS: "linux", Architecture: "arm64", Variant: "v8"}, "sha256:def"))
+}
+
+func TestParseRemoteCaches(t *testing.T) {
+	caches, err := ParseRemoteCaches([]string{
+		"type=registry,ref=ghcr.io/org/app:buildcache,mode=max",
+		"ghcr.io/org/app",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []v1.RemoteCache{
+		{Type: "registry", Attrs: map[string]string{"ref": "ghcr.io/org/app:buildcache", "mode": "max"}},
+		{Type: "registry", Attrs: map[string]string{"ref": "ghcr.io/org/app"}},
+	}, caches)
+
+	for _, value := range []string{
+		"type=local,dest=/tmp/cache",
+		"type=registry",
+		"type=registry,ref=ghcr.io/org/app@sha256:0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef",
+		"type=registry,ref=ghcr.io/org/app,mode=all",
+		"type=registry,ghcr.io/org/app",
+	} {
+		_, err := ParseRemoteCaches([]string{value})
+		assert.Error(t, err, value)
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"path/filepath"
+	"strings"
 
 	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
 	"github.com/acorn-io/runtime/pkg/build/authprovider"
@@ -12,9 +13,12 @@ import (
 	"github.com/acorn-io/runtime/pkg/digest"
 	cplatforms "github.com/containerd/containerd/platforms"
 	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/name"
 	"github.com/google/uuid"
 	buildkit "github.com/moby/buildkit/client"
 	"github.com/moby/buildkit/session"
+	"github.com/moby/buildkit/session/secrets"
+	"github.com/moby/buildkit/session/secrets/secretsprovider"
 	ocispecs "github.com/opencontainers/image-spec/specs-go/v1"
 	"github.com/sirupsen/logrus"
 )
@@ -30,7 +34,23 @@ func getCacheKey(ctx context.Context) string {
 	return v
 }
 
-func Build(ctx context.Context, pushRepo string, local bool, cwd string, platforms []v1.Platform, build v1.Build, messages buildclient.Messages, keychain authn.Keychain) ([]v1.Platform, []string, error) {
+type remoteCachesKey struct{}
+
+type remoteCaches struct {
+	from, to []v1.RemoteCache
+}
+
+// WithRemoteCaches sets the remote caches that the image builds import layers from and export layers to
+func WithRemoteCaches(ctx context.Context, from, to []v1.RemoteCache) context.Context {
+	return context.WithValue(ctx, remoteCachesKey{}, remoteCaches{from: from, to: to})
+}
+
+func getRemoteCaches(ctx context.Context) remoteCaches {
+	v, _ := ctx.Value(remoteCachesKey{}).(remoteCaches)
+	return v
+}
+
+func Build(ctx context.Context, pushRepo string, local bool, cwd string, platforms []v1.Platform, build v1.Build, messages buildclient.Messages, keychain authn.Keychain, secretStore secrets.SecretStore) ([]v1.Platform, []string, error) {
 	bkc, err := buildkit.New(ctx, "")
 	if err != nil {
 		return nil, nil, err
@@ -60,6 +80,10 @@ func Build(ctx context.Context, pushRepo string, local bool, cwd string, platfor
 	logrus.Debugf("sharedKey=[%s] cacheKey=[%s] cwd=[%s], buildData=[%s] local=[%v]",
 		sharedKey, getCacheKey(ctx), cwd, buildData, local)
 
+	// Unlike the shared key, the key of the remote cache must not depend on the machine the build runs on
+	remoteCacheKey := digest.SHA256(string(buildData), fmt.Sprint(local))[:12]
+	caches := getRemoteCaches(ctx)
+
 	for _, platform := range platforms {
 		options := buildkit.SolveOpt{
 			SharedKey: sharedKey,
@@ -101,10 +125,22 @@ func Build(ctx context.Context, pushRepo string, local bool, cwd string, platfor
 					build.DockerfileContents))
 		}
 
+		if secretStore != nil {
+			// Secrets are only mounted into the RUN instructions that ask for them, they never end up in a layer
+			options.Session = append(options.Session, secretsprovider.NewSecretProvider(secretStore))
+		}
+
 		for key, value := range build.BuildArgs {
 			options.FrontendAttrs["build-arg:"+key] = value
 		}
 
+		if options.CacheImports, err = toCacheOptions(caches.from, remoteCacheKey, platform); err != nil {
+			return nil, nil, err
+		}
+		if options.CacheExports, err = toCacheOptions(caches.to, remoteCacheKey, platform); err != nil {
+			return nil, nil, err
+		}
+
 		imageName, err := buildImage(ctx, pushRepo, options, messages)
 		if err != nil {
 			return nil, nil, err
@@ -116,6 +152,39 @@ func Build(ctx context.Context, pushRepo string, local bool, cwd string, platfor
 	return platforms, result, nil
 }
 
+// toCacheOptions converts the remote caches to BuildKit cache options for one image build of one platform. Every
+// export replaces the cache stored at a ref, so each image and platform of a build gets its own tag derived from the
+// ref, like ghcr.io/org/app:buildcache-0123456789ab-linux-arm64.
+func toCacheOptions(caches []v1.RemoteCache, remoteCacheKey string, platform v1.Platform) (result []buildkit.CacheOptionsEntry, _ error) {
+	for _, cache := range caches {
+		attrs := map[string]string{}
+		for k, v := range cache.Attrs {
+			attrs[k] = v
+		}
+		if ref := attrs["ref"]; ref != "" {
+			tag, err := name.NewTag(ref)
+			if err != nil {
+				return nil, err
+			}
+			attrs["ref"] = tag.Context().Tag(tag.TagStr() + "-" + remoteCacheKey + "-" + platformTag(platform)).String()
+		}
+		result = append(result, buildkit.CacheOptionsEntry{
+			Type:  cache.Type,
+			Attrs: attrs,
+		})
+	}
+	return
+}
+
+// platformTag formats the platform to be used in an image tag, like linux-arm64-v8
+func platformTag(platform v1.Platform) string {
+	parts := []string{platform.OS, platform.Architecture}
+	if platform.Variant != "" {
+		parts = append(parts, platform.Variant)
+	}
+	return strings.Join(parts, "-")
+}
+
 func buildImage(ctx context.Context, pushRepo string, options buildkit.SolveOpt, messages buildclient.Messages) (imageName string, returnErr error) {
 	bkc, bkcClose, err := newClient(ctx, pushRepo, options.FrontendAttrs["platform"])
 	if err != nil {
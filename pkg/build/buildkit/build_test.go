@@ -0,0 +1,26 @@
+package buildkit
+
+import (
+	"testing"
+
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	buildkit "github.com/moby/buildkit/client"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_toCacheOptions(t *testing.T) {
+	caches := []v1.RemoteCache{
+		{Type: "registry", Attrs: map[string]string{"ref": "ghcr.io/org/app:buildcache", "mode": "max"}},
+		{Type: "registry", Attrs: map[string]string{"ref": "ghcr.io/org/app"}},
+	}
+
+	result, err := toCacheOptions(caches, "0123456789ab", v1.Platform{OS: "linux", Architecture: "arm64", Variant: "v8"})
+	assert.NoError(t, err)
+	assert.Equal(t, []buildkit.CacheOptionsEntry{
+		{Type: "registry", Attrs: map[string]string{"ref": "ghcr.io/org/app:buildcache-0123456789ab-linux-arm64-v8", "mode": "max"}},
+		{Type: "registry", Attrs: map[string]string{"ref": "ghcr.io/org/app:latest-0123456789ab-linux-arm64-v8"}},
+	}, result)
+
+	// The caches of the build spec are shared by all images and platforms, so they must not be modified
+	assert.Equal(t, "ghcr.io/org/app:buildcache", caches[0].Attrs["ref"])
+}
@@ -0,0 +1,58 @@
+package build
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/acorn-io/runtime/pkg/buildclient"
+	"github.com/moby/buildkit/session/secrets"
+	"github.com/pkg/errors"
+)
+
+// remoteSecretStore asks the client for the build secrets BuildKit mounts into RUN --mount=type=secret instructions,
+// so that their values are never part of the build spec, the build args or the image.
+type remoteSecretStore struct {
+	messages buildclient.Messages
+	timeout  time.Duration
+}
+
+func newRemoteSecretStore(messages buildclient.Messages) *remoteSecretStore {
+	return &remoteSecretStore{
+		messages: messages,
+		timeout:  15 * time.Second,
+	}
+}
+
+func (r *remoteSecretStore) GetSecret(ctx context.Context, id string) ([]byte, error) {
+	msgs, cancel := r.messages.Recv()
+	defer cancel()
+
+	timeoutCtx, timeoutCancel := context.WithTimeout(ctx, r.timeout)
+	defer timeoutCancel()
+
+	err := r.messages.Send(&buildclient.Message{
+		BuildSecretID: id,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		select {
+		case <-timeoutCtx.Done():
+			return nil, fmt.Errorf("timeout waiting for build secret [%s]", id)
+		case resp, ok := <-msgs:
+			if !ok {
+				return nil, errors.WithStack(secrets.ErrNotFound)
+			}
+			if resp.BuildSecretID != id {
+				continue
+			}
+			if resp.Packet == nil {
+				return nil, errors.WithStack(secrets.ErrNotFound)
+			}
+			return resp.Packet.Data, nil
+		}
+	}
+}
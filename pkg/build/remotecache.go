@@ -0,0 +1,94 @@
+package build
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+)
+
+const remoteCacheTypeRegistry = "registry"
+
+// ParseRemoteCaches parses the values of --cache-from and --cache-to. The format is type=registry,ref=REPO[:TAG]
+// followed by any other attribute BuildKit accepts, like mode=max. A bare REPO[:TAG] is short for type=registry,ref=...
+func ParseRemoteCaches(values []string) (result []v1.RemoteCache, _ error) {
+	for _, value := range values {
+		cache, err := parseRemoteCache(value)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, cache)
+	}
+	return
+}
+
+func parseRemoteCache(value string) (v1.RemoteCache, error) {
+	result := v1.RemoteCache{
+		Type:  remoteCacheTypeRegistry,
+		Attrs: map[string]string{},
+	}
+
+	if !strings.Contains(value, "=") {
+		result.Attrs["ref"] = value
+	} else {
+		for _, field := range strings.Split(value, ",") {
+			key, val, ok := strings.Cut(field, "=")
+			if !ok || key == "" {
+				return result, fmt.Errorf("invalid cache %s, fields must be KEY=VALUE", value)
+			}
+			if key == "type" {
+				result.Type = val
+			} else {
+				result.Attrs[key] = val
+			}
+		}
+	}
+
+	return result, validateRemoteCache(result)
+}
+
+func validateRemoteCache(cache v1.RemoteCache) error {
+	if cache.Type != remoteCacheTypeRegistry {
+		return fmt.Errorf("unsupported cache type %s, only %s is supported", cache.Type, remoteCacheTypeRegistry)
+	}
+	ref := cache.Attrs["ref"]
+	if ref == "" {
+		return fmt.Errorf("cache of type %s requires a ref", cache.Type)
+	}
+	if _, err := name.NewTag(ref); err != nil {
+		return fmt.Errorf("invalid cache ref %s, must be a repository with an optional tag: %w", ref, err)
+	}
+	if mode := cache.Attrs["mode"]; mode != "" && mode != "min" && mode != "max" {
+		return fmt.Errorf("invalid cache mode %s, must be min or max", mode)
+	}
+	return nil
+}
+
+// validateRemoteCaches checks the caches of the build spec and that the credentials resolved through the keychain
+// can push to the caches that are exported to, so that a missing login fails the build before anything is built.
+func validateRemoteCaches(opts v1.AcornImageBuildInstanceSpec, keychain authn.Keychain) error {
+	for _, cache := range opts.CacheFrom {
+		if err := validateRemoteCache(cache); err != nil {
+			return err
+		}
+	}
+
+	for _, cache := range opts.CacheTo {
+		if err := validateRemoteCache(cache); err != nil {
+			return err
+		}
+		ref, err := name.NewTag(cache.Attrs["ref"])
+		if err != nil {
+			return err
+		}
+		if err := remote.CheckPushPermission(ref, keychain, http.DefaultTransport); err != nil {
+			return fmt.Errorf("cannot export build cache to %s, check the credentials for %s: %w", ref, ref.RegistryStr(), err)
+		}
+	}
+
+	return nil
+}
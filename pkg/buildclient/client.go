@@ -30,10 +30,13 @@ func wsURL(url string) string {
 
 type CredentialLookup func(ctx context.Context, serverAddress string) (*apiv1.RegistryAuth, bool, error)
 
+// BuildSecretLookup returns the value of the build secret with the given id and whether the client has it
+type BuildSecretLookup func(ctx context.Context, id string) ([]byte, bool, error)
+
 type WebSocketDialer func(ctx context.Context, urlStr string, requestHeader http.Header) (*websocket.Conn, *http.Response, error)
 
 func Stream(ctx context.Context, cwd string, streams *streams.Output, dialer WebSocketDialer,
-	creds CredentialLookup, build *apiv1.AcornImageBuild) (*v1.AppImage, error) {
+	creds CredentialLookup, secrets BuildSecretLookup, build *apiv1.AcornImageBuild) (*v1.AppImage, error) {
 	conn, response, err := dialer(ctx, wsURL(build.Status.BuildURL), map[string][]string{
 		"X-Acorn-Build-Token": {build.Status.Token},
 	})
@@ -118,6 +121,14 @@ func Stream(ctx context.Context, cwd string, streams *streams.Output, dialer Web
 			if err := messages.Send(cm); err != nil {
 				return nil, err
 			}
+		} else if msg.BuildSecretID != "" {
+			sm, err := lookupBuildSecret(ctx, secrets, msg.BuildSecretID)
+			if err != nil {
+				return nil, err
+			}
+			if err := messages.Send(sm); err != nil {
+				return nil, err
+			}
 		} else if msg.Acornfile != "" {
 			data, err := aml.ReadFile(filepath.Join(cwd, msg.Acornfile))
 			if err != nil {
@@ -182,6 +193,27 @@ func lookupCred(ctx context.Context, creds CredentialLookup, serverAddress strin
 	return
 }
 
+// lookupBuildSecret returns the response to a build secret request, which has no Packet if the secret isn't known
+func lookupBuildSecret(ctx context.Context, secrets BuildSecretLookup, id string) (*Message, error) {
+	result := &Message{
+		BuildSecretID: id,
+	}
+
+	if secrets == nil {
+		return result, nil
+	}
+
+	data, found, err := secrets(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read build secret %s: %w", id, err)
+	} else if found {
+		result.Packet = &types.Packet{
+			Data: data,
+		}
+	}
+	return result, nil
+}
+
 func PingBuilder(ctx context.Context, baseURL string) bool {
 	for i := 0; i < 5; i++ {
 		req, err := http.NewRequest(http.MethodGet, baseURL+"/ping", nil)
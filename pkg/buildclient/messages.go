@@ -32,6 +32,7 @@ type Message struct {
 	//         Acornfile - Request/Response for Acornfile lookup
 	//         ReadFile - Request/Response for file lookup
 	//         RegistryServerAddress - Server requesting a registry credential, or Client responding
+	//         BuildSecretID - Server requesting a build secret, or Client responding with it in Packet
 
 	FileSessionID         string       `json:"fileSessionID,omitempty"`
 	StatusSessionID       string       `json:"statusSessionID,omitempty"`
@@ -40,6 +41,7 @@ type Message struct {
 	Acornfile             string       `json:"acornfile,omitempty"`
 	ReadFile              string       `json:"readFile,omitempty"`
 	RegistryServerAddress string       `json:"registryServerAddress,omitempty"`
+	BuildSecretID         string       `json:"buildSecretID,omitempty"`
 
 	// The below fields are additional metadata for each one of the above messages types
 
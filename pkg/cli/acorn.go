@@ -91,6 +91,7 @@ type Acorn struct {
 	Project         string `usage:"Project to work in" short:"j" env:"ACORN_PROJECT"`
 	Debug           bool   `usage:"Enable debug logging" env:"ACORN_DEBUG"`
 	DebugLevel      int    `usage:"Debug log level (valid 0-9) (default 7)" env:"ACORN_DEBUG_LEVEL"`
+	NoAuthCache     bool   `usage:"Resolve registry credentials on every use instead of reusing them for a short time" env:"ACORN_NO_AUTH_CACHE"`
 }
 
 func setEnv(key, value string) error {
@@ -109,6 +110,7 @@ func (a *Acorn) PersistentPre(_ *cobra.Command, _ []string) error {
 	if err := setEnv("ACORN_CONFIG_FILE", a.AcornConfigFile); err != nil {
 		return err
 	}
+	registryAuthCache.setDisabled(a.NoAuthCache)
 	if !term.IsTerminal(os.Stdout) || !term.IsTerminal(os.Stderr) || os.Getenv("NO_COLOR") != "" || os.Getenv("NOCOLOR") != "" {
 		pterm.DisableStyling()
 	}
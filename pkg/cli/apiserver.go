@@ -1,15 +1,25 @@
 package cli
 
 import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
 	minkserver "github.com/acorn-io/mink/pkg/server"
 	cli "github.com/acorn-io/runtime/pkg/cli/builder"
 	"github.com/acorn-io/runtime/pkg/logserver"
 	"github.com/acorn-io/runtime/pkg/server"
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"sigs.k8s.io/yaml"
 )
 
 var (
 	opts = minkserver.DefaultOpts()
+
+	// sensitiveFlagPatterns are used to identify flags whose values are redacted by --print-config
+	sensitiveFlagPatterns = []string{"token", "password", "secret"}
 )
 
 func NewApiServer(c CommandContext) *cobra.Command {
@@ -25,13 +35,35 @@ func NewApiServer(c CommandContext) *cobra.Command {
 }
 
 type APIServer struct {
-	client ClientFactory
+	PrintConfig         bool   `usage:"Print the resolved configuration as YAML and exit without starting the server" local:"true"`
+	ShutdownGracePeriod string `usage:"Time to let in-flight requests (e.g. exec and log streams) finish on shutdown before closing them" local:"true" default:"0s"`
+	client              ClientFactory
+}
+
+type apiServerConfig struct {
+	Version string            `json:"version,omitempty"`
+	Flags   map[string]string `json:"flags,omitempty"`
 }
 
 func (a *APIServer) Run(cmd *cobra.Command, args []string) error {
+	if a.PrintConfig {
+		data, err := yaml.Marshal(resolvedAPIServerConfig(cmd))
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprint(cmd.OutOrStdout(), string(data))
+		return err
+	}
+
+	shutdownGracePeriod, err := time.ParseDuration(a.ShutdownGracePeriod)
+	if err != nil {
+		return fmt.Errorf("invalid shutdown grace period %q: %w", a.ShutdownGracePeriod, err)
+	}
+
 	cfg, err := server.New(server.Config{
-		Version:     cmd.Version,
-		DefaultOpts: opts,
+		Version:             cmd.Version,
+		DefaultOpts:         opts,
+		ShutdownGracePeriod: shutdownGracePeriod,
 	})
 	if err != nil {
 		return err
@@ -44,5 +76,41 @@ func (a *APIServer) Run(cmd *cobra.Command, args []string) error {
 	logserver.StartServerWithDefaults()
 
 	<-cmd.Context().Done()
+	<-cfg.Done()
 	return cmd.Context().Err()
 }
+
+// resolvedAPIServerConfig collects the effective value of every flag, after defaults and environment variables have been applied
+func resolvedAPIServerConfig(cmd *cobra.Command) apiServerConfig {
+	cfg := apiServerConfig{
+		Version: cmd.Version,
+		Flags:   map[string]string{},
+	}
+
+	cmd.Flags().VisitAll(func(f *pflag.Flag) {
+		if f.Name == "help" || f.Name == "print-config" {
+			return
+		}
+		value := f.Value.String()
+		if value != "" && f.Value.Type() == "string" && isSensitiveFlag(f.Name) {
+			value = "***"
+		}
+		cfg.Flags[f.Name] = value
+	})
+
+	// The listen port is always overridden when the server is created
+	if _, ok := cfg.Flags["secure-port"]; ok {
+		cfg.Flags["secure-port"] = strconv.Itoa(server.HTTPSListenPort)
+	}
+
+	return cfg
+}
+
+func isSensitiveFlag(name string) bool {
+	for _, pattern := range sensitiveFlagPatterns {
+		if strings.Contains(name, pattern) {
+			return true
+		}
+	}
+	return false
+}
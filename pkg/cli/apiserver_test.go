@@ -0,0 +1,29 @@
+package cli
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"sigs.k8s.io/yaml"
+)
+
+func TestAPIServerPrintConfig(t *testing.T) {
+	cmd := NewApiServer(CommandContext{})
+	cmd.Flags().String("bearer-token", "", "")
+
+	out := &bytes.Buffer{}
+	cmd.SetOut(out)
+	cmd.SetArgs([]string{"--print-config", "--bind-address", "127.0.0.1", "--bearer-token", "supersecret"})
+	require.NoError(t, cmd.Execute())
+
+	cfg := apiServerConfig{}
+	require.NoError(t, yaml.Unmarshal(out.Bytes(), &cfg))
+
+	assert.Equal(t, "127.0.0.1", cfg.Flags["bind-address"])
+	assert.Equal(t, "***", cfg.Flags["bearer-token"])
+	assert.Equal(t, "7443", cfg.Flags["secure-port"])
+	assert.NotEqual(t, "***", cfg.Flags["authentication-token-webhook-cache-ttl"])
+	assert.NotContains(t, out.String(), "supersecret")
+}
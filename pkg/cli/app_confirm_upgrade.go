@@ -0,0 +1,73 @@
+package cli
+
+import (
+	"fmt"
+
+	cli "github.com/acorn-io/runtime/pkg/cli/builder"
+	"github.com/spf13/cobra"
+)
+
+func NewAppConfirmUpgrade(c CommandContext) *cobra.Command {
+	return cli.Command(&AppConfirmUpgrade{client: c.ClientFactory}, cobra.Command{
+		Use: "confirm-upgrade [flags] [ACORN_NAME...]",
+		Example: `
+# Upgrade an app with --notify-upgrade to the new image it is notified of, shown in the message of acorn app
+acorn app confirm-upgrade my-app
+
+# Confirm the upgrades of all apps of the project that have one available
+acorn app confirm-upgrade --all`,
+		SilenceUsage:      true,
+		Short:             "Confirm the available upgrade of an app with --notify-upgrade",
+		ValidArgsFunction: newCompletion(c.ClientFactory, appsCompletion).complete,
+	})
+}
+
+type AppConfirmUpgrade struct {
+	All    bool `usage:"Confirm the upgrades of all apps that have one available" short:"a"`
+	client ClientFactory
+}
+
+func (a *AppConfirmUpgrade) Run(cmd *cobra.Command, args []string) error {
+	if a.All && len(args) > 0 {
+		return fmt.Errorf("--all can not be combined with app names")
+	} else if !a.All && len(args) == 0 {
+		return fmt.Errorf("at least one app name or --all is required")
+	}
+
+	c, err := a.client.CreateDefault()
+	if err != nil {
+		return err
+	}
+
+	if a.All {
+		apps, err := c.AppList(cmd.Context())
+		if err != nil {
+			return err
+		}
+		for _, app := range apps {
+			if app.Status.ConfirmUpgradeAppImage != "" {
+				args = append(args, app.Name)
+			}
+		}
+	} else {
+		// Confirming an app without an available upgrade is a no-op on the server, fail instead of pretending it worked
+		for _, arg := range args {
+			app, err := c.AppGet(cmd.Context(), arg)
+			if err != nil {
+				return err
+			}
+			if app.Status.ConfirmUpgradeAppImage == "" {
+				return fmt.Errorf("app %s has no upgrade to confirm", arg)
+			}
+		}
+	}
+
+	for _, arg := range args {
+		if err := c.AppConfirmUpgrade(cmd.Context(), arg); err != nil {
+			return fmt.Errorf("confirming upgrade of %s: %w", arg, err)
+		}
+		fmt.Println(arg)
+	}
+
+	return nil
+}
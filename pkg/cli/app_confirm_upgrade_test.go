@@ -0,0 +1,98 @@
+package cli
+
+import (
+	"io"
+	"os"
+	"strings"
+	"testing"
+
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/cli/testdata"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestAppConfirmUpgrade(t *testing.T) {
+	pending := apiv1.App{
+		ObjectMeta: metav1.ObjectMeta{Name: "pending"},
+		Status: v1.AppInstanceStatus{
+			ConfirmUpgradeAppImage: "ghcr.io/acme/app:v2",
+			ConfirmUpgradeDigest:   "sha256:1234",
+		},
+	}
+
+	tests := []struct {
+		name    string
+		args    []string
+		appList []apiv1.App
+		appItem *apiv1.App
+		wantErr bool
+		wantOut string
+	}{
+		{
+			name:    "acorn app confirm-upgrade pending",
+			args:    []string{"confirm-upgrade", "pending"},
+			appItem: &pending,
+			wantOut: "pending\n",
+		},
+		{
+			name:    "acorn app confirm-upgrade found",
+			args:    []string{"confirm-upgrade", "found"},
+			wantErr: true,
+			wantOut: "app found has no upgrade to confirm",
+		},
+		{
+			name:    "acorn app confirm-upgrade dne",
+			args:    []string{"confirm-upgrade", "dne"},
+			wantErr: true,
+			wantOut: "error: app dne does not exist",
+		},
+		{
+			name:    "acorn app confirm-upgrade --all",
+			args:    []string{"confirm-upgrade", "--all"},
+			appList: []apiv1.App{{ObjectMeta: metav1.ObjectMeta{Name: "found"}}, pending},
+			wantOut: "pending\n",
+		},
+		{
+			name:    "acorn app confirm-upgrade --all found",
+			args:    []string{"confirm-upgrade", "--all", "found"},
+			wantErr: true,
+			wantOut: "--all can not be combined with app names",
+		},
+		{
+			name:    "acorn app confirm-upgrade",
+			args:    []string{"confirm-upgrade"},
+			wantErr: true,
+			wantOut: "at least one app name or --all is required",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, w, _ := os.Pipe()
+			os.Stdout = w
+			cmd := NewPs(CommandContext{
+				ClientFactory: &testdata.MockClientFactory{
+					AppList: tt.appList,
+					AppItem: tt.appItem,
+				},
+				StdOut: w,
+				StdErr: w,
+				StdIn:  strings.NewReader(""),
+			})
+			cmd.SetArgs(tt.args)
+			err := cmd.Execute()
+			if err != nil && !tt.wantErr {
+				assert.Failf(t, "got err when err not expected", "got err: %s", err.Error())
+			} else if err != nil && tt.wantErr {
+				assert.Equal(t, tt.wantOut, err.Error())
+			} else if tt.wantErr {
+				assert.Fail(t, "expected an error")
+			} else {
+				w.Close()
+				out, _ := io.ReadAll(r)
+				assert.Equal(t, tt.wantOut, string(out))
+			}
+		})
+	}
+}
@@ -0,0 +1,137 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/acorn-io/baaah/pkg/typed"
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	cli "github.com/acorn-io/runtime/pkg/cli/builder"
+	"github.com/acorn-io/runtime/pkg/portforward"
+	"github.com/spf13/cobra"
+)
+
+func NewAppConnect(c CommandContext) *cobra.Command {
+	cmd := cli.Command(&AppConnect{client: c.ClientFactory}, cobra.Command{
+		Use: "connect [flags] ACORN_NAME",
+		Example: `
+# Connect to the database of an app on localhost:5432 without publishing its port
+acorn app connect my-app --port 5432
+
+# Forward local port 8080 to port 80 and local port 9090 to the admin port of the "api" container
+acorn app connect my-app -p 8080:80 -p 9090:9000 -c api`,
+		SilenceUsage:      true,
+		Short:             "Forward local ports to ports of the containers of an app",
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: newCompletion(c.ClientFactory, appsCompletion).withShouldCompleteOptions(onlyNumArgs(1)).complete,
+	})
+
+	// This will produce an error if the container flag doesn't exist or a completion function has already
+	// been registered for this flag. Not returning the error since neither of these is likely occur.
+	if err := cmd.RegisterFlagCompletionFunc("container", newCompletion(c.ClientFactory, acornContainerCompletion).complete); err != nil {
+		cmd.Printf("Error registering completion function for -c flag: %v\n", err)
+	}
+
+	return cmd
+}
+
+type AppConnect struct {
+	Port      []string `usage:"Forward a local port to a port of the app, can be repeated, fails if the local port is in use (format [local:]remote) (ex 5432, 8080:80)" short:"p"`
+	Container string   `usage:"Name of the container to connect to, by default the container that declares the port" short:"c"`
+	Address   string   `usage:"The IP address to listen on" default:"127.0.0.1"`
+	client    ClientFactory
+}
+
+func (s *AppConnect) Run(cmd *cobra.Command, args []string) error {
+	if len(s.Port) == 0 {
+		return fmt.Errorf("at least one --port is required")
+	}
+
+	ctx := cmd.Context()
+	c, err := s.client.CreateDefault()
+	if err != nil {
+		return err
+	}
+
+	app, err := c.AppGet(ctx, args[0])
+	if err != nil {
+		return err
+	}
+
+	var forwards []portforward.Forward
+	for _, portDef := range s.Port {
+		local, remote, err := parseConnectPort(portDef)
+		if err != nil {
+			return err
+		}
+
+		containerName := s.Container
+		if containerName == "" {
+			containerName, remote = containerForPort(app, remote)
+		} else {
+			_, remote = containerPort(app, containerName, remote)
+		}
+
+		replica, err := getContainerForApp(ctx, c, app, containerName, true)
+		if err != nil {
+			return err
+		}
+
+		// The user connects to the local port they asked for, so it is never swapped for the next free one
+		forwards = append(forwards, portforward.Forward{
+			ContainerName: replica,
+			PortDef:       fmt.Sprintf("%d:%d", local, remote),
+			ExactPort:     true,
+		})
+	}
+
+	return portforward.PortForwards(ctx, c, s.Address, forwards)
+}
+
+// parseConnectPort parses a port mapping in the format [local:]remote. The local port defaults to the remote one.
+func parseConnectPort(portDef string) (int, int, error) {
+	local, remote, ok := strings.Cut(portDef, ":")
+	if !ok {
+		remote = local
+	}
+
+	localPort, err := strconv.Atoi(local)
+	if err != nil || localPort <= 0 || localPort > 65535 {
+		return 0, 0, fmt.Errorf("invalid port %s, must be in the format [local:]remote", portDef)
+	}
+	remotePort, err := strconv.Atoi(remote)
+	if err != nil || remotePort <= 0 || remotePort > 65535 {
+		return 0, 0, fmt.Errorf("invalid port %s, must be in the format [local:]remote", portDef)
+	}
+	return localPort, remotePort, nil
+}
+
+// containerForPort returns the first container of the app, by name, that declares the port, and the port the
+// container listens on. If no container declares the port, the user picks one of the containers of the app.
+func containerForPort(app *apiv1.App, port int) (string, int) {
+	for _, entry := range typed.Sorted(app.Status.AppSpec.Containers) {
+		if ok, targetPort := containerPort(app, entry.Key, port); ok {
+			return entry.Key, targetPort
+		}
+	}
+	return "", port
+}
+
+// containerPort tells whether the container declares the port, and returns the port the container listens on. The
+// port is either that one or a port the container is reached on through its service.
+func containerPort(app *apiv1.App, containerName string, port int) (bool, int) {
+	for _, portDef := range app.Status.AppSpec.Containers[containerName].Ports {
+		portDef = portDef.Complete()
+		if int(portDef.TargetPort) == port {
+			return true, port
+		}
+	}
+	for _, portDef := range app.Status.AppSpec.Containers[containerName].Ports {
+		portDef = portDef.Complete()
+		if int(portDef.Port) == port {
+			return true, int(portDef.TargetPort)
+		}
+	}
+	return false, port
+}
@@ -0,0 +1,59 @@
+package cli
+
+import (
+	"testing"
+
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseConnectPort(t *testing.T) {
+	local, remote, err := parseConnectPort("5432")
+	require.NoError(t, err)
+	assert.Equal(t, 5432, local)
+	assert.Equal(t, 5432, remote)
+
+	local, remote, err = parseConnectPort("8080:80")
+	require.NoError(t, err)
+	assert.Equal(t, 8080, local)
+	assert.Equal(t, 80, remote)
+
+	_, _, err = parseConnectPort("db:5432")
+	assert.EqualError(t, err, "invalid port db:5432, must be in the format [local:]remote")
+
+	_, _, err = parseConnectPort("8080:70000")
+	assert.EqualError(t, err, "invalid port 8080:70000, must be in the format [local:]remote")
+}
+
+func TestContainerForPort(t *testing.T) {
+	app := &apiv1.App{
+		Status: v1.AppInstanceStatus{
+			AppSpec: v1.AppSpec{
+				Containers: map[string]v1.Container{
+					"web": {Ports: []v1.PortDef{{Port: 80, TargetPort: 8080}}},
+					"db":  {Ports: []v1.PortDef{{Port: 5432}}},
+					"api": {Ports: []v1.PortDef{{Port: 80, TargetPort: 9000}, {Port: 9090}}},
+				},
+			},
+		},
+	}
+
+	container, port := containerForPort(app, 5432)
+	assert.Equal(t, "db", container)
+	assert.Equal(t, 5432, port)
+
+	// The port of the service is translated to the port the container listens on
+	container, port = containerForPort(app, 80)
+	assert.Equal(t, "api", container)
+	assert.Equal(t, 9000, port)
+
+	container, port = containerForPort(app, 8080)
+	assert.Equal(t, "web", container)
+	assert.Equal(t, 8080, port)
+
+	container, port = containerForPort(app, 1234)
+	assert.Equal(t, "", container)
+	assert.Equal(t, 1234, port)
+}
@@ -0,0 +1,442 @@
+package cli
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/acorn-io/baaah/pkg/typed"
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	cli "github.com/acorn-io/runtime/pkg/cli/builder"
+	"github.com/acorn-io/runtime/pkg/client"
+	"github.com/acorn-io/runtime/pkg/version"
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// appDebugAPIVersion is bumped whenever fields of the bundle are removed or change their meaning, adding fields
+	// doesn't change it
+	appDebugAPIVersion = "debug.acorn.io/v1"
+	appDebugKind       = "AppDebugBundle"
+	redactedValue      = "REDACTED"
+)
+
+// appDebugRedacted lists the fields of the app whose values are replaced by REDACTED in a bundle, it is recorded in the
+// bundle so readers know which values are missing
+var appDebugRedacted = []string{
+	"spec.environment[].value",
+	"spec.deployArgs",
+	"status.devSession.specOverride.environment[].value",
+	"status.devSession.specOverride.deployArgs",
+	"status.appSpec.containers.*.environment[].value",
+	"status.appSpec.containers.*.files.*.content",
+	"status.appSpec.containers.*.sidecars.*.environment[].value",
+	"status.appSpec.containers.*.sidecars.*.files.*.content",
+	"status.appSpec.functions.*.environment[].value",
+	"status.appSpec.functions.*.files.*.content",
+	"status.appSpec.functions.*.sidecars.*.environment[].value",
+	"status.appSpec.functions.*.sidecars.*.files.*.content",
+	"status.appSpec.jobs.*.environment[].value",
+	"status.appSpec.jobs.*.files.*.content",
+	"status.appSpec.jobs.*.sidecars.*.environment[].value",
+	"status.appSpec.jobs.*.sidecars.*.files.*.content",
+	"status.appSpec.secrets.*.data",
+	"status.appSpec.secrets.*.params",
+	"status.appImage.buildArgs",
+}
+
+func NewAppDebug(c CommandContext) *cobra.Command {
+	return cli.Command(&AppDebug{out: c.StdOut, client: c.ClientFactory}, cobra.Command{
+		Use: "debug [flags] ACORN_NAME",
+		Example: `
+# Collect the diagnostics of an app into my-app-debug-<timestamp>.tar.gz
+acorn app debug my-app
+
+# Collect the last 1000 log lines of each container into a file of your choice
+acorn app debug -n 1000 --file my-app.tar.gz my-app
+
+# Print the diagnostics as a single JSON document
+acorn app debug -o json my-app`,
+		Long: `Collect the diagnostics of an app into a single bundle to attach to a bug report or support ticket
+
+The bundle contains the app with its spec and status, the images it runs, its container replicas, its events and
+the last log lines of each container. The values of secrets, deploy args, environment overrides, build args and the
+environment variables and files of the containers, jobs and sidecars are replaced by REDACTED. Log lines are included
+as they are, check them before sharing the bundle.
+
+The tarball has a manifest.json that describes the bundle and lists its files, app.json, images.json,
+containers.json and events.json, and a file per container replica under logs/. With -o json the same content is
+printed as one JSON document instead.`,
+		SilenceUsage:      true,
+		Short:             "Collect the diagnostics of an app into a bundle",
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: newCompletion(c.ClientFactory, appsCompletion).withShouldCompleteOptions(onlyNumArgs(1)).complete,
+	})
+}
+
+type AppDebug struct {
+	Tail   int64  `usage:"Number of latest log lines to include per container" short:"n" default:"200"`
+	Since  string `usage:"Only include events and logs since timestamp (e.g. 42m for 42 minutes ago or 2006-01-02T15:04:05Z)" short:"s"`
+	Output string `usage:"Output format (tar, json), json prints the bundle to stdout" short:"o" default:"tar"`
+	File   string `usage:"File to write the tarball to, - for stdout (default ACORN_NAME-debug-TIMESTAMP.tar.gz)"`
+
+	out    io.Writer
+	client ClientFactory
+}
+
+type appDebugBundle struct {
+	APIVersion    string      `json:"apiVersion"`
+	Kind          string      `json:"kind"`
+	CreatedAt     metav1.Time `json:"createdAt"`
+	ClientVersion string      `json:"clientVersion"`
+	App           string      `json:"app"`
+	LogTail       int64       `json:"logTail"`
+	Since         string      `json:"since,omitempty"`
+	Redacted      []string    `json:"redacted"`
+	// Errors are the parts that could not be collected, the rest of the bundle is still valid
+	Errors []string `json:"errors,omitempty"`
+	// Files are the files of the tarball, only set in its manifest.json
+	Files []string `json:"files,omitempty"`
+
+	AppInstance *apiv1.App                   `json:"appInstance,omitempty"`
+	Images      []appDebugImage              `json:"images,omitempty"`
+	Containers  []appDebugContainer          `json:"containers,omitempty"`
+	Events      []apiv1.Event                `json:"events,omitempty"`
+	Logs        map[string][]appDebugLogLine `json:"logs,omitempty"`
+}
+
+type appDebugImage struct {
+	Kind   string `json:"kind"`
+	Name   string `json:"name"`
+	Image  string `json:"image"`
+	Digest string `json:"digest,omitempty"`
+}
+
+type appDebugContainer struct {
+	Name         string                `json:"name"`
+	Container    string                `json:"container,omitempty"`
+	Job          string                `json:"job,omitempty"`
+	Sidecar      string                `json:"sidecar,omitempty"`
+	Phase        corev1.PodPhase       `json:"phase,omitempty"`
+	Message      string                `json:"message,omitempty"`
+	Reason       string                `json:"reason,omitempty"`
+	Ready        bool                  `json:"ready"`
+	RestartCount int32                 `json:"restartCount"`
+	Image        string                `json:"image,omitempty"`
+	ImageID      string                `json:"imageID,omitempty"`
+	State        corev1.ContainerState `json:"state,omitempty"`
+	LastState    corev1.ContainerState `json:"lastState,omitempty"`
+}
+
+type appDebugLogLine struct {
+	Time metav1.Time `json:"time,omitempty"`
+	Line string      `json:"line"`
+	Init bool        `json:"init,omitempty"`
+}
+
+func (a *AppDebug) Run(cmd *cobra.Command, args []string) error {
+	switch a.Output {
+	case "tar", "json":
+	default:
+		return fmt.Errorf("invalid output format %s, must be one of tar or json", a.Output)
+	}
+	if a.Output == "json" && a.File != "" {
+		return fmt.Errorf("--file can only be used with -o tar")
+	}
+
+	c, err := a.client.CreateDefault()
+	if err != nil {
+		return err
+	}
+
+	app, err := c.AppGet(cmd.Context(), args[0])
+	if err != nil {
+		return err
+	}
+
+	now := time.Now().UTC()
+	bundle := &appDebugBundle{
+		APIVersion:    appDebugAPIVersion,
+		Kind:          appDebugKind,
+		CreatedAt:     metav1.NewTime(now),
+		ClientVersion: version.Get().String(),
+		App:           app.Name,
+		LogTail:       a.Tail,
+		Since:         a.Since,
+		Redacted:      appDebugRedacted,
+		AppInstance:   redactApp(app),
+		Images:        appDebugImages(app),
+		Logs:          map[string][]appDebugLogLine{},
+	}
+
+	replicas, err := c.ContainerReplicaList(cmd.Context(), &client.ContainerReplicaListOptions{App: app.Name})
+	if err != nil {
+		bundle.Errors = append(bundle.Errors, fmt.Sprintf("listing containers: %v", err))
+	}
+	for _, replica := range replicas {
+		bundle.Containers = append(bundle.Containers, appDebugContainer{
+			Name:         replica.Name,
+			Container:    replica.Spec.ContainerName,
+			Job:          replica.Spec.JobName,
+			Sidecar:      replica.Spec.SidecarName,
+			Phase:        replica.Status.Phase,
+			Message:      replica.Status.PodMessage,
+			Reason:       replica.Status.PodReason,
+			Ready:        replica.Status.Ready,
+			RestartCount: replica.Status.RestartCount,
+			Image:        replica.Status.Image,
+			ImageID:      replica.Status.ImageID,
+			State:        replica.Status.State,
+			LastState:    replica.Status.LastTerminationState,
+		})
+	}
+
+	events, err := c.EventStream(cmd.Context(), &client.EventStreamOptions{Since: a.Since})
+	if err != nil {
+		bundle.Errors = append(bundle.Errors, fmt.Sprintf("listing events: %v", err))
+	} else {
+		for event := range events {
+			if isAppEvent(app.Name, event) {
+				bundle.Events = append(bundle.Events, event)
+			}
+		}
+	}
+
+	logs, err := c.AppLog(cmd.Context(), app.Name, &client.LogOptions{
+		LogOptions: apiv1.LogOptions{
+			Tail:  &a.Tail,
+			Since: a.Since,
+		},
+	})
+	if err != nil {
+		bundle.Errors = append(bundle.Errors, fmt.Sprintf("reading logs: %v", err))
+	} else {
+		for msg := range logs {
+			if msg.Error != "" {
+				bundle.Errors = append(bundle.Errors, fmt.Sprintf("reading logs of %s: %s", msg.ContainerName, msg.Error))
+				continue
+			}
+			if msg.ContainerName == "" {
+				continue
+			}
+			bundle.Logs[msg.ContainerName] = append(bundle.Logs[msg.ContainerName], appDebugLogLine{
+				Time: msg.Time,
+				Line: msg.Line,
+				Init: msg.Init,
+			})
+		}
+	}
+
+	if a.Output == "json" {
+		return printJSON(bundle)
+	}
+
+	if a.File == "-" {
+		return writeAppDebugTarball(a.out, bundle)
+	}
+
+	file := a.File
+	if file == "" {
+		file = fmt.Sprintf("%s-debug-%s.tar.gz", app.Name, now.Format("20060102T150405Z"))
+	}
+	// Logs may contain sensitive data the bundle can't redact, so only make it readable by the user
+	f, err := os.OpenFile(file, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	if err := writeAppDebugTarball(f, bundle); err != nil {
+		_ = f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	_, err = fmt.Fprintln(a.out, file)
+	return err
+}
+
+// writeAppDebugTarball writes the bundle as a gzipped tarball with a file per part of the bundle and a manifest.json
+// describing them
+func writeAppDebugTarball(out io.Writer, bundle *appDebugBundle) error {
+	files := map[string]any{
+		"app.json":        bundle.AppInstance,
+		"images.json":     bundle.Images,
+		"containers.json": bundle.Containers,
+		"events.json":     bundle.Events,
+	}
+	names := []string{"app.json", "images.json", "containers.json", "events.json"}
+
+	logs := map[string][]byte{}
+	for container, lines := range bundle.Logs {
+		name := path.Join("logs", strings.ReplaceAll(container, "/", "_")+".log")
+		var buf strings.Builder
+		for _, line := range lines {
+			if line.Init {
+				buf.WriteString("[init] ")
+			}
+			buf.WriteString(line.Time.UTC().Format(time.RFC3339Nano))
+			buf.WriteString(" ")
+			buf.WriteString(line.Line)
+			buf.WriteString("\n")
+		}
+		logs[name] = []byte(buf.String())
+		names = append(names, name)
+	}
+	sort.Strings(names[4:])
+
+	manifest := *bundle
+	manifest.AppInstance, manifest.Images, manifest.Containers, manifest.Events, manifest.Logs = nil, nil, nil, nil, nil
+	manifest.Files = names
+
+	gz := gzip.NewWriter(out)
+	tw := tar.NewWriter(gz)
+	if err := writeTarJSON(tw, "manifest.json", &manifest, bundle.CreatedAt.Time); err != nil {
+		return err
+	}
+	for _, name := range names {
+		var err error
+		if data, ok := logs[name]; ok {
+			err = writeTarFile(tw, name, data, bundle.CreatedAt.Time)
+		} else {
+			err = writeTarJSON(tw, name, files[name], bundle.CreatedAt.Time)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	if err := tw.Close(); err != nil {
+		return err
+	}
+	return gz.Close()
+}
+
+func writeTarJSON(tw *tar.Writer, name string, obj any, modTime time.Time) error {
+	data, err := json.MarshalIndent(obj, "", "  ")
+	if err != nil {
+		return err
+	}
+	return writeTarFile(tw, name, append(data, '\n'), modTime)
+}
+
+func writeTarFile(tw *tar.Writer, name string, data []byte, modTime time.Time) error {
+	if err := tw.WriteHeader(&tar.Header{
+		Typeflag: tar.TypeReg,
+		Name:     name,
+		Mode:     0600,
+		Size:     int64(len(data)),
+		ModTime:  modTime,
+	}); err != nil {
+		return err
+	}
+	_, err := tw.Write(data)
+	return err
+}
+
+// appDebugImages returns the app image and the images of the containers, jobs, sidecars and nested acorns of the app
+// as resolved when the app image was pulled or built
+func appDebugImages(app *apiv1.App) []appDebugImage {
+	appImage := app.Status.AppImage
+	result := []appDebugImage{{
+		Kind:   "app",
+		Name:   app.Name,
+		Image:  appImage.Name,
+		Digest: appImage.Digest,
+	}}
+
+	add := func(kind string, images map[string]v1.ContainerData) {
+		for _, name := range typed.SortedKeys(images) {
+			result = append(result, appDebugImage{Kind: kind, Name: name, Image: images[name].Image})
+			for _, sidecar := range typed.SortedKeys(images[name].Sidecars) {
+				result = append(result, appDebugImage{Kind: "sidecar", Name: name + "." + sidecar, Image: images[name].Sidecars[sidecar].Image})
+			}
+		}
+	}
+	add("container", appImage.ImageData.Containers)
+	add("function", appImage.ImageData.Functions)
+	add("job", appImage.ImageData.Jobs)
+	for _, name := range typed.SortedKeys(appImage.ImageData.Images) {
+		result = append(result, appDebugImage{Kind: "image", Name: name, Image: appImage.ImageData.Images[name].Image})
+	}
+	for _, name := range typed.SortedKeys(appImage.ImageData.Acorns) {
+		result = append(result, appDebugImage{Kind: "acorn", Name: name, Image: appImage.ImageData.Acorns[name].Image})
+	}
+	return result
+}
+
+// redactApp returns a copy of app with the fields listed in appDebugRedacted redacted and without managed fields
+func redactApp(app *apiv1.App) *apiv1.App {
+	app = app.DeepCopy()
+	app.ManagedFields = nil
+	redactAppSpec(&app.Spec)
+	if app.Status.DevSession != nil && app.Status.DevSession.SpecOverride != nil {
+		redactAppSpec(app.Status.DevSession.SpecOverride)
+	}
+	redactContainers(app.Status.AppSpec.Containers)
+	redactContainers(app.Status.AppSpec.Functions)
+	redactContainers(app.Status.AppSpec.Jobs)
+	for name, secret := range app.Status.AppSpec.Secrets {
+		for k := range secret.Data {
+			secret.Data[k] = redactedValue
+		}
+		secret.Params = redactGenericMap(secret.Params)
+		app.Status.AppSpec.Secrets[name] = secret
+	}
+	app.Status.AppImage.BuildArgs = redactGenericMap(app.Status.AppImage.BuildArgs)
+	return app
+}
+
+func redactAppSpec(spec *v1.AppInstanceSpec) {
+	for i := range spec.Environment {
+		spec.Environment[i].Value = redactedValue
+	}
+	spec.DeployArgs = redactGenericMap(spec.DeployArgs)
+}
+
+// redactContainers redacts the environment values and file contents of the containers and their sidecars, values that
+// reference secrets only name them and are kept
+func redactContainers(containers map[string]v1.Container) {
+	for name, container := range containers {
+		redactContainer(&container)
+		for sidecarName, sidecar := range container.Sidecars {
+			redactContainer(&sidecar)
+			container.Sidecars[sidecarName] = sidecar
+		}
+		containers[name] = container
+	}
+}
+
+func redactContainer(container *v1.Container) {
+	for i := range container.Environment {
+		if container.Environment[i].Value != "" {
+			container.Environment[i].Value = redactedValue
+		}
+	}
+	for path, file := range container.Files {
+		if file.Content != "" {
+			file.Content = redactedValue
+			container.Files[path] = file
+		}
+	}
+}
+
+// redactGenericMap keeps the keys of m, so it's visible which args were set, and replaces their values
+func redactGenericMap(m *v1.GenericMap) *v1.GenericMap {
+	if m == nil {
+		return nil
+	}
+	result := &v1.GenericMap{Data: map[string]any{}}
+	for k := range m.Data {
+		result.Data[k] = redactedValue
+	}
+	return result
+}
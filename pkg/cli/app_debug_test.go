@@ -0,0 +1,151 @@
+package cli
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/cli/testdata"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestAppDebug(t *testing.T) {
+	app := &apiv1.App{
+		ObjectMeta: metav1.ObjectMeta{Name: "found"},
+		Spec: v1.AppInstanceSpec{
+			Environment: []v1.NameValue{{Name: "API_KEY", Value: "hunter2"}},
+			DeployArgs:  &v1.GenericMap{Data: map[string]any{"password": "hunter2"}},
+		},
+		Status: v1.AppInstanceStatus{
+			AppImage: v1.AppImage{
+				Name:   "ghcr.io/acme/app:v1",
+				Digest: "sha256:1234",
+				ImageData: v1.ImagesData{
+					Containers: map[string]v1.ContainerData{"web": {Image: "sha256:5678"}},
+				},
+			},
+			AppSpec: v1.AppSpec{
+				Containers: map[string]v1.Container{
+					"web": {
+						Environment: v1.EnvVars{{Name: "TOKEN", Value: "hunter2"}, {Name: "DB_PASSWORD", Secret: v1.SecretReference{Name: "db", Key: "password"}}},
+						Files:       v1.Files{"/etc/app.conf": {Content: "aHVudGVyMg=="}},
+						Sidecars: map[string]v1.Container{
+							"proxy": {Environment: v1.EnvVars{{Name: "TOKEN", Value: "hunter2"}}},
+						},
+					},
+				},
+				Jobs: map[string]v1.Container{
+					"migrate": {Files: v1.Files{"/run.sh": {Content: "aHVudGVyMg=="}}},
+				},
+				Secrets: map[string]v1.Secret{"db": {Type: "basic", Data: map[string]string{"password": "hunter2"}}},
+			},
+		},
+	}
+	events := []apiv1.Event{
+		{ObjectMeta: metav1.ObjectMeta{Name: "event-1"}, AppName: "found"},
+		{ObjectMeta: metav1.ObjectMeta{Name: "event-2"}, AppName: "other"},
+	}
+
+	t.Run("acorn app debug -o json found", func(t *testing.T) {
+		r, w, _ := os.Pipe()
+		os.Stdout = w
+		cmd := NewPs(CommandContext{
+			ClientFactory: &testdata.MockClientFactory{AppItem: app, EventList: events},
+			StdOut:        w,
+			StdErr:        w,
+			StdIn:         strings.NewReader(""),
+		})
+		cmd.SetArgs([]string{"debug", "-o", "json", "found"})
+		require.NoError(t, cmd.Execute())
+		require.NoError(t, w.Close())
+		out, _ := io.ReadAll(r)
+
+		assert.NotContains(t, string(out), "hunter2")
+		assert.NotContains(t, string(out), "aHVudGVyMg==")
+
+		var bundle appDebugBundle
+		require.NoError(t, json.Unmarshal(out, &bundle))
+		assert.Equal(t, appDebugAPIVersion, bundle.APIVersion)
+		assert.Equal(t, appDebugKind, bundle.Kind)
+		assert.Equal(t, "found", bundle.App)
+		assert.Equal(t, int64(200), bundle.LogTail)
+		assert.Empty(t, bundle.Errors)
+		assert.Equal(t, redactedValue, bundle.AppInstance.Spec.Environment[0].Value)
+		assert.Equal(t, redactedValue, bundle.AppInstance.Status.AppSpec.Secrets["db"].Data["password"])
+		web := bundle.AppInstance.Status.AppSpec.Containers["web"]
+		assert.ElementsMatch(t, v1.EnvVars{{Name: "TOKEN", Value: redactedValue}, {Name: "DB_PASSWORD", Secret: v1.SecretReference{Name: "db", Key: "password"}}}, web.Environment)
+		assert.Equal(t, redactedValue, web.Files["/etc/app.conf"].Content)
+		assert.Equal(t, redactedValue, web.Sidecars["proxy"].Environment[0].Value)
+		assert.Equal(t, redactedValue, bundle.AppInstance.Status.AppSpec.Jobs["migrate"].Files["/run.sh"].Content)
+		assert.Equal(t, "hunter2", app.Status.AppSpec.Containers["web"].Environment[0].Value, "the app itself is not changed")
+		assert.Equal(t, []appDebugImage{
+			{Kind: "app", Name: "found", Image: "ghcr.io/acme/app:v1", Digest: "sha256:1234"},
+			{Kind: "container", Name: "web", Image: "sha256:5678"},
+		}, bundle.Images)
+		require.Len(t, bundle.Containers, 1)
+		assert.Equal(t, "found.container", bundle.Containers[0].Name)
+		require.Len(t, bundle.Events, 1)
+		assert.Equal(t, "event-1", bundle.Events[0].Name)
+	})
+
+	t.Run("acorn app debug --file found.tar.gz found", func(t *testing.T) {
+		file := filepath.Join(t.TempDir(), "found.tar.gz")
+		cmd := NewPs(CommandContext{
+			ClientFactory: &testdata.MockClientFactory{AppItem: app, EventList: events},
+			StdOut:        io.Discard,
+			StdErr:        io.Discard,
+			StdIn:         strings.NewReader(""),
+		})
+		cmd.SetArgs([]string{"debug", "--file", file, "found"})
+		require.NoError(t, cmd.Execute())
+
+		f, err := os.Open(file)
+		require.NoError(t, err)
+		defer f.Close()
+		gz, err := gzip.NewReader(f)
+		require.NoError(t, err)
+		tr := tar.NewReader(gz)
+
+		contents := map[string]string{}
+		var names []string
+		for {
+			header, err := tr.Next()
+			if err == io.EOF {
+				break
+			}
+			require.NoError(t, err)
+			data, err := io.ReadAll(tr)
+			require.NoError(t, err)
+			names = append(names, header.Name)
+			contents[header.Name] = string(data)
+		}
+		assert.Equal(t, []string{"manifest.json", "app.json", "images.json", "containers.json", "events.json"}, names)
+		assert.NotContains(t, contents["app.json"], "hunter2")
+
+		var manifest appDebugBundle
+		require.NoError(t, json.Unmarshal([]byte(contents["manifest.json"]), &manifest))
+		assert.Equal(t, appDebugKind, manifest.Kind)
+		assert.Equal(t, names[1:], manifest.Files)
+		assert.Nil(t, manifest.AppInstance)
+	})
+
+	t.Run("acorn app debug -o yaml found", func(t *testing.T) {
+		cmd := NewPs(CommandContext{
+			ClientFactory: &testdata.MockClientFactory{AppItem: app},
+			StdOut:        io.Discard,
+			StdErr:        io.Discard,
+			StdIn:         strings.NewReader(""),
+		})
+		cmd.SetArgs([]string{"debug", "-o", "yaml", "found"})
+		assert.EqualError(t, cmd.Execute(), "invalid output format yaml, must be one of tar or json")
+	})
+}
@@ -0,0 +1,135 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	cli "github.com/acorn-io/runtime/pkg/cli/builder"
+	"github.com/acorn-io/runtime/pkg/client"
+	"github.com/acorn-io/runtime/pkg/imagesource"
+	"github.com/spf13/cobra"
+)
+
+func NewAppDiff(c CommandContext) *cobra.Command {
+	cmd := cli.Command(&AppDiff{out: c.StdOut, client: c.ClientFactory}, cobra.Command{
+		Use: "diff [flags] ACORN_NAME [deploy flags]",
+		Example: `
+# Show what changing the image of an app would change
+acorn app diff --image ghcr.io/acorn-io/hello-world:v2 my-app
+
+# Show what changing an environment variable and a deploy arg would change, as JSON
+acorn app diff -o json -e LOG_LEVEL=debug my-app --replicas 3`,
+		SilenceUsage:      true,
+		Short:             "Show how an update would change a running app",
+		Args:              cobra.MinimumNArgs(1),
+		ValidArgsFunction: newCompletion(c.ClientFactory, appsCompletion).withShouldCompleteOptions(onlyNumArgs(1)).complete,
+	})
+	cmd.Flags().SetInterspersed(false)
+	return cmd
+}
+
+type AppDiff struct {
+	Image    string   `usage:"Acorn image name"`
+	File     string   `short:"f" usage:"Name of the build file (default \"DIRECTORY/Acornfile\")"`
+	ArgsFile string   `usage:"Default args to apply to the update" default:".args.acorn"`
+	Volume   []string `usage:"Bind an existing volume (format existing:vol-name,field=value) (ex: pvc-name:app-data)" short:"v" split:"false"`
+	Publish  []string `usage:"Publish port of application (format [public:]private) (ex 81:80)" short:"p"`
+	Env      []string `usage:"Environment variables to set on running containers" short:"e"`
+	Output   string   `usage:"Output format (json)" short:"o"`
+
+	out    io.Writer
+	client ClientFactory
+}
+
+func (a *AppDiff) Run(cmd *cobra.Command, args []string) error {
+	if a.Output != "" && a.Output != "json" {
+		return fmt.Errorf("invalid output format %s, only json is supported", a.Output)
+	}
+
+	c, err := a.client.CreateDefault()
+	if err != nil {
+		return err
+	}
+
+	name := args[0]
+	app, err := c.AppGet(cmd.Context(), name)
+	if err != nil {
+		return err
+	} else if app == nil {
+		return fmt.Errorf("app %s does not exist", name)
+	}
+
+	runArgs := RunArgs{
+		Name: name,
+		UpdateArgs: UpdateArgs{
+			File:     a.File,
+			ArgsFile: a.ArgsFile,
+			Volume:   a.Volume,
+			Publish:  a.Publish,
+			Env:      a.Env,
+		},
+	}
+	opts, err := runArgs.ToOpts()
+	if err != nil {
+		return err
+	}
+	updateOpts := opts.ToUpdate()
+
+	imageSource := imagesource.NewImageSource(a.client.AcornConfigFile(), a.File, a.ArgsFile, append([]string{a.Image}, args[1:]...), nil, false)
+	if imageSource.IsImageSet() {
+		updateOpts.Image, updateOpts.DeployArgs, updateOpts.Profiles, err = imageSource.GetImageAndDeployArgs(cmd.Context(), c)
+		if err != nil {
+			return err
+		}
+	} else if len(imageSource.Args) > 0 {
+		imageSource.Image = app.Status.AppImage.Name
+		if _, updateOpts.DeployArgs, updateOpts.Profiles, err = imageSource.GetImageAndDeployArgs(cmd.Context(), c); err != nil {
+			return err
+		}
+	}
+
+	updated, err := client.ToAppUpdate(cmd.Context(), c, name, &updateOpts)
+	if err != nil {
+		return err
+	}
+
+	diff, err := c.AppDiff(cmd.Context(), name, &updated.Spec)
+	if err != nil {
+		return err
+	}
+
+	if a.Output == "json" {
+		data, err := json.MarshalIndent(diff, "", "  ")
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintln(a.out, string(data))
+		return err
+	}
+
+	_, err = io.WriteString(a.out, formatAppDiff(diff))
+	return err
+}
+
+// formatAppDiff renders diff as a unified diff with one hunk per changed field.
+func formatAppDiff(diff *client.AppDiff) string {
+	if len(diff.Changes) == 0 {
+		return ""
+	}
+
+	buf := &strings.Builder{}
+	fmt.Fprintf(buf, "--- %s (running)\n", diff.Name)
+	fmt.Fprintf(buf, "+++ %s (updated)\n", diff.Name)
+	for _, change := range diff.Changes {
+		fmt.Fprintf(buf, "@@ %s @@\n", change.Field)
+		for _, line := range change.Removed {
+			fmt.Fprintf(buf, "-%s\n", line)
+		}
+		for _, line := range change.Added {
+			fmt.Fprintf(buf, "+%s\n", line)
+		}
+	}
+	return buf.String()
+}
@@ -0,0 +1,73 @@
+package cli
+
+import (
+	"io"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/acorn-io/runtime/pkg/cli/testdata"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAppDiff(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr bool
+		wantOut string
+	}{
+		{
+			name:    "acorn app diff found",
+			args:    []string{"diff", "-e", "FOO=bar", "found"},
+			wantOut: "--- found (running)\n+++ found (updated)\n@@ environment @@\n+FOO=bar\n",
+		},
+		{
+			name:    "acorn app diff found without changes",
+			args:    []string{"diff", "found"},
+			wantOut: "",
+		},
+		{
+			name:    "acorn app diff found -o json",
+			args:    []string{"diff", "-o", "json", "-e", "FOO=bar", "found"},
+			wantOut: "{\n  \"name\": \"found\",\n  \"changes\": [\n    {\n      \"field\": \"environment\",\n      \"added\": [\n        \"FOO=bar\"\n      ]\n    }\n  ]\n}\n",
+		},
+		{
+			name:    "acorn app diff -o yaml found",
+			args:    []string{"diff", "-o", "yaml", "found"},
+			wantErr: true,
+			wantOut: "invalid output format yaml, only json is supported",
+		},
+		{
+			name:    "acorn app diff dne",
+			args:    []string{"diff", "dne"},
+			wantErr: true,
+			wantOut: "error: app dne does not exist",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, w, _ := os.Pipe()
+			os.Stdout = w
+			cmd := NewPs(CommandContext{
+				ClientFactory: &testdata.MockClientFactory{},
+				StdOut:        w,
+				StdErr:        w,
+				StdIn:         strings.NewReader(""),
+			})
+			cmd.SetArgs(tt.args)
+			err := cmd.Execute()
+			if err != nil && !tt.wantErr {
+				assert.Failf(t, "got err when err not expected", "got err: %s", err.Error())
+			} else if err != nil && tt.wantErr {
+				assert.Equal(t, tt.wantOut, err.Error())
+			} else if tt.wantErr {
+				assert.Fail(t, "expected an error")
+			} else {
+				w.Close()
+				out, _ := io.ReadAll(r)
+				assert.Equal(t, tt.wantOut, string(out))
+			}
+		})
+	}
+}
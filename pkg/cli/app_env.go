@@ -0,0 +1,63 @@
+package cli
+
+import (
+	"fmt"
+
+	cli "github.com/acorn-io/runtime/pkg/cli/builder"
+	"github.com/acorn-io/runtime/pkg/cli/builder/table"
+	"github.com/acorn-io/runtime/pkg/client"
+	"github.com/acorn-io/runtime/pkg/tables"
+	"github.com/spf13/cobra"
+)
+
+func NewAppEnv(c CommandContext) *cobra.Command {
+	return cli.Command(&AppEnv{client: c.ClientFactory}, cobra.Command{
+		Use: "env [flags] ACORN_NAME CONTAINER_NAME",
+		Example: `
+# Show the environment of the web container of an app, masking the values that come from secrets
+acorn app env my-app web
+
+# Show the environment including the values of secrets, as JSON
+acorn app env --reveal -o json my-app web`,
+		SilenceUsage:      true,
+		Short:             "Show the environment variables of a container of an app as the container sees them",
+		Args:              cobra.ExactArgs(2),
+		ValidArgsFunction: newCompletion(c.ClientFactory, appsCompletion).withShouldCompleteOptions(onlyNumArgs(1)).complete,
+	})
+}
+
+type AppEnv struct {
+	Reveal bool   `usage:"Show the values that come from secrets instead of masking them"`
+	Output string `usage:"Output format (table, json)" short:"o" default:"table"`
+	client ClientFactory
+}
+
+func (a *AppEnv) Run(cmd *cobra.Command, args []string) error {
+	switch a.Output {
+	case "table", "json":
+	default:
+		return fmt.Errorf("invalid output format %s, must be one of table or json", a.Output)
+	}
+
+	c, err := a.client.CreateDefault()
+	if err != nil {
+		return err
+	}
+
+	env, err := c.AppContainerEnv(cmd.Context(), args[0], args[1], &client.AppContainerEnvOptions{
+		Reveal: a.Reveal,
+	})
+	if err != nil {
+		return err
+	}
+
+	if a.Output == "json" {
+		return printJSON(env)
+	}
+
+	out := table.NewWriter(tables.AppContainerEnv, false, "")
+	for i := range env {
+		out.WriteFormatted(&env[i], nil)
+	}
+	return out.Err()
+}
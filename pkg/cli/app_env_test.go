@@ -0,0 +1,82 @@
+package cli
+
+import (
+	"io"
+	"os"
+	"strings"
+	"testing"
+
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/cli/testdata"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestAppEnv(t *testing.T) {
+	app := &apiv1.App{
+		ObjectMeta: metav1.ObjectMeta{Name: "app"},
+		Status: v1.AppInstanceStatus{
+			AppSpec: v1.AppSpec{
+				Containers: map[string]v1.Container{
+					"web": {
+						Environment: []v1.EnvVar{
+							{Name: "MODE", Value: "production"},
+							{Name: "DB_PASSWORD", Secret: v1.SecretReference{Name: "db", Key: "password"}},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+		wantOut string
+	}{
+		{
+			name:    "acorn app env app web",
+			args:    []string{"env", "app", "web"},
+			wantOut: "NAME          VALUE        SOURCE\nMODE          production   \nDB_PASSWORD   ********     from secret app.db.password\n",
+		},
+		{
+			name:    "acorn app env --reveal -o json app web",
+			args:    []string{"env", "--reveal", "-o", "json", "app", "web"},
+			wantOut: "[\n  {\n    \"name\": \"MODE\",\n    \"value\": \"production\"\n  },\n  {\n    \"name\": \"DB_PASSWORD\",\n    \"value\": \"revealed\",\n    \"source\": \"secret app.db.password\"\n  }\n]\n",
+		},
+		{
+			name:    "acorn app env app worker",
+			args:    []string{"env", "app", "worker"},
+			wantErr: "app app has no container worker",
+		},
+		{
+			name:    "acorn app env -o yaml app web",
+			args:    []string{"env", "-o", "yaml", "app", "web"},
+			wantErr: "invalid output format yaml, must be one of table or json",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, w, _ := os.Pipe()
+			os.Stdout = w
+			cmd := NewPs(CommandContext{
+				ClientFactory: &testdata.MockClientFactory{AppItem: app},
+				StdOut:        w,
+				StdErr:        w,
+				StdIn:         strings.NewReader(""),
+			})
+			cmd.SetArgs(tt.args)
+			err := cmd.Execute()
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Nil(t, w.Close(), "error closing writer")
+			out, _ := io.ReadAll(r)
+			assert.Equal(t, tt.wantOut, string(out))
+		})
+	}
+}
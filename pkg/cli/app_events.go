@@ -0,0 +1,99 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	cli "github.com/acorn-io/runtime/pkg/cli/builder"
+	"github.com/acorn-io/runtime/pkg/cli/builder/table"
+	"github.com/acorn-io/runtime/pkg/client"
+	"github.com/acorn-io/runtime/pkg/tables"
+	"github.com/pterm/pterm"
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/fields"
+)
+
+func NewAppEvents(c CommandContext) *cobra.Command {
+	return cli.Command(&AppEvents{client: c.ClientFactory}, cobra.Command{
+		Use: "events [flags] ACORN_NAME",
+		Example: `
+# List the events of an app, oldest first
+acorn app events my-app
+
+# Follow the events of an app
+acorn app events --watch my-app`,
+		SilenceUsage:      true,
+		Short:             "List the events of an app",
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: newCompletion(c.ClientFactory, appsCompletion).withShouldCompleteOptions(onlyNumArgs(1)).complete,
+	})
+}
+
+type AppEvents struct {
+	Tail   int    `usage:"Return this number of latest events of the app" short:"t"`
+	Watch  bool   `usage:"Watch for new events as they occur" short:"w"`
+	Since  string `usage:"Show all events created since timestamp" short:"s"`
+	Output string `usage:"Output format (json, yaml, {{gotemplate}})" short:"o"`
+	client ClientFactory
+}
+
+func (a *AppEvents) Run(cmd *cobra.Command, args []string) error {
+	c, err := a.client.CreateDefault()
+	if err != nil {
+		return err
+	}
+
+	// Fail early with a clear error instead of silently listing no events
+	app, err := c.AppGet(cmd.Context(), args[0])
+	if err != nil {
+		return err
+	}
+
+	// Filter on the server, so that --tail applies to the events of the app instead of the whole project
+	events, err := c.EventStream(cmd.Context(), &client.EventStreamOptions{
+		Tail:          a.Tail,
+		Follow:        a.Watch,
+		Since:         a.Since,
+		FieldSelector: fields.OneTermEqualSelector("appName", app.Name).String(),
+	})
+	if err != nil {
+		return err
+	}
+
+	out := table.NewWriter(tables.AppEvent, false, a.Output)
+	out.AddFormatFunc("severity", formatEventSeverity)
+	for event := range events {
+		out.Write(&event)
+		if !a.Watch {
+			// Wait to flush until all events have been written, so the columns have a consistent width
+			continue
+		}
+
+		if err := out.Flush(); err != nil {
+			break
+		}
+	}
+
+	return out.Err()
+}
+
+// isAppEvent returns whether the event is about the app, or an app nested in it, or one of their resources
+func isAppEvent(appName string, event apiv1.Event) bool {
+	name := event.AppName
+	if name == "" && event.Resource != nil && event.Resource.Kind == "app" {
+		name = event.Resource.Name
+	}
+	return name == appName || strings.HasPrefix(name, appName+".")
+}
+
+func formatEventSeverity(severity v1.EventSeverity) string {
+	switch severity {
+	case apiv1.EventSeverityError:
+		return pterm.FgRed.Sprint(severity)
+	case apiv1.EventSeverityInfo:
+		return pterm.FgGreen.Sprint(severity)
+	}
+	return fmt.Sprint(severity)
+}
@@ -0,0 +1,112 @@
+package cli
+
+import (
+	"io"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/cli/testdata"
+	"github.com/pterm/pterm"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestAppEvents(t *testing.T) {
+	pterm.DisableStyling()
+	defer pterm.EnableStyling()
+
+	observed := apiv1.NewMicroTime(time.Date(2023, 5, 8, 15, 4, 5, 0, time.UTC))
+	events := []apiv1.Event{
+		{
+			ObjectMeta:  metav1.ObjectMeta{Name: "1"},
+			Type:        "AppCreated",
+			Severity:    apiv1.EventSeverityInfo,
+			AppName:     "found",
+			Resource:    &apiv1.EventResource{Kind: "app", Name: "found"},
+			Description: "App acorn/found created",
+			Observed:    observed,
+		},
+		{
+			ObjectMeta:  metav1.ObjectMeta{Name: "2"},
+			Type:        "AppCreated",
+			Severity:    apiv1.EventSeverityInfo,
+			AppName:     "other",
+			Resource:    &apiv1.EventResource{Kind: "app", Name: "other"},
+			Description: "App acorn/other created",
+			Observed:    observed,
+		},
+		{
+			ObjectMeta:  metav1.ObjectMeta{Name: "3"},
+			Type:        "ImagePullFailed",
+			Severity:    apiv1.EventSeverityError,
+			AppName:     "found.db",
+			Resource:    &apiv1.EventResource{Kind: "app", Name: "found.db"},
+			Description: "Failed to pull image",
+			Observed:    observed,
+		},
+		{
+			ObjectMeta:  metav1.ObjectMeta{Name: "4"},
+			Type:        "AppCreated",
+			Severity:    apiv1.EventSeverityInfo,
+			AppName:     "foundry",
+			Resource:    &apiv1.EventResource{Kind: "app", Name: "foundry"},
+			Description: "App acorn/foundry created",
+			Observed:    observed,
+		},
+	}
+
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr bool
+		wantOut string
+	}{
+		{
+			name: "acorn app events found",
+			args: []string{"events", "found"},
+			wantOut: "OBSERVED                        SEVERITY   TYPE         RESOURCE    ACTOR     DESCRIPTION\n" +
+				"2023-05-08 15:04:05 +0000 UTC   info       AppCreated   app/found             App acorn/found created\n",
+		},
+		{
+			name:    "acorn app events dne",
+			args:    []string{"events", "dne"},
+			wantErr: true,
+			wantOut: "error: app dne does not exist",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, w, _ := os.Pipe()
+			os.Stdout = w
+			cmd := NewPs(CommandContext{
+				ClientFactory: &testdata.MockClientFactory{
+					EventList: events,
+				},
+				StdOut: w,
+				StdErr: w,
+				StdIn:  strings.NewReader(""),
+			})
+			cmd.SetArgs(tt.args)
+			err := cmd.Execute()
+			if err != nil && !tt.wantErr {
+				assert.Failf(t, "got err when err not expected", "got err: %s", err.Error())
+			} else if err != nil && tt.wantErr {
+				assert.Equal(t, tt.wantOut, err.Error())
+			} else if tt.wantErr {
+				assert.Fail(t, "expected an error")
+			} else {
+				w.Close()
+				out, _ := io.ReadAll(r)
+				assert.Equal(t, tt.wantOut, string(out))
+			}
+		})
+	}
+}
+
+func TestFormatEventSeverity(t *testing.T) {
+	assert.Equal(t, pterm.FgRed.Sprint("error"), formatEventSeverity(apiv1.EventSeverityError))
+	assert.Equal(t, pterm.FgGreen.Sprint("info"), formatEventSeverity(apiv1.EventSeverityInfo))
+}
@@ -0,0 +1,57 @@
+package cli
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	cli "github.com/acorn-io/runtime/pkg/cli/builder"
+	"github.com/acorn-io/runtime/pkg/client"
+	"github.com/spf13/cobra"
+)
+
+func NewAppExport(c CommandContext) *cobra.Command {
+	return cli.Command(&AppExport{out: c.StdOut, client: c.ClientFactory}, cobra.Command{
+		Use: "export [flags] ACORN_NAME",
+		Example: `
+# Export an app to a file, only recording the names and types of its secrets
+acorn app export my-app -o my-app.yaml
+
+# Export an app including the data of its secrets
+acorn app export --include-secrets my-app -o my-app.yaml`,
+		SilenceUsage:      true,
+		Short:             "Export an app with its secrets and volumes so it can be imported elsewhere",
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: newCompletion(c.ClientFactory, appsCompletion).withShouldCompleteOptions(onlyNumArgs(1)).complete,
+	})
+}
+
+type AppExport struct {
+	IncludeSecrets bool   `usage:"Include the data of the secrets bound to the app, otherwise only their names and types are exported"`
+	Output         string `usage:"File to write the export to, defaults to stdout" short:"o"`
+
+	out    io.Writer
+	client ClientFactory
+}
+
+func (a *AppExport) Run(cmd *cobra.Command, args []string) error {
+	c, err := a.client.CreateDefault()
+	if err != nil {
+		return err
+	}
+
+	bundle, err := c.AppExport(cmd.Context(), args[0], &client.AppExportOptions{
+		IncludeSecrets: a.IncludeSecrets,
+	})
+	if err != nil {
+		return fmt.Errorf("exporting %s: %w", args[0], err)
+	}
+
+	if a.Output == "" || a.Output == "-" {
+		_, err = a.out.Write(bundle)
+		return err
+	}
+
+	// The export may contain secret data, so only make it readable by the user
+	return os.WriteFile(a.Output, bundle, 0600)
+}
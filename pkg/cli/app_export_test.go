@@ -0,0 +1,80 @@
+package cli
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/acorn-io/runtime/pkg/cli/testdata"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAppExportImport(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "found.yaml")
+
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr bool
+		wantOut string
+	}{
+		{
+			name:    "acorn app export found",
+			args:    []string{"export", "found"},
+			wantOut: "name: found\nspec:\n  secrets:\n  - secret: found.secret\n    target: found\n",
+		},
+		{
+			name: "acorn app export found -o file",
+			args: []string{"export", "found", "-o", file},
+		},
+		{
+			name:    "acorn app export dne",
+			args:    []string{"export", "dne"},
+			wantErr: true,
+			wantOut: "exporting dne: error: app dne does not exist",
+		},
+		{
+			name:    "acorn app import --name copied file",
+			args:    []string{"import", "--name", "copied", file},
+			wantOut: "copied\n",
+		},
+		{
+			name:    "acorn app import file to existing",
+			args:    []string{"import", file},
+			wantErr: true,
+			wantOut: "importing " + file + ": app found already exists",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, w, _ := os.Pipe()
+			os.Stdout = w
+			cmd := NewPs(CommandContext{
+				ClientFactory: &testdata.MockClientFactory{},
+				StdOut:        w,
+				StdErr:        w,
+				StdIn:         strings.NewReader(""),
+			})
+			cmd.SetArgs(tt.args)
+			err := cmd.Execute()
+			if err != nil && !tt.wantErr {
+				assert.Failf(t, "got err when err not expected", "got err: %s", err.Error())
+			} else if err != nil && tt.wantErr {
+				assert.Equal(t, tt.wantOut, err.Error())
+			} else if tt.wantErr {
+				assert.Fail(t, "expected an error")
+			} else {
+				w.Close()
+				out, _ := io.ReadAll(r)
+				assert.Equal(t, tt.wantOut, string(out))
+			}
+		})
+	}
+
+	info, err := os.Stat(file)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+}
@@ -0,0 +1,144 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	cli "github.com/acorn-io/runtime/pkg/cli/builder"
+	"github.com/acorn-io/runtime/pkg/cli/builder/table"
+	"github.com/acorn-io/runtime/pkg/tables"
+	"github.com/spf13/cobra"
+)
+
+// pendingEndpoint is shown in place of the parts of an endpoint that the ingress or load balancer didn't assign yet
+const pendingEndpoint = "pending"
+
+func NewAppFQDN(c CommandContext) *cobra.Command {
+	return cli.Command(&AppFQDN{client: c.ClientFactory}, cobra.Command{
+		Use:     "fqdn [flags] ACORN_NAME",
+		Aliases: []string{"endpoints"},
+		Example: `
+# List the published endpoints of an app
+acorn app fqdn my-app
+
+# Print the URL of the first endpoint, e.g. for a smoke test after acorn run --wait
+acorn app fqdn my-app -o json | jq -r '.items[0].url'`,
+		SilenceUsage:      true,
+		Short:             "List the published endpoints of an app",
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: newCompletion(c.ClientFactory, appsCompletion).withShouldCompleteOptions(onlyNumArgs(1)).complete,
+	})
+}
+
+type AppFQDN struct {
+	Quiet  bool   `usage:"Output only URLs" short:"q"`
+	Output string `usage:"Output format (json, yaml, {{gotemplate}})" short:"o"`
+	client ClientFactory
+}
+
+// appEndpoint is a published endpoint of an app as resolved by the ingress and DNS configuration
+type appEndpoint struct {
+	Target   string `json:"target,omitempty"`
+	Protocol string `json:"protocol,omitempty"`
+	Hostname string `json:"hostname,omitempty"`
+	Port     int32  `json:"port,omitempty"`
+	Path     string `json:"path,omitempty"`
+	URL      string `json:"url,omitempty"`
+	Pending  bool   `json:"pending,omitempty"`
+}
+
+func (a *AppFQDN) Run(cmd *cobra.Command, args []string) error {
+	c, err := a.client.CreateDefault()
+	if err != nil {
+		return err
+	}
+
+	app, err := c.AppGet(cmd.Context(), args[0])
+	if err != nil {
+		return err
+	} else if app == nil {
+		return fmt.Errorf("app %s does not exist", args[0])
+	}
+
+	if a.Quiet {
+		for _, ep := range appEndpoints(app) {
+			fmt.Println(ep.URL)
+		}
+		return nil
+	}
+
+	out := table.NewWriter(tables.AppEndpoint, false, a.Output)
+	for _, ep := range appEndpoints(app) {
+		ep := ep
+		out.WriteFormatted(&ep, nil)
+	}
+
+	return out.Err()
+}
+
+func appEndpoints(app *apiv1.App) (result []appEndpoint) {
+	for _, ep := range app.Status.AppStatus.Endpoints {
+		result = append(result, toAppEndpoint(ep))
+	}
+	return result
+}
+
+func toAppEndpoint(ep v1.Endpoint) appEndpoint {
+	protocol := string(ep.PublishProtocol)
+	if protocol == "" {
+		protocol = string(ep.Protocol)
+	}
+
+	result := appEndpoint{
+		Target:   ep.Target,
+		Protocol: protocol,
+		Path:     ep.Path,
+		Pending:  ep.Pending,
+	}
+
+	host, portStr, hasPort := strings.Cut(ep.Address, ":")
+	if hasPort {
+		if port, err := strconv.ParseInt(portStr, 10, 32); err == nil {
+			result.Port = int32(port)
+		}
+	}
+	switch {
+	case result.Port == 0 && protocol == string(v1.PublishProtocolHTTPS):
+		result.Port = 443
+	case result.Port == 0 && (protocol == string(v1.PublishProtocolHTTP) || protocol == string(v1.PublishProtocolHTTP2)):
+		result.Port = 80
+	}
+
+	if host == "" || strings.HasPrefix(host, "<") {
+		// The load balancer didn't assign an address yet, like "<Pending Ingress>:5432"
+		result.Hostname = pendingEndpoint
+		result.Pending = true
+	} else {
+		result.Hostname = host
+	}
+
+	if result.Pending {
+		result.URL = pendingEndpoint
+		return result
+	}
+
+	switch protocol {
+	case string(v1.PublishProtocolHTTP), string(v1.PublishProtocolHTTPS), string(v1.PublishProtocolHTTP2):
+		scheme := "http"
+		if protocol == string(v1.PublishProtocolHTTPS) {
+			scheme = "https"
+		}
+		result.URL = scheme + "://" + result.Hostname
+		if hasPort {
+			result.URL += ":" + portStr
+		}
+		result.URL += result.Path
+	default:
+		result.URL = fmt.Sprintf("%s://%s:%d", protocol, result.Hostname, result.Port)
+	}
+
+	return result
+}
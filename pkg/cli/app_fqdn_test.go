@@ -0,0 +1,126 @@
+package cli
+
+import (
+	"io"
+	"os"
+	"strings"
+	"testing"
+
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/cli/testdata"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestAppFQDN(t *testing.T) {
+	app := &apiv1.App{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-app"},
+		Status: v1.AppInstanceStatus{
+			AppStatus: v1.AppStatus{
+				Endpoints: []v1.Endpoint{
+					{Target: "web", TargetPort: 80, Address: "web-my-app-abc123.local.oss-acorn.io", Protocol: v1.ProtocolHTTP, PublishProtocol: v1.PublishProtocolHTTPS},
+					{Target: "db", TargetPort: 5432, Address: "<Pending Ingress>:5432", Protocol: v1.ProtocolTCP, PublishProtocol: v1.PublishProtocolTCP, Pending: true},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr bool
+		wantOut string
+	}{
+		{
+			name: "acorn app fqdn my-app",
+			args: []string{"fqdn", "my-app"},
+			wantOut: "TARGET    PROTOCOL   HOSTNAME                               PORT      URL\n" +
+				"web       https      web-my-app-abc123.local.oss-acorn.io   443       https://web-my-app-abc123.local.oss-acorn.io\n" +
+				"db        tcp        pending                                5432      pending\n",
+		},
+		{
+			name:    "acorn app fqdn -q my-app",
+			args:    []string{"fqdn", "-q", "my-app"},
+			wantOut: "https://web-my-app-abc123.local.oss-acorn.io\npending\n",
+		},
+		{
+			name: "acorn app fqdn -o json my-app",
+			args: []string{"fqdn", "-o", "json", "my-app"},
+			wantOut: `{
+    "items": [
+        {
+            "target": "web",
+            "protocol": "https",
+            "hostname": "web-my-app-abc123.local.oss-acorn.io",
+            "port": 443,
+            "url": "https://web-my-app-abc123.local.oss-acorn.io"
+        },
+        {
+            "target": "db",
+            "protocol": "tcp",
+            "hostname": "pending",
+            "port": 5432,
+            "url": "pending",
+            "pending": true
+        }
+    ]
+}
+
+`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, w, _ := os.Pipe()
+			os.Stdout = w
+			cmd := NewPs(CommandContext{
+				ClientFactory: &testdata.MockClientFactory{AppItem: app},
+				StdOut:        w,
+				StdErr:        w,
+				StdIn:         strings.NewReader(""),
+			})
+			cmd.SetArgs(tt.args)
+			err := cmd.Execute()
+			if err != nil && !tt.wantErr {
+				assert.Failf(t, "got err when err not expected", "got err: %s", err.Error())
+			} else if err != nil && tt.wantErr {
+				assert.Equal(t, tt.wantOut, err.Error())
+			} else if tt.wantErr {
+				assert.Fail(t, "expected an error")
+			} else {
+				w.Close()
+				out, _ := io.ReadAll(r)
+				assert.Equal(t, tt.wantOut, string(out))
+			}
+		})
+	}
+}
+
+func TestToAppEndpoint(t *testing.T) {
+	assert.Equal(t, appEndpoint{
+		Target:   "web",
+		Protocol: "http",
+		Hostname: "web.example.com",
+		Port:     8080,
+		Path:     "/api",
+		URL:      "http://web.example.com:8080/api",
+	}, toAppEndpoint(v1.Endpoint{Target: "web", Address: "web.example.com:8080", Path: "/api", Protocol: v1.ProtocolHTTP, PublishProtocol: v1.PublishProtocolHTTP}))
+
+	assert.Equal(t, appEndpoint{
+		Target:   "db",
+		Protocol: "tcp",
+		Hostname: "10.0.0.1",
+		Port:     5432,
+		URL:      "tcp://10.0.0.1:5432",
+	}, toAppEndpoint(v1.Endpoint{Target: "db", Address: "10.0.0.1:5432", Protocol: v1.ProtocolTCP}))
+
+	assert.Equal(t, appEndpoint{
+		Target:   "web",
+		Protocol: "http",
+		Hostname: "web.example.com",
+		Port:     80,
+		URL:      pendingEndpoint,
+		Pending:  true,
+	}, toAppEndpoint(v1.Endpoint{Target: "web", Address: "web.example.com", Protocol: v1.ProtocolHTTP, PublishProtocol: v1.PublishProtocolHTTP, Pending: true}))
+}
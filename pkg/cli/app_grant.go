@@ -0,0 +1,138 @@
+package cli
+
+import (
+	"fmt"
+
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	cli "github.com/acorn-io/runtime/pkg/cli/builder"
+	"github.com/acorn-io/runtime/pkg/cli/builder/table"
+	"github.com/acorn-io/runtime/pkg/tables"
+	"github.com/spf13/cobra"
+	"k8s.io/utils/strings/slices"
+)
+
+func NewAppGrant(c CommandContext) *cobra.Command {
+	cmd := cli.Command(&AppGrant{client: c.ClientFactory}, cobra.Command{
+		Use: "grant [flags] PUBLISHER --to CONSUMER",
+		Example: `
+# Allow the app web to link to the services of the app db. Once an app has a grant, only the granted apps can link to
+# its services, the apps already linking to them are granted on the first grant.
+acorn app grant db --to web`,
+		SilenceUsage:      true,
+		Short:             "Allow an app to link to the services of another app",
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: newCompletion(c.ClientFactory, appsCompletion).withShouldCompleteOptions(onlyNumArgs(1)).complete,
+	})
+	if err := cmd.RegisterFlagCompletionFunc("to", newCompletion(c.ClientFactory, appsCompletion).complete); err != nil {
+		cmd.Printf("Error registering completion function for --to flag: %v\n", err)
+	}
+	return cmd
+}
+
+type AppGrant struct {
+	To     string `usage:"App to allow to link to the services of the app"`
+	client ClientFactory
+}
+
+func (a *AppGrant) Run(cmd *cobra.Command, args []string) error {
+	if a.To == "" {
+		return fmt.Errorf("--to is required")
+	}
+
+	c, err := a.client.CreateDefault()
+	if err != nil {
+		return err
+	}
+
+	app, err := c.ServiceGrant(cmd.Context(), args[0], a.To)
+	if err != nil {
+		return fmt.Errorf("granting %s to %s: %w", args[0], a.To, err)
+	}
+
+	fmt.Println(app.Name)
+	return nil
+}
+
+func NewAppRevoke(c CommandContext) *cobra.Command {
+	cmd := cli.Command(&AppRevoke{client: c.ClientFactory}, cobra.Command{
+		Use: "revoke [flags] PUBLISHER --from CONSUMER",
+		Example: `
+# Stop the app web from linking to the services of the app db, the existing links of web to db stop working
+acorn app revoke db --from web`,
+		SilenceUsage:      true,
+		Short:             "Revoke the grant of an app to link to the services of another app",
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: newCompletion(c.ClientFactory, appsCompletion).withShouldCompleteOptions(onlyNumArgs(1)).complete,
+	})
+	if err := cmd.RegisterFlagCompletionFunc("from", newCompletion(c.ClientFactory, appsCompletion).complete); err != nil {
+		cmd.Printf("Error registering completion function for --from flag: %v\n", err)
+	}
+	return cmd
+}
+
+type AppRevoke struct {
+	From   string `usage:"App to revoke the grant of"`
+	client ClientFactory
+}
+
+func (a *AppRevoke) Run(cmd *cobra.Command, args []string) error {
+	if a.From == "" {
+		return fmt.Errorf("--from is required")
+	}
+
+	c, err := a.client.CreateDefault()
+	if err != nil {
+		return err
+	}
+
+	app, err := c.ServiceRevoke(cmd.Context(), args[0], a.From)
+	if err != nil {
+		return fmt.Errorf("revoking %s from %s: %w", args[0], a.From, err)
+	}
+
+	fmt.Println(app.Name)
+	return nil
+}
+
+func NewAppGrants(c CommandContext) *cobra.Command {
+	return cli.Command(&AppGrants{client: c.ClientFactory}, cobra.Command{
+		Use: "grants [flags] [PUBLISHER...]",
+		Example: `
+acorn app grants`,
+		SilenceUsage:      true,
+		Short:             "List the apps that restrict links to their services and the apps granted to link to them",
+		ValidArgsFunction: newCompletion(c.ClientFactory, appsCompletion).complete,
+	})
+}
+
+type AppGrants struct {
+	Quiet  bool   `usage:"Output only names" short:"q"`
+	Output string `usage:"Output format (json, yaml, {{gotemplate}}, go-template={{gotemplate}})" short:"o"`
+	client ClientFactory
+}
+
+func (a *AppGrants) Run(cmd *cobra.Command, args []string) error {
+	if err := table.ValidateFormat(a.Output, &apiv1.App{Spec: v1.AppInstanceSpec{ServiceGrants: &v1.ServiceGrants{}}}); err != nil {
+		return err
+	}
+
+	c, err := a.client.CreateDefault()
+	if err != nil {
+		return err
+	}
+
+	apps, err := c.AppList(cmd.Context())
+	if err != nil {
+		return err
+	}
+
+	out := table.NewWriter(tables.ServiceGrant, a.Quiet, a.Output)
+	for _, app := range apps {
+		if app.Spec.ServiceGrants != nil && (len(args) == 0 || slices.Contains(args, app.Name)) {
+			out.Write(&app)
+		}
+	}
+
+	return out.Err()
+}
@@ -0,0 +1,144 @@
+package cli
+
+import (
+	"io"
+	"os"
+	"strings"
+	"testing"
+
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/cli/testdata"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestAppGrant(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr bool
+		wantOut string
+	}{
+		{
+			name:    "acorn app grant found --to web",
+			args:    []string{"grant", "found", "--to", "web"},
+			wantOut: "found\n",
+		},
+		{
+			name:    "acorn app grant found without --to",
+			args:    []string{"grant", "found"},
+			wantErr: true,
+			wantOut: "--to is required",
+		},
+		{
+			name:    "acorn app grant dne --to web",
+			args:    []string{"grant", "dne", "--to", "web"},
+			wantErr: true,
+			wantOut: "granting dne to web: error: app dne does not exist",
+		},
+		{
+			name:    "acorn app revoke found --from granted",
+			args:    []string{"revoke", "found", "--from", "granted"},
+			wantOut: "found\n",
+		},
+		{
+			name:    "acorn app revoke found --from web",
+			args:    []string{"revoke", "found", "--from", "web"},
+			wantErr: true,
+			wantOut: "revoking found from web: app found has no grant to web",
+		},
+		{
+			name:    "acorn app revoke found without --from",
+			args:    []string{"revoke", "found"},
+			wantErr: true,
+			wantOut: "--from is required",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, w, _ := os.Pipe()
+			os.Stdout = w
+			cmd := NewPs(CommandContext{
+				ClientFactory: &testdata.MockClientFactory{},
+				StdOut:        w,
+				StdErr:        w,
+				StdIn:         strings.NewReader(""),
+			})
+			cmd.SetArgs(tt.args)
+			err := cmd.Execute()
+			if err != nil && !tt.wantErr {
+				assert.Failf(t, "got err when err not expected", "got err: %s", err.Error())
+			} else if err != nil && tt.wantErr {
+				assert.Equal(t, tt.wantOut, err.Error())
+			} else if tt.wantErr {
+				assert.Fail(t, "expected an error")
+			} else {
+				w.Close()
+				out, _ := io.ReadAll(r)
+				assert.Equal(t, tt.wantOut, string(out))
+			}
+		})
+	}
+}
+
+func TestAppGrants(t *testing.T) {
+	apps := []apiv1.App{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "db"},
+			Spec: v1.AppInstanceSpec{
+				ServiceGrants: &v1.ServiceGrants{Consumers: []string{"api", "web"}},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "cache"},
+			Spec: v1.AppInstanceSpec{
+				ServiceGrants: &v1.ServiceGrants{},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "web"},
+		},
+	}
+
+	tests := []struct {
+		name    string
+		args    []string
+		wantOut string
+	}{
+		{
+			name:    "acorn app grants",
+			args:    []string{"grants"},
+			wantOut: "NAME      CONSUMERS\ndb        api,web\ncache     \n",
+		},
+		{
+			name:    "acorn app grants db",
+			args:    []string{"grants", "db"},
+			wantOut: "NAME      CONSUMERS\ndb        api,web\n",
+		},
+		{
+			name:    "acorn app grants -q",
+			args:    []string{"grants", "-q"},
+			wantOut: "db\ncache\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, w, _ := os.Pipe()
+			os.Stdout = w
+			cmd := NewPs(CommandContext{
+				ClientFactory: &testdata.MockClientFactory{
+					AppList: apps,
+				},
+				StdOut: w,
+				StdErr: w,
+				StdIn:  strings.NewReader(""),
+			})
+			cmd.SetArgs(tt.args)
+			assert.NoError(t, cmd.Execute())
+			w.Close()
+			out, _ := io.ReadAll(r)
+			assert.Equal(t, tt.wantOut, string(out))
+		})
+	}
+}
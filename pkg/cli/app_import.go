@@ -0,0 +1,61 @@
+package cli
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	cli "github.com/acorn-io/runtime/pkg/cli/builder"
+	"github.com/acorn-io/runtime/pkg/client"
+	"github.com/spf13/cobra"
+)
+
+func NewAppImport(c CommandContext) *cobra.Command {
+	return cli.Command(&AppImport{client: c.ClientFactory}, cobra.Command{
+		Use: "import [flags] FILE",
+		Example: `
+# Recreate an app from a file written by acorn app export
+acorn app import my-app.yaml
+
+# Recreate an app under a new name, reading the export from stdin
+acorn app export my-app | acorn --project other-project app import --name my-copy -`,
+		SilenceUsage: true,
+		Short:        "Import an app exported with acorn app export",
+		Args:         cobra.ExactArgs(1),
+	})
+}
+
+type AppImport struct {
+	Name   string `usage:"Name of the app to create, defaults to the name of the exported app" short:"n"`
+	client ClientFactory
+}
+
+func (a *AppImport) Run(cmd *cobra.Command, args []string) error {
+	var (
+		bundle []byte
+		err    error
+	)
+	if args[0] == "-" {
+		bundle, err = io.ReadAll(os.Stdin)
+	} else {
+		bundle, err = os.ReadFile(args[0])
+	}
+	if err != nil {
+		return err
+	}
+
+	c, err := a.client.CreateDefault()
+	if err != nil {
+		return err
+	}
+
+	app, err := c.AppImport(cmd.Context(), bundle, &client.AppImportOptions{
+		Name: a.Name,
+	})
+	if err != nil {
+		return fmt.Errorf("importing %s: %w", args[0], err)
+	}
+
+	fmt.Println(app.Name)
+	return nil
+}
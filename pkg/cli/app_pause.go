@@ -0,0 +1,41 @@
+package cli
+
+import (
+	"fmt"
+
+	cli "github.com/acorn-io/runtime/pkg/cli/builder"
+	"github.com/spf13/cobra"
+)
+
+func NewAppPause(c CommandContext) *cobra.Command {
+	return cli.Command(&AppPause{client: c.ClientFactory}, cobra.Command{
+		Use: "pause [flags] ACORN_NAME...",
+		Example: `
+# Scale all containers of an app to zero, without losing their replica counts
+acorn app pause my-app`,
+		SilenceUsage:      true,
+		Short:             "Pause an app, it can be resumed with the same number of replicas",
+		Args:              cobra.MinimumNArgs(1),
+		ValidArgsFunction: newCompletion(c.ClientFactory, appsCompletion).complete,
+	})
+}
+
+type AppPause struct {
+	client ClientFactory
+}
+
+func (a *AppPause) Run(cmd *cobra.Command, args []string) error {
+	c, err := a.client.CreateDefault()
+	if err != nil {
+		return err
+	}
+
+	for _, arg := range args {
+		if err := c.AppPause(cmd.Context(), arg); err != nil {
+			return fmt.Errorf("pausing %s: %w", arg, err)
+		}
+		fmt.Println(arg)
+	}
+
+	return nil
+}
@@ -0,0 +1,80 @@
+package cli
+
+import (
+	"io"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/acorn-io/runtime/pkg/cli/testdata"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAppPauseResume(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr bool
+		wantOut string
+	}{
+		{
+			name:    "acorn app pause found",
+			args:    []string{"pause", "found"},
+			wantOut: "found\n",
+		},
+		{
+			name:    "acorn app pause stopped",
+			args:    []string{"pause", "stopped"},
+			wantErr: true,
+			wantOut: "pausing stopped: app stopped is stopped, start it before pausing it",
+		},
+		{
+			name:    "acorn app pause without name",
+			args:    []string{"pause"},
+			wantErr: true,
+			wantOut: "requires at least 1 arg(s), only received 0",
+		},
+		{
+			name:    "acorn app resume found",
+			args:    []string{"resume", "found"},
+			wantOut: "found\n",
+		},
+		{
+			name:    "acorn app resume running",
+			args:    []string{"resume", "running"},
+			wantErr: true,
+			wantOut: "resuming running: app running is not paused",
+		},
+		{
+			name:    "acorn app resume dne",
+			args:    []string{"resume", "dne"},
+			wantErr: true,
+			wantOut: "resuming dne: error: app dne does not exist",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, w, _ := os.Pipe()
+			os.Stdout = w
+			cmd := NewPs(CommandContext{
+				ClientFactory: &testdata.MockClientFactory{},
+				StdOut:        w,
+				StdErr:        w,
+				StdIn:         strings.NewReader(""),
+			})
+			cmd.SetArgs(tt.args)
+			err := cmd.Execute()
+			if err != nil && !tt.wantErr {
+				assert.Failf(t, "got err when err not expected", "got err: %s", err.Error())
+			} else if err != nil && tt.wantErr {
+				assert.Equal(t, tt.wantOut, err.Error())
+			} else if tt.wantErr {
+				assert.Fail(t, "expected an error")
+			} else {
+				w.Close()
+				out, _ := io.ReadAll(r)
+				assert.Equal(t, tt.wantOut, string(out))
+			}
+		})
+	}
+}
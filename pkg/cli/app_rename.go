@@ -0,0 +1,40 @@
+package cli
+
+import (
+	"fmt"
+
+	cli "github.com/acorn-io/runtime/pkg/cli/builder"
+	"github.com/spf13/cobra"
+)
+
+func NewAppRename(c CommandContext) *cobra.Command {
+	return cli.Command(&AppRename{client: c.ClientFactory}, cobra.Command{
+		Use: "rename [flags] ACORN_NAME NEW_NAME",
+		Example: `
+# Rename an app, keeping its volumes and secrets and updating the links of other apps to it
+acorn app rename my-app my-new-app`,
+		SilenceUsage:      true,
+		Short:             "Rename an app",
+		Args:              cobra.ExactArgs(2),
+		ValidArgsFunction: newCompletion(c.ClientFactory, appsCompletion).withShouldCompleteOptions(onlyNumArgs(1)).complete,
+	})
+}
+
+type AppRename struct {
+	client ClientFactory
+}
+
+func (a *AppRename) Run(cmd *cobra.Command, args []string) error {
+	c, err := a.client.CreateDefault()
+	if err != nil {
+		return err
+	}
+
+	app, err := c.AppRename(cmd.Context(), args[0], args[1])
+	if app == nil && err != nil {
+		return fmt.Errorf("renaming %s: %w", args[0], err)
+	}
+
+	fmt.Println(app.Name)
+	return err
+}
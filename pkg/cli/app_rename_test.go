@@ -0,0 +1,69 @@
+package cli
+
+import (
+	"io"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/acorn-io/runtime/pkg/cli/testdata"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAppRename(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr bool
+		wantOut string
+	}{
+		{
+			name:    "acorn app rename found",
+			args:    []string{"rename", "found", "renamed"},
+			wantOut: "renamed\n",
+		},
+		{
+			name:    "acorn app rename to existing",
+			args:    []string{"rename", "found", "existing"},
+			wantErr: true,
+			wantOut: "renaming found: app existing already exists",
+		},
+		{
+			name:    "acorn app rename dne",
+			args:    []string{"rename", "dne", "renamed"},
+			wantErr: true,
+			wantOut: "renaming dne: error: app dne does not exist",
+		},
+		{
+			name:    "acorn app rename without new name",
+			args:    []string{"rename", "found"},
+			wantErr: true,
+			wantOut: "accepts 2 arg(s), received 1",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, w, _ := os.Pipe()
+			os.Stdout = w
+			cmd := NewPs(CommandContext{
+				ClientFactory: &testdata.MockClientFactory{},
+				StdOut:        w,
+				StdErr:        w,
+				StdIn:         strings.NewReader(""),
+			})
+			cmd.SetArgs(tt.args)
+			err := cmd.Execute()
+			if err != nil && !tt.wantErr {
+				assert.Failf(t, "got err when err not expected", "got err: %s", err.Error())
+			} else if err != nil && tt.wantErr {
+				assert.Equal(t, tt.wantOut, err.Error())
+			} else if tt.wantErr {
+				assert.Fail(t, "expected an error")
+			} else {
+				w.Close()
+				out, _ := io.ReadAll(r)
+				assert.Equal(t, tt.wantOut, string(out))
+			}
+		})
+	}
+}
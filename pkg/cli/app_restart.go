@@ -0,0 +1,88 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/acorn-io/baaah/pkg/typed"
+	cli "github.com/acorn-io/runtime/pkg/cli/builder"
+	"github.com/acorn-io/runtime/pkg/client"
+	"github.com/acorn-io/runtime/pkg/wait"
+	"github.com/spf13/cobra"
+	"golang.org/x/exp/slices"
+)
+
+func NewAppRestart(c CommandContext) *cobra.Command {
+	return cli.Command(&AppRestart{client: c.ClientFactory}, cobra.Command{
+		Use: "restart [flags] ACORN_NAME",
+		Example: `
+# Restart all containers of an app, one replica at a time, and wait for them to be ready
+acorn app restart my-app
+
+# Only restart the web container and don't wait for it
+acorn app restart --container web --no-wait my-app`,
+		SilenceUsage:      true,
+		Short:             "Restart the containers of an app without changing it",
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: newCompletion(c.ClientFactory, appsCompletion).withShouldCompleteOptions(onlyNumArgs(1)).complete,
+	})
+}
+
+type AppRestart struct {
+	Container string `usage:"Only restart this container" short:"c"`
+	NoWait    bool   `usage:"Do not wait for the restarted containers to be ready"`
+	client    ClientFactory
+}
+
+func (a *AppRestart) Run(cmd *cobra.Command, args []string) error {
+	c, err := a.client.CreateDefault()
+	if err != nil {
+		return err
+	}
+
+	app, err := c.AppGet(cmd.Context(), args[0])
+	if err != nil {
+		return fmt.Errorf("restarting %s: %w", args[0], err)
+	} else if app == nil {
+		return fmt.Errorf("restarting %s: app does not exist", args[0])
+	}
+
+	containers := typed.SortedKeys(app.Status.AppSpec.Containers)
+	if a.Container != "" {
+		containers = []string{a.Container}
+	}
+
+	replicas, err := c.ContainerReplicaList(cmd.Context(), &client.ContainerReplicaListOptions{
+		App: app.Name,
+	})
+	if err != nil {
+		return err
+	}
+	var oldReplicas []string
+	for _, replica := range replicas {
+		if slices.Contains(containers, replica.Spec.ContainerName) {
+			oldReplicas = append(oldReplicas, replica.Name)
+		}
+	}
+
+	if err := c.AppRestart(cmd.Context(), app.Name, &client.AppRestartOptions{
+		Container: a.Container,
+	}); err != nil {
+		return fmt.Errorf("restarting %s: %w", args[0], err)
+	}
+
+	if a.NoWait {
+		fmt.Println(app.Name)
+		return nil
+	}
+
+	app, err = wait.AppRestart(cmd.Context(), c, app.Name, containers, oldReplicas)
+	if err != nil {
+		return err
+	}
+
+	for _, container := range containers {
+		status := app.Status.AppStatus.Containers[container]
+		fmt.Printf("%s: %d/%d ready\n", container, status.ReadyReplicaCount, status.DesiredReplicaCount)
+	}
+	return nil
+}
@@ -0,0 +1,68 @@
+package cli
+
+import (
+	"io"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/acorn-io/runtime/pkg/cli/testdata"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAppRestart(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr bool
+		wantOut string
+	}{
+		{
+			name:    "acorn app restart found --no-wait",
+			args:    []string{"restart", "--no-wait", "found"},
+			wantOut: "found\n",
+		},
+		{
+			name:    "acorn app restart found --container found --no-wait",
+			args:    []string{"restart", "--container", "found", "--no-wait", "found"},
+			wantOut: "found\n",
+		},
+		{
+			name:    "acorn app restart found --container missing",
+			args:    []string{"restart", "--container", "missing", "--no-wait", "found"},
+			wantErr: true,
+			wantOut: "restarting found: app found has no container missing",
+		},
+		{
+			name:    "acorn app restart dne",
+			args:    []string{"restart", "dne"},
+			wantErr: true,
+			wantOut: "restarting dne: error: app dne does not exist",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, w, _ := os.Pipe()
+			os.Stdout = w
+			cmd := NewPs(CommandContext{
+				ClientFactory: &testdata.MockClientFactory{},
+				StdOut:        w,
+				StdErr:        w,
+				StdIn:         strings.NewReader(""),
+			})
+			cmd.SetArgs(tt.args)
+			err := cmd.Execute()
+			if err != nil && !tt.wantErr {
+				assert.Failf(t, "got err when err not expected", "got err: %s", err.Error())
+			} else if err != nil && tt.wantErr {
+				assert.Equal(t, tt.wantOut, err.Error())
+			} else if tt.wantErr {
+				assert.Fail(t, "expected an error")
+			} else {
+				w.Close()
+				out, _ := io.ReadAll(r)
+				assert.Equal(t, tt.wantOut, string(out))
+			}
+		})
+	}
+}
@@ -0,0 +1,41 @@
+package cli
+
+import (
+	"fmt"
+
+	cli "github.com/acorn-io/runtime/pkg/cli/builder"
+	"github.com/spf13/cobra"
+)
+
+func NewAppResume(c CommandContext) *cobra.Command {
+	return cli.Command(&AppResume{client: c.ClientFactory}, cobra.Command{
+		Use: "resume [flags] ACORN_NAME...",
+		Example: `
+# Scale all containers of a paused app back to the replica counts they had
+acorn app resume my-app`,
+		SilenceUsage:      true,
+		Short:             "Resume a paused app",
+		Args:              cobra.MinimumNArgs(1),
+		ValidArgsFunction: newCompletion(c.ClientFactory, appsCompletion).complete,
+	})
+}
+
+type AppResume struct {
+	client ClientFactory
+}
+
+func (a *AppResume) Run(cmd *cobra.Command, args []string) error {
+	c, err := a.client.CreateDefault()
+	if err != nil {
+		return err
+	}
+
+	for _, arg := range args {
+		if err := c.AppResume(cmd.Context(), arg); err != nil {
+			return fmt.Errorf("resuming %s: %w", arg, err)
+		}
+		fmt.Println(arg)
+	}
+
+	return nil
+}
@@ -0,0 +1,76 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	cli "github.com/acorn-io/runtime/pkg/cli/builder"
+	"github.com/acorn-io/runtime/pkg/wait"
+	"github.com/spf13/cobra"
+)
+
+func NewAppScale(c CommandContext) *cobra.Command {
+	return cli.Command(&AppScale{client: c.ClientFactory}, cobra.Command{
+		Use: "scale [flags] ACORN_NAME CONTAINER=REPLICAS",
+		Example: `
+# Run 3 replicas of the web container of an app
+acorn app scale my-app web=3
+
+# Stop the worker container of an app and wait until its replicas are gone
+acorn app scale --wait my-app worker=0`,
+		SilenceUsage:      true,
+		Short:             "Set the number of replicas of a container of an app",
+		Args:              cobra.ExactArgs(2),
+		ValidArgsFunction: newCompletion(c.ClientFactory, appsCompletion).withShouldCompleteOptions(onlyNumArgs(1)).complete,
+	})
+}
+
+type AppScale struct {
+	Wait   bool `usage:"Wait until the replicas of the container are ready" short:"w"`
+	client ClientFactory
+}
+
+func (a *AppScale) Run(cmd *cobra.Command, args []string) error {
+	container, replicas, err := parseScale(args[1])
+	if err != nil {
+		return err
+	}
+
+	c, err := a.client.CreateDefault()
+	if err != nil {
+		return err
+	}
+
+	app, err := c.AppScale(cmd.Context(), args[0], container, replicas)
+	if err != nil {
+		return fmt.Errorf("scaling %s: %w", args[0], err)
+	}
+
+	if !a.Wait {
+		fmt.Println(app.Name)
+		return nil
+	}
+
+	app, err = wait.AppScale(cmd.Context(), c, app.Name, container, replicas)
+	if err != nil {
+		return err
+	}
+
+	status := app.Status.AppStatus.Containers[container]
+	fmt.Printf("%s: %d/%d ready\n", container, status.ReadyReplicaCount, status.DesiredReplicaCount)
+	return nil
+}
+
+// parseScale parses a scale in the form CONTAINER=REPLICAS
+func parseScale(scale string) (string, int32, error) {
+	container, value, ok := strings.Cut(scale, "=")
+	if !ok || container == "" {
+		return "", 0, fmt.Errorf("invalid scale %q: must be in the form CONTAINER=REPLICAS", scale)
+	}
+	replicas, err := strconv.ParseInt(value, 10, 32)
+	if err != nil || replicas < 0 {
+		return "", 0, fmt.Errorf("invalid scale %q: replicas must be a number greater than or equal to 0", scale)
+	}
+	return container, int32(replicas), nil
+}
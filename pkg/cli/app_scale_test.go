@@ -0,0 +1,110 @@
+package cli
+
+import (
+	"io"
+	"os"
+	"strings"
+	"testing"
+
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/cli/testdata"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestAppScale(t *testing.T) {
+	scaled := &apiv1.App{
+		ObjectMeta: metav1.ObjectMeta{Name: "found"},
+		Spec: v1.AppInstanceSpec{
+			Scale: map[string]int32{"web": 2},
+		},
+		Status: v1.AppInstanceStatus{
+			AppStatus: v1.AppStatus{
+				Containers: map[string]v1.ContainerStatus{
+					"web": {
+						ReadyReplicaCount:    2,
+						DesiredReplicaCount:  2,
+						UpToDateReplicaCount: 2,
+					},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name    string
+		args    []string
+		appItem *apiv1.App
+		wantErr bool
+		wantOut string
+	}{
+		{
+			name:    "acorn app scale found web=3",
+			args:    []string{"scale", "found", "web=3"},
+			wantOut: "found\n",
+		},
+		{
+			name:    "acorn app scale found web=0",
+			args:    []string{"scale", "found", "web=0"},
+			wantOut: "found\n",
+		},
+		{
+			name:    "acorn app scale --wait found web=2",
+			args:    []string{"scale", "--wait", "found", "web=2"},
+			appItem: scaled,
+			wantOut: "web: 2/2 ready\n",
+		},
+		{
+			name:    "acorn app scale found missing=2",
+			args:    []string{"scale", "found", "missing=2"},
+			wantErr: true,
+			wantOut: "scaling found: app found has no container missing",
+		},
+		{
+			name:    "acorn app scale dne web=2",
+			args:    []string{"scale", "dne", "web=2"},
+			wantErr: true,
+			wantOut: "scaling dne: error: app dne does not exist",
+		},
+		{
+			name:    "acorn app scale found web",
+			args:    []string{"scale", "found", "web"},
+			wantErr: true,
+			wantOut: `invalid scale "web": must be in the form CONTAINER=REPLICAS`,
+		},
+		{
+			name:    "acorn app scale found web=-1",
+			args:    []string{"scale", "found", "web=-1"},
+			wantErr: true,
+			wantOut: `invalid scale "web=-1": replicas must be a number greater than or equal to 0`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, w, _ := os.Pipe()
+			os.Stdout = w
+			cmd := NewPs(CommandContext{
+				ClientFactory: &testdata.MockClientFactory{
+					AppItem: tt.appItem,
+				},
+				StdOut: w,
+				StdErr: w,
+				StdIn:  strings.NewReader(""),
+			})
+			cmd.SetArgs(tt.args)
+			err := cmd.Execute()
+			if err != nil && !tt.wantErr {
+				assert.Failf(t, "got err when err not expected", "got err: %s", err.Error())
+			} else if err != nil && tt.wantErr {
+				assert.Equal(t, tt.wantOut, err.Error())
+			} else if tt.wantErr {
+				assert.Fail(t, "expected an error")
+			} else {
+				w.Close()
+				out, _ := io.ReadAll(r)
+				assert.Equal(t, tt.wantOut, string(out))
+			}
+		})
+	}
+}
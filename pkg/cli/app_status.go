@@ -0,0 +1,106 @@
+package cli
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	cli "github.com/acorn-io/runtime/pkg/cli/builder"
+	"github.com/pterm/pterm"
+	"github.com/spf13/cobra"
+)
+
+func NewAppStatus(c CommandContext) *cobra.Command {
+	return cli.Command(&AppStatus{out: c.StdOut, client: c.ClientFactory}, cobra.Command{
+		Use: "status [flags] ACORN_NAME",
+		Example: `
+# Show the conditions of an app
+acorn app status my-app
+
+# Keep showing the conditions of an app as they change, until interrupted or the app is deleted
+acorn app status --watch my-app`,
+		SilenceUsage:      true,
+		Short:             "Show the status conditions of an app",
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: newCompletion(c.ClientFactory, appsCompletion).withShouldCompleteOptions(onlyNumArgs(1)).complete,
+	})
+}
+
+type AppStatus struct {
+	Watch  bool `usage:"Keep updating the status as it changes" short:"w"`
+	out    io.Writer
+	client ClientFactory
+}
+
+func (a *AppStatus) Run(cmd *cobra.Command, args []string) error {
+	c, err := a.client.CreateDefault()
+	if err != nil {
+		return err
+	}
+
+	if !a.Watch {
+		app, err := c.AppGet(cmd.Context(), args[0])
+		if err != nil {
+			return err
+		} else if app == nil {
+			return fmt.Errorf("app %s does not exist", args[0])
+		}
+		_, err = fmt.Fprintln(a.out, formatAppStatus(app))
+		return err
+	}
+
+	apps, err := c.AppStatusStream(cmd.Context(), args[0])
+	if err != nil {
+		return err
+	}
+
+	area, err := pterm.DefaultArea.Start()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = area.Stop()
+	}()
+
+	for app := range apps {
+		area.Update(formatAppStatus(&app))
+	}
+	return nil
+}
+
+// formatAppStatus renders a summary line of the app followed by one line per condition, marked as succeeded (✓),
+// failed (✗), transitioning (…) or unknown (-).
+func formatAppStatus(app *apiv1.App) string {
+	state := "not ready"
+	if app.Status.Ready && app.Generation == app.Status.ObservedGeneration {
+		state = "ready"
+	}
+	if !app.DeletionTimestamp.IsZero() {
+		state = "deleting"
+	}
+
+	lines := []string{fmt.Sprintf("%s: %s", app.Name, state)}
+	if msg := app.Status.Columns.Message; msg != "" {
+		lines[0] += " (" + msg + ")"
+	}
+
+	for _, cond := range app.Status.Conditions {
+		mark := "-"
+		switch {
+		case cond.Error:
+			mark = "✗"
+		case cond.Transitioning:
+			mark = "…"
+		case cond.Success:
+			mark = "✓"
+		}
+		line := fmt.Sprintf("  %s %s", mark, cond.Type)
+		if cond.Message != "" {
+			line += ": " + cond.Message
+		}
+		lines = append(lines, line)
+	}
+
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,98 @@
+package cli
+
+import (
+	"io"
+	"os"
+	"strings"
+	"testing"
+
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/cli/testdata"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestAppStatus(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr bool
+		wantOut string
+	}{
+		{
+			name:    "acorn app status found",
+			args:    []string{"status", "found"},
+			wantOut: "found: ready\n",
+		},
+		{
+			name:    "acorn app status found.container",
+			args:    []string{"status", "found.container"},
+			wantOut: "found.container: not ready\n",
+		},
+		{
+			name:    "acorn app status dne",
+			args:    []string{"status", "dne"},
+			wantErr: true,
+			wantOut: "error: app dne does not exist",
+		},
+		{
+			name:    "acorn app status --watch dne",
+			args:    []string{"status", "--watch", "dne"},
+			wantErr: true,
+			wantOut: "error: app dne does not exist",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, w, _ := os.Pipe()
+			os.Stdout = w
+			cmd := NewPs(CommandContext{
+				ClientFactory: &testdata.MockClientFactory{},
+				StdOut:        w,
+				StdErr:        w,
+				StdIn:         strings.NewReader(""),
+			})
+			cmd.SetArgs(tt.args)
+			err := cmd.Execute()
+			if err != nil && !tt.wantErr {
+				assert.Failf(t, "got err when err not expected", "got err: %s", err.Error())
+			} else if err != nil && tt.wantErr {
+				assert.Equal(t, tt.wantOut, err.Error())
+			} else if tt.wantErr {
+				assert.Fail(t, "expected an error")
+			} else {
+				w.Close()
+				out, _ := io.ReadAll(r)
+				assert.Equal(t, tt.wantOut, string(out))
+			}
+		})
+	}
+}
+
+func TestFormatAppStatus(t *testing.T) {
+	app := &apiv1.App{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-app", Generation: 2},
+		Status: v1.AppInstanceStatus{
+			ObservedGeneration: 1,
+			Ready:              true,
+			Columns:            v1.AppColumns{Message: "updating"},
+			Conditions: []v1.Condition{
+				{Type: "defined", Success: true},
+				{Type: "deployed", Transitioning: true, Message: "waiting for web"},
+				{Type: "secrets", Error: true, Message: "secret db not found"},
+				{Type: "quota"},
+			},
+		},
+	}
+
+	assert.Equal(t, "my-app: not ready (updating)\n"+
+		"  ✓ defined\n"+
+		"  … deployed: waiting for web\n"+
+		"  ✗ secrets: secret db not found\n"+
+		"  - quota", formatAppStatus(app))
+
+	app.Status.ObservedGeneration = 2
+	app.Status.Conditions = nil
+	assert.Equal(t, "my-app: ready (updating)", formatAppStatus(app))
+}
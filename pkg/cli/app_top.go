@@ -0,0 +1,167 @@
+package cli
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"sort"
+	"time"
+
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	cli "github.com/acorn-io/runtime/pkg/cli/builder"
+	"github.com/liggitt/tabwriter"
+	"github.com/pterm/pterm"
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// appTopInterval is how often the usage is refreshed with --watch. The metrics API doesn't measure much more often.
+const appTopInterval = 5 * time.Second
+
+func NewAppTop(c CommandContext) *cobra.Command {
+	return cli.Command(&AppTop{out: c.StdOut, client: c.ClientFactory}, cobra.Command{
+		Use: "top [flags] ACORN_NAME",
+		Example: `
+# Show the CPU and memory usage of the containers of an app
+acorn app top my-app
+
+# Keep refreshing the usage, sorted by memory, until interrupted
+acorn app top --watch --sort memory my-app`,
+		Long: `Show the current CPU and memory usage of every container replica and sidecar of an app, like kubectl top
+
+The usage is read from the metrics API of the cluster, which requires metrics-server to be installed. The request and
+limit columns show the percentage of them that is used.`,
+		SilenceUsage:      true,
+		Short:             "Show the CPU and memory usage of the containers of an app",
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: newCompletion(c.ClientFactory, appsCompletion).withShouldCompleteOptions(onlyNumArgs(1)).complete,
+	})
+}
+
+type AppTop struct {
+	Watch  bool   `usage:"Keep refreshing the usage until interrupted" short:"w"`
+	Sort   string `usage:"Sort the containers by cpu or memory usage" default:"cpu"`
+	out    io.Writer
+	client ClientFactory
+}
+
+func (a *AppTop) Run(cmd *cobra.Command, args []string) error {
+	var sortBy corev1.ResourceName
+	switch a.Sort {
+	case "cpu":
+		sortBy = corev1.ResourceCPU
+	case "memory":
+		sortBy = corev1.ResourceMemory
+	default:
+		return fmt.Errorf("invalid --sort %s, must be one of cpu or memory", a.Sort)
+	}
+
+	c, err := a.client.CreateDefault()
+	if err != nil {
+		return err
+	}
+
+	metrics, err := c.AppMetrics(cmd.Context(), args[0])
+	if err != nil {
+		return err
+	}
+
+	if !a.Watch {
+		_, err = io.WriteString(a.out, formatAppTop(args[0], metrics, sortBy))
+		return err
+	}
+
+	area, err := pterm.DefaultArea.Start()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = area.Stop()
+	}()
+
+	ticker := time.NewTicker(appTopInterval)
+	defer ticker.Stop()
+
+	for {
+		area.Update(formatAppTop(args[0], metrics, sortBy))
+
+		select {
+		case <-cmd.Context().Done():
+			return nil
+		case <-ticker.C:
+		}
+
+		metrics, err = c.AppMetrics(cmd.Context(), args[0])
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// formatAppTop renders the usage of the containers as a table, sorted by the usage of sortBy with the highest first.
+// The request and limit columns include the percentage of them that is used.
+func formatAppTop(appName string, metrics *apiv1.AppMetrics, sortBy corev1.ResourceName) string {
+	if len(metrics.Containers) == 0 {
+		return fmt.Sprintf("No running containers in app %s\n", appName)
+	}
+
+	containers := make([]apiv1.ContainerReplicaMetrics, len(metrics.Containers))
+	copy(containers, metrics.Containers)
+	sort.SliceStable(containers, func(i, j int) bool {
+		left, leftOK := containers[i].Usage[sortBy]
+		right, rightOK := containers[j].Usage[sortBy]
+		if leftOK != rightOK {
+			return leftOK
+		}
+		if cmp := left.Cmp(right); cmp != 0 {
+			return cmp > 0
+		}
+		return containers[i].Name < containers[j].Name
+	})
+
+	buf := &bytes.Buffer{}
+	w := tabwriter.NewWriter(buf, 10, 1, 3, ' ', 0)
+	_, _ = fmt.Fprintln(w, "NAME\tCPU\tCPU REQUEST\tCPU LIMIT\tMEMORY\tMEMORY REQUEST\tMEMORY LIMIT")
+	for _, con := range containers {
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n", con.Name,
+			formatUsage(con.Usage, corev1.ResourceCPU),
+			formatUtilization(con.Usage, con.Requests, corev1.ResourceCPU),
+			formatUtilization(con.Usage, con.Limits, corev1.ResourceCPU),
+			formatUsage(con.Usage, corev1.ResourceMemory),
+			formatUtilization(con.Usage, con.Requests, corev1.ResourceMemory),
+			formatUtilization(con.Usage, con.Limits, corev1.ResourceMemory))
+	}
+	_ = w.Flush()
+	return buf.String()
+}
+
+// formatUsage formats CPU in millicores and memory in MiB like kubectl top, and - if there is no value
+func formatUsage(resources corev1.ResourceList, name corev1.ResourceName) string {
+	q, ok := resources[name]
+	if !ok {
+		return "-"
+	}
+	return formatQuantity(q, name)
+}
+
+func formatQuantity(q resource.Quantity, name corev1.ResourceName) string {
+	if name == corev1.ResourceCPU {
+		return fmt.Sprintf("%dm", q.MilliValue())
+	}
+	return fmt.Sprintf("%dMi", q.Value()/(1024*1024))
+}
+
+// formatUtilization formats the request or limit in bounds along with the percentage of it that is used
+func formatUtilization(usage, bounds corev1.ResourceList, name corev1.ResourceName) string {
+	bound, ok := bounds[name]
+	if !ok || bound.IsZero() {
+		return "-"
+	}
+
+	result := formatQuantity(bound, name)
+	if used, ok := usage[name]; ok {
+		result += fmt.Sprintf(" (%d%%)", used.MilliValue()*100/bound.MilliValue())
+	}
+	return result
+}
@@ -0,0 +1,89 @@
+package cli
+
+import (
+	"io"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/acorn-io/runtime/pkg/cli/testdata"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAppTop(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr bool
+		wantOut string
+	}{
+		{
+			name: "acorn app top found",
+			args: []string{"top", "found"},
+			wantOut: `NAME                         CPU       CPU REQUEST   CPU LIMIT   MEMORY    MEMORY REQUEST   MEMORY LIMIT
+found.db-5d8b6-r4k2p         120m      250m (48%)    -           256Mi     512Mi (50%)      512Mi (50%)
+found.web-7f9c4-x2x8q        5m        50m (10%)     -           20Mi      64Mi (31%)       128Mi (15%)
+found.db-5d8b6-r4k2p:proxy   1m        -             -           300Mi     -                -
+found.web-7f9c4-zz9k1        -         -             -           -         -                -
+`,
+		},
+		{
+			name: "acorn app top --sort memory found",
+			args: []string{"top", "--sort", "memory", "found"},
+			wantOut: `NAME                         CPU       CPU REQUEST   CPU LIMIT   MEMORY    MEMORY REQUEST   MEMORY LIMIT
+found.db-5d8b6-r4k2p:proxy   1m        -             -           300Mi     -                -
+found.db-5d8b6-r4k2p         120m      250m (48%)    -           256Mi     512Mi (50%)      512Mi (50%)
+found.web-7f9c4-x2x8q        5m        50m (10%)     -           20Mi      64Mi (31%)       128Mi (15%)
+found.web-7f9c4-zz9k1        -         -             -           -         -                -
+`,
+		},
+		{
+			name:    "acorn app top found.container",
+			args:    []string{"top", "found.container"},
+			wantOut: "No running containers in app found.container\n",
+		},
+		{
+			name:    "acorn app top --sort disk found",
+			args:    []string{"top", "--sort", "disk", "found"},
+			wantErr: true,
+			wantOut: "invalid --sort disk, must be one of cpu or memory",
+		},
+		{
+			name:    "acorn app top nometrics",
+			args:    []string{"top", "nometrics"},
+			wantErr: true,
+			wantOut: "the metrics API (metrics.k8s.io) is not available in the cluster, metrics-server needs to be installed to show the resource usage of apps",
+		},
+		{
+			name:    "acorn app top dne",
+			args:    []string{"top", "dne"},
+			wantErr: true,
+			wantOut: "error: app dne does not exist",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, w, _ := os.Pipe()
+			os.Stdout = w
+			cmd := NewPs(CommandContext{
+				ClientFactory: &testdata.MockClientFactory{},
+				StdOut:        w,
+				StdErr:        w,
+				StdIn:         strings.NewReader(""),
+			})
+			cmd.SetArgs(tt.args)
+			err := cmd.Execute()
+			if err != nil && !tt.wantErr {
+				assert.Failf(t, "got err when err not expected", "got err: %s", err.Error())
+			} else if err != nil && tt.wantErr {
+				assert.Equal(t, tt.wantOut, err.Error())
+			} else if tt.wantErr {
+				assert.Fail(t, "expected an error")
+			} else {
+				w.Close()
+				out, _ := io.ReadAll(r)
+				assert.Equal(t, tt.wantOut, string(out))
+			}
+		})
+	}
+}
@@ -0,0 +1,76 @@
+package cli
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	cli "github.com/acorn-io/runtime/pkg/cli/builder"
+	"github.com/acorn-io/runtime/pkg/wait"
+	"github.com/spf13/cobra"
+	"golang.org/x/exp/slices"
+)
+
+func NewAppWait(c CommandContext) *cobra.Command {
+	return cli.Command(&AppWait{out: c.StdOut, client: c.ClientFactory}, cobra.Command{
+		Use: "wait [flags] ACORN_NAME",
+		Example: `
+# Wait until an app is ready
+acorn app wait my-app
+
+# Fail if not all replicas of an app are ready and up to date within 2 minutes
+acorn app wait --for healthy --timeout 2m my-app
+
+# Wait until the hostnames of the published endpoints of an app resolve
+acorn app wait --for endpoint my-app`,
+		Long: `Wait until a running app meets a condition, exiting with a non-zero code if it doesn't within --timeout
+
+The conditions are:
+  ready     The app is ready with its latest changes, like acorn run waits for
+  healthy   The app is ready, all replicas of its containers are ready and up to date and no container or job has errors
+  endpoint  The app has published endpoints and the hostnames of all of them resolve
+
+Waiting fails right away if the app is stopped, being deleted or has a failed job.`,
+		SilenceUsage:      true,
+		Short:             "Wait for an app to become ready, healthy or reachable",
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: newCompletion(c.ClientFactory, appsCompletion).withShouldCompleteOptions(onlyNumArgs(1)).complete,
+	})
+}
+
+type AppWait struct {
+	For     string `usage:"Condition to wait for (ready, healthy, endpoint)" default:"ready"`
+	Timeout string `usage:"Fail if the app doesn't meet the condition within this time (ex: 2m, 90s), printing what it is still waiting for"`
+	out     io.Writer
+	client  ClientFactory
+}
+
+func (a *AppWait) Run(cmd *cobra.Command, args []string) error {
+	if !slices.Contains(wait.Conditions, a.For) {
+		return fmt.Errorf("invalid condition %s, must be one of %s", a.For, strings.Join(wait.Conditions, ", "))
+	}
+
+	var timeout time.Duration
+	if a.Timeout != "" {
+		var err error
+		timeout, err = time.ParseDuration(a.Timeout)
+		if err != nil {
+			return fmt.Errorf("invalid --timeout %s: %w", a.Timeout, err)
+		} else if timeout <= 0 {
+			return fmt.Errorf("invalid --timeout %s, must be greater than zero", a.Timeout)
+		}
+	}
+
+	c, err := a.client.CreateDefault()
+	if err != nil {
+		return err
+	}
+
+	if err := wait.AppCondition(cmd.Context(), c, args[0], a.For, timeout); err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintln(a.out, args[0])
+	return err
+}
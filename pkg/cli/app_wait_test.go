@@ -0,0 +1,75 @@
+package cli
+
+import (
+	"io"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/acorn-io/runtime/pkg/cli/testdata"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAppWait(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr bool
+		wantOut string
+	}{
+		{
+			name:    "acorn app wait found",
+			args:    []string{"wait", "found"},
+			wantOut: "found\n",
+		},
+		{
+			name:    "acorn app wait --timeout 1s found.container",
+			args:    []string{"wait", "--timeout", "1s", "found.container"},
+			wantErr: true,
+			wantOut: "app found.container did not become ready within 1s: the app is not ready",
+		},
+		{
+			name:    "acorn app wait dne",
+			args:    []string{"wait", "dne"},
+			wantErr: true,
+			wantOut: "error: app dne does not exist",
+		},
+		{
+			name:    "acorn app wait --for running found",
+			args:    []string{"wait", "--for", "running", "found"},
+			wantErr: true,
+			wantOut: "invalid condition running, must be one of ready, healthy, endpoint",
+		},
+		{
+			name:    "acorn app wait --timeout 0s found",
+			args:    []string{"wait", "--timeout", "0s", "found"},
+			wantErr: true,
+			wantOut: "invalid --timeout 0s, must be greater than zero",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, w, _ := os.Pipe()
+			os.Stdout = w
+			cmd := NewPs(CommandContext{
+				ClientFactory: &testdata.MockClientFactory{},
+				StdOut:        w,
+				StdErr:        w,
+				StdIn:         strings.NewReader(""),
+			})
+			cmd.SetArgs(tt.args)
+			err := cmd.Execute()
+			if err != nil && !tt.wantErr {
+				assert.Failf(t, "got err when err not expected", "got err: %s", err.Error())
+			} else if err != nil && tt.wantErr {
+				assert.Equal(t, tt.wantOut, err.Error())
+			} else if tt.wantErr {
+				assert.Fail(t, "expected an error")
+			} else {
+				w.Close()
+				out, _ := io.ReadAll(r)
+				assert.Equal(t, tt.wantOut, string(out))
+			}
+		})
+	}
+}
@@ -2,34 +2,168 @@ package cli
 
 import (
 	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
 
 	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
 	"github.com/acorn-io/runtime/pkg/imagesource"
 	"github.com/acorn-io/runtime/pkg/tags"
 	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/remote/transport"
 )
 
+// authCacheTTL bounds how long resolved registry credentials are reused, so that credentials changed while a
+// long-running command is iterating are picked up.
+const authCacheTTL = time.Minute
+
+// registryAuthCache holds the credentials resolved by getAuthForImage, keyed by registry host.
+var registryAuthCache = newAuthCache(authCacheTTL)
+
+type cachedAuth struct {
+	auth    *apiv1.RegistryAuth
+	expires time.Time
+}
+
+type authCache struct {
+	lock     sync.Mutex
+	ttl      time.Duration
+	disabled bool
+	entries  map[string]cachedAuth
+	now      func() time.Time
+}
+
+func newAuthCache(ttl time.Duration) *authCache {
+	return &authCache{
+		ttl:     ttl,
+		entries: map[string]cachedAuth{},
+		now:     time.Now,
+	}
+}
+
+func (a *authCache) get(registry string) (*apiv1.RegistryAuth, bool) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	if a.disabled {
+		return nil, false
+	}
+	entry, ok := a.entries[registry]
+	if !ok || !a.now().Before(entry.expires) {
+		delete(a.entries, registry)
+		return nil, false
+	}
+	return entry.auth, true
+}
+
+func (a *authCache) set(registry string, auth *apiv1.RegistryAuth) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	if !a.disabled {
+		a.entries[registry] = cachedAuth{
+			auth:    auth,
+			expires: a.now().Add(a.ttl),
+		}
+	}
+}
+
+func (a *authCache) invalidate(registry string) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	delete(a.entries, registry)
+}
+
+func (a *authCache) setDisabled(disabled bool) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	a.disabled = disabled
+	if disabled {
+		a.entries = map[string]cachedAuth{}
+	}
+}
+
 func getAuthForImage(ctx context.Context, clientFactory ClientFactory, image string) (*apiv1.RegistryAuth, error) {
+	auth, _, err := resolveAuthForImage(ctx, clientFactory, image)
+	return auth, err
+}
+
+// resolveAuthForImage returns the credentials for the registry of the image and whether they came from the cache.
+func resolveAuthForImage(ctx context.Context, clientFactory ClientFactory, image string) (*apiv1.RegistryAuth, bool, error) {
 	if tags.IsLocalReference(image) {
-		return nil, nil
+		return nil, false, nil
 	}
 
-	c, err := clientFactory.CreateDefault()
+	ref, err := name.ParseReference(image)
 	if err != nil {
-		return nil, err
+		// not failing on malformed image names
+		return nil, false, nil
 	}
 
-	ref, err := name.ParseReference(image)
+	registry := ref.Context().RegistryStr()
+	if auth, ok := registryAuthCache.get(registry); ok {
+		return auth, true, nil
+	}
+
+	c, err := clientFactory.CreateDefault()
 	if err != nil {
-		// not failing on malformed image names
-		return nil, nil
+		return nil, false, err
 	}
 
 	creds, err := imagesource.GetCreds(clientFactory.AcornConfigFile(), c)
 	if err != nil {
-		return nil, err
+		return nil, false, err
 	}
 
-	auth, _, err := creds(ctx, ref.Context().RegistryStr())
-	return auth, err
+	auth, _, err := creds(ctx, registry)
+	if err != nil {
+		return nil, false, err
+	}
+
+	registryAuthCache.set(registry, auth)
+	return auth, false, nil
+}
+
+// withAuthForImage calls fn with the credentials for the registry of the image. If fn fails because the registry
+// rejected cached credentials, they are resolved again and fn is retried once.
+func withAuthForImage(ctx context.Context, clientFactory ClientFactory, image string, fn func(auth *apiv1.RegistryAuth) error) error {
+	auth, cached, err := resolveAuthForImage(ctx, clientFactory, image)
+	if err != nil {
+		return err
+	}
+
+	err = fn(auth)
+	if err == nil || !isAuthError(err) {
+		return err
+	}
+
+	invalidateAuthForImage(image)
+	if !cached {
+		return err
+	}
+
+	auth, err = getAuthForImage(ctx, clientFactory, image)
+	if err != nil {
+		return err
+	}
+	return fn(auth)
+}
+
+func invalidateAuthForImage(image string) {
+	if ref, err := name.ParseReference(image); err == nil {
+		registryAuthCache.invalidate(ref.Context().RegistryStr())
+	}
+}
+
+// isAuthError returns true if the error is a registry rejecting the credentials. Errors relayed by the acorn API
+// server lose their type, so the registry error codes are matched in the message as well.
+func isAuthError(err error) bool {
+	var terr *transport.Error
+	if errors.As(err, &terr) {
+		return terr.StatusCode == http.StatusUnauthorized || terr.StatusCode == http.StatusForbidden
+	}
+	msg := err.Error()
+	return strings.Contains(msg, string(transport.UnauthorizedErrorCode)) || strings.Contains(msg, string(transport.DeniedErrorCode))
 }
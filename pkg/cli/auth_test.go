@@ -0,0 +1,95 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/cli/testdata"
+	"github.com/acorn-io/runtime/pkg/client"
+	"github.com/acorn-io/runtime/pkg/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/google/go-containerregistry/pkg/v1/remote/transport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuthCache(t *testing.T) {
+	now := time.Now()
+	cache := newAuthCache(time.Minute)
+	cache.now = func() time.Time { return now }
+
+	auth := &apiv1.RegistryAuth{Username: "user", Password: "pass"}
+	cache.set("ghcr.io", auth)
+
+	got, ok := cache.get("ghcr.io")
+	assert.True(t, ok)
+	assert.Equal(t, auth, got)
+
+	_, ok = cache.get("docker.io")
+	assert.False(t, ok, "other registries are not cached")
+
+	now = now.Add(time.Minute)
+	_, ok = cache.get("ghcr.io")
+	assert.False(t, ok, "expired entries are not returned")
+
+	cache.set("ghcr.io", auth)
+	cache.invalidate("ghcr.io")
+	_, ok = cache.get("ghcr.io")
+	assert.False(t, ok, "invalidated entries are not returned")
+
+	cache.set("ghcr.io", auth)
+	cache.setDisabled(true)
+	_, ok = cache.get("ghcr.io")
+	assert.False(t, ok, "disabling the cache drops its entries")
+	cache.set("ghcr.io", auth)
+	_, ok = cache.get("ghcr.io")
+	assert.False(t, ok, "a disabled cache stores nothing")
+}
+
+func TestIsAuthError(t *testing.T) {
+	assert.True(t, isAuthError(fmt.Errorf("pushing: %w", &transport.Error{StatusCode: http.StatusUnauthorized})))
+	assert.True(t, isAuthError(&transport.Error{StatusCode: http.StatusForbidden}))
+	assert.False(t, isAuthError(&transport.Error{StatusCode: http.StatusNotFound}))
+	assert.True(t, isAuthError(errors.New("GET https://ghcr.io/v2/acme/app/manifests/v1: UNAUTHORIZED: authentication required")))
+	assert.True(t, isAuthError(errors.New("DENIED: requested access to the resource is denied")))
+	assert.False(t, isAuthError(errors.New("MANIFEST_UNKNOWN: manifest unknown")))
+}
+
+func TestPullRefreshesStaleAuth(t *testing.T) {
+	// "fresh:pass", the credentials stored after the cached ones went stale
+	configFile := filepath.Join(t.TempDir(), "config.yaml")
+	require.NoError(t, os.WriteFile(configFile, []byte(`{"auths": {"ghcr.io": {"auth": "ZnJlc2g6cGFzcw=="}}}`), 0600))
+
+	stale := &apiv1.RegistryAuth{Username: "stale", Password: "pass"}
+	registryAuthCache.set("ghcr.io", stale)
+	defer registryAuthCache.invalidate("ghcr.io")
+
+	done := make(chan client.ImageProgress)
+	close(done)
+
+	ctrl := gomock.NewController(t)
+	mClient := mocks.NewMockClient(ctrl)
+	gomock.InOrder(
+		mClient.EXPECT().ImagePull(gomock.Any(), "ghcr.io/acme/app:v1", &client.ImagePullOptions{Auth: stale}).
+			Return(nil, &transport.Error{StatusCode: http.StatusUnauthorized}),
+		mClient.EXPECT().ImagePull(gomock.Any(), "ghcr.io/acme/app:v1", &client.ImagePullOptions{Auth: &apiv1.RegistryAuth{Username: "fresh", Password: "pass"}}).
+			Return(done, nil),
+	)
+
+	cmd := NewPull(CommandContext{
+		ClientFactory: &testdata.MockClientFactoryManual{
+			MockAcornConfigFile: configFile,
+			Client:              mClient,
+		},
+		StdIn: strings.NewReader(""),
+	})
+	cmd.SetArgs([]string{"--quiet", "ghcr.io/acme/app:v1"})
+	require.NoError(t, cmd.Execute())
+}
@@ -1,10 +1,15 @@
 package cli
 
 import (
+	"context"
 	"fmt"
+	"os"
 	"strings"
 
 	"github.com/acorn-io/baaah/pkg/merr"
+	"github.com/acorn-io/baaah/pkg/typed"
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/build"
 	cli "github.com/acorn-io/runtime/pkg/cli/builder"
 	"github.com/acorn-io/runtime/pkg/client"
 	"github.com/acorn-io/runtime/pkg/imagesource"
@@ -18,7 +23,14 @@ func NewBuild(c CommandContext) *cobra.Command {
 		Use: "build [flags] DIRECTORY",
 		Example: `
 # Build from Acornfile file in the local directory
-acorn build .`,
+acorn build .
+
+# Mount a local file and a key of an Acorn secret into RUN --mount=type=secret,id=ID instructions
+acorn build --secret id=npmrc,src=$HOME/.npmrc --secret id=token,src=secret://build-creds.token .
+
+# Reuse the layers of previous builds in CI, each image and platform is cached under its own tag derived from the ref
+acorn build --cache-from type=registry,ref=ghcr.io/org/app:buildcache \
+  --cache-to type=registry,ref=ghcr.io/org/app:buildcache,mode=max --platform linux/amd64,linux/arm64 .`,
 		SilenceUsage: true,
 		Short:        "Build an app from a Acornfile file",
 		Long:         "Build all dependent container and app images from your Acornfile file",
@@ -28,12 +40,15 @@ acorn build .`,
 }
 
 type Build struct {
-	ArgsFile string   `usage:"Default args to apply to the build" default:".build-args.acorn"`
-	Push     bool     `usage:"Push image after build"`
-	File     string   `short:"f" usage:"Name of the build file (default \"DIRECTORY/Acornfile\")"`
-	Tag      []string `short:"t" usage:"Apply a tag to the final build"`
-	Platform []string `short:"p" usage:"Target platforms (form os/arch[/variant][:osversion] example linux/amd64)"`
-	client   ClientFactory
+	ArgsFile  string   `usage:"Default args to apply to the build" default:".build-args.acorn"`
+	Push      bool     `usage:"Push image after build"`
+	File      string   `short:"f" usage:"Name of the build file (default \"DIRECTORY/Acornfile\")"`
+	Tag       []string `short:"t" usage:"Apply a tag to the final build"`
+	Platform  []string `short:"p" usage:"Target platforms, comma separated for a multi-platform build pushed as a manifest list (form os/arch[/variant][:osversion] example linux/amd64,linux/arm64)"`
+	CacheFrom []string `split:"false" usage:"Remote cache to import layers from (format type=registry,ref=REPO[:TAG])"`
+	CacheTo   []string `split:"false" usage:"Remote cache to export layers to (format type=registry,ref=REPO[:TAG][,mode=min|max]), the credentials for the registry are resolved like for --push"`
+	Secret    []string `split:"false" usage:"Secret to mount into RUN --mount=type=secret,id=ID instructions (format id=ID,src=FILE or id=ID,src=secret://NAME.KEY)"`
+	client    ClientFactory
 }
 
 func (s *Build) Run(cmd *cobra.Command, args []string) error {
@@ -57,7 +72,19 @@ func (s *Build) Run(cmd *cobra.Command, args []string) error {
 		}
 	}
 
+	buildSecrets, err := parseBuildSecrets(s.Secret)
+	if err != nil {
+		return err
+	}
+
 	helper := imagesource.NewImageSource(s.client.AcornConfigFile(), s.File, s.ArgsFile, args, s.Platform, false)
+	helper.BuildSecrets = buildSecrets.lookup(c)
+	if helper.CacheFrom, err = build.ParseRemoteCaches(s.CacheFrom); err != nil {
+		return err
+	}
+	if helper.CacheTo, err = build.ParseRemoteCaches(s.CacheTo); err != nil {
+		return err
+	}
 
 	image, _, _, err := helper.GetImageAndDeployArgs(cmd.Context(), c)
 	if err != nil {
@@ -66,7 +93,7 @@ func (s *Build) Run(cmd *cobra.Command, args []string) error {
 
 	var errs []error
 	for _, tag := range s.Tag {
-		if err = c.ImageTag(cmd.Context(), image, tag); err != nil {
+		if err = c.ImageTag(cmd.Context(), image, tag, nil); err != nil {
 			errs = append(errs, err)
 		}
 	}
@@ -79,17 +106,15 @@ func (s *Build) Run(cmd *cobra.Command, args []string) error {
 
 	if s.Push {
 		for _, tag := range s.Tag {
-			auth, err := getAuthForImage(cmd.Context(), s.client, tag)
-			if err != nil {
-				return err
-			}
-			prog, err := c.ImagePush(cmd.Context(), tag, &client.ImagePushOptions{
-				Auth: auth,
-			})
-			if err != nil {
-				return err
-			}
-			if err := progressbar.Print(prog); err != nil {
+			if err := withAuthForImage(cmd.Context(), s.client, tag, func(auth *apiv1.RegistryAuth) error {
+				prog, err := c.ImagePush(cmd.Context(), tag, &client.ImagePushOptions{
+					Auth: auth,
+				})
+				if err != nil {
+					return err
+				}
+				return progressbar.Print(prog)
+			}); err != nil {
 				return err
 			}
 		}
@@ -97,3 +122,88 @@ func (s *Build) Run(cmd *cobra.Command, args []string) error {
 
 	return nil
 }
+
+// secretURIPrefix marks a build secret source that refers to a key of an Acorn secret instead of a local file
+const secretURIPrefix = "secret://"
+
+// buildSecretSource is where the value of a build secret is read from, either File or the Key of the Acorn secret Secret
+type buildSecretSource struct {
+	File   string
+	Secret string
+	Key    string
+}
+
+type buildSecrets map[string]buildSecretSource
+
+func parseBuildSecrets(values []string) (buildSecrets, error) {
+	result := buildSecrets{}
+	for _, value := range values {
+		var id, src string
+		for _, field := range strings.Split(value, ",") {
+			key, val, _ := strings.Cut(field, "=")
+			switch strings.TrimSpace(key) {
+			case "id":
+				id = val
+			case "src", "source":
+				src = val
+			default:
+				return nil, fmt.Errorf("invalid build secret %s, unknown field %q, must be id=ID,src=SOURCE", value, key)
+			}
+		}
+		if id == "" || src == "" {
+			return nil, fmt.Errorf("invalid build secret %s, must be id=ID,src=SOURCE", value)
+		}
+		if _, ok := result[id]; ok {
+			return nil, fmt.Errorf("build secret %s is defined more than once", id)
+		}
+
+		if !strings.HasPrefix(src, secretURIPrefix) {
+			result[id] = buildSecretSource{File: src}
+			continue
+		}
+
+		secretName, key, ok := cutLast(strings.TrimPrefix(src, secretURIPrefix), ".")
+		if !ok || secretName == "" || key == "" {
+			return nil, fmt.Errorf("invalid build secret source %s, must be %sNAME.KEY", src, secretURIPrefix)
+		}
+		result[id] = buildSecretSource{Secret: secretName, Key: key}
+	}
+	return result, nil
+}
+
+func cutLast(s, sep string) (string, string, bool) {
+	i := strings.LastIndex(s, sep)
+	if i < 0 {
+		return s, "", false
+	}
+	return s[:i], s[i+len(sep):], true
+}
+
+// lookup returns the function the build server uses to ask for build secrets. The values are read when the build
+// needs them, so they are never part of the build request.
+func (b buildSecrets) lookup(c client.Client) client.BuildSecretLookup {
+	if len(b) == 0 {
+		return nil
+	}
+	return func(ctx context.Context, id string) ([]byte, bool, error) {
+		src, ok := b[id]
+		if !ok {
+			return nil, false, nil
+		}
+
+		if src.File != "" {
+			data, err := os.ReadFile(src.File)
+			return data, err == nil, err
+		}
+
+		secret, err := c.SecretReveal(ctx, src.Secret)
+		if err != nil {
+			return nil, false, err
+		}
+		data, ok := secret.Data[src.Key]
+		if !ok {
+			return nil, false, fmt.Errorf("secret %s has no key %s, available keys are %v", src.Secret, src.Key, typed.SortedKeys(secret.Data))
+		}
+		return data, true, nil
+	}
+}
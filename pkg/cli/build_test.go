@@ -1,13 +1,18 @@
 package cli
 
 import (
+	"context"
 	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
 	"github.com/acorn-io/runtime/pkg/cli/testdata"
 	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 func TestBuildBadTag(t *testing.T) {
@@ -46,3 +51,61 @@ func TestBuildBadTag(t *testing.T) {
 		assert.Equal(t, test.wantOut, err.Error())
 	})
 }
+
+func TestParseBuildSecrets(t *testing.T) {
+	secrets, err := parseBuildSecrets([]string{"id=npmrc,src=/home/me/.npmrc", "id=token,src=secret://build.creds.token"})
+	require.NoError(t, err)
+	assert.Equal(t, buildSecrets{
+		"npmrc": {File: "/home/me/.npmrc"},
+		"token": {Secret: "build.creds", Key: "token"},
+	}, secrets)
+
+	for _, value := range []string{
+		"id=token",
+		"src=/tmp/token",
+		"id=token,src=/tmp/token,mode=0400",
+		"id=token,src=secret://creds",
+		"id=token,src=secret://.token",
+	} {
+		_, err := parseBuildSecrets([]string{value})
+		assert.Error(t, err, value)
+	}
+
+	_, err = parseBuildSecrets([]string{"id=token,src=/tmp/a", "id=token,src=/tmp/b"})
+	assert.EqualError(t, err, "build secret token is defined more than once")
+}
+
+func TestBuildSecretsLookup(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "token")
+	require.NoError(t, os.WriteFile(file, []byte("from-file"), 0600))
+
+	c := &testdata.MockClient{
+		SecretItem: &apiv1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "creds"},
+			Data:       map[string][]byte{"token": []byte("from-secret")},
+		},
+	}
+
+	secrets, err := parseBuildSecrets([]string{"id=file,src=" + file, "id=secret,src=secret://creds.token", "id=missing,src=secret://creds.password"})
+	require.NoError(t, err)
+	lookup := secrets.lookup(c)
+
+	data, ok, err := lookup(context.Background(), "file")
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, "from-file", string(data))
+
+	data, ok, err = lookup(context.Background(), "secret")
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, "from-secret", string(data))
+
+	_, ok, err = lookup(context.Background(), "unknown")
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	_, _, err = lookup(context.Background(), "missing")
+	assert.EqualError(t, err, "secret creds has no key password, available keys are [token]")
+
+	assert.Nil(t, buildSecrets{}.lookup(c))
+}
@@ -47,6 +47,7 @@ var (
 		"ownerName":     OwnerReferenceName,
 		"imageName":     ImageName,
 		"imageCommit":   ImageCommit,
+		"byteSize":      FormatByteSize,
 	}
 )
 
@@ -370,3 +371,19 @@ func ImageCommit(obj metav1.Object) string {
 
 	return app.Status.AppImage.VCS.Revision
 }
+
+// FormatByteSize formats a size in bytes with a decimal unit like docker does, sizes of 0 are unknown and left empty
+func FormatByteSize(size int64) string {
+	if size <= 0 {
+		return ""
+	}
+	value, units := float64(size), []string{"B", "kB", "MB", "GB", "TB"}
+	i := 0
+	for ; value >= 1000 && i < len(units)-1; i++ {
+		value /= 1000
+	}
+	if i == 0 {
+		return fmt.Sprintf("%dB", size)
+	}
+	return fmt.Sprintf("%.3g%s", value, units[i])
+}
@@ -6,6 +6,7 @@ import (
 	"io"
 	"os"
 	"reflect"
+	"strings"
 	"text/template"
 
 	"github.com/acorn-io/aml"
@@ -54,6 +55,9 @@ type buffered struct {
 
 type FormatFunc any
 
+// GoTemplatePrefix marks a format as a Go template explicitly, e.g. "go-template={{.Name}}"
+const GoTemplatePrefix = "go-template="
+
 func NewWriter(values [][]string, quiet bool, format string) Writer {
 	t := &writer{
 		funcMap: maps.Clone(FuncMap),
@@ -75,6 +79,10 @@ func NewWriter(values [][]string, quiet bool, format string) Writer {
 	}
 
 	switch {
+	case strings.HasPrefix(format, GoTemplatePrefix):
+		t.HeaderFormat = ""
+		t.ValueFormat = strings.TrimPrefix(format, GoTemplatePrefix) + "\n"
+		t.customFormat = true
 	case isDataFormat(format):
 		t.HeaderFormat = ""
 		t.ValueFormat = format
@@ -313,6 +321,29 @@ func (t *writer) printTemplate(out io.Writer, templateContent string, obj any) e
 	return tmpl.Execute(out, obj)
 }
 
+// ValidateFormat checks a custom format before any objects are fetched to write with it. The template is parsed and,
+// if sample isn't nil, executed against it so that references to fields that don't exist are reported.
+func ValidateFormat(format string, sample any) error {
+	if format == "" || format == "table" || isDataFormat(format) {
+		return nil
+	}
+
+	tmpl, err := template.New("").Funcs(FuncMap).Parse(strings.TrimPrefix(format, GoTemplatePrefix))
+	if err != nil {
+		return fmt.Errorf("invalid output format: %w", err)
+	}
+
+	if sample != nil {
+		// Only report missing fields, the zero values of a sample may fail other parts of a valid template
+		var execErr template.ExecError
+		if err := tmpl.Execute(io.Discard, sample); errors.As(err, &execErr) && strings.Contains(execErr.Error(), "can't evaluate field") {
+			return fmt.Errorf("invalid output format: %w", err)
+		}
+	}
+
+	return nil
+}
+
 func isDataFormat(format string) bool {
 	switch format {
 	case "aml", "json", "jsoncompact", "yaml":
@@ -0,0 +1,59 @@
+package table
+
+import (
+	"testing"
+
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateFormat(t *testing.T) {
+	tests := []struct {
+		name    string
+		format  string
+		wantErr string
+	}{
+		{name: "table", format: ""},
+		{name: "data format", format: "json"},
+		{name: "template", format: "{{.Name}}"},
+		{name: "prefixed template", format: "go-template={{.Name}} {{.Status.Namespace}}"},
+		{name: "template with funcs", format: "go-template={{.Name}} {{ago .CreationTimestamp}}"},
+		{
+			name:   "nil pointers in the sample are not errors",
+			format: "go-template={{.Spec.Stop}}",
+		},
+		{
+			name:    "parse error",
+			format:  "go-template={{.Name",
+			wantErr: "invalid output format: template: :1: unclosed action",
+		},
+		{
+			name:    "unknown function",
+			format:  "go-template={{nope .Name}}",
+			wantErr: `invalid output format: template: :1: function "nope" not defined`,
+		},
+		{
+			name:    "unknown field",
+			format:  "go-template={{.Name}} {{.Spec.Imag}}",
+			wantErr: `invalid output format: template: :1:17: executing "" at <.Spec.Imag>: can't evaluate field Imag in type v1.AppInstanceSpec`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateFormat(tt.format, &apiv1.App{})
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestFormatByteSize(t *testing.T) {
+	assert.Equal(t, "", FormatByteSize(0))
+	assert.Equal(t, "512B", FormatByteSize(512))
+	assert.Equal(t, "2.05kB", FormatByteSize(2048))
+	assert.Equal(t, "12.3MB", FormatByteSize(12345678))
+	assert.Equal(t, "1.5GB", FormatByteSize(1500000000))
+}
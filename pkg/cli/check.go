@@ -15,7 +15,10 @@ func NewCheck(c CommandContext) *cobra.Command {
 	return cli.Command(&Check{client: c.ClientFactory}, cobra.Command{
 		Use: "check",
 		Example: `
-acorn check`,
+acorn check
+
+# Fail on warnings too, e.g. when a cluster is onboarded in CI
+acorn check --fail-on warn -o json`,
 		SilenceUsage: true,
 		Short:        "Check if the cluster is ready for Acorn",
 	})
@@ -28,22 +31,31 @@ type Check struct {
 	Image            string  `usage:"Override the image used for test deployments." short:"i"`
 	IngressClassName *string `usage:"Specify ingress class used for tests"`
 	TestNamespace    *string `usage:"Specify namespace used for tests" short:"n"`
+	FailOn           string  `usage:"Fail if a check has this status or a worse one (fail, warn)" default:"fail"`
 	client           ClientFactory
 }
 
 func (a *Check) Run(cmd *cobra.Command, args []string) error {
+	failOn := install.CheckStatus(a.FailOn)
+	if failOn != install.CheckStatusFail && failOn != install.CheckStatusWarn {
+		return fmt.Errorf("invalid --fail-on %s, must be %s or %s", a.FailOn, install.CheckStatusFail, install.CheckStatusWarn)
+	}
+
 	checkOpts := install.CheckOptions{RuntimeImage: a.Image, IngressClassName: a.IngressClassName, Namespace: a.TestNamespace}
 	checkresult := install.RunChecks(cmd.Context(), checkOpts,
 		install.CheckRBAC,
 		install.CheckNodesReady,
+		install.CheckCRDs,
 		install.CheckDefaultStorageClass,
+		install.CheckIngressClass,
+		install.CheckClusterDNS,
 		install.CheckIngressCapability,
 		install.CheckExec,
 	)
 
 	failures := 0
 	for _, r := range checkresult {
-		if !r.Passed {
+		if r.Status == install.CheckStatusFail || (r.Status == install.CheckStatusWarn && failOn == install.CheckStatusWarn) {
 			failures++
 		}
 	}
@@ -62,7 +74,9 @@ func (a *Check) Run(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("%d checks failed", failures)
 	}
 
-	pterm.Success.Println("Checks PASSED")
+	if a.Output == "" {
+		pterm.Success.Println("Checks PASSED")
+	}
 
 	return nil
 }
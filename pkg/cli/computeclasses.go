@@ -1,29 +1,50 @@
 package cli
 
 import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
 	cli "github.com/acorn-io/runtime/pkg/cli/builder"
 	"github.com/acorn-io/runtime/pkg/cli/builder/table"
 	"github.com/acorn-io/runtime/pkg/tables"
 	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/utils/strings/slices"
+	"sigs.k8s.io/yaml"
 )
 
 func NewComputeClasses(c CommandContext) *cobra.Command {
-	return cli.Command(&ComputeClass{client: c.ClientFactory}, cobra.Command{
+	cmd := cli.Command(&ComputeClass{out: c.StdOut, client: c.ClientFactory}, cobra.Command{
 		Use:     "computeclasses [flags] [COMPUTECLASS_NAME...]",
 		Aliases: []string{"computeclass", "wc", "workload"},
 		Example: `
-acorn computeclasses`,
+acorn computeclasses
+
+# Show the memory, CPU and scheduling details of a compute class
+acorn computeclasses --describe small
+
+# Print the full compute class for scripts
+acorn computeclasses --describe small -o json`,
 		SilenceUsage:      true,
 		Short:             "List available ComputeClasses",
 		ValidArgsFunction: newCompletion(c.ClientFactory, computeClassCompletion).complete,
 	})
+	if err := cmd.RegisterFlagCompletionFunc("describe", newCompletion(c.ClientFactory, computeClassCompletion).complete); err != nil {
+		cmd.Printf("Error registering completion function for --describe flag: %v\n", err)
+	}
+	return cmd
 }
 
 type ComputeClass struct {
-	Quiet  bool   `usage:"Output only names" short:"q"`
-	Output string `usage:"Output format (json, yaml, {{gotemplate}})" short:"o"`
-	client ClientFactory
+	Quiet    bool   `usage:"Output only names" short:"q"`
+	Output   string `usage:"Output format (json, yaml, {{gotemplate}})" short:"o"`
+	Describe string `usage:"Show the memory, CPU and scheduling details of the named ComputeClass"`
+	out      io.Writer
+	client   ClientFactory
 }
 
 func (a *ComputeClass) Run(cmd *cobra.Command, args []string) error {
@@ -34,6 +55,32 @@ func (a *ComputeClass) Run(cmd *cobra.Command, args []string) error {
 
 	out := table.NewWriter(tables.ComputeClass, a.Quiet, a.Output)
 
+	if a.Describe != "" {
+		cc, err := c.ComputeClassGet(cmd.Context(), a.Describe)
+		if apierrors.IsNotFound(err) {
+			return fmt.Errorf("compute class %s does not exist", a.Describe)
+		} else if err != nil {
+			return err
+		}
+
+		switch {
+		case a.Output == "json":
+			return printJSON(cc)
+		case a.Output == "yaml":
+			data, err := yaml.Marshal(cc)
+			if err != nil {
+				return err
+			}
+			_, err = a.out.Write(data)
+			return err
+		case a.Quiet || a.Output != "":
+			out.Write(cc)
+			return out.Err()
+		}
+		_, err = fmt.Fprint(a.out, describeComputeClass(cc))
+		return err
+	}
+
 	if len(args) == 1 {
 		cc, err := c.ComputeClassGet(cmd.Context(), args[0])
 		if err != nil {
@@ -56,3 +103,116 @@ func (a *ComputeClass) Run(cmd *cobra.Command, args []string) error {
 
 	return out.Err()
 }
+
+// describeComputeClass renders the details of a compute class as indented "Key: value" lines.
+func describeComputeClass(cc *apiv1.ComputeClass) string {
+	sb := &strings.Builder{}
+	line := func(indent int, key, value string) {
+		if value == "" {
+			value = "<none>"
+		}
+		fmt.Fprintf(sb, "%s%s: %s\n", strings.Repeat("  ", indent), key, value)
+	}
+
+	line(0, "Name", cc.Name)
+	line(0, "Description", cc.Description)
+	line(0, "Default", strconv.FormatBool(cc.Default))
+	line(0, "Regions", strings.Join(cc.SupportedRegions, ", "))
+
+	sb.WriteString("Memory:\n")
+	line(1, "Min", cc.Memory.Min)
+	line(1, "Max", cc.Memory.Max)
+	line(1, "Default", cc.Memory.Default)
+	if len(cc.Memory.Values) > 0 {
+		line(1, "Values", strings.Join(cc.Memory.Values, ", "))
+	}
+
+	if cc.CPUScaler == 0 {
+		line(0, "CPU Scaler", "")
+	} else {
+		line(0, "CPU Scaler", fmt.Sprintf("%s (CPUs per Gi of memory)", strconv.FormatFloat(cc.CPUScaler, 'f', -1, 64)))
+	}
+
+	affinity := affinitySummary(cc.Affinity)
+	if len(affinity) == 0 {
+		line(0, "Affinity", "")
+	} else {
+		sb.WriteString("Affinity:\n")
+		for _, a := range affinity {
+			fmt.Fprintf(sb, "  %s\n", a)
+		}
+	}
+
+	if len(cc.Tolerations) == 0 {
+		line(0, "Tolerations", "")
+	} else {
+		sb.WriteString("Tolerations:\n")
+		for _, t := range cc.Tolerations {
+			fmt.Fprintf(sb, "  %s\n", tolerationString(t))
+		}
+	}
+
+	return sb.String()
+}
+
+// affinitySummary returns one line per node selector requirement and per pod (anti-)affinity term of the affinity.
+func affinitySummary(affinity *corev1.Affinity) []string {
+	if affinity == nil {
+		return nil
+	}
+
+	var result []string
+	if na := affinity.NodeAffinity; na != nil {
+		if na.RequiredDuringSchedulingIgnoredDuringExecution != nil {
+			for _, term := range na.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms {
+				result = append(result, "required nodes: "+nodeSelectorTermString(term))
+			}
+		}
+		for _, term := range na.PreferredDuringSchedulingIgnoredDuringExecution {
+			result = append(result, fmt.Sprintf("preferred nodes (weight %d): %s", term.Weight, nodeSelectorTermString(term.Preference)))
+		}
+	}
+	if pa := affinity.PodAffinity; pa != nil {
+		if n := len(pa.RequiredDuringSchedulingIgnoredDuringExecution) + len(pa.PreferredDuringSchedulingIgnoredDuringExecution); n > 0 {
+			result = append(result, fmt.Sprintf("pod affinity: %d term(s)", n))
+		}
+	}
+	if pa := affinity.PodAntiAffinity; pa != nil {
+		if n := len(pa.RequiredDuringSchedulingIgnoredDuringExecution) + len(pa.PreferredDuringSchedulingIgnoredDuringExecution); n > 0 {
+			result = append(result, fmt.Sprintf("pod anti-affinity: %d term(s)", n))
+		}
+	}
+	return result
+}
+
+func nodeSelectorTermString(term corev1.NodeSelectorTerm) string {
+	var requirements []string
+	for _, expr := range append(append([]corev1.NodeSelectorRequirement{}, term.MatchExpressions...), term.MatchFields...) {
+		switch expr.Operator {
+		case corev1.NodeSelectorOpExists, corev1.NodeSelectorOpDoesNotExist:
+			requirements = append(requirements, fmt.Sprintf("%s %s", expr.Key, expr.Operator))
+		default:
+			requirements = append(requirements, fmt.Sprintf("%s %s [%s]", expr.Key, expr.Operator, strings.Join(expr.Values, ", ")))
+		}
+	}
+	return strings.Join(requirements, ", ")
+}
+
+// tolerationString formats a toleration like kubectl does, e.g. "key=value:NoSchedule".
+func tolerationString(t corev1.Toleration) string {
+	s := t.Key
+	if t.Operator == corev1.TolerationOpExists {
+		if s == "" {
+			s = "<all>"
+		}
+	} else if t.Value != "" {
+		s += "=" + t.Value
+	}
+	if t.Effect != "" {
+		s += ":" + string(t.Effect)
+	}
+	if t.TolerationSeconds != nil {
+		s += fmt.Sprintf(" for %ds", *t.TolerationSeconds)
+	}
+	return s
+}
@@ -0,0 +1,106 @@
+package cli
+
+import (
+	"io"
+	"os"
+	"strings"
+	"testing"
+
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/cli/testdata"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestComputeClass(t *testing.T) {
+	computeClasses := []apiv1.ComputeClass{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "small"},
+			Default:    true,
+			Memory: apiv1.ComputeClassMemory{
+				Min:     "512Mi",
+				Max:     "1Gi",
+				Default: "512Mi",
+			},
+			Description:      "Small workloads",
+			SupportedRegions: []string{apiv1.LocalRegion},
+			CPUScaler:        0.25,
+			Affinity: &corev1.Affinity{
+				NodeAffinity: &corev1.NodeAffinity{
+					RequiredDuringSchedulingIgnoredDuringExecution: &corev1.NodeSelector{
+						NodeSelectorTerms: []corev1.NodeSelectorTerm{
+							{
+								MatchExpressions: []corev1.NodeSelectorRequirement{
+									{Key: "pool", Operator: corev1.NodeSelectorOpIn, Values: []string{"small", "shared"}},
+									{Key: "gpu", Operator: corev1.NodeSelectorOpDoesNotExist},
+								},
+							},
+						},
+					},
+				},
+			},
+			Tolerations: []corev1.Toleration{
+				{Key: "pool", Operator: corev1.TolerationOpEqual, Value: "small", Effect: corev1.TaintEffectNoSchedule},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "large"},
+			Memory: apiv1.ComputeClassMemory{
+				Values: []string{"4Gi", "8Gi"},
+			},
+		},
+	}
+
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr bool
+		wantOut string
+	}{
+		{
+			name:    "acorn computeclasses --describe with affinity and tolerations",
+			args:    []string{"--describe", "small"},
+			wantOut: "Name: small\nDescription: Small workloads\nDefault: true\nRegions: local\nMemory:\n  Min: 512Mi\n  Max: 1Gi\n  Default: 512Mi\nCPU Scaler: 0.25 (CPUs per Gi of memory)\nAffinity:\n  required nodes: pool In [small, shared], gpu DoesNotExist\nTolerations:\n  pool=small:NoSchedule\n",
+		},
+		{
+			name:    "acorn computeclasses --describe without scheduling details",
+			args:    []string{"--describe", "large"},
+			wantOut: "Name: large\nDescription: <none>\nDefault: false\nRegions: <none>\nMemory:\n  Min: <none>\n  Max: <none>\n  Default: <none>\n  Values: 4Gi, 8Gi\nCPU Scaler: <none>\nAffinity: <none>\nTolerations: <none>\n",
+		},
+		{
+			name:    "acorn computeclasses --describe -o json",
+			args:    []string{"--describe", "large", "-o", "json"},
+			wantOut: "{\n  \"metadata\": {\n    \"name\": \"large\",\n    \"creationTimestamp\": null\n  },\n  \"memory\": {\n    \"values\": [\n      \"4Gi\",\n      \"8Gi\"\n    ]\n  },\n  \"default\": false\n}\n",
+		},
+		{
+			name:    "acorn computeclasses --describe of missing class",
+			args:    []string{"--describe", "dne"},
+			wantErr: true,
+			wantOut: "compute class dne does not exist",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, w, _ := os.Pipe()
+			os.Stdout = w
+			cmd := NewComputeClasses(CommandContext{
+				ClientFactory: &testdata.MockClientFactory{ComputeClassList: computeClasses},
+				StdOut:        w,
+				StdErr:        w,
+				StdIn:         strings.NewReader(""),
+			})
+			cmd.SetArgs(tt.args)
+			err := cmd.Execute()
+			if err != nil && !tt.wantErr {
+				assert.Failf(t, "got err when err not expected", "got err: %s", err.Error())
+			} else if err != nil && tt.wantErr {
+				assert.Equal(t, tt.wantOut, err.Error())
+			} else {
+				assert.Nil(t, w.Close(), "error closing writer")
+				out, _ := io.ReadAll(r)
+				assert.Equal(t, tt.wantOut, string(out))
+			}
+		})
+	}
+}
@@ -3,6 +3,7 @@ package cli
 import (
 	"context"
 
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
 	cli "github.com/acorn-io/runtime/pkg/cli/builder"
 	"github.com/acorn-io/runtime/pkg/cli/builder/table"
 	"github.com/acorn-io/runtime/pkg/client"
@@ -27,12 +28,16 @@ acorn containers`,
 
 type Container struct {
 	Quiet  bool   `usage:"Output only names" short:"q"`
-	Output string `usage:"Output format (json, yaml, {{gotemplate}})" short:"o"`
+	Output string `usage:"Output format (json, yaml, {{gotemplate}}, go-template={{gotemplate}})" short:"o"`
 	All    bool   `usage:"Include stopped containers" short:"a"`
 	client ClientFactory
 }
 
 func (a *Container) Run(cmd *cobra.Command, args []string) error {
+	if err := table.ValidateFormat(a.Output, &apiv1.ContainerReplica{}); err != nil {
+		return err
+	}
+
 	c, err := a.client.CreateDefault()
 	if err != nil {
 		return err
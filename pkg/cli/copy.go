@@ -1,16 +1,18 @@
 package cli
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net/http"
 	"os"
+	"strings"
 	"time"
 
 	"github.com/acorn-io/baaah/pkg/typed"
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
 	cli "github.com/acorn-io/runtime/pkg/cli/builder"
 	"github.com/acorn-io/runtime/pkg/client"
-	acornsign "github.com/acorn-io/runtime/pkg/cosign"
 	"github.com/acorn-io/runtime/pkg/images"
 	"github.com/acorn-io/runtime/pkg/progressbar"
 	"github.com/google/go-containerregistry/pkg/name"
@@ -27,7 +29,8 @@ func NewImageCopy(c CommandContext) *cobra.Command {
 
   This command copies Acorn images between remote image registries.
   It does not interact with images stored in the Acorn internal registry, or with the Acorn API in any way.
-  To set up credentials for a registry, use 'acorn login -l <registry>'. It only works with locally stored credentials.`,
+  To set up credentials for a registry, use 'acorn login -l <registry>'. It only works with locally stored credentials,
+  unless they are overridden with --src-auth and --dst-auth.`,
 		Aliases:           []string{"cp"},
 		SilenceUsage:      true,
 		Short:             "Copy Acorn images between registries",
@@ -40,14 +43,25 @@ func NewImageCopy(c CommandContext) *cobra.Command {
     acorn copy docker.io/<username>/myimage:main prod --force
 
   # Copy all tags on a particular image repo in Docker Hub to GHCR:
-    acorn copy --all-tags docker.io/<username>/myimage ghcr.io/<username>/myimage`,
+    acorn copy --all-tags docker.io/<username>/myimage ghcr.io/<username>/myimage
+
+  # Copy an image without its signature, using other credentials for the destination registry:
+    acorn copy --sign-artifacts=false --dst-auth <username>:<password> docker.io/<username>/myimage:v1 ghcr.io/<username>/myimage:v1
+
+  # Copy an image with all of its signatures and attestations, and only if all of them can be copied:
+    acorn copy --all-signatures-and-attestations --atomic docker.io/<username>/myimage:v1 ghcr.io/<username>/myimage:v1`,
 	})
 }
 
 type ImageCopy struct {
-	AllTags bool `usage:"Copy all tags of the image" short:"a"`
-	Force   bool `usage:"Overwrite the destination image if it already exists" short:"f"`
-	client  ClientFactory
+	AllTags                      bool   `usage:"Copy all tags of the image" short:"a"`
+	Force                        bool   `usage:"Overwrite the destination image if it already exists" short:"f"`
+	SignArtifacts                *bool  `usage:"Copy the signature of the image as well, --sign-artifacts=false only copies the image (default: true)"`
+	AllSignaturesAndAttestations bool   `usage:"Copy all signatures and attestations (SBOM, provenance) of the image as well, verifying the digest of each copy"`
+	Atomic                       bool   `usage:"Don't copy the image if any of its signatures or attestations fails to copy, used with --all-signatures-and-attestations"`
+	SrcAuth                      string `usage:"Credentials for the source registry in USERNAME:PASSWORD form, instead of the stored ones"`
+	DstAuth                      string `usage:"Credentials for the destination registry in USERNAME:PASSWORD form, instead of the stored ones"`
+	client                       ClientFactory
 }
 
 func (a *ImageCopy) Run(cmd *cobra.Command, args []string) (err error) {
@@ -65,115 +79,80 @@ func (a *ImageCopy) Run(cmd *cobra.Command, args []string) (err error) {
 		}
 	}()
 
-	source, err := name.ParseReference(args[0], name.WithDefaultRegistry(images.NoDefaultRegistry))
-	if err != nil {
-		return err
-	}
-	if source.Context().RegistryStr() == images.NoDefaultRegistry {
-		return fmt.Errorf("image %s has no specified registry", args[0])
-	}
-
-	sourceAuth, err := getAuthForImage(cmd.Context(), a.client, args[0])
-	if err != nil {
-		return err
+	if a.Atomic && !a.AllSignaturesAndAttestations {
+		return errors.New("--atomic can only be used with --all-signatures-and-attestations")
 	}
-	sourceOpts := []remote.Option{remote.WithContext(cmd.Context())}
-	if sourceAuth != nil {
-		sourceKeychain := images.NewSimpleKeychain(source.Context(), *sourceAuth, nil)
-		sourceOpts = append(sourceOpts, remote.WithAuthFromKeychain(sourceKeychain))
+	if a.AllTags && a.AllSignaturesAndAttestations {
+		return errors.New("cannot use --all-signatures-and-attestations with --all-tags, which copies the signature and attestation tags already")
 	}
 
-	dest, err := name.ParseReference(args[1], name.WithDefaultRegistry(images.NoDefaultRegistry))
-	if err != nil {
-		return err
-	}
-	if dest.Context().RegistryStr() == images.NoDefaultRegistry {
-		// If the dest has no registry, then it is just a new tag to create on the source image
-		return a.copyTag(source, args[1], sourceOpts)
-	}
-
-	destAuth, err := getAuthForImage(cmd.Context(), a.client, args[1])
+	source, err := name.ParseReference(args[0], name.WithDefaultRegistry(images.NoDefaultRegistry))
 	if err != nil {
 		return err
 	}
-	destOpts := []remote.Option{remote.WithContext(cmd.Context())}
-	if destAuth != nil {
-		destKeychain := images.NewSimpleKeychain(dest.Context(), *destAuth, nil)
-		destOpts = append(destOpts, remote.WithAuthFromKeychain(destKeychain))
-	}
-
-	if a.AllTags {
-		return a.copyRepo(args, sourceOpts, destOpts)
+	if source.Context().RegistryStr() == images.NoDefaultRegistry {
+		return fmt.Errorf("image %s has no specified registry", args[0])
 	}
 
-	sourceIndex, err := remote.Index(source, sourceOpts...)
-	if err != nil {
-		return err
-	}
+	return a.withAuth(cmd.Context(), a.SrcAuth, args[0], func(sourceAuth *apiv1.RegistryAuth) error {
+		sourceOpts := []remote.Option{remote.WithContext(cmd.Context())}
+		if sourceAuth != nil {
+			sourceKeychain := images.NewSimpleKeychain(source.Context(), *sourceAuth, nil)
+			sourceOpts = append(sourceOpts, remote.WithAuthFromKeychain(sourceKeychain))
+		}
 
-	if !a.Force {
-		if err := errIfImageExistsAndIsDifferent(sourceIndex, dest, destOpts); err != nil {
+		dest, err := name.ParseReference(args[1], name.WithDefaultRegistry(images.NoDefaultRegistry))
+		if err != nil {
 			return err
 		}
-	}
-
-	// Signature
-	sourceDigest, err := acornsign.SimpleDigest(source, sourceOpts...)
-	if err != nil {
-		return err
-	}
-	sigTag, sig, err := acornsign.FindSignatureImage(source.Context().Digest(sourceDigest), sourceOpts...)
-	if err != nil {
-		return err
-	}
-	sigPushTag := dest.Context().Tag(sigTag.TagStr())
-
-	// metachannel is used to send another channel with updates for each tag to be copied
-	metachannel := make(chan images.SimpleUpdate)
-	// progress is the channel returned by this function and used to write websocket messages to the client
-	progress := make(chan images.ImageProgress)
+		if dest.Context().RegistryStr() == images.NoDefaultRegistry {
+			// If the dest has no registry, then it is just a new tag to create on the source image
+			return a.copyTag(source, args[1], sourceOpts)
+		}
 
-	go func() {
-		defer close(progress)
-		images.ForwardUpdates(progress, metachannel)
-	}()
+		return a.withAuth(cmd.Context(), a.DstAuth, args[1], func(destAuth *apiv1.RegistryAuth) error {
+			destOpts := []remote.Option{remote.WithContext(cmd.Context())}
+			if destAuth != nil {
+				destKeychain := images.NewSimpleKeychain(dest.Context(), *destAuth, nil)
+				destOpts = append(destOpts, remote.WithAuthFromKeychain(destKeychain))
+			}
 
-	go func() {
-		defer close(metachannel)
-		images.RemoteWrite(metachannel, dest, sourceIndex, fmt.Sprintf("Copying %s to %s", args[0], args[1]), nil, destOpts...)
+			if a.AllTags {
+				return a.copyRepo(args, sourceOpts, destOpts)
+			}
 
-		if sig != nil {
-			images.RemoteWrite(metachannel, sigPushTag, sig, fmt.Sprintf("Copying %s to %s", sigTag.String(), sigPushTag.String()), nil, destOpts...)
-		}
-	}()
+			progress, err := client.ImageCopy(cmd.Context(), args[0], args[1], &client.ImageCopyOptions{
+				SourceAuth:                   sourceAuth,
+				DestAuth:                     destAuth,
+				SignArtifacts:                a.SignArtifacts == nil || *a.SignArtifacts,
+				AllSignaturesAndAttestations: a.AllSignaturesAndAttestations,
+				Atomic:                       a.Atomic,
+				Force:                        a.Force,
+			})
+			if err != nil {
+				return err
+			}
 
-	return progressbar.Print(adaptChannel(progress))
+			return progressbar.Print(typed.Every(500*time.Millisecond, progress))
+		})
+	})
 }
 
-func errIfImageExistsAndIsDifferent(sourceIndex ggcrv1.ImageIndex, destRef name.Reference, destOpts []remote.Option) error {
-	// Make sure that we are not about to destroy the remote index
-	destIndex, err := remote.Index(destRef, destOpts...)
-	var terr *transport.Error
-	if ok := errors.As(err, &terr); ok && terr.StatusCode == http.StatusNotFound {
-		return nil
-	} else if err != nil {
-		return err
+// withAuth calls fn with the credentials given by a flag in USERNAME:PASSWORD form, or with the stored ones for the
+// image, which are resolved again and fn retried once if the registry rejects them.
+func (a *ImageCopy) withAuth(ctx context.Context, flag, image string, fn func(auth *apiv1.RegistryAuth) error) error {
+	if flag == "" {
+		return withAuthForImage(ctx, a.client, image, fn)
 	}
 
-	destDigest, err := destIndex.Digest()
-	if err != nil {
-		return err
-	}
-
-	sourceDigest, err := sourceIndex.Digest()
-	if err != nil {
-		return err
-	}
-
-	if destDigest.String() != sourceDigest.String() {
-		return fmt.Errorf("not copying image to %s since it already exists (use --force to override)", destRef.String())
+	username, password, ok := strings.Cut(flag, ":")
+	if !ok || username == "" {
+		return fmt.Errorf("invalid credentials for %s, must be in USERNAME:PASSWORD form", image)
 	}
-	return nil
+	return fn(&apiv1.RegistryAuth{
+		Username: username,
+		Password: password,
+	})
 }
 
 func (a *ImageCopy) copyRepo(args []string, sourceOpts, destOpts []remote.Option) error {
@@ -232,7 +211,7 @@ func (a *ImageCopy) copyRepo(args []string, sourceOpts, destOpts []remote.Option
 	if !a.Force {
 		var newIndexSlice []ggcrv1.ImageIndex
 		for i, imageIndex := range sourceIndexes {
-			if err := errIfImageExistsAndIsDifferent(imageIndex, destRepo.Tag(sourceTags[i]), destOpts); err == nil {
+			if err := images.ErrIfImageExistsAndIsDifferent(imageIndex, destRepo.Tag(sourceTags[i]), destOpts); err == nil {
 				newIndexSlice = append(newIndexSlice, imageIndex)
 			}
 		}
@@ -282,7 +261,7 @@ func (a *ImageCopy) copyTag(source name.Reference, newTag string, sourceOpts []r
 	}
 
 	if !a.Force {
-		if err := errIfImageExistsAndIsDifferent(sourceIndex, dest, sourceOpts); err != nil {
+		if err := images.ErrIfImageExistsAndIsDifferent(sourceIndex, dest, sourceOpts); err != nil {
 			return err
 		}
 	}
@@ -0,0 +1,104 @@
+package cli
+
+import (
+	"io"
+	"log"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/acorn-io/runtime/pkg/cli/testdata"
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/registry"
+	"github.com/google/go-containerregistry/pkg/v1/random"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestImageCopy(t *testing.T) {
+	s := httptest.NewServer(registry.New(registry.Logger(log.New(io.Discard, "", 0))))
+	defer s.Close()
+	host := strings.TrimPrefix(s.URL, "http://")
+
+	index, err := random.Index(64, 1, 1)
+	require.NoError(t, err)
+	source, err := name.ParseReference(host + "/user/image:v1")
+	require.NoError(t, err)
+	require.NoError(t, remote.WriteIndex(source, index))
+	digest, err := index.Digest()
+	require.NoError(t, err)
+	signature, err := random.Image(64, 1)
+	require.NoError(t, err)
+	signatureTag := strings.Replace(digest.String(), ":", "-", 1) + ".sig"
+	require.NoError(t, remote.Write(source.Context().Tag(signatureTag), signature))
+
+	tests := []struct {
+		name          string
+		args          []string
+		wantErr       string
+		wantSignature bool
+	}{
+		{
+			name:          "acorn copy with credentials",
+			args:          []string{"--src-auth", "user:pass", "--dst-auth", "user:pass", host + "/user/image:v1", host + "/copy/image:v1"},
+			wantSignature: true,
+		},
+		{
+			name: "acorn copy without the signature",
+			args: []string{"--src-auth", "user:pass", "--dst-auth", "user:pass", "--sign-artifacts=false", host + "/user/image:v1", host + "/unsigned/image:v1"},
+		},
+		{
+			name:    "acorn copy with invalid credentials",
+			args:    []string{"--src-auth", "user", "docker.io/user/image:v1", "ghcr.io/user/image:v1"},
+			wantErr: "invalid credentials for docker.io/user/image:v1, must be in USERNAME:PASSWORD form",
+		},
+		{
+			name:          "acorn copy with all signatures and attestations",
+			args:          []string{"--src-auth", "user:pass", "--dst-auth", "user:pass", "--all-signatures-and-attestations", "--atomic", host + "/user/image:v1", host + "/all/image:v1"},
+			wantSignature: true,
+		},
+		{
+			name:    "acorn copy --atomic without all signatures and attestations",
+			args:    []string{"--atomic", "docker.io/user/image:v1", "ghcr.io/user/image:v1"},
+			wantErr: "--atomic can only be used with --all-signatures-and-attestations",
+		},
+		{
+			name:    "acorn copy --all-tags with all signatures and attestations",
+			args:    []string{"--all-tags", "--all-signatures-and-attestations", "docker.io/user/image", "ghcr.io/user/image"},
+			wantErr: "cannot use --all-signatures-and-attestations with --all-tags, which copies the signature and attestation tags already",
+		},
+		{
+			name:    "acorn copy without source registry",
+			args:    []string{"image:v1", "ghcr.io/user/image:v1"},
+			wantErr: "image image:v1 has no specified registry",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := NewImageCopy(CommandContext{
+				ClientFactory: &testdata.MockClientFactory{},
+				StdIn:         strings.NewReader(""),
+			})
+			cmd.SetArgs(tt.args)
+			err := cmd.Execute()
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+
+			dest, err := name.ParseReference(tt.args[len(tt.args)-1])
+			require.NoError(t, err)
+			desc, err := remote.Head(dest)
+			require.NoError(t, err)
+			assert.Equal(t, digest, desc.Digest)
+			_, err = remote.Head(dest.Context().Tag(signatureTag))
+			if tt.wantSignature {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
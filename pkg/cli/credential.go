@@ -21,6 +21,7 @@ acorn credential`,
 	})
 	cmd.AddCommand(NewCredentialLogin(false, c))
 	cmd.AddCommand(NewCredentialLogout(false, c))
+	cmd.AddCommand(NewCredentialValidate(c))
 	return cmd
 }
 
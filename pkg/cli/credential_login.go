@@ -1,6 +1,8 @@
 package cli
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"io"
 	"os"
@@ -11,8 +13,10 @@ import (
 	cli "github.com/acorn-io/runtime/pkg/cli/builder"
 	"github.com/acorn-io/runtime/pkg/client"
 	"github.com/acorn-io/runtime/pkg/credentials"
+	"github.com/acorn-io/runtime/pkg/imagesource"
 	"github.com/acorn-io/runtime/pkg/login"
 	"github.com/acorn-io/runtime/pkg/manager"
+	"github.com/acorn-io/runtime/pkg/prompt"
 	"github.com/acorn-io/runtime/pkg/system"
 	"github.com/pterm/pterm"
 	"github.com/spf13/cobra"
@@ -24,7 +28,13 @@ func NewCredentialLogin(root bool, c CommandContext) *cobra.Command {
 		Use:     "login [flags] [SERVER_ADDRESS]",
 		Aliases: []string{"add"},
 		Example: `
-acorn login ghcr.io`,
+acorn login ghcr.io
+
+# Log in with a token from stdin, so that it isn't on the command line or in the shell history
+echo $TOKEN | acorn login --token-stdin -u ci ghcr.io
+
+# Print the credentials Acorn uses for a registry in docker credential helper JSON format
+acorn login --get ghcr.io`,
 		SilenceUsage: true,
 		Short:        "Add registry credentials",
 	})
@@ -39,6 +49,8 @@ type CredentialLogin struct {
 	SkipChecks        bool   `usage:"Bypass login validation checks"`
 	SetDefaultContext bool   `usage:"Set default context for project names"`
 	PasswordStdin     bool   `usage:"Take the password from stdin"`
+	TokenStdin        bool   `usage:"Take a token from stdin and use it as the password"`
+	Get               bool   `usage:"Print the credentials for the server in docker credential helper JSON format instead of logging in"`
 	Password          string `usage:"Password" short:"p"`
 	Username          string `usage:"Username" short:"u"`
 	client            ClientFactory
@@ -49,6 +61,19 @@ func (a *CredentialLogin) Run(cmd *cobra.Command, args []string) error {
 		client client.Client
 	)
 
+	if a.Get {
+		if len(args) != 1 {
+			return fmt.Errorf("--get requires exactly one server address")
+		}
+		return a.printCredential(cmd.Context(), args[0])
+	}
+
+	if a.PasswordStdin && a.TokenStdin {
+		return fmt.Errorf("only --password-stdin or --token-stdin can be set at once")
+	} else if (a.PasswordStdin || a.TokenStdin) && a.Password != "" {
+		return fmt.Errorf("--password can not be combined with --password-stdin or --token-stdin")
+	}
+
 	if len(args) == 1 {
 		if c, err := a.client.CreateDefault(); err == nil {
 			app, err := c.AppGet(cmd.Context(), args[0])
@@ -82,13 +107,15 @@ func (a *CredentialLogin) Run(cmd *cobra.Command, args []string) error {
 	}
 
 	if a.PasswordStdin {
-		contents, err := io.ReadAll(os.Stdin)
+		a.Password, err = readSecretStdin("Password")
+		if err != nil {
+			return err
+		}
+	} else if a.TokenStdin {
+		a.Password, err = readSecretStdin("Token")
 		if err != nil {
 			return err
 		}
-
-		a.Password = strings.TrimSuffix(string(contents), "\n")
-		a.Password = strings.TrimSuffix(a.Password, "\r")
 	}
 
 	var q []*survey.Question
@@ -182,3 +209,51 @@ func (a *CredentialLogin) Run(cmd *cobra.Command, args []string) error {
 	pterm.Success.Printf("Login to %s as %s succeeded\n", serverAddress, a.Username)
 	return nil
 }
+
+// dockerCredential is the format in which docker credential helpers print credentials.
+type dockerCredential struct {
+	ServerURL string `json:"ServerURL"`
+	Username  string `json:"Username"`
+	Secret    string `json:"Secret"`
+}
+
+// printCredential prints the credentials Acorn resolves for the server in the format of docker credential helpers, so
+// that other tools can use them.
+func (a *CredentialLogin) printCredential(ctx context.Context, serverAddress string) error {
+	c, err := a.client.CreateDefault()
+	if err != nil {
+		return err
+	}
+
+	creds, err := imagesource.GetCreds(a.client.AcornConfigFile(), c)
+	if err != nil {
+		return err
+	}
+
+	auth, found, err := creds(ctx, serverAddress)
+	if err != nil {
+		return err
+	} else if !found || auth == nil {
+		return fmt.Errorf("no credentials found for %s", serverAddress)
+	}
+
+	return json.NewEncoder(os.Stdout).Encode(dockerCredential{
+		ServerURL: serverAddress,
+		Username:  auth.Username,
+		Secret:    auth.Password,
+	})
+}
+
+// readSecretStdin reads a password or token from stdin like getPrivateKeyPass: a terminal is prompted without echoing
+// the input, piped input is read until EOF without its trailing newline.
+func readSecretStdin(message string) (string, error) {
+	if isTerm() {
+		secret, err := prompt.Password(message)
+		return string(secret), err
+	}
+	contents, err := io.ReadAll(os.Stdin)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimRight(string(contents), "\r\n"), nil
+}
@@ -0,0 +1,72 @@
+package cli
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/acorn-io/runtime/pkg/cli/testdata"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCredentialLogin(t *testing.T) {
+	acornConfigFile := filepath.Join(t.TempDir(), "acorn.yaml")
+	// valid:valid
+	require.NoError(t, os.WriteFile(acornConfigFile, []byte(`auths:
+  valid.example.com:
+    auth: dmFsaWQ6dmFsaWQ=
+`), 0600))
+
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+		wantOut string
+	}{
+		{
+			name:    "acorn login --get",
+			args:    []string{"--get", "valid.example.com"},
+			wantOut: "{\"ServerURL\":\"valid.example.com\",\"Username\":\"valid\",\"Secret\":\"valid\"}\n",
+		},
+		{
+			name:    "acorn login --get without server",
+			args:    []string{"--get"},
+			wantErr: "--get requires exactly one server address",
+		},
+		{
+			name:    "acorn login --password-stdin --token-stdin",
+			args:    []string{"--password-stdin", "--token-stdin", "valid.example.com"},
+			wantErr: "only --password-stdin or --token-stdin can be set at once",
+		},
+		{
+			name:    "acorn login --token-stdin -p",
+			args:    []string{"--token-stdin", "-p", "secret", "valid.example.com"},
+			wantErr: "--password can not be combined with --password-stdin or --token-stdin",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, w, _ := os.Pipe()
+			os.Stdout = w
+			cmd := NewCredentialLogin(true, CommandContext{
+				ClientFactory: &testdata.MockClientFactory{MockAcornConfigFile: acornConfigFile},
+				StdOut:        w,
+				StdErr:        w,
+				StdIn:         strings.NewReader(""),
+			})
+			cmd.SetArgs(tt.args)
+			err := cmd.Execute()
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Nil(t, w.Close(), "error closing writer")
+			out, _ := io.ReadAll(r)
+			assert.Equal(t, tt.wantOut, string(out))
+		})
+	}
+}
@@ -0,0 +1,106 @@
+package cli
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	cli "github.com/acorn-io/runtime/pkg/cli/builder"
+	"github.com/acorn-io/runtime/pkg/client"
+	"github.com/acorn-io/runtime/pkg/credentials"
+	"github.com/spf13/cobra"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+func NewCredentialValidate(c CommandContext) *cobra.Command {
+	return cli.Command(&CredentialValidate{out: c.StdOut, client: c.ClientFactory}, cobra.Command{
+		Use:     "test [flags] SERVER_ADDRESS",
+		Aliases: []string{"validate"},
+		Example: `
+# Check that the stored credentials for ghcr.io are accepted
+acorn credential test ghcr.io
+
+# Check that the stored credentials can push to a repository
+acorn credential test ghcr.io --repository acme/my-app`,
+		Long: `Test registry credentials
+
+Authenticates against the registry with the credentials stored on this client and reports whether the registry
+accepts them. Credentials stored in the project can't be read by the CLI, they are validated when they are added.
+If there are no credentials for the registry, it is accessed anonymously.`,
+		SilenceUsage:      true,
+		Short:             "Test registry credentials",
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: newCompletion(c.ClientFactory, credentialsCompletion).withShouldCompleteOptions(onlyNumArgs(1)).complete,
+	})
+}
+
+type CredentialValidate struct {
+	Repository string `usage:"Also test that the credentials can push to and pull from this repository of the registry"`
+	Output     string `usage:"Output format (json)" short:"o"`
+	out        io.Writer
+	client     ClientFactory
+}
+
+func (a *CredentialValidate) Run(cmd *cobra.Command, args []string) error {
+	if a.Output != "" && a.Output != "json" {
+		return fmt.Errorf("invalid output format %s, only json is supported", a.Output)
+	}
+
+	c, err := a.client.CreateDefault()
+	if err != nil {
+		return err
+	}
+
+	cfg, err := a.client.Options().CLIConfig()
+	if err != nil {
+		return err
+	}
+
+	store, err := credentials.NewLocalOnlyStore(cfg)
+	if err != nil {
+		return err
+	}
+
+	serverAddress := args[0]
+	auth, found, err := store.Get(cmd.Context(), serverAddress)
+	if err != nil {
+		return err
+	} else if !found {
+		if _, err := c.CredentialGet(cmd.Context(), serverAddress); err == nil {
+			return fmt.Errorf("credentials for %s are stored in the project and can't be read by the CLI, use acorn login --local-storage to test them", serverAddress)
+		} else if !apierrors.IsNotFound(err) {
+			return err
+		}
+	}
+
+	result, err := c.CredentialValidate(cmd.Context(), serverAddress, &client.CredentialValidateOptions{
+		Auth:       auth,
+		Repository: a.Repository,
+	})
+	if err != nil {
+		return err
+	}
+
+	if a.Output == "json" {
+		if err := printJSON(result); err != nil {
+			return err
+		}
+	} else {
+		who := "anonymous access"
+		if result.Username != "" {
+			who = "user " + result.Username
+		}
+		fmt.Fprintf(a.out, "Server: %s (%s)\n", result.ServerAddress, who)
+		if len(result.Scopes) > 0 {
+			fmt.Fprintf(a.out, "Scopes: %s\n", strings.Join(result.Scopes, ", "))
+		}
+		if result.Valid {
+			fmt.Fprintln(a.out, "Credentials are valid")
+		}
+	}
+
+	if !result.Valid {
+		return fmt.Errorf("credentials for %s are not valid: %s", result.ServerAddress, result.Error)
+	}
+	return nil
+}
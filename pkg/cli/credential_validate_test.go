@@ -0,0 +1,85 @@
+package cli
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/acorn-io/runtime/pkg/cli/testdata"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCredentialValidate(t *testing.T) {
+	acornConfigFile := filepath.Join(t.TempDir(), "acorn.yaml")
+	// valid:valid and alice:wrong
+	require.NoError(t, os.WriteFile(acornConfigFile, []byte(`auths:
+  valid.example.com:
+    auth: dmFsaWQ6dmFsaWQ=
+  invalid.example.com:
+    auth: YWxpY2U6d3Jvbmc=
+`), 0600))
+
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+		wantOut string
+	}{
+		{
+			name:    "acorn credential test valid",
+			args:    []string{"valid.example.com"},
+			wantOut: "Server: valid.example.com (user valid)\nCredentials are valid\n",
+		},
+		{
+			name:    "acorn credential test with repository",
+			args:    []string{"valid.example.com", "--repository", "acme/app"},
+			wantOut: "Server: valid.example.com (user valid)\nScopes: repository:acme/app:push,pull\nCredentials are valid\n",
+		},
+		{
+			name:    "acorn credential test -o json",
+			args:    []string{"valid.example.com", "-o", "json"},
+			wantOut: "{\n  \"serverAddress\": \"valid.example.com\",\n  \"username\": \"valid\",\n  \"valid\": true\n}\n",
+		},
+		{
+			name:    "acorn credential test invalid",
+			args:    []string{"invalid.example.com"},
+			wantErr: "credentials for invalid.example.com are not valid: UNAUTHORIZED: authentication required",
+			wantOut: "Server: invalid.example.com (user alice)\n",
+		},
+		{
+			name:    "acorn credential test stored in project",
+			args:    []string{"found"},
+			wantErr: "credentials for found are stored in the project and can't be read by the CLI, use acorn login --local-storage to test them",
+		},
+		{
+			name:    "acorn credential test invalid output",
+			args:    []string{"valid.example.com", "-o", "yaml"},
+			wantErr: "invalid output format yaml, only json is supported",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, w, _ := os.Pipe()
+			os.Stdout = w
+			cmd := NewCredentialValidate(CommandContext{
+				ClientFactory: &testdata.MockClientFactory{MockAcornConfigFile: acornConfigFile},
+				StdOut:        w,
+				StdErr:        w,
+				StdIn:         strings.NewReader(""),
+			})
+			cmd.SetArgs(tt.args)
+			err := cmd.Execute()
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Nil(t, w.Close(), "error closing writer")
+			out, _ := io.ReadAll(r)
+			assert.Equal(t, tt.wantOut, string(out))
+		})
+	}
+}
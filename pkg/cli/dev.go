@@ -26,6 +26,8 @@ acorn dev .
 acorn dev --name wandering-sound
 acorn dev --name wandering-sound <IMAGE>
 acorn dev --name wandering-sound --clone [acorn args]
+acorn dev --exclude node_modules --exclude /dist .
+acorn dev --no-rebuild --restart-cmd 'kill -HUP 1' .
 `})
 
 	// This will produce an error if the volume flag doesn't exist or a completion function has already
@@ -45,12 +47,15 @@ acorn dev --name wandering-sound --clone [acorn args]
 
 type Dev struct {
 	RunArgs
-	BidirectionalSync    bool   `usage:"In interactive mode download changes in addition to uploading" short:"b"`
-	Replace              bool   `usage:"Replace the app with only defined values, resetting undefined fields to default values" json:"replace,omitempty"` // Replace sets patchMode to false, resulting in a full update, resetting all undefined fields to their defaults
-	Clone                bool   `usage:"Clone the vcs repository and infer the build context for the given app allowing for local development"`
-	CloneDir             string `usage:"Provide a directory to clone the repository into, use in conjunction with clone flag" default:"." hidden:"true"`
-	SessionTimeout       string `usage:"Timeout in seconds for the dev session" default:"360s"`
-	SessionReleaseOnExit *bool  `usage:"Release the session when the dev command exits (default: true)"`
+	BidirectionalSync    bool     `usage:"In interactive mode download changes in addition to uploading" short:"b"`
+	Replace              bool     `usage:"Replace the app with only defined values, resetting undefined fields to default values" json:"replace,omitempty"` // Replace sets patchMode to false, resulting in a full update, resetting all undefined fields to their defaults
+	Clone                bool     `usage:"Clone the vcs repository and infer the build context for the given app allowing for local development"`
+	CloneDir             string   `usage:"Provide a directory to clone the repository into, use in conjunction with clone flag" default:"." hidden:"true"`
+	SessionTimeout       string   `usage:"Timeout in seconds for the dev session" default:"360s"`
+	SessionReleaseOnExit *bool    `usage:"Release the session when the dev command exits (default: true)"`
+	NoRebuild            bool     `usage:"Only sync changed files into the running containers, rebuild the image only when an Acornfile or a dependency manifest (like package.json or requirements.txt) changes"`
+	RestartCmd           string   `usage:"Command run with sh -c in the container after changed files were synced into it, to restart the app (ex: 'kill -HUP 1')"`
+	Exclude              []string `usage:"Exclude files matching the pattern (gitignore syntax, relative to the build directory) from the file watcher and sync, in addition to the ones of the .acornignore file" split:"false"`
 	out                  io.Writer
 	client               ClientFactory
 }
@@ -104,6 +109,9 @@ func (s *Dev) Run(cmd *cobra.Command, args []string) error {
 		Replace:           s.Replace,
 		Dangerous:         s.Dangerous,
 		BidirectionalSync: s.BidirectionalSync,
+		Exclude:           s.Exclude,
+		NoRebuild:         s.NoRebuild,
+		RestartCmd:        s.RestartCmd,
 		TimeoutSeconds:    int32(sessionTimeout.Seconds()),
 		ReleaseOnExit:     s.SessionReleaseOnExit,
 	})
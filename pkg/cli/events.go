@@ -28,6 +28,15 @@ func NewEvent(c CommandContext) *cobra.Command {
   # List the last 5 events and follow the event log
   acorn events --tail 5 -f
 
+  # Watch for new events related to the 'foo' app
+  acorn events -w --field-selector involved.kind=app,involved.name=foo
+
+  # Filter by Field
+  # The --field-selector option is evaluated by the server, it supports the fields involved.kind, involved.name,
+  # involved.uid, type, severity, actor and appName, and the operators =, == and !=.
+  # List events with a severity other than info
+  acorn events --field-selector severity!=info
+
   # Filter by Related Resource 
   # If a PREFIX is given in the form '<kind>/<name>', the results of this command are pruned to include
   # only those events related to resources matching the given kind and name.
@@ -59,12 +68,14 @@ func NewEvent(c CommandContext) *cobra.Command {
 }
 
 type Events struct {
-	Tail   int    `usage:"Return this number of latest events" short:"t"`
-	Follow bool   `usage:"Follow the event log" short:"f"`
-	Since  string `usage:"Show all events created since timestamp" short:"s"`
-	Until  string `usage:"Stream events until this timestamp" short:"u"`
-	Output string `usage:"Output format (json, yaml, {{gotemplate}})" short:"o"`
-	client ClientFactory
+	Tail          int    `usage:"Return this number of latest events" short:"t"`
+	Follow        bool   `usage:"Follow the event log" short:"f"`
+	Watch         bool   `usage:"Watch for new events as they occur, reconnecting if the connection is lost" short:"w"`
+	Since         string `usage:"Show all events created since timestamp" short:"s"`
+	Until         string `usage:"Stream events until this timestamp" short:"u"`
+	Output        string `usage:"Output format (json, yaml, {{gotemplate}})" short:"o"`
+	FieldSelector string `usage:"Filter events on the server by field, e.g. involved.kind=app,involved.name=foo (supports involved.kind, involved.name, involved.uid, type, severity, actor and appName)"`
+	client        ClientFactory
 }
 
 func (e *Events) Run(cmd *cobra.Command, args []string) error {
@@ -74,10 +85,11 @@ func (e *Events) Run(cmd *cobra.Command, args []string) error {
 	}
 
 	opts := &client.EventStreamOptions{
-		Tail:   e.Tail,
-		Follow: e.Follow,
-		Since:  e.Since,
-		Until:  e.Until,
+		Tail:          e.Tail,
+		Follow:        e.Follow || e.Watch,
+		Since:         e.Since,
+		Until:         e.Until,
+		FieldSelector: e.FieldSelector,
 	}
 
 	if len(args) > 0 {
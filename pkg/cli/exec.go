@@ -4,21 +4,32 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"sort"
+	"strconv"
+	"strings"
 
 	"github.com/AlecAivazis/survey/v2"
 	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
 	cli "github.com/acorn-io/runtime/pkg/cli/builder"
 	"github.com/acorn-io/runtime/pkg/cli/builder/table"
 	"github.com/acorn-io/runtime/pkg/client"
 	"github.com/acorn-io/runtime/pkg/client/term"
 	"github.com/acorn-io/runtime/pkg/streams"
 	"github.com/spf13/cobra"
+	"golang.org/x/exp/slices"
 )
 
 func NewExec(c CommandContext) *cobra.Command {
 	exec := &Exec{client: c.ClientFactory}
 	cmd := cli.Command(exec, cobra.Command{
-		Use:               "exec [flags] ACORN_NAME|CONTAINER_NAME CMD",
+		Use: "exec [flags] ACORN_NAME|CONTAINER_NAME CMD",
+		Example: `
+# Run a shell in the oldest replica of the web container of an app
+acorn exec --container web --replica 0 my-app sh
+
+# Same as above, addressing the replica as APP.CONTAINER.REPLICA
+acorn exec my-app.web.0 sh`,
 		SilenceUsage:      true,
 		Short:             "Run a command in a container",
 		Long:              "Run a command in a container",
@@ -36,10 +47,14 @@ func NewExec(c CommandContext) *cobra.Command {
 }
 
 type Exec struct {
-	Interactive bool   `usage:"Not used" short:"i"`
-	TTY         bool   `usage:"Not used" short:"t"`
-	DebugImage  string `usage:"Use image as container root for command" short:"d"`
-	Container   string `usage:"Name of container to exec into" short:"c"`
+	Interactive bool     `usage:"Not used" short:"i"`
+	TTY         bool     `usage:"Not used" short:"t"`
+	DebugImage  string   `usage:"Use image as container root for command" short:"d"`
+	Container   string   `usage:"Name of container to exec into" short:"c"`
+	Replica     *int     `usage:"Index of the replica of the container to exec into, starting at 0 for the oldest replica" short:"r"`
+	Env         []string `usage:"Environment variables to set for the command (format KEY=VALUE or KEY to use the local value), requires env in the container" short:"e" split:"false"`
+	WorkingDir  string   `usage:"Working directory to run the command in" short:"w"`
+	NoStdin     bool     `usage:"Don't pass stdin to the command, so that it reads EOF right away (default if stdin is not a terminal, pipe or file)"`
 	client      ClientFactory
 }
 
@@ -149,23 +164,130 @@ func getContainerForApp(ctx context.Context, c client.Client, app *apiv1.App, co
 	return names[choice], nil
 }
 
+// splitReplicaAddress splits an APP.CONTAINER.REPLICA address, like my-app.web.2, into its parts.
+func splitReplicaAddress(name string) (appName, containerName string, replica int, ok bool) {
+	i := strings.LastIndex(name, ".")
+	if i == -1 {
+		return "", "", 0, false
+	}
+	replica, err := strconv.Atoi(name[i+1:])
+	if err != nil || replica < 0 {
+		return "", "", 0, false
+	}
+	appName, containerName, ok = strings.Cut(name[:i], ".")
+	if !ok || appName == "" || containerName == "" {
+		return "", "", 0, false
+	}
+	return appName, containerName, replica, true
+}
+
+func getContainerReplica(ctx context.Context, c client.Client, app *apiv1.App, containerName string, replica int) (string, error) {
+	containers, err := c.ContainerReplicaList(ctx, &client.ContainerReplicaListOptions{
+		App: app.Name,
+	})
+	if err != nil {
+		return "", err
+	}
+	return selectReplica(containers, app.Name, containerName, replica)
+}
+
+// selectReplica returns the name of the replica with the given index, ordered by creation time, of the container.
+// If the app only has one container, containerName may be empty.
+func selectReplica(containers []apiv1.ContainerReplica, appName, containerName string, replica int) (string, error) {
+	var (
+		replicas       []apiv1.ContainerReplica
+		containerNames []string
+	)
+	for _, container := range containers {
+		name := container.Spec.ContainerName
+		if container.Spec.SidecarName != "" {
+			name = container.Spec.SidecarName
+		} else if container.Spec.JobName != "" {
+			name = container.Spec.JobName
+		}
+		if containerName == "" || name == containerName {
+			replicas = append(replicas, container)
+			if !slices.Contains(containerNames, name) {
+				containerNames = append(containerNames, name)
+			}
+		}
+	}
+
+	if len(replicas) == 0 && containerName != "" {
+		return "", fmt.Errorf("failed to find container %s in app %s", containerName, appName)
+	} else if len(replicas) == 0 {
+		return "", fmt.Errorf("failed to find any containers for app %s", appName)
+	} else if len(containerNames) > 1 {
+		sort.Strings(containerNames)
+		return "", fmt.Errorf("app %s has more than one container, select one with --container: %s", appName, strings.Join(containerNames, ", "))
+	}
+
+	sort.Slice(replicas, func(i, j int) bool {
+		if replicas[i].CreationTimestamp.Equal(&replicas[j].CreationTimestamp) {
+			return replicas[i].Name < replicas[j].Name
+		}
+		return replicas[i].CreationTimestamp.Before(&replicas[j].CreationTimestamp)
+	})
+
+	if replica < 0 || replica >= len(replicas) {
+		available := make([]string, 0, len(replicas))
+		for i, container := range replicas {
+			available = append(available, fmt.Sprintf("  %d: %s (%s %s)", i, container.Name, container.Status.Columns.State, table.FormatCreated(container.CreationTimestamp)))
+		}
+		return "", fmt.Errorf("replica %d of container %s does not exist, available replicas are:\n%s", replica, containerNames[0], strings.Join(available, "\n"))
+	}
+
+	return replicas[replica].Name, nil
+}
+
 func (s *Exec) execContainer(ctx context.Context, c client.Client, containerName string, args []string) error {
 	tty := term.IsTerminal(os.Stdin) && term.IsTerminal(os.Stdout) && term.IsTerminal(os.Stdout)
-	cIO, err := c.ContainerReplicaExec(ctx, containerName, args, tty, &client.ContainerReplicaExecOptions{
+	opts := &client.ContainerReplicaExecOptions{
 		DebugImage: s.DebugImage,
-	})
+		WorkingDir: s.WorkingDir,
+		NoStdin:    s.NoStdin || !hasInput(os.Stdin),
+	}
+	for _, env := range v1.ParseNameValues(true, s.Env...) {
+		opts.Env = append(opts.Env, env.Name+"="+env.Value)
+	}
+	if tty {
+		if size := term.GetSize(os.Stdout); size != nil {
+			opts.Rows, opts.Cols = size.Height, size.Width
+		}
+	}
+
+	cIO, err := c.ContainerReplicaExec(ctx, containerName, args, tty, opts)
 	if err != nil {
 		return err
 	}
 
 	exitCode, err := term.Pipe(cIO, streams.Current())
 	if err != nil {
+		if len(opts.Env) > 0 && isExecutableNotFound(err, "env") {
+			return fmt.Errorf("container %s has no env binary, which is required to set environment variables with --env: %w", containerName, err)
+		}
 		return err
 	}
 	os.Exit(exitCode)
 	return nil
 }
 
+// hasInput returns whether f is a terminal, pipe, socket or file the command can read from. Otherwise, e.g. for
+// /dev/null or a closed stdin, there is nothing to pass on to the command.
+func hasInput(f *os.File) bool {
+	fi, err := f.Stat()
+	if err != nil {
+		return false
+	}
+	return term.IsTerminal(f) || fi.Mode()&(os.ModeNamedPipe|os.ModeSocket) != 0 || fi.Mode().IsRegular()
+}
+
+// isExecutableNotFound returns whether err is the error of the container runtime for a command whose executable is
+// not in the image.
+func isExecutableNotFound(err error, executable string) bool {
+	return strings.Contains(err.Error(), strconv.Quote(executable)+": executable file not found")
+}
+
 func (s *Exec) Run(cmd *cobra.Command, args []string) error {
 	ctx := cmd.Context()
 	c, err := s.client.CreateDefault()
@@ -178,12 +300,24 @@ func (s *Exec) Run(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	containerName, replica := s.Container, s.Replica
+	if appName, addressedContainer, addressedReplica, ok := splitReplicaAddress(name); ok && containerName == "" && replica == nil {
+		name, containerName, replica = appName, addressedContainer, &addressedReplica
+	}
+
 	app, appErr := c.AppGet(ctx, name)
-	if appErr == nil {
-		name, err = getContainerForApp(ctx, c, app, s.Container, false)
+	if appErr == nil && replica != nil {
+		name, err = getContainerReplica(ctx, c, app, containerName, *replica)
+		if err != nil {
+			return err
+		}
+	} else if appErr == nil {
+		name, err = getContainerForApp(ctx, c, app, containerName, false)
 		if err != nil {
 			return err
 		}
+	} else if replica != nil {
+		return appErr
 	}
 	return s.execContainer(ctx, c, name, args)
 }
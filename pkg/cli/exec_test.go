@@ -0,0 +1,115 @@
+package cli
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestSplitReplicaAddress(t *testing.T) {
+	appName, containerName, replica, ok := splitReplicaAddress("my-app.web.2")
+	assert.True(t, ok)
+	assert.Equal(t, "my-app", appName)
+	assert.Equal(t, "web", containerName)
+	assert.Equal(t, 2, replica)
+
+	for _, name := range []string{"my-app", "my-app.web", "my-app.2", "my-app.web-7d8f9-x2b4c", "my-app.web.-1"} {
+		_, _, _, ok = splitReplicaAddress(name)
+		assert.False(t, ok, name)
+	}
+}
+
+func TestSelectReplica(t *testing.T) {
+	now := time.Now()
+	replica := func(name, container string, age time.Duration) apiv1.ContainerReplica {
+		return apiv1.ContainerReplica{
+			ObjectMeta: metav1.ObjectMeta{Name: name, CreationTimestamp: metav1.NewTime(now.Add(-age))},
+			Spec:       apiv1.ContainerReplicaSpec{ContainerName: container},
+			Status:     apiv1.ContainerReplicaStatus{Columns: apiv1.ContainerReplicaColumns{State: "running"}},
+		}
+	}
+	containers := []apiv1.ContainerReplica{
+		replica("app.web-b", "web", time.Minute),
+		replica("app.db-a", "db", time.Hour),
+		replica("app.web-a", "web", time.Hour),
+	}
+
+	name, err := selectReplica(containers, "app", "web", 0)
+	assert.NoError(t, err)
+	assert.Equal(t, "app.web-a", name)
+
+	name, err = selectReplica(containers, "app", "web", 1)
+	assert.NoError(t, err)
+	assert.Equal(t, "app.web-b", name)
+
+	_, err = selectReplica(containers, "app", "web", 2)
+	assert.EqualError(t, err, "replica 2 of container web does not exist, available replicas are:\n"+
+		"  0: app.web-a (running 60m ago)\n"+
+		"  1: app.web-b (running 60s ago)")
+
+	_, err = selectReplica(containers, "app", "", 0)
+	assert.EqualError(t, err, "app app has more than one container, select one with --container: db, web")
+
+	_, err = selectReplica(containers, "app", "cache", 0)
+	assert.EqualError(t, err, "failed to find container cache in app app")
+
+	name, err = selectReplica(containers[1:2], "app", "", 0)
+	assert.NoError(t, err)
+	assert.Equal(t, "app.db-a", name)
+}
+
+func TestIsExecutableNotFound(t *testing.T) {
+	for _, tc := range []struct {
+		err  error
+		want bool
+	}{
+		{
+			err:  errors.New(`OCI runtime exec failed: exec failed: unable to start container process: exec: "env": executable file not found in $PATH: unknown`),
+			want: true,
+		},
+		{
+			err:  errors.New(`OCI runtime exec failed: exec failed: unable to start container process: exec: "/bin/sh": stat /bin/sh: no such file or directory: unknown`),
+			want: false,
+		},
+		{
+			err:  errors.New(`exec: "printenv": executable file not found in $PATH`),
+			want: false,
+		},
+		{
+			err:  errors.New("command terminated with exit code 1"),
+			want: false,
+		},
+	} {
+		assert.Equal(t, tc.want, isExecutableNotFound(tc.err, "env"), tc.err.Error())
+	}
+}
+
+func TestHasInput(t *testing.T) {
+	r, w, err := os.Pipe()
+	require.NoError(t, err)
+	defer r.Close()
+	defer w.Close()
+	assert.True(t, hasInput(r), "piped input must be passed to the command")
+
+	file, err := os.Create(filepath.Join(t.TempDir(), "input"))
+	require.NoError(t, err)
+	defer file.Close()
+	assert.True(t, hasInput(file), "redirected files must be passed to the command")
+
+	devNull, err := os.Open(os.DevNull)
+	require.NoError(t, err)
+	defer devNull.Close()
+	assert.False(t, hasInput(devNull))
+
+	closed, err := os.Open(os.DevNull)
+	require.NoError(t, err)
+	require.NoError(t, closed.Close())
+	assert.False(t, hasInput(closed))
+}
@@ -28,10 +28,17 @@ acorn images`,
 		ValidArgsFunction: newCompletion(c.ClientFactory, imagesCompletion(true)).withShouldCompleteOptions(onlyNumArgs(1)).complete,
 	})
 	cmd.AddCommand(NewImageDelete(c))
+	cmd.AddCommand(NewImagePrune(c))
 	cmd.AddCommand(NewImageDetails(c))
 	cmd.AddCommand(NewImageCopy(c))
+	cmd.AddCommand(NewImageIndex(c))
 	cmd.AddCommand(NewImageSign(c))
 	cmd.AddCommand(NewImageVerify(c))
+	cmd.AddCommand(NewImageSignatures(c))
+	cmd.AddCommand(NewImageScan(c))
+	cmd.AddCommand(NewImageExtract(c))
+	cmd.AddCommand(NewImageHistory(c))
+	cmd.AddCommand(NewImageUnsign(c))
 	return cmd
 }
 
@@ -39,12 +46,16 @@ type Image struct {
 	All        bool   `usage:"Include untagged images" short:"a" local:"true"`
 	Quiet      bool   `usage:"Output only names" short:"q" local:"true"`
 	NoTrunc    bool   `usage:"Don't truncate IDs" local:"true"`
-	Output     string `usage:"Output format (json, yaml, {{gotemplate}})" short:"o" local:"true"`
+	Output     string `usage:"Output format (json, yaml, {{gotemplate}}, go-template={{gotemplate}})" short:"o" local:"true"`
 	Containers bool   `usage:"Show containers for images" short:"c" local:"true"`
 	client     ClientFactory
 }
 
 func (a *Image) Run(cmd *cobra.Command, args []string) error {
+	if err := table.ValidateFormat(a.Output, &apiv1.Image{}); err != nil {
+		return err
+	}
+
 	c, err := a.client.CreateDefault()
 	if err != nil {
 		return err
@@ -5,13 +5,23 @@ import (
 	cli "github.com/acorn-io/runtime/pkg/cli/builder"
 	"github.com/acorn-io/runtime/pkg/cli/builder/table"
 	"github.com/acorn-io/runtime/pkg/client"
+	"github.com/acorn-io/runtime/pkg/tables"
+	"github.com/acorn-io/runtime/pkg/tags"
 	"github.com/spf13/cobra"
 )
 
 func NewImageDetails(c CommandContext) *cobra.Command {
 	cmd := cli.Command(&ImageDetails{client: c.ClientFactory}, cobra.Command{
-		Use:               "details IMAGE_NAME [NESTED DIGEST]",
-		Example:           `acorn image details my-image`,
+		Use: "details IMAGE_NAME [NESTED DIGEST]",
+		Example: `
+# Show the details of an image
+acorn image details my-image
+
+# List the images contained in an image and its nested acorns with their digests and sizes
+acorn image details --nested my-image -o json
+
+# Show the details of a locally built image, failing right away instead of contacting a registry if it isn't there
+acorn image details --local my-image`,
 		Aliases:           []string{"detail"},
 		SilenceUsage:      true,
 		Short:             "Show details of an Image",
@@ -24,6 +34,8 @@ func NewImageDetails(c CommandContext) *cobra.Command {
 type ImageDetails struct {
 	client ClientFactory
 	Output string `usage:"Output format (json, yaml, aml)" short:"o" local:"true" default:"aml"`
+	Nested bool   `usage:"List the images contained in the image and its nested images, with their repositories, digests and sizes"`
+	Local  bool   `usage:"Only look the image up in the local image store and fail if it isn't there, instead of contacting its registry (default: true for image IDs)"`
 }
 
 func (a *ImageDetails) Run(cmd *cobra.Command, args []string) error {
@@ -41,20 +53,37 @@ func (a *ImageDetails) Run(cmd *cobra.Command, args []string) error {
 		nested = args[1]
 	}
 
-	auth, err = getAuthForImage(cmd.Context(), a.client, args[0])
-	if err != nil {
-		return err
+	local := a.Local || tags.IsLocalReference(args[0])
+	if !local {
+		auth, err = getAuthForImage(cmd.Context(), a.client, args[0])
+		if err != nil {
+			return err
+		}
 	}
 
 	image, err := c.ImageDetails(cmd.Context(), args[0], &client.ImageDetailsOptions{
 		NestedDigest:  nested,
 		Auth:          auth,
 		IncludeNested: nested == "",
+		ListImages:    a.Nested,
+		Local:         local,
 	})
 	if err != nil {
 		return err
 	}
 
+	if a.Nested {
+		format := a.Output
+		if !cmd.Flags().Changed("output") {
+			format = ""
+		}
+		out := table.NewWriter(tables.ContainedImage, false, format)
+		for i := range image.Images {
+			out.WriteFormatted(&image.Images[i], nil)
+		}
+		return out.Err()
+	}
+
 	w := table.NewWriter(nil, false, a.Output)
 	w.WriteFormatted(image, nil)
 
@@ -0,0 +1,110 @@
+package cli
+
+import (
+	"io"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/acorn-io/runtime/pkg/cli/testdata"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestImageDetailsNested(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		wantOut string
+	}{
+		{
+			name: "acorn image details --nested my-image",
+			args: []string{"details", "--nested", "my-image"},
+			wantOut: "NAME                           TYPE        REPO                    DIGEST              SIZE\n" +
+				"test-image-running-container   container   ghcr.io/acorn-io/test   sha256:1234567890   12.3MB\n" +
+				"db                             acorn       ghcr.io/acorn-io/db     sha256:0987654321   2.05kB\n",
+		},
+		{
+			name: "acorn image details --nested -o json my-image",
+			args: []string{"details", "--nested", "-o", "json", "my-image"},
+			wantOut: `{
+    "items": [
+        {
+            "name": "test-image-running-container",
+            "type": "container",
+            "repo": "ghcr.io/acorn-io/test",
+            "digest": "sha256:1234567890",
+            "size": 12345678
+        },
+        {
+            "name": "db",
+            "type": "acorn",
+            "repo": "ghcr.io/acorn-io/db",
+            "digest": "sha256:0987654321",
+            "size": 2048
+        }
+    ]
+}
+
+`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, w, _ := os.Pipe()
+			os.Stdout = w
+			cmd := NewImage(CommandContext{
+				ClientFactory: &testdata.MockClientFactory{},
+				StdOut:        w,
+				StdErr:        w,
+				StdIn:         strings.NewReader(""),
+			})
+			cmd.SetArgs(tt.args)
+			assert.NoError(t, cmd.Execute())
+			w.Close()
+			out, _ := io.ReadAll(r)
+			assert.Equal(t, tt.wantOut, string(out))
+		})
+	}
+}
+
+func TestImageDetailsLocal(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{
+			name: "acorn image details --local my-image",
+			args: []string{"details", "--local", "my-image"},
+		},
+		{
+			name:    "acorn image details --local ghcr.io/acorn-io/remote",
+			args:    []string{"details", "--local", "ghcr.io/acorn-io/remote"},
+			wantErr: "image ghcr.io/acorn-io/remote was not found in the local image store",
+		},
+		{
+			name: "acorn image details ghcr.io/acorn-io/remote",
+			args: []string{"details", "ghcr.io/acorn-io/remote"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, w, _ := os.Pipe()
+			os.Stdout = w
+			cmd := NewImage(CommandContext{
+				ClientFactory: &testdata.MockClientFactory{},
+				StdOut:        w,
+				StdErr:        w,
+				StdIn:         strings.NewReader(""),
+			})
+			cmd.SetArgs(tt.args)
+			err := cmd.Execute()
+			w.Close()
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,77 @@
+package cli
+
+import (
+	"os"
+
+	cli "github.com/acorn-io/runtime/pkg/cli/builder"
+	"github.com/acorn-io/runtime/pkg/client"
+	"github.com/spf13/cobra"
+)
+
+func NewImageExtract(c CommandContext) *cobra.Command {
+	cmd := cli.Command(&ImageExtract{client: c.ClientFactory}, cobra.Command{
+		Use: "extract IMAGE_NAME PATH [flags]",
+		Example: `# Print a config file of the only container image of an image
+acorn image extract ghcr.io/acme/my-image:v1 /etc/nginx/nginx.conf
+
+# Copy a binary out of the image of the "api" container
+acorn image extract my-image /usr/local/bin/api -c api -o ./api
+
+# Save a directory as a tar archive
+acorn image extract my-image /app/config -o config.tar
+`,
+		Long: `Extract a file or directory from an image without running it
+
+The file is read from the image of a container, sidecar (CONTAINER.SIDECAR), function, job or image of the Acorn
+image. Directories are written as a tar archive. The image is pulled from its registry, so images that only exist in
+the internal registry have to be pushed before files can be extracted from them.`,
+		SilenceUsage:      true,
+		Short:             "Extract a file or directory from an image",
+		ValidArgsFunction: newCompletion(c.ClientFactory, imagesCompletion(true)).withShouldCompleteOptions(onlyNumArgs(1)).complete,
+		Args:              cobra.ExactArgs(2),
+	})
+	_ = cmd.MarkFlagFilename("output")
+	return cmd
+}
+
+type ImageExtract struct {
+	Output    string `usage:"File to write the file or tar archive of the directory to, - for stdout" short:"o" default:"-"`
+	Container string `usage:"Container, sidecar (CONTAINER.SIDECAR), function, job or image to extract from, required if the image has more than one" short:"c"`
+	client    ClientFactory
+}
+
+func (a *ImageExtract) Run(cmd *cobra.Command, args []string) (err error) {
+	imageName, filePath := args[0], args[1]
+
+	c, err := a.client.CreateDefault()
+	if err != nil {
+		return err
+	}
+
+	auth, err := getAuthForImage(cmd.Context(), a.client, imageName)
+	if err != nil {
+		return err
+	}
+
+	out := cmd.OutOrStdout()
+	if a.Output != "" && a.Output != "-" {
+		f, err := os.Create(a.Output)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			if closeErr := f.Close(); err == nil {
+				err = closeErr
+			}
+			if err != nil {
+				_ = os.Remove(a.Output)
+			}
+		}()
+		out = f
+	}
+
+	return c.ImageExtractFile(cmd.Context(), imageName, filePath, out, &client.ImageExtractOptions{
+		Auth:      auth,
+		Container: a.Container,
+	})
+}
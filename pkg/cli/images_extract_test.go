@@ -0,0 +1,72 @@
+package cli
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/acorn-io/runtime/pkg/cli/testdata"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestImageExtract(t *testing.T) {
+	output := filepath.Join(t.TempDir(), "nginx.conf")
+
+	tests := []struct {
+		name     string
+		args     []string
+		wantErr  string
+		wantOut  string
+		wantFile string
+	}{
+		{
+			name:    "acorn image extract",
+			args:    []string{"extract", "ghcr.io/acorn-io/test:v1", "/etc/nginx/nginx.conf"},
+			wantOut: "content of /etc/nginx/nginx.conf in ghcr.io/acorn-io/test:v1",
+		},
+		{
+			name:     "acorn image extract -o",
+			args:     []string{"extract", "ghcr.io/acorn-io/test:v1", "/etc/nginx/nginx.conf", "-o", output},
+			wantFile: "content of /etc/nginx/nginx.conf in ghcr.io/acorn-io/test:v1",
+		},
+		{
+			name:    "acorn image extract dne",
+			args:    []string{"extract", "dne", "/etc/nginx/nginx.conf"},
+			wantErr: "error: image dne does not exist",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, w, _ := os.Pipe()
+			stdout := os.Stdout
+			os.Stdout = w
+			defer func() { os.Stdout = stdout }()
+
+			cmd := NewImage(CommandContext{
+				ClientFactory: &testdata.MockClientFactory{},
+				StdOut:        w,
+				StdErr:        w,
+				StdIn:         strings.NewReader(""),
+			})
+			cmd.SetArgs(tt.args)
+			err := cmd.Execute()
+			w.Close()
+			out, _ := io.ReadAll(r)
+
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantOut, string(out))
+			if tt.wantFile != "" {
+				content, err := os.ReadFile(output)
+				require.NoError(t, err)
+				assert.Equal(t, tt.wantFile, string(content))
+			}
+		})
+	}
+}
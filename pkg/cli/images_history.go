@@ -0,0 +1,112 @@
+package cli
+
+import (
+	"fmt"
+
+	cli "github.com/acorn-io/runtime/pkg/cli/builder"
+	"github.com/acorn-io/runtime/pkg/cli/builder/table"
+	"github.com/acorn-io/runtime/pkg/client"
+	"github.com/acorn-io/runtime/pkg/tables"
+	"github.com/spf13/cobra"
+)
+
+// createdByWidth is the length the commands that created layers are truncated to, unless --no-trunc is set
+const createdByWidth = 45
+
+func NewImageHistory(c CommandContext) *cobra.Command {
+	return cli.Command(&ImageHistory{client: c.ClientFactory}, cobra.Command{
+		Use: "history IMAGE_NAME [flags]",
+		Example: `# Show the layers of the images of an image and their build provenance
+acorn image history ghcr.io/acme/my-image:v1
+
+# Show the full commands that created the layers
+acorn image history my-image --no-trunc
+
+# Write the history and provenance as JSON for auditing
+acorn image history my-image -o json
+`,
+		Long: `Show the layer history and build provenance of an image
+
+Lists the layers of the image of each container, sidecar (CONTAINER.SIDECAR), function, job and image of the Acorn
+image, oldest first, with the commands that created them. SLSA provenance attached to the images by buildkit or cosign
+is listed with its predicate type. The image is pulled from its registry, so images that only exist in the internal
+registry have to be pushed before their history can be shown.`,
+		SilenceUsage:      true,
+		Short:             "Show the layer history and build provenance of an image",
+		ValidArgsFunction: newCompletion(c.ClientFactory, imagesCompletion(true)).complete,
+		Args:              cobra.ExactArgs(1),
+	})
+}
+
+type ImageHistory struct {
+	Output  string `usage:"Output format (table, json)" short:"o" default:"table"`
+	NoTrunc bool   `usage:"Don't truncate the commands that created the layers"`
+	client  ClientFactory
+}
+
+type imageHistoryLayer struct {
+	*client.ImageHistoryLayer
+	CreatedBy string
+}
+
+func (a *ImageHistory) Run(cmd *cobra.Command, args []string) error {
+	switch a.Output {
+	case "table", "json":
+	default:
+		return fmt.Errorf("invalid output format %s, must be one of table or json", a.Output)
+	}
+
+	imageName := args[0]
+
+	c, err := a.client.CreateDefault()
+	if err != nil {
+		return err
+	}
+
+	auth, err := getAuthForImage(cmd.Context(), a.client, imageName)
+	if err != nil {
+		return err
+	}
+
+	history, err := c.ImageHistory(cmd.Context(), imageName, &client.ImageHistoryOptions{
+		Auth: auth,
+	})
+	if err != nil {
+		return err
+	}
+
+	if a.Output == "json" {
+		return printJSON(history)
+	}
+
+	out := table.NewWriter(tables.ImageHistory, false, "")
+	for i := range history.Layers {
+		layer := &history.Layers[i]
+		createdBy := layer.CreatedBy
+		if !a.NoTrunc && len(createdBy) > createdByWidth {
+			createdBy = createdBy[:createdByWidth-3] + "..."
+		}
+		out.WriteFormatted(&imageHistoryLayer{
+			ImageHistoryLayer: layer,
+			CreatedBy:         createdBy,
+		}, nil)
+	}
+	if err := out.Err(); err != nil {
+		return err
+	}
+
+	fmt.Println()
+	if len(history.Provenance) == 0 {
+		fmt.Println("No SLSA provenance attached")
+		return nil
+	}
+	fmt.Println("SLSA provenance:")
+	for _, provenance := range history.Provenance {
+		subject := provenance.Container
+		if subject == "" {
+			subject = imageName
+		}
+		fmt.Printf("  %s: %s (%s, %s)\n", subject, provenance.PredicateType, provenance.Source, table.Trunc(provenance.Digest))
+	}
+	return nil
+}
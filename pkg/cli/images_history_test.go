@@ -0,0 +1,86 @@
+package cli
+
+import (
+	"io"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/acorn-io/runtime/pkg/cli/testdata"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestImageHistory(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+		wantOut string
+	}{
+		{
+			name: "acorn image history",
+			args: []string{"history", "ghcr.io/acorn-io/test:v1"},
+			wantOut: "CONTAINER   CREATED   CREATED BY                                      SIZE      COMMENT\n" +
+				"web                   /bin/sh -c #(nop) ADD file:1234567890abcde...   3.4MB     \n" +
+				"web                   CMD [\"/bin/sh\"]                                           buildkit.dockerfile.v0\n" +
+				"\n" +
+				"No SLSA provenance attached\n",
+		},
+		{
+			name:    "acorn image history --no-trunc",
+			args:    []string{"history", "ghcr.io/acorn-io/test:v1", "--no-trunc"},
+			wantOut: "/bin/sh -c #(nop) ADD file:1234567890abcdef1234567890abcdef in / ",
+		},
+		{
+			name:    "acorn image history with provenance",
+			args:    []string{"history", "provenance"},
+			wantOut: "SLSA provenance:\n  web: https://slsa.dev/provenance/v0.2 (buildkit, fedcba098765)",
+		},
+		{
+			name:    "acorn image history -o json",
+			args:    []string{"history", "ghcr.io/acorn-io/test:v1", "-o", "json"},
+			wantOut: `"createdBy": "CMD [\"/bin/sh\"]"`,
+		},
+		{
+			name:    "acorn image history -o yaml",
+			args:    []string{"history", "ghcr.io/acorn-io/test:v1", "-o", "yaml"},
+			wantErr: "invalid output format yaml, must be one of table or json",
+		},
+		{
+			name:    "acorn image history dne",
+			args:    []string{"history", "dne"},
+			wantErr: "error: image dne does not exist",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, w, _ := os.Pipe()
+			stdout := os.Stdout
+			os.Stdout = w
+			defer func() { os.Stdout = stdout }()
+
+			cmd := NewImage(CommandContext{
+				ClientFactory: &testdata.MockClientFactory{},
+				StdOut:        w,
+				StdErr:        w,
+				StdIn:         strings.NewReader(""),
+			})
+			cmd.SetArgs(tt.args)
+			err := cmd.Execute()
+			w.Close()
+			out, _ := io.ReadAll(r)
+
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			if strings.HasSuffix(tt.wantOut, "\n") {
+				assert.Equal(t, tt.wantOut, string(out))
+			} else {
+				assert.Contains(t, string(out), tt.wantOut)
+			}
+		})
+	}
+}
@@ -0,0 +1,94 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	cli "github.com/acorn-io/runtime/pkg/cli/builder"
+	"github.com/acorn-io/runtime/pkg/client"
+	"github.com/spf13/cobra"
+)
+
+func NewImageIndex(c CommandContext) *cobra.Command {
+	cmd := cli.Command(&ImageIndex{}, cobra.Command{
+		Use:          "index [flags]",
+		SilenceUsage: true,
+		Short:        "Manage multi-platform image indexes",
+		Args:         cobra.NoArgs,
+	})
+	cmd.AddCommand(NewImageIndexCreate(c))
+	return cmd
+}
+
+type ImageIndex struct{}
+
+func (a *ImageIndex) Run(cmd *cobra.Command, args []string) error {
+	return cmd.Help()
+}
+
+func NewImageIndexCreate(c CommandContext) *cobra.Command {
+	return cli.Command(&ImageIndexCreate{client: c.ClientFactory}, cobra.Command{
+		Use: "create [flags] TAG",
+		Example: `
+# Combine images built for each platform by separate pipelines into a multi-platform image
+acorn image index create ghcr.io/acme/app:v1 --add linux/amd64=sha256:4a1c... --add linux/arm64=sha256:9f3e...
+
+# Combine images pushed to other repositories of the registry, copying them and their signatures into ghcr.io/acme/app
+acorn image index create ghcr.io/acme/app:v1 --copy-signatures \
+  --add linux/amd64=ghcr.io/acme/app-amd64@sha256:4a1c... --add linux/arm64=ghcr.io/acme/app-arm64@sha256:9f3e...`,
+		Long: `Create a multi-platform image index from existing images and push it to a registry
+
+Each image is added for the platform it is built for, which is checked against the config of the image. Images are
+given by digest, either of an image in the repository of TAG or as a reference to an image in another repository of
+the same registry. Images from other repositories are copied into the repository of TAG. The index itself is not
+signed, sign it with acorn image sign after creating it.`,
+		SilenceUsage: true,
+		Short:        "Create a multi-platform image index from existing images",
+		Args:         cobra.ExactArgs(1),
+	})
+}
+
+type ImageIndexCreate struct {
+	Add            []string `usage:"Image to add to the index for a platform (format PLATFORM=DIGEST or PLATFORM=IMAGE@DIGEST) (ex: linux/arm64=sha256:...)"`
+	CopySignatures bool     `usage:"Copy the signatures of images from other repositories into the repository of the index along with the images"`
+	client         ClientFactory
+}
+
+func (a *ImageIndexCreate) Run(cmd *cobra.Command, args []string) error {
+	if len(a.Add) == 0 {
+		return fmt.Errorf("at least one image is required, add images with --add PLATFORM=DIGEST")
+	}
+
+	var entries []client.ImageIndexEntry
+	for _, add := range a.Add {
+		platform, image, ok := strings.Cut(add, "=")
+		if !ok || platform == "" || image == "" {
+			return fmt.Errorf("invalid image %s, must be in PLATFORM=DIGEST form", add)
+		}
+		entries = append(entries, client.ImageIndexEntry{
+			Image:    image,
+			Platform: platform,
+		})
+	}
+
+	c, err := a.client.CreateDefault()
+	if err != nil {
+		return err
+	}
+
+	auth, err := getAuthForImage(cmd.Context(), a.client, args[0])
+	if err != nil {
+		return err
+	}
+
+	index, err := c.ImageIndexCreate(cmd.Context(), args[0], entries, &client.ImageIndexCreateOptions{
+		Auth:           auth,
+		CopySignatures: a.CopySignatures,
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(index)
+	return nil
+}
@@ -0,0 +1,63 @@
+package cli
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/acorn-io/runtime/pkg/cli/testdata"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestImageIndexCreate(t *testing.T) {
+	acornConfigFile := filepath.Join(t.TempDir(), "acorn.yaml")
+	require.NoError(t, os.WriteFile(acornConfigFile, nil, 0600))
+
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+		wantOut string
+	}{
+		{
+			name:    "acorn image index create",
+			args:    []string{"index", "create", "ghcr.io/acme/app:v1", "--add", "linux/amd64=sha256:1111", "--add", "linux/arm64=sha256:2222"},
+			wantOut: "ghcr.io/acme/app@sha256:1234567890abcdef1234567890abcdef1234567890abcdef1234567890abcdef\n",
+		},
+		{
+			name:    "acorn image index create without images",
+			args:    []string{"index", "create", "ghcr.io/acme/app:v1"},
+			wantErr: "at least one image is required, add images with --add PLATFORM=DIGEST",
+		},
+		{
+			name:    "acorn image index create without platform",
+			args:    []string{"index", "create", "ghcr.io/acme/app:v1", "--add", "sha256:1111"},
+			wantErr: "invalid image sha256:1111, must be in PLATFORM=DIGEST form",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, w, _ := os.Pipe()
+			os.Stdout = w
+			cmd := NewImage(CommandContext{
+				ClientFactory: &testdata.MockClientFactory{MockAcornConfigFile: acornConfigFile},
+				StdOut:        w,
+				StdErr:        w,
+				StdIn:         strings.NewReader(""),
+			})
+			cmd.SetArgs(tt.args)
+			err := cmd.Execute()
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Nil(t, w.Close(), "error closing writer")
+			out, _ := io.ReadAll(r)
+			assert.Equal(t, tt.wantOut, string(out))
+		})
+	}
+}
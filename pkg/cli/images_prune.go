@@ -0,0 +1,96 @@
+package cli
+
+import (
+	"fmt"
+	"time"
+
+	cli "github.com/acorn-io/runtime/pkg/cli/builder"
+	"github.com/acorn-io/runtime/pkg/cli/builder/table"
+	"github.com/acorn-io/runtime/pkg/client"
+	"github.com/acorn-io/runtime/pkg/prompt"
+	"github.com/acorn-io/runtime/pkg/tables"
+	"github.com/spf13/cobra"
+)
+
+func NewImagePrune(c CommandContext) *cobra.Command {
+	return cli.Command(&ImagePrune{client: c.ClientFactory}, cobra.Command{
+		Use: "prune [flags]",
+		Example: `
+# Delete the images without tags that aren't used by any app, after confirming them
+acorn image prune
+
+# Delete all images that aren't used by any app, tagged or not, after confirming them
+acorn image prune --all
+
+# Delete untagged images older than a day without asking
+acorn image prune --until 24h --force`,
+		SilenceUsage: true,
+		Short:        "Delete images that aren't used by any app",
+		Args:         cobra.NoArgs,
+	})
+}
+
+type ImagePrune struct {
+	All          bool   `usage:"Also delete images with tags that aren't used by any app" short:"a"`
+	DanglingOnly bool   `usage:"Only delete images without tags, the default unless --all is set"`
+	Until        string `usage:"Only delete images created longer ago than this (ex: 24h, 30m)"`
+	Force        bool   `usage:"Delete the images without asking for confirmation" short:"f"`
+	client       ClientFactory
+}
+
+func (a *ImagePrune) Run(cmd *cobra.Command, args []string) error {
+	if a.All && a.DanglingOnly {
+		return fmt.Errorf("cannot use --all with --dangling-only")
+	}
+
+	opts := &client.ImagePruneOptions{
+		All:          a.All,
+		DanglingOnly: a.DanglingOnly,
+		DryRun:       true,
+	}
+	if a.Until != "" {
+		until, err := time.ParseDuration(a.Until)
+		if err != nil {
+			return fmt.Errorf("invalid --until %s: %w", a.Until, err)
+		}
+		opts.Until = until
+	}
+
+	c, err := a.client.CreateDefault()
+	if err != nil {
+		return err
+	}
+
+	images, err := c.ImagePrune(cmd.Context(), opts)
+	if err != nil {
+		return err
+	}
+	if len(images) == 0 {
+		fmt.Println("No images to prune")
+		return nil
+	}
+
+	out := table.NewWriter(tables.ImagePrune, false, "")
+	for _, image := range images {
+		out.Write(&image)
+		opts.Images = append(opts.Images, image.Name)
+	}
+	if err := out.Err(); err != nil {
+		return err
+	}
+
+	if !a.Force {
+		ok, err := prompt.Bool(fmt.Sprintf("Delete these %d images?", len(images)), false)
+		if err != nil || !ok {
+			return err
+		}
+	}
+
+	// Only delete the images that were listed, even if more became unused in the meantime
+	opts.DryRun = false
+	pruned, err := c.ImagePrune(cmd.Context(), opts)
+	for _, image := range pruned {
+		fmt.Printf("Deleted %s\n", image.Name)
+	}
+	return err
+}
@@ -0,0 +1,65 @@
+package cli
+
+import (
+	"io"
+	"os"
+	"strings"
+	"testing"
+
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/cli/testdata"
+	"github.com/acorn-io/runtime/pkg/client"
+	"github.com/acorn-io/runtime/pkg/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/pterm/pterm"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestImagePruneDeletesListedImages(t *testing.T) {
+	listed := []apiv1.Image{
+		{ObjectMeta: metav1.ObjectMeta{Name: "0123456789ab"}, Digest: "sha256:0123456789ab"},
+		{ObjectMeta: metav1.ObjectMeta{Name: "fedcba987654"}, Digest: "sha256:fedcba987654", Tags: []string{"app:v1"}},
+	}
+
+	ctrl := gomock.NewController(t)
+	mClient := mocks.NewMockClient(ctrl)
+	gomock.InOrder(
+		mClient.EXPECT().ImagePrune(gomock.Any(), &client.ImagePruneOptions{All: true, DryRun: true}).Return(listed, nil),
+		// The images are pruned again by ID, so that images which became unused after the listing are kept
+		mClient.EXPECT().ImagePrune(gomock.Any(), &client.ImagePruneOptions{All: true, Images: []string{"0123456789ab", "fedcba987654"}}).Return(listed[:1], nil),
+	)
+
+	r, w, _ := os.Pipe()
+	stdout := os.Stdout
+	os.Stdout = w
+	defer func() { os.Stdout = stdout }()
+	pterm.SetDefaultOutput(w)
+	defer pterm.SetDefaultOutput(os.Stderr)
+	cmd := NewImagePrune(CommandContext{
+		ClientFactory: &testdata.MockClientFactoryManual{
+			MockAcornConfigFile: "/fake-file",
+			Client:              mClient,
+		},
+		StdOut: w,
+		StdErr: w,
+		StdIn:  strings.NewReader(""),
+	})
+	cmd.SetArgs([]string{"--all", "--force"})
+	require.NoError(t, cmd.Execute())
+
+	require.NoError(t, w.Close())
+	out, _ := io.ReadAll(r)
+	assert.Contains(t, string(out), "Deleted 0123456789ab\n")
+	assert.NotContains(t, string(out), "Deleted fedcba987654")
+}
+
+func TestImagePruneAllAndDanglingOnly(t *testing.T) {
+	cmd := NewImagePrune(CommandContext{
+		ClientFactory: &testdata.MockClientFactory{},
+		StdIn:         strings.NewReader(""),
+	})
+	cmd.SetArgs([]string{"--all", "--dangling-only"})
+	assert.EqualError(t, cmd.Execute(), "cannot use --all with --dangling-only")
+}
@@ -0,0 +1,238 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	cli "github.com/acorn-io/runtime/pkg/cli/builder"
+	"github.com/acorn-io/runtime/pkg/cli/builder/table"
+	"github.com/acorn-io/runtime/pkg/client"
+	"github.com/acorn-io/runtime/pkg/config"
+	"github.com/acorn-io/runtime/pkg/tables"
+	"github.com/spf13/cobra"
+)
+
+func NewImageScan(c CommandContext) *cobra.Command {
+	return cli.Command(&ImageScan{client: c.ClientFactory}, cobra.Command{
+		Use: "scan IMAGE_NAME [flags]",
+		Example: `# Scan an image for vulnerabilities
+acorn image scan ghcr.io/acme/my-image:v1
+
+# Write the findings as SARIF, e.g. to upload them to a code scanning dashboard
+acorn image scan my-image -o sarif > my-image.sarif
+
+# Fail if the image has vulnerabilities of severity HIGH or CRITICAL
+acorn image scan my-image --severity-threshold high
+`,
+		Long: `Scan an image for vulnerabilities
+
+The image is scanned by digest with the scanner set as imageScanner in the CLI config (trivy by default). The
+scanner has to support the trivy CLI arguments and JSON report format. It pulls the image from its registry, so
+images that only exist in the internal registry have to be pushed before they can be scanned.`,
+		SilenceUsage:      true,
+		Short:             "Scan an image for vulnerabilities",
+		ValidArgsFunction: newCompletion(c.ClientFactory, imagesCompletion(true)).complete,
+		Args:              cobra.ExactArgs(1),
+	})
+}
+
+type ImageScan struct {
+	Output            string `usage:"Output format (table, json, sarif)" short:"o" default:"table"`
+	SeverityThreshold string `usage:"Exit with an error if there are findings of this severity or higher (low, medium, high, critical)"`
+	client            ClientFactory
+}
+
+func (a *ImageScan) Run(cmd *cobra.Command, args []string) error {
+	switch a.Output {
+	case "table", "json", "sarif":
+	default:
+		return fmt.Errorf("invalid output format %s, must be one of table, json or sarif", a.Output)
+	}
+
+	threshold := -1
+	if a.SeverityThreshold != "" {
+		threshold = client.ImageScanSeverityRank(a.SeverityThreshold)
+		if threshold == 0 {
+			return fmt.Errorf("invalid severity threshold %s, must be one of low, medium, high or critical", a.SeverityThreshold)
+		}
+	}
+
+	imageName := args[0]
+
+	c, err := a.client.CreateDefault()
+	if err != nil {
+		return err
+	}
+
+	auth, err := getAuthForImage(cmd.Context(), a.client, imageName)
+	if err != nil {
+		return err
+	}
+
+	cfg, err := config.ReadCLIConfig(a.client.AcornConfigFile(), true)
+	if err != nil {
+		return err
+	}
+
+	result, err := c.ImageScan(cmd.Context(), imageName, &client.ImageScanOptions{
+		Auth:    auth,
+		Scanner: cfg.ImageScanner,
+	})
+	if err != nil {
+		return err
+	}
+
+	switch a.Output {
+	case "json":
+		err = printJSON(result)
+	case "sarif":
+		err = printJSON(toSARIF(result))
+	default:
+		out := table.NewWriter(tables.ImageScan, false, "")
+		for i := range result.Findings {
+			out.WriteFormatted(&result.Findings[i], nil)
+		}
+		err = out.Err()
+	}
+	if err != nil || threshold < 0 {
+		return err
+	}
+
+	var exceeding int
+	for _, finding := range result.Findings {
+		if client.ImageScanSeverityRank(finding.Severity) >= threshold {
+			exceeding++
+		}
+	}
+	if exceeding > 0 {
+		return fmt.Errorf("image %s has %d vulnerabilities of severity %s or higher", imageName, exceeding, strings.ToUpper(a.SeverityThreshold))
+	}
+	return nil
+}
+
+func printJSON(obj any) error {
+	data, err := json.MarshalIndent(obj, "", "  ")
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintln(os.Stdout, string(data))
+	return err
+}
+
+type sarifLog struct {
+	Version string     `json:"version"`
+	Schema  string     `json:"$schema"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool    sarifTool     `json:"tool"`
+	Results []sarifResult `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name  string      `json:"name"`
+	Rules []sarifRule `json:"rules"`
+}
+
+type sarifRule struct {
+	ID               string         `json:"id"`
+	ShortDescription sarifMessage   `json:"shortDescription"`
+	HelpURI          string         `json:"helpUri,omitempty"`
+	Properties       map[string]any `json:"properties,omitempty"`
+}
+
+type sarifResult struct {
+	RuleID    string          `json:"ruleId"`
+	Level     string          `json:"level"`
+	Message   sarifMessage    `json:"message"`
+	Locations []sarifLocation `json:"locations"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation struct {
+		ArtifactLocation struct {
+			URI string `json:"uri"`
+		} `json:"artifactLocation"`
+	} `json:"physicalLocation"`
+}
+
+// sarifSecuritySeverity maps severities to the CVSS-like scores code scanning dashboards sort and filter by.
+var sarifSecuritySeverity = map[string]string{
+	"CRITICAL": "9.5",
+	"HIGH":     "8.0",
+	"MEDIUM":   "5.5",
+	"LOW":      "2.0",
+}
+
+// toSARIF converts a scan result to a SARIF 2.1.0 log with one rule per vulnerability and one result per finding.
+func toSARIF(result *client.ImageScanResult) sarifLog {
+	run := sarifRun{
+		Tool: sarifTool{
+			Driver: sarifDriver{
+				Name:  result.Scanner,
+				Rules: []sarifRule{},
+			},
+		},
+		Results: []sarifResult{},
+	}
+
+	seen := map[string]bool{}
+	for _, finding := range result.Findings {
+		if !seen[finding.ID] {
+			seen[finding.ID] = true
+			rule := sarifRule{
+				ID:               finding.ID,
+				ShortDescription: sarifMessage{Text: finding.Title},
+				HelpURI:          finding.URL,
+				Properties: map[string]any{
+					"tags": []string{"vulnerability", "security", finding.Severity},
+				},
+			}
+			if score, ok := sarifSecuritySeverity[finding.Severity]; ok {
+				rule.Properties["security-severity"] = score
+			}
+			run.Tool.Driver.Rules = append(run.Tool.Driver.Rules, rule)
+		}
+
+		message := fmt.Sprintf("Package %s %s in %s is affected by %s", finding.Package, finding.InstalledVersion, result.Image, finding.ID)
+		if finding.FixedVersion != "" {
+			message += ", fixed in " + finding.FixedVersion
+		}
+		location := sarifLocation{}
+		location.PhysicalLocation.ArtifactLocation.URI = finding.Target
+		run.Results = append(run.Results, sarifResult{
+			RuleID:    finding.ID,
+			Level:     sarifLevel(finding.Severity),
+			Message:   sarifMessage{Text: message},
+			Locations: []sarifLocation{location},
+		})
+	}
+
+	return sarifLog{
+		Version: "2.1.0",
+		Schema:  "https://json.schemastore.org/sarif-2.1.0.json",
+		Runs:    []sarifRun{run},
+	}
+}
+
+func sarifLevel(severity string) string {
+	switch severity {
+	case "CRITICAL", "HIGH":
+		return "error"
+	case "MEDIUM":
+		return "warning"
+	default:
+		return "note"
+	}
+}
@@ -0,0 +1,119 @@
+package cli
+
+import (
+	"io"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/acorn-io/runtime/pkg/cli/testdata"
+	"github.com/acorn-io/runtime/pkg/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestImageScan(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+		wantOut string
+	}{
+		{
+			name: "acorn image scan",
+			args: []string{"scan", "ghcr.io/acorn-io/test:v1"},
+			wantOut: "ID              SEVERITY   PACKAGE   INSTALLED   FIXED     TARGET\n" +
+				"CVE-2023-0001   HIGH       openssl   3.1.0       3.1.1     ghcr.io/acorn-io/test (alpine 3.18)\n" +
+				"CVE-2023-0002   LOW        busybox   1.36.0                ghcr.io/acorn-io/test (alpine 3.18)\n",
+		},
+		{
+			name:    "acorn image scan --severity-threshold critical",
+			args:    []string{"scan", "ghcr.io/acorn-io/test:v1", "--severity-threshold", "critical"},
+			wantOut: "CVE-2023-0001",
+		},
+		{
+			name:    "acorn image scan --severity-threshold high",
+			args:    []string{"scan", "ghcr.io/acorn-io/test:v1", "--severity-threshold", "high"},
+			wantErr: "image ghcr.io/acorn-io/test:v1 has 1 vulnerabilities of severity HIGH or higher",
+		},
+		{
+			name:    "acorn image scan --severity-threshold invalid",
+			args:    []string{"scan", "ghcr.io/acorn-io/test:v1", "--severity-threshold", "severe"},
+			wantErr: "invalid severity threshold severe, must be one of low, medium, high or critical",
+		},
+		{
+			name:    "acorn image scan -o yaml",
+			args:    []string{"scan", "ghcr.io/acorn-io/test:v1", "-o", "yaml"},
+			wantErr: "invalid output format yaml, must be one of table, json or sarif",
+		},
+		{
+			name:    "acorn image scan -o sarif",
+			args:    []string{"scan", "ghcr.io/acorn-io/test:v1", "-o", "sarif"},
+			wantOut: `"security-severity": "8.0"`,
+		},
+		{
+			name:    "acorn image scan dne",
+			args:    []string{"scan", "dne"},
+			wantErr: "error: image dne does not exist",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, w, _ := os.Pipe()
+			stdout := os.Stdout
+			os.Stdout = w
+			defer func() { os.Stdout = stdout }()
+
+			cmd := NewImage(CommandContext{
+				ClientFactory: &testdata.MockClientFactory{},
+				StdOut:        w,
+				StdErr:        w,
+				StdIn:         strings.NewReader(""),
+			})
+			cmd.SetArgs(tt.args)
+			err := cmd.Execute()
+			w.Close()
+			out, _ := io.ReadAll(r)
+
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			if strings.HasSuffix(tt.wantOut, "\n") {
+				assert.Equal(t, tt.wantOut, string(out))
+			} else {
+				assert.Contains(t, string(out), tt.wantOut)
+			}
+		})
+	}
+}
+
+func TestToSARIF(t *testing.T) {
+	log := toSARIF(&client.ImageScanResult{
+		Image:   "my-image",
+		Scanner: "trivy",
+		Findings: []client.ImageScanFinding{
+			{ID: "CVE-1", Target: "my-image (debian 12)", Package: "libc", InstalledVersion: "1", FixedVersion: "2", Severity: "CRITICAL"},
+			{ID: "CVE-1", Target: "app/go.sum", Package: "libc", InstalledVersion: "1", Severity: "CRITICAL"},
+			{ID: "CVE-2", Target: "my-image (debian 12)", Package: "zlib", InstalledVersion: "3", Severity: "MEDIUM"},
+			{ID: "CVE-3", Target: "my-image (debian 12)", Package: "curl", InstalledVersion: "4", Severity: "UNKNOWN"},
+		},
+	})
+
+	assert.Equal(t, "2.1.0", log.Version)
+	require.Len(t, log.Runs, 1)
+	run := log.Runs[0]
+	assert.Equal(t, "trivy", run.Tool.Driver.Name)
+
+	require.Len(t, run.Tool.Driver.Rules, 3)
+	assert.Equal(t, "9.5", run.Tool.Driver.Rules[0].Properties["security-severity"])
+	assert.NotContains(t, run.Tool.Driver.Rules[2].Properties, "security-severity")
+
+	require.Len(t, run.Results, 4)
+	assert.Equal(t, "error", run.Results[0].Level)
+	assert.Equal(t, "Package libc 1 in my-image is affected by CVE-1, fixed in 2", run.Results[0].Message.Text)
+	assert.Equal(t, "app/go.sum", run.Results[1].Locations[0].PhysicalLocation.ArtifactLocation.URI)
+	assert.Equal(t, "warning", run.Results[2].Level)
+	assert.Equal(t, "note", run.Results[3].Level)
+}
@@ -1,61 +1,250 @@
 package cli
 
 import (
+	"bytes"
+	"context"
 	"encoding/base64"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"os"
+	"path/filepath"
+	"runtime"
+	"slices"
+	"sort"
+	"strconv"
 	"strings"
+	"time"
 
+	"github.com/acorn-io/baaah/pkg/typed"
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
 	internalv1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
 	cli "github.com/acorn-io/runtime/pkg/cli/builder"
 	"github.com/acorn-io/runtime/pkg/client"
 	acornsign "github.com/acorn-io/runtime/pkg/cosign"
 	signatureannotations "github.com/acorn-io/runtime/pkg/imageselector/signatures/annotations"
 	"github.com/acorn-io/runtime/pkg/tags"
+	"github.com/acorn-io/runtime/pkg/vcs"
 	"github.com/google/go-containerregistry/pkg/name"
+	ggcrv1 "github.com/google/go-containerregistry/pkg/v1"
 	"github.com/pterm/pterm"
-	"github.com/sigstore/cosign/v2/pkg/cosign"
-	"github.com/sigstore/cosign/v2/pkg/signature"
+	cbundle "github.com/sigstore/cosign/v2/pkg/cosign/bundle"
+	"github.com/sigstore/cosign/v2/pkg/oci/static"
 	sigsig "github.com/sigstore/sigstore/pkg/signature"
+	"github.com/sigstore/sigstore/pkg/signature/payload"
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
+	"golang.org/x/sync/errgroup"
 	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"sigs.k8s.io/yaml"
 
 	"github.com/acorn-io/runtime/pkg/prompt"
 )
 
 func NewImageSign(c CommandContext) *cobra.Command {
 	cmd := cli.Command(&ImageSign{client: c.ClientFactory}, cobra.Command{
-		Use:               "sign IMAGE_NAME [flags]",
-		Example:           `acorn image sign my-image --key ./my-key`,
+		Use: "sign [IMAGE_NAME] [flags]",
+		Example: `# Sign using a locally stored private key file
+acorn image sign my-image --key ./my-key
+
+# Sign using a key stored in AWS KMS (also supports gcpkms://, azurekms:// and hashivault://)
+acorn image sign my-image --key awskms:///arn:aws:kms:us-east-2:111122223333:alias/my-key
+
+# Sign using the private key stored under cosign.key in the Acorn secret signing-key
+acorn image sign my-image --key-from-secret signing-key.cosign.key
+
+# Sign and record the signature in the Rekor transparency log
+acorn image sign my-image --key ./my-key --tlog-upload
+
+# Sign keyless using an ephemeral key certified by Fulcio for your OIDC identity
+acorn image sign my-image --keyless
+
+# Sign in CI, annotating the signature with the commit, branch and build URL of the pipeline
+acorn image sign my-image --key ./my-key --annotation-from-git
+
+# Check that the key can be loaded and the annotations are valid without creating a signature
+acorn image sign my-image --key ./my-key --annotation env=prod --dry-run
+
+# Print the created signature as JSON, e.g. to capture its digest in a pipeline
+acorn image sign my-image --key ./my-key -o json
+
+# Sign an image in a local OCI layout before pushing it, the signature is stored in the layout as well
+acorn image sign ghcr.io/acme/my-image:v1 --key ./my-key --oci-layout ./my-image-layout
+
+# Re-sign an image, replacing the identical signature it already has (without --force, the image is reported as
+# already signed and left unchanged, so signing can safely be re-run)
+acorn image sign my-image --key ./my-key --force
+
+# Sign in a script without asking for confirmation if the image is already signed with a different key
+acorn image sign my-image --key ./my-key --yes
+
+# Sign the image under all of its tags, e.g. if it was pushed to multiple registries
+acorn image sign my-image --key ./my-key --all-tags
+
+# Sign up to 8 tags at the same time
+acorn image sign my-image --key ./my-key --all-tags --parallel 8
+
+# Sign a locally built image without contacting any registry
+acorn image sign my-image --key ./my-key --local
+
+# Sign and attest the CycloneDX SBOM generated during the build with the same key
+acorn image sign my-image --key ./my-key --attach-sbom ./sbom.cdx.json --sbom-type cyclonedx
+
+# Sign without pushing the signature, e.g. to transfer it into an air-gapped environment ...
+acorn image sign my-image --key ./my-key --output-dir ./signatures
+
+# ... and push it from there later on
+acorn image sign my-image --from-dir ./signatures`,
 		SilenceUsage:      true,
 		Short:             "Sign an Image",
 		ValidArgsFunction: newCompletion(c.ClientFactory, imagesCompletion(true)).complete,
-		Args:              cobra.ExactArgs(1),
+		Args:              cobra.RangeArgs(0, 1),
 		Hidden:            true,
 	})
 	_ = cmd.MarkFlagFilename("key")
+	_ = cmd.MarkFlagFilename("password-file")
+	_ = cmd.MarkFlagDirname("output-dir")
+	_ = cmd.MarkFlagDirname("from-dir")
+	_ = cmd.MarkFlagDirname("oci-layout")
+	_ = cmd.MarkFlagFilename("attach-sbom")
 	return cmd
 }
 
 type ImageSign struct {
-	client      ClientFactory
-	Key         string            `usage:"Key to use for signing" short:"k" local:"true"`
-	Annotations map[string]string `usage:"Annotations to add to the signature" short:"a" local:"true" name:"annotation"`
+	client                 ClientFactory
+	Key                    string            `usage:"Key to use for signing (file, PEM or KMS URI)" short:"k" local:"true"`
+	KeyFromSecret          string            `usage:"Acorn secret (NAME[.KEY]) holding the private key to use for signing, KEY can be omitted if the secret has a single key" local:"true"`
+	Annotations            map[string]string `usage:"Annotations to add to the signature" short:"a" local:"true" name:"annotation"`
+	Keyless                bool              `usage:"Sign with an ephemeral key certified by Fulcio for your OIDC identity instead of using --key" local:"true"`
+	OIDCIssuer             string            `usage:"OIDC issuer to get the identity token from for keyless signing (default https://oauth2.sigstore.dev/auth)" local:"true" name:"oidc-issuer"`
+	FulcioURL              string            `usage:"Fulcio instance to request the signing certificate from for keyless signing (default https://fulcio.sigstore.dev)" local:"true" name:"fulcio-url"`
+	IdentityToken          string            `usage:"OIDC identity token to use for keyless signing instead of the interactive login flow" local:"true" env:"ACORN_IMAGE_SIGN_IDENTITY_TOKEN"`
+	PasswordFile           string            `usage:"File to read the password for the private key from" local:"true"`
+	AnnotationsFile        string            `usage:"YAML or JSON file with annotations to add to the signature (nested keys are joined with dots, --annotation takes precedence)" local:"true"`
+	AnnotationFromGit      bool              `usage:"Add the commit, branch and CI build URL detected from the git repository and CI environment variables as annotations (--annotations-file and --annotation take precedence)" local:"true"`
+	OutputDir              string            `usage:"Write the signature to this directory instead of pushing it to the registry" local:"true"`
+	FromDir                string            `usage:"Push a signature previously written with --output-dir from this directory instead of signing" local:"true"`
+	ExpectedKeyFingerprint string            `usage:"Fail if the SHA-256 fingerprint of the DER encoded signing public key (or of the PEM encoded one, as listed by acorn image signatures) is not this hex string" local:"true"`
+	DryRun                 bool              `usage:"Sign the image, but neither push nor write the signature" local:"true"`
+	Force                  bool              `usage:"Replace an identical existing signature (same key and annotations) instead of skipping the new one" local:"true"`
+	AllTags                bool              `usage:"Sign the image under every tag it currently has, instead of only the given name" local:"true"`
+	Output                 string            `usage:"Output format (json), prints a JSON object per signature instead of the human readable messages" short:"o" local:"true"`
+	OCILayout              string            `usage:"Sign an image in this local OCI layout directory and write the signature back into it, IMAGE_NAME is the reference it will be pushed as" local:"true" name:"oci-layout"`
+	TlogUpload             bool              `usage:"Record the signature in the Rekor transparency log (always done for keyless signatures)" local:"true"`
+	RekorURL               string            `usage:"Rekor transparency log to record signatures in (default https://rekor.sigstore.dev)" local:"true" name:"rekor-url"`
+	Retry                  int               `usage:"Number of times to retry registry requests failing with transient errors (rate limits, server or network errors)" local:"true" default:"0"`
+	RetryDelay             string            `usage:"Delay before the first retry, doubled for every further retry" local:"true" default:"1s"`
+	Parallel               int               `usage:"Number of tags to sign concurrently with --all-tags (default: number of CPUs, at most 4)" local:"true"`
+	Yes                    bool              `usage:"Don't ask for confirmation before signing an image that is already signed with a different key" short:"y" local:"true"`
+	Local                  bool              `usage:"Only look the image up in the local image store and fail if it isn't there, instead of contacting its registry (default: true for image IDs)" local:"true"`
+	AttachSbom             string            `usage:"Also attach a signed attestation of this SBOM file to the image, created with the same key as the signature" local:"true"`
+	SbomType               string            `usage:"Format of the --attach-sbom file, spdx or cyclonedx (default: spdx)" local:"true"`
+
+	sigSigner     sigsig.SignerVerifier
+	keylessSigner *acornsign.KeylessSigner
+	retryDelay    time.Duration
+	sbom          []byte
+	// out buffers the output of a parallel worker, so that it can be printed in order
+	out io.Writer
 }
 
 func (a *ImageSign) Run(cmd *cobra.Command, args []string) error {
-	if a.Key == "" {
+	if a.Keyless && a.Key != "" {
+		return fmt.Errorf("--keyless and --key are mutually exclusive")
+	}
+	if a.KeyFromSecret != "" && (a.Keyless || a.Key != "") {
+		return fmt.Errorf("--key-from-secret cannot be combined with --key or --keyless")
+	}
+	if a.FromDir != "" {
+		if a.Keyless || a.Key != "" || a.KeyFromSecret != "" || a.OutputDir != "" {
+			return fmt.Errorf("--from-dir cannot be combined with --key, --key-from-secret, --keyless or --output-dir")
+		}
+	} else if !a.Keyless && a.Key == "" && a.KeyFromSecret == "" {
 		return fmt.Errorf("key is required")
 	}
+	if a.AllTags && (a.FromDir != "" || a.OutputDir != "") {
+		return fmt.Errorf("--all-tags cannot be combined with --from-dir or --output-dir")
+	}
+	if a.Parallel < 0 {
+		return fmt.Errorf("invalid value %d for --parallel, must be at least 1", a.Parallel)
+	} else if a.Parallel > 0 && !a.AllTags {
+		return fmt.Errorf("--parallel can only be used with --all-tags")
+	}
+	if a.Output != "" && a.Output != "json" {
+		return fmt.Errorf("invalid output format %s, only json is supported", a.Output)
+	}
+	if a.Force && (a.OutputDir != "" || a.OCILayout != "") {
+		return fmt.Errorf("--force cannot be combined with --output-dir or --oci-layout")
+	}
+	if a.OCILayout != "" {
+		if a.AllTags || a.FromDir != "" || a.OutputDir != "" {
+			return fmt.Errorf("--oci-layout cannot be combined with --all-tags, --from-dir or --output-dir")
+		}
+	} else if len(args) != 1 {
+		return fmt.Errorf("IMAGE_NAME is required")
+	}
+	if a.AttachSbom == "" && a.SbomType != "" {
+		return fmt.Errorf("--sbom-type requires --attach-sbom")
+	}
+	if a.AttachSbom != "" {
+		// The attestation is only attached to the single image given and isn't recorded in the transparency log, which
+		// keyless attestations need to be trusted
+		if a.Keyless || a.TlogUpload || a.AllTags || a.FromDir != "" || a.OutputDir != "" || a.OCILayout != "" {
+			return fmt.Errorf("--attach-sbom cannot be combined with --keyless, --tlog-upload, --all-tags, --from-dir, --output-dir or --oci-layout")
+		}
+		if a.SbomType == "" {
+			a.SbomType = "spdx"
+		} else if a.SbomType != "spdx" && a.SbomType != "cyclonedx" {
+			return fmt.Errorf("invalid SBOM type %s, must be one of spdx or cyclonedx", a.SbomType)
+		}
+	}
+
+	var err error
+	a.retryDelay, err = time.ParseDuration(a.RetryDelay)
+	if err != nil {
+		return fmt.Errorf("invalid retry delay %s: %w", a.RetryDelay, err)
+	}
+
+	if a.AttachSbom != "" {
+		a.sbom, err = os.ReadFile(a.AttachSbom)
+		if err != nil {
+			return fmt.Errorf("failed to read SBOM: %w", err)
+		}
+	}
+
+	if a.AnnotationsFile != "" {
+		fileAnnotations, err := readAnnotationsFile(a.AnnotationsFile)
+		if err != nil {
+			return err
+		}
+		for k, v := range a.Annotations {
+			fileAnnotations[k] = v
+		}
+		a.Annotations = fileAnnotations
+	}
+
+	if a.AnnotationFromGit {
+		gitAnnotations := provenanceAnnotations(vcs.DetectProvenance("."))
+		if len(gitAnnotations) == 0 {
+			return fmt.Errorf("--annotation-from-git found neither a git repository nor CI environment variables to take the annotations from")
+		}
+		for k, v := range a.Annotations {
+			gitAnnotations[k] = v
+		}
+		a.Annotations = gitAnnotations
+	}
 
 	// Validate user-provided Annotations
-	_, err := signatureannotations.GenerateSelector(internalv1.SignatureAnnotations{Match: a.Annotations}, signatureannotations.LabelSelectorOpts{LabelRequirementErrorFilters: []utilerrors.Matcher{signatureannotations.IgnoreInvalidFieldErrors(signatureannotations.LabelValueMaxLengthErrMsg, signatureannotations.LabelValueRegexpErrMsg)}})
+	_, err = signatureannotations.GenerateSelector(internalv1.SignatureAnnotations{Match: a.Annotations}, signatureannotations.LabelSelectorOpts{LabelRequirementErrorFilters: []utilerrors.Matcher{signatureannotations.IgnoreInvalidFieldErrors(signatureannotations.LabelValueMaxLengthErrMsg, signatureannotations.LabelValueRegexpErrMsg)}})
 	if err != nil {
 		return fmt.Errorf("failed to parse provided annotations: %w", err)
 	}
 
+	if a.OCILayout != "" {
+		return a.signOCILayout(cmd, args)
+	}
+
 	imageName := args[0]
 
 	c, err := a.client.CreateDefault()
@@ -63,75 +252,460 @@ func (a *ImageSign) Run(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	auth, err := getAuthForImage(cmd.Context(), a.client, imageName)
+	local := a.Local || tags.IsLocalReference(imageName)
+
+	// not failing here, since it could be a local image
+	ref, _ := name.ParseReference(imageName)
+
+	var (
+		auth    *apiv1.RegistryAuth
+		details *client.ImageDetails
+	)
+	getDetails := func() error {
+		return a.withRetry(cmd, func() (err error) {
+			details, err = c.ImageDetails(cmd.Context(), args[0], &client.ImageDetailsOptions{
+				Auth:  auth,
+				Local: local,
+			})
+			return err
+		})
+	}
+	if local {
+		err = getDetails()
+	} else {
+		// Stale cached credentials are refreshed once, the ones that worked are used for the rest of the signing
+		err = withAuthForImage(cmd.Context(), a.client, imageName, func(imageAuth *apiv1.RegistryAuth) error {
+			auth = imageAuth
+			return getDetails()
+		})
+	}
+	if err != nil {
+		return err
+	}
+
+	if a.AllTags {
+		return a.signAllTags(cmd, c, details, auth)
+	}
+
+	targetDigest := ref.Context().Digest(details.AppImage.Digest)
+
+	imageSignOpts := &client.ImageSignOptions{
+		Auth:  auth,
+		Force: a.Force,
+	}
+
+	var (
+		payload      []byte
+		signatureB64 string
+	)
+
+	if a.FromDir != "" {
+		a.info("Pushing exported signature for Image %s (digest: %s) from %s\n", imageName, targetDigest, a.FromDir)
+		payload, signatureB64, err = readExportedSignature(a.FromDir, details.AppImage.Digest, imageSignOpts)
+	} else {
+		a.info("Signing Image %s (digest: %s)\n", imageName, targetDigest)
+		payload, signatureB64, err = a.signPayload(cmd, ref, targetDigest, details, imageSignOpts)
+	}
 	if err != nil {
 		return err
 	}
 
-	// not failing here, since it could be a local image
-	ref, _ := name.ParseReference(imageName)
+	if a.sbom != nil {
+		imageSignOpts.Attestation, err = a.signSBOM(cmd, targetDigest)
+		if err != nil {
+			return err
+		}
+	}
+
+	if a.OutputDir != "" && !a.DryRun {
+		if err := writeExportedSignature(a.OutputDir, details.AppImage.Digest, payload, signatureB64, imageSignOpts); err != nil {
+			return err
+		}
+		a.success("Exported signature for digest %s to %s\n", details.AppImage.Digest, a.OutputDir)
+		return nil
+	}
+
+	if !a.DryRun {
+		if err := a.confirmSigning(cmd, c, []string{imageName}, auth, imageSignOpts.PublicKey); err != nil {
+			return err
+		}
+	}
+
+	var sig *apiv1.ImageSignature
+	if a.DryRun {
+		sig, err = a.dryRunSignature(cmd, c, imageName, payload, imageSignOpts)
+	} else {
+		err = a.withRetry(cmd, func() (err error) {
+			sig, err = c.ImageSign(cmd.Context(), imageName, payload, signatureB64, imageSignOpts)
+			return err
+		})
+	}
+	if err != nil {
+		return err
+	}
+
+	if a.DryRun {
+		if err := a.printDryRunSignature(payload, sig, ""); err != nil {
+			return err
+		}
+	} else {
+		a.signatureWritten(sig, "")
+		if sig.AttestationDigest != "" {
+			a.success("Created attestation %s\n", sig.AttestationDigest)
+		}
+	}
+
+	return a.printResult(cmd, imageName, targetDigest, payload, imageSignOpts, sig)
+}
+
+// signSBOM creates the attestation of the SBOM of --attach-sbom for targetDigest, signed with the same signer as the
+// signature, so that the key is only loaded once
+func (a *ImageSign) signSBOM(cmd *cobra.Command, targetDigest name.Digest) ([]byte, error) {
+	sigSigner, _, err := a.getSigner(cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	predicateType := a.SbomType
+	if predicateType == "spdx" && json.Valid(a.sbom) {
+		// cosign attest calls SPDX documents in JSON format spdxjson, both have the same predicate type
+		predicateType = "spdxjson"
+	}
+
+	a.info("Attesting %s SBOM %s (digest: %s)\n", a.SbomType, a.AttachSbom, targetDigest)
+	return acornsign.SignAttestation(cmd.Context(), sigSigner, targetDigest, predicateType, a.sbom)
+}
+
+// signatureWritten tells whether the pushed signature was created, replaced an identical one or was skipped, because
+// an identical one exists already
+func (a *ImageSign) signatureWritten(sig *apiv1.ImageSignature, forTag string) {
+	switch {
+	case sig.Duplicate && a.Force:
+		a.success("Replaced identical signature in %s%s\n", sig.SignatureDigest, forTag)
+	case sig.Duplicate:
+		a.info("Already signed (unchanged), identical signature exists in %s%s (use --force to replace it)\n", sig.SignatureDigest, forTag)
+	default:
+		a.success("Created signature %s%s\n", sig.SignatureDigest, forTag)
+	}
+}
+
+// confirmSigning asks for confirmation if any of the images already has signatures which were not created with the
+// given public key, since a signature from a different key is easily added by accident. Without a terminal, a warning
+// is logged and signing proceeds.
+func (a *ImageSign) confirmSigning(cmd *cobra.Command, c client.Client, images []string, auth *apiv1.RegistryAuth, publicKey string) error {
+	// Keyless signatures are created with an ephemeral key, so they never match an existing signature's key
+	if a.Yes || a.Keyless || publicKey == "" {
+		return nil
+	}
+
+	pubKey, err := acornsign.UnmarshalPEMToPublicKey([]byte(publicKey))
+	if err != nil {
+		return err
+	}
+	_, fingerprint, err := acornsign.PemEncodeCryptoPublicKey(pubKey)
+	if err != nil {
+		return err
+	}
+
+	var warnings []string
+	for _, image := range images {
+		var sigs []apiv1.ImageSignatureEntry
+		err := a.withRetry(cmd, func() (err error) {
+			// Signatures created with the given key are identified by it, all others have a different fingerprint
+			sigs, err = c.ImageSignatures(cmd.Context(), image, &client.ImageSignaturesOptions{
+				PublicKey: publicKey,
+				Auth:      auth,
+			})
+			return err
+		})
+		if err != nil {
+			return fmt.Errorf("failed to list existing signatures of image %s: %w", image, err)
+		}
+		if n := countForeignSignatures(sigs, fingerprint); n > 0 {
+			warnings = append(warnings, fmt.Sprintf("Image %s already has %d signature(s) from a different key", image, n))
+		}
+	}
+	if len(warnings) == 0 {
+		return nil
+	}
 
-	details, err := c.ImageDetails(cmd.Context(), args[0], &client.ImageDetailsOptions{
-		Auth: auth,
+	if !isTerm() {
+		for _, warning := range warnings {
+			logrus.Warnf("%s, signing anyway", warning)
+		}
+		return nil
+	}
+
+	for _, warning := range warnings {
+		pterm.Warning.Println(warning)
+	}
+	if ok, err := prompt.Bool("Do you want to add a signature from your key anyway?", false); err != nil {
+		return err
+	} else if !ok {
+		pterm.Warning.Println("Aborting signing")
+		return fmt.Errorf("aborting signing")
+	}
+	return nil
+}
+
+// countForeignSignatures returns the number of signatures not created with the key with the given fingerprint
+func countForeignSignatures(sigs []apiv1.ImageSignatureEntry, fingerprint string) int {
+	var n int
+	for _, sig := range sigs {
+		if sig.Fingerprint != fingerprint {
+			n++
+		}
+	}
+	return n
+}
+
+// signAllTags signs the image digest once for every tag of the image, so that the signed name matches no matter
+// which of its names the image is referenced by
+func (a *ImageSign) signAllTags(cmd *cobra.Command, c client.Client, details *client.ImageDetails, auth *apiv1.RegistryAuth) error {
+	var img *apiv1.Image
+	err := a.withRetry(cmd, func() (err error) {
+		img, err = c.ImageGet(cmd.Context(), details.AppImage.ID)
+		return err
 	})
+	if err != nil {
+		return fmt.Errorf("failed to get tags of image %s: %w", details.AppImage.ID, err)
+	}
+	if len(img.Tags) == 0 {
+		return fmt.Errorf("image %s has no tags", details.AppImage.ID)
+	}
+
+	tags := slices.Clone(img.Tags)
+	sort.Strings(tags)
+
+	// Load the signer before starting the workers, so that they all share it and the password prompt or OIDC flow
+	// is only gone through once. Signers don't hold any state while signing, so they can be used concurrently.
+	sigSigner, _, err := a.getSigner(cmd)
 	if err != nil {
 		return err
 	}
 
+	if !a.DryRun {
+		// Ask once for all tags, before the workers start
+		pubKey, err := sigSigner.PublicKey()
+		if err != nil {
+			return err
+		}
+		publicKey, _, err := acornsign.PemEncodeCryptoPublicKey(pubKey)
+		if err != nil {
+			return err
+		}
+		if err := a.confirmSigning(cmd, c, tags, auth, string(publicKey)); err != nil {
+			return err
+		}
+	}
+
+	var (
+		outputs = make([]bytes.Buffer, len(tags))
+		errs    = make([]error, len(tags))
+		eg      errgroup.Group
+	)
+	eg.SetLimit(a.parallelism())
+
+	for i, tag := range tags {
+		i, tag := i, tag
+		eg.Go(func() error {
+			worker := *a
+			worker.out = &outputs[i]
+			errs[i] = worker.signTag(cmd, c, details, auth, tag)
+			return nil
+		})
+	}
+	_ = eg.Wait()
+
+	// Print in the order of the tags, no matter in which order the workers finished
+	for i := range outputs {
+		if _, err := outputs[i].WriteTo(cmd.OutOrStdout()); err != nil {
+			return err
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// parallelism is the number of tags signed concurrently, kept low by default to avoid hitting registry rate limits
+func (a *ImageSign) parallelism() int {
+	if a.Parallel > 0 {
+		return a.Parallel
+	}
+	return min(runtime.NumCPU(), 4)
+}
+
+// signTag signs the image digest under the given tag and pushes the signature
+func (a *ImageSign) signTag(cmd *cobra.Command, c client.Client, details *client.ImageDetails, auth *apiv1.RegistryAuth, tag string) error {
+	ref, err := name.ParseReference(tag)
+	if err != nil {
+		return fmt.Errorf("failed to parse tag %s: %w", tag, err)
+	}
+
 	targetDigest := ref.Context().Digest(details.AppImage.Digest)
+	imageSignOpts := &client.ImageSignOptions{
+		Auth:  auth,
+		Force: a.Force,
+	}
 
-	pterm.Info.Printf("Signing Image %s (digest: %s)\n", imageName, targetDigest)
+	a.info("Signing Image %s (digest: %s)\n", tag, targetDigest)
+	payload, signatureB64, err := a.signPayload(cmd, ref, targetDigest, details, imageSignOpts)
+	if err != nil {
+		return fmt.Errorf("failed to sign tag %s: %w", tag, err)
+	}
+
+	var sig *apiv1.ImageSignature
+	if a.DryRun {
+		sig, err = a.dryRunSignature(cmd, c, tag, payload, imageSignOpts)
+		if err != nil {
+			return err
+		}
+		if err := a.printDryRunSignature(payload, sig, " for tag "+tag); err != nil {
+			return err
+		}
+	} else {
+		err = a.withRetry(cmd, func() (err error) {
+			sig, err = c.ImageSign(cmd.Context(), tag, payload, signatureB64, imageSignOpts)
+			return err
+		})
+		if err != nil {
+			return fmt.Errorf("failed to push signature for tag %s: %w", tag, err)
+		}
+		a.signatureWritten(sig, " for tag "+tag)
+	}
+	return a.printResult(cmd, tag, targetDigest, payload, imageSignOpts, sig)
+}
 
-	pass, err := getPrivateKeyPass()
+// signOCILayout signs an image in a local OCI layout and stores the signature in the layout next to it.
+// The optional argument is the reference the image will be pushed as, it defaults to the reference the image has
+// in the layout.
+func (a *ImageSign) signOCILayout(cmd *cobra.Command, args []string) error {
+	var (
+		img *acornsign.LayoutImage
+		err error
+	)
+	if len(args) == 0 {
+		img, err = acornsign.FindLayoutImage(a.OCILayout, "")
+	} else {
+		img, err = findLayoutImageByName(a.OCILayout, args[0])
+	}
 	if err != nil {
 		return err
 	}
-	if len(pass) == 0 {
-		pass = nil // nothing instead of empty pass
+
+	refName := img.RefName
+	if len(args) > 0 {
+		refName = args[0]
+	}
+	// The canonical reference ends up in the signature payload, so it has to name a repository (and tag)
+	ref, err := name.ParseReference(refName, name.StrictValidation)
+	if err != nil {
+		return fmt.Errorf("image in OCI layout %s has no valid reference (%q), pass the reference it will be pushed as: %w", a.OCILayout, refName, err)
 	}
 
-	pf := func(_ bool) ([]byte, error) {
-		return pass, nil
+	targetDigest := ref.Context().Digest(img.Digest.String())
+	details := &client.ImageDetails{
+		AppImage: internalv1.AppImage{
+			Digest: img.Digest.String(),
+		},
 	}
+	imageSignOpts := &client.ImageSignOptions{}
 
-	// Get a sigSigner-verifier from a private key and if the key type is not supported, try to import it first
-	var sigSigner sigsig.SignerVerifier
+	a.info("Signing Image %s from OCI layout %s (digest: %s)\n", ref, a.OCILayout, targetDigest)
+	payload, signatureB64, err := a.signPayload(cmd, ref, targetDigest, details, imageSignOpts)
+	if err != nil {
+		return err
+	}
 
-	if len(a.Key) > 255 || strings.Contains(strings.Trim(a.Key, "\n"), "\n") {
-		// Not a file (filename too long or contains newlines) - load from raw key data
-		sigSigner, err = cosign.LoadPrivateKey([]byte(a.Key), pass)
-	} else {
-		var finfo os.FileInfo
-		finfo, err = os.Stat(a.Key)
-		if err != nil {
-			if os.IsNotExist(err) || strings.Contains("\n", a.Key) {
-				// Not a file - load from raw key data
-				sigSigner, err = cosign.LoadPrivateKey([]byte(a.Key), pass)
-			} else {
-				return fmt.Errorf("failed to stat key file: %w", err)
-			}
-		} else {
-			if finfo.IsDir() {
-				return fmt.Errorf("invalid key file: is directory")
-			}
-			// Load from file
-			sigSigner, err = signature.SignerVerifierFromKeyRef(cmd.Context(), a.Key, pf)
+	var staticOpts []static.Option
+	if len(imageSignOpts.Certificate) > 0 {
+		staticOpts = append(staticOpts, static.WithCertChain(imageSignOpts.Certificate, imageSignOpts.CertificateChain))
+	}
+	if len(imageSignOpts.RekorBundle) > 0 {
+		rekorBundle := &cbundle.RekorBundle{}
+		if err := json.Unmarshal(imageSignOpts.RekorBundle, rekorBundle); err != nil {
+			return fmt.Errorf("failed to parse transparency log bundle: %w", err)
+		}
+		staticOpts = append(staticOpts, static.WithBundle(rekorBundle))
+	}
+
+	sig, err := static.NewSignature(payload, signatureB64, staticOpts...)
+	if err != nil {
+		return err
+	}
+
+	if a.DryRun {
+		sigDigest, err := acornsign.LayoutSignatureDigest(a.OCILayout, img.Digest, sig)
+		if err != nil {
+			return err
 		}
+
+		result := &apiv1.ImageSignature{SignatureDigest: sigDigest.String()}
+		if err := a.printDryRunSignature(payload, result, ""); err != nil {
+			return err
+		}
+		return a.printResult(cmd, ref.String(), targetDigest, payload, imageSignOpts, result)
 	}
 
+	sigDigest, err := acornsign.WriteLayoutSignature(a.OCILayout, img.Digest, sig)
 	if err != nil {
-		if !strings.Contains(err.Error(), "unsupported pem type") {
-			return fmt.Errorf("failed to create signer from private key: %w", err)
+		return err
+	}
+
+	a.success("Created signature %s in OCI layout %s\n", sigDigest, a.OCILayout)
+
+	return a.printResult(cmd, ref.String(), targetDigest, payload, imageSignOpts, &apiv1.ImageSignature{SignatureDigest: sigDigest.String()})
+}
+
+// findLayoutImageByName selects the image in the OCI layout by the given reference, falling back to just its tag
+// (which is how e.g. buildx names images in OCI layouts) and the only image in the layout
+func findLayoutImageByName(path, imageName string) (*acornsign.LayoutImage, error) {
+	img, err := acornsign.FindLayoutImage(path, imageName)
+	if err == nil {
+		return img, nil
+	}
+	if tag, tagErr := name.NewTag(imageName, name.StrictValidation); tagErr == nil {
+		if img, err := acornsign.FindLayoutImage(path, tag.TagStr()); err == nil {
+			return img, nil
 		}
-		logrus.Debugf("Key %s is not a supported PEM key, importing...\n", a.Key)
-		keyBytes, err := acornsign.ImportKeyPair(a.Key, pass)
+	}
+	return acornsign.FindLayoutImage(path, "")
+}
+
+// signPayload signs the payload for targetDigest with either the keyless or the key-based signer
+// and populates the signer specific options (public key, certificate, transparency log bundle).
+func (a *ImageSign) signPayload(cmd *cobra.Command, ref name.Reference, targetDigest name.Digest, details *client.ImageDetails, imageSignOpts *client.ImageSignOptions) ([]byte, string, error) {
+	sigSigner, keylessSigner, err := a.getSigner(cmd)
+	if err != nil {
+		return nil, "", err
+	}
+
+	pubkey, err := sigSigner.PublicKey()
+	if err != nil {
+		return nil, "", err
+	}
+
+	if a.ExpectedKeyFingerprint != "" {
+		matches, err := acornsign.MatchesFingerprint(pubkey, a.ExpectedKeyFingerprint)
 		if err != nil {
-			return fmt.Errorf("failed to import private key: %w", err)
+			return nil, "", err
+		}
+		if !matches {
+			fingerprint, err := acornsign.DERFingerprint(pubkey)
+			if err != nil {
+				return nil, "", err
+			}
+			return nil, "", fmt.Errorf("signing key fingerprint %s does not match expected fingerprint %s", fingerprint, a.ExpectedKeyFingerprint)
 		}
-		sigSigner, err = cosign.LoadPrivateKey(keyBytes.PrivateBytes, keyBytes.Password())
+	}
+
+	if pubkey != nil {
+		pem, _, err := acornsign.PemEncodeCryptoPublicKey(pubkey)
 		if err != nil {
-			return fmt.Errorf("failed to create signer from imported private key: %w", err)
+			return nil, "", err
 		}
+
+		imageSignOpts.PublicKey = string(pem)
 	}
 
 	signedName := ref.String()
@@ -148,44 +722,487 @@ func (a *ImageSign) Run(cmd *cobra.Command, args []string) error {
 
 	payload, signature, err := sigsig.SignImage(sigSigner, targetDigest, annotations)
 	if err != nil {
-		return err
+		return nil, "", err
 	}
 
 	logrus.Debugf("Payload Annotations: %#v", annotations)
 
 	signatureB64 := base64.StdEncoding.EncodeToString(signature)
 
-	imageSignOpts := &client.ImageSignOptions{
-		Auth: auth,
+	if a.DryRun {
+		return payload, signatureB64, nil
 	}
 
-	pubkey, err := sigSigner.PublicKey()
+	var tlogPEM []byte
+	if keylessSigner != nil {
+		// Keyless signatures are only trustworthy if the short-lived certificate was used while it was valid,
+		// which is what the transparency log entry attests to.
+		imageSignOpts.Certificate = keylessSigner.Cert
+		imageSignOpts.CertificateChain = keylessSigner.Chain
+		tlogPEM = keylessSigner.Cert
+	} else if a.TlogUpload {
+		tlogPEM = []byte(imageSignOpts.PublicKey)
+	}
+
+	if tlogPEM != nil {
+		entry, err := acornsign.UploadToTransparencyLog(cmd.Context(), a.RekorURL, payload, signature, tlogPEM)
+		if err != nil {
+			return nil, "", err
+		}
+		imageSignOpts.RekorBundle = entry.Bundle
+		imageSignOpts.TlogEntryUUID = entry.UUID
+		imageSignOpts.TlogIntegratedTime = entry.IntegratedTime
+		a.info("Recorded signature in transparency log (entry: %s, integrated time: %s)\n", entry.UUID, time.Unix(entry.IntegratedTime, 0).UTC().Format(time.RFC3339))
+	}
+
+	return payload, signatureB64, nil
+}
+
+// withRetry retries fn as configured by --retry and --retry-delay if it fails with a transient error
+func (a *ImageSign) withRetry(cmd *cobra.Command, fn func() error) error {
+	return client.WithRetry(cmd.Context(), a.Retry, a.retryDelay, fn)
+}
+
+// getSigner loads the signer once, so that signing multiple tags doesn't prompt for the password or
+// go through the OIDC flow more than once
+func (a *ImageSign) getSigner(cmd *cobra.Command) (sigsig.SignerVerifier, *acornsign.KeylessSigner, error) {
+	if a.sigSigner != nil {
+		return a.sigSigner, a.keylessSigner, nil
+	}
+
+	if a.Keyless {
+		keylessSigner, err := acornsign.NewKeylessSigner(cmd.Context(), acornsign.KeylessOpts{
+			FulcioURL:     a.FulcioURL,
+			OIDCIssuer:    a.OIDCIssuer,
+			IdentityToken: a.IdentityToken,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+		a.sigSigner, a.keylessSigner = keylessSigner, keylessSigner
+	} else {
+		sigSigner, err := a.loadSigner(cmd)
+		if err != nil {
+			return nil, nil, err
+		}
+		a.sigSigner = sigSigner
+	}
+
+	return a.sigSigner, a.keylessSigner, nil
+}
+
+// loadSigner gets a signer-verifier from the private key referenced by --key or --key-from-secret
+func (a *ImageSign) loadSigner(cmd *cobra.Command) (sigsig.SignerVerifier, error) {
+	if a.KeyFromSecret != "" {
+		c, err := a.client.CreateDefault()
+		if err != nil {
+			return nil, err
+		}
+
+		// The key is only ever held in memory, it's passed on as raw key data
+		keyData, err := keyFromSecret(cmd.Context(), c, a.KeyFromSecret)
+		if err != nil {
+			return nil, err
+		}
+
+		pass, err := getPrivateKeyPass(a.PasswordFile)
+		if err != nil {
+			return nil, err
+		}
+		if len(pass) == 0 {
+			pass = nil // nothing instead of empty pass
+		}
+
+		return acornsign.SignerFromKey(cmd.Context(), string(keyData), pass)
+	}
+
+	if acornsign.IsKMSKeyRef(a.Key) {
+		// The private key never leaves the KMS, so there's no file to look for and no password to ask for
+		return acornsign.SignerVerifierFromKMSKeyRef(cmd.Context(), a.Key)
+	}
+
+	pass, err := getPrivateKeyPass(a.PasswordFile)
+	if err != nil {
+		return nil, err
+	}
+	if len(pass) == 0 {
+		pass = nil // nothing instead of empty pass
+	}
+
+	return acornsign.SignerFromKey(cmd.Context(), a.Key, pass)
+}
+
+// keyFromSecret reveals the private key stored in the Acorn secret referenced as NAME[.KEY]
+func keyFromSecret(ctx context.Context, c client.Client, secretRef string) ([]byte, error) {
+	secretName, key, _ := strings.Cut(secretRef, ".")
+
+	secret, err := c.SecretReveal(ctx, secretName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get secret %s: %w", secretName, err)
+	}
+
+	if key == "" {
+		if len(secret.Data) != 1 {
+			return nil, fmt.Errorf("secret %s has %d keys, use %s.KEY to select the one holding the private key", secretName, len(secret.Data), secretName)
+		}
+		for k := range secret.Data {
+			key = k
+		}
+	}
+
+	keyData, ok := secret.Data[key]
+	if !ok {
+		return nil, fmt.Errorf("secret %s has no key %s", secretName, key)
+	}
+	if len(keyData) == 0 {
+		return nil, fmt.Errorf("key %s of secret %s is empty", key, secretName)
+	}
+
+	return keyData, nil
+}
+
+type imageSignResult struct {
+	Image           string `json:"image"`
+	Digest          string `json:"digest"`
+	SignatureDigest string `json:"signatureDigest,omitempty"`
+	// AttestationDigest is the digest of the attestations artifact the SBOM attestation was written to
+	AttestationDigest string `json:"attestationDigest,omitempty"`
+	// Unchanged is set if the image was already signed with the same key and annotations and nothing was pushed
+	Unchanged          bool           `json:"unchanged,omitempty"`
+	PublicKey          string         `json:"publicKey,omitempty"`
+	Annotations        map[string]any `json:"annotations,omitempty"`
+	TlogEntryUUID      string         `json:"tlogEntryUUID,omitempty"`
+	TlogIntegratedTime int64          `json:"tlogIntegratedTime,omitempty"`
+}
+
+// info and success print the human-readable progress messages, unless the output is meant to be machine-readable
+func (a *ImageSign) info(format string, args ...any) {
+	if a.Output == "" {
+		a.printer(pterm.Info).Printf(format, args...)
+	} else {
+		logrus.Debugf(format, args...)
+	}
+}
+
+func (a *ImageSign) success(format string, args ...any) {
+	if a.Output == "" {
+		a.printer(pterm.Success).Printf(format, args...)
+	} else {
+		logrus.Debugf(format, args...)
+	}
+}
+
+// printer returns the printer writing to the buffered output of a parallel worker, if any
+func (a *ImageSign) printer(printer pterm.PrefixPrinter) *pterm.PrefixPrinter {
+	if a.out != nil {
+		return printer.WithWriter(a.out)
+	}
+	return &printer
+}
+
+// printResult prints the signature as JSON object if requested by --output, sig is the pushed signature, if any
+func (a *ImageSign) printResult(cmd *cobra.Command, image string, targetDigest name.Digest, pld []byte, imageSignOpts *client.ImageSignOptions, sig *apiv1.ImageSignature) error {
+	if a.Output != "json" {
+		return nil
+	}
+
+	sci := payload.SimpleContainerImage{}
+	if err := json.Unmarshal(pld, &sci); err != nil {
+		return fmt.Errorf("failed to decode signature payload: %w", err)
+	}
+
+	result := imageSignResult{
+		Image:              image,
+		Digest:             targetDigest.DigestStr(),
+		PublicKey:          imageSignOpts.PublicKey,
+		Annotations:        sci.Optional,
+		TlogEntryUUID:      imageSignOpts.TlogEntryUUID,
+		TlogIntegratedTime: imageSignOpts.TlogIntegratedTime,
+	}
+	if sig != nil {
+		result.SignatureDigest = sig.SignatureDigest
+		result.AttestationDigest = sig.AttestationDigest
+		result.Unchanged = sig.Duplicate && !a.Force
+	}
+
+	out, err := json.MarshalIndent(result, "", "  ")
 	if err != nil {
 		return err
 	}
 
-	if pubkey != nil {
-		pem, _, err := acornsign.PemEncodeCryptoPublicKey(pubkey)
+	w := cmd.OutOrStdout()
+	if a.out != nil {
+		w = a.out
+	}
+	_, err = fmt.Fprintln(w, string(out))
+	return err
+}
+
+// dryRunSignature returns the signature a real run would create for image, without sending it to the server, so that
+// nothing is written no matter which version the server runs. The digests are the ones acorn image signatures lists.
+// An identical signature is looked up among the existing signatures of the image, like the server does before
+// writing.
+func (a *ImageSign) dryRunSignature(cmd *cobra.Command, c client.Client, image string, pld []byte, imageSignOpts *client.ImageSignOptions) (*apiv1.ImageSignature, error) {
+	// The layers of signatures and attestations are their uncompressed payloads, so the digests are their hashes
+	sigDigest, _, err := ggcrv1.SHA256(bytes.NewReader(pld))
+	if err != nil {
+		return nil, err
+	}
+	result := &apiv1.ImageSignature{SignatureDigest: sigDigest.String()}
+
+	if len(imageSignOpts.Attestation) > 0 {
+		attDigest, _, err := ggcrv1.SHA256(bytes.NewReader(imageSignOpts.Attestation))
 		if err != nil {
-			return err
+			return nil, err
 		}
+		result.AttestationDigest = attDigest.String()
+	}
 
-		imageSignOpts.PublicKey = string(pem)
+	// Keyless signatures are created with an ephemeral key, so they never match an existing signature
+	if a.Keyless || imageSignOpts.PublicKey == "" {
+		return result, nil
+	}
+
+	sci := payload.SimpleContainerImage{}
+	if err := json.Unmarshal(pld, &sci); err != nil {
+		return nil, fmt.Errorf("failed to decode signature payload: %w", err)
+	}
+	pubKey, err := acornsign.UnmarshalPEMToPublicKey([]byte(imageSignOpts.PublicKey))
+	if err != nil {
+		return nil, err
+	}
+	_, fingerprint, err := acornsign.PemEncodeCryptoPublicKey(pubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	var existing []apiv1.ImageSignatureEntry
+	err = a.withRetry(cmd, func() (err error) {
+		existing, err = c.ImageSignatures(cmd.Context(), image, &client.ImageSignaturesOptions{
+			PublicKey: imageSignOpts.PublicKey,
+			Auth:      imageSignOpts.Auth,
+		})
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list existing signatures of image %s: %w", image, err)
+	}
+
+	for _, entry := range existing {
+		if entry.Fingerprint == fingerprint && sameAnnotations(entry.Annotations, sci.Optional) {
+			result.SignatureDigest = entry.Digest
+			result.Duplicate = true
+			break
+		}
+	}
+
+	return result, nil
+}
+
+// sameAnnotations compares the annotations of a listed signature to the optional payload fields of a new one, which are
+// listed in their string form
+func sameAnnotations(listed map[string]string, optional map[string]any) bool {
+	var n int
+	for k, v := range optional {
+		if v == nil {
+			continue
+		}
+		if listedValue, ok := listed[k]; !ok || listedValue != fmt.Sprint(v) {
+			return false
+		}
+		n++
+	}
+	return n == len(listed)
+}
+
+// printDryRunSignature prints the payload annotations and the digests of the signature and attestation a real run
+// would create
+func (a *ImageSign) printDryRunSignature(pld []byte, sig *apiv1.ImageSignature, forTag string) error {
+	if a.Output != "" {
+		return nil
 	}
 
-	sig, err := c.ImageSign(cmd.Context(), imageName, payload, signatureB64, imageSignOpts)
+	sci := payload.SimpleContainerImage{}
+	if err := json.Unmarshal(pld, &sci); err != nil {
+		return fmt.Errorf("failed to decode signature payload: %w", err)
+	}
+
+	annotations, err := yaml.Marshal(sci.Optional)
 	if err != nil {
 		return err
 	}
 
-	pterm.Success.Printf("Created signature %s\n", sig.SignatureDigest)
+	a.printer(pterm.Info).Printf("Payload annotations:\n%s", annotations)
+	switch {
+	case sig.Duplicate && a.Force:
+		a.printer(pterm.Success).Printf("Dry run: would have replaced identical signature in %s%s\n", sig.SignatureDigest, forTag)
+	case sig.Duplicate:
+		a.printer(pterm.Info).Printf("Dry run: already signed (unchanged), identical signature exists in %s%s\n", sig.SignatureDigest, forTag)
+	default:
+		a.printer(pterm.Success).Printf("Dry run: would have created signature %s%s\n", sig.SignatureDigest, forTag)
+	}
+	if sig.AttestationDigest != "" {
+		a.printer(pterm.Success).Printf("Dry run: would have created attestation %s\n", sig.AttestationDigest)
+	}
+
+	return nil
+}
+
+// Files holding an exported signature, named after the digest of the signed image
+const (
+	exportedPayloadSuffix     = ".payload"
+	exportedSignatureSuffix   = ".sig"
+	exportedPublicKeySuffix   = ".pub"
+	exportedCertificateSuffix = ".crt"
+	exportedChainSuffix       = ".chain.crt"
+	exportedBundleSuffix      = ".bundle.json"
+)
+
+func exportedSignatureBasePath(dir, digest string) string {
+	return filepath.Join(dir, strings.ReplaceAll(digest, ":", "-"))
+}
+
+// writeExportedSignature writes the payload, signature and signer details to dir, so they can be pushed later on using readExportedSignature
+func writeExportedSignature(dir, digest string, payload []byte, signatureB64 string, opts *client.ImageSignOptions) error {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+
+	base := exportedSignatureBasePath(dir, digest)
+	files := map[string][]byte{
+		base + exportedPayloadSuffix:     payload,
+		base + exportedSignatureSuffix:   []byte(signatureB64),
+		base + exportedPublicKeySuffix:   []byte(opts.PublicKey),
+		base + exportedCertificateSuffix: opts.Certificate,
+		base + exportedChainSuffix:       opts.CertificateChain,
+		base + exportedBundleSuffix:      opts.RekorBundle,
+	}
+
+	for _, file := range typed.SortedKeys(files) {
+		if len(files[file]) == 0 {
+			// Remove what a previous export of the digest left behind, so that it isn't pushed with this signature
+			if err := os.Remove(file); err != nil && !os.IsNotExist(err) {
+				return err
+			}
+			continue
+		}
+		if err := os.WriteFile(file, files[file], 0644); err != nil {
+			return err
+		}
+		logrus.Debugf("Wrote %s", file)
+	}
+
+	return nil
+}
+
+// readExportedSignature reads a signature written by writeExportedSignature from dir and populates opts with the signer details
+func readExportedSignature(dir, digest string, opts *client.ImageSignOptions) ([]byte, string, error) {
+	base := exportedSignatureBasePath(dir, digest)
+
+	payload, err := os.ReadFile(base + exportedPayloadSuffix)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to read exported payload for digest %s: %w", digest, err)
+	}
+
+	signatureB64, err := os.ReadFile(base + exportedSignatureSuffix)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to read exported signature for digest %s: %w", digest, err)
+	}
+
+	optional := map[string]*[]byte{
+		base + exportedCertificateSuffix: &opts.Certificate,
+		base + exportedChainSuffix:       &opts.CertificateChain,
+		base + exportedBundleSuffix:      &opts.RekorBundle,
+	}
+	for file, target := range optional {
+		data, err := os.ReadFile(file)
+		if err != nil && !os.IsNotExist(err) {
+			return nil, "", err
+		}
+		*target = data
+	}
+
+	publicKey, err := os.ReadFile(base + exportedPublicKeySuffix)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, "", err
+	}
+	opts.PublicKey = string(publicKey)
+
+	return payload, strings.TrimSpace(string(signatureB64)), nil
+}
+
+// provenanceAnnotations returns the signature annotations for the detected fields of the provenance.
+func provenanceAnnotations(p vcs.Provenance) map[string]string {
+	result := map[string]string{}
+	for k, v := range map[string]string{
+		acornsign.SignatureAnnotationGitCommit: p.Commit,
+		acornsign.SignatureAnnotationGitBranch: p.Branch,
+		acornsign.SignatureAnnotationBuildURL:  p.BuildURL,
+	} {
+		if v != "" {
+			result[k] = v
+		}
+	}
+	return result
+}
+
+// readAnnotationsFile reads a YAML or JSON map of annotations from file.
+// Nested maps are flattened by joining their keys with dots, lists are not supported.
+func readAnnotationsFile(file string) (map[string]string, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read annotations file: %w", err)
+	}
+
+	raw := map[string]any{}
+	if err := yaml.Unmarshal(data, &raw); err != nil {
+		return nil, fmt.Errorf("failed to parse annotations file %s: %w", file, err)
+	}
 
+	result := map[string]string{}
+	if err := flattenAnnotations("", raw, result); err != nil {
+		return nil, fmt.Errorf("invalid annotations file %s: %w", file, err)
+	}
+	return result, nil
+}
+
+func flattenAnnotations(prefix string, in map[string]any, out map[string]string) error {
+	for k, v := range in {
+		key := k
+		if prefix != "" {
+			key = prefix + "." + k
+		}
+		switch val := v.(type) {
+		case map[string]any:
+			if err := flattenAnnotations(key, val, out); err != nil {
+				return err
+			}
+		case []any:
+			return fmt.Errorf("annotation %s: lists are not supported", key)
+		case nil:
+			out[key] = ""
+		case float64:
+			// YAML and JSON numbers are decoded as float64, format them without an exponent so 1234567 stays 1234567
+			out[key] = strconv.FormatFloat(val, 'f', -1, 64)
+		default:
+			out[key] = fmt.Sprint(val)
+		}
+	}
 	return nil
 }
 
-// Get password for private key from environment, prompt or stdin (piped)
+// Get password for private key from file, environment, prompt or stdin (piped)
 // Adapted from Cosign's readPasswordFn
-func getPrivateKeyPass() ([]byte, error) {
+func getPrivateKeyPass(passwordFile string) ([]byte, error) {
+	if passwordFile != "" {
+		pw, err := os.ReadFile(passwordFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read password file: %w", err)
+		}
+		// Only trim the trailing newline that editors and secret mounts tend to add
+		return bytes.TrimRight(pw, "\r\n"), nil
+	}
+
 	pw, ok := os.LookupEnv("ACORN_IMAGE_SIGN_PASSWORD")
 	switch {
 	case ok:
@@ -0,0 +1,817 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	internalv1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/cli/testdata"
+	"github.com/acorn-io/runtime/pkg/client"
+	acornsign "github.com/acorn-io/runtime/pkg/cosign"
+	"github.com/acorn-io/runtime/pkg/mocks"
+	"github.com/acorn-io/runtime/pkg/vcs"
+	"github.com/golang/mock/gomock"
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/pterm/pterm"
+	sigsig "github.com/sigstore/sigstore/pkg/signature"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetPrivateKeyPassFromFile(t *testing.T) {
+	t.Setenv("ACORN_IMAGE_SIGN_PASSWORD", "from-env")
+
+	dir := t.TempDir()
+
+	passwordFile := filepath.Join(dir, "password")
+	require.NoError(t, os.WriteFile(passwordFile, []byte("from-file\n"), 0600))
+
+	emptyFile := filepath.Join(dir, "empty")
+	require.NoError(t, os.WriteFile(emptyFile, nil, 0600))
+
+	tests := []struct {
+		name         string
+		passwordFile string
+		want         []byte
+		wantErr      bool
+	}{
+		{
+			name:         "file takes precedence over env",
+			passwordFile: passwordFile,
+			want:         []byte("from-file"),
+		},
+		{
+			name:         "empty file",
+			passwordFile: emptyFile,
+			want:         []byte{},
+		},
+		{
+			name:         "missing file",
+			passwordFile: filepath.Join(dir, "missing"),
+			wantErr:      true,
+		},
+		{
+			name: "env without file",
+			want: []byte("from-env"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := getPrivateKeyPass(tt.passwordFile)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestReadAnnotationsFile(t *testing.T) {
+	dir := t.TempDir()
+
+	tests := []struct {
+		name    string
+		content string
+		want    map[string]string
+		wantErr bool
+	}{
+		{
+			name:    "flat yaml",
+			content: "team: platform\ncompliant: true\nrevision: 3\n",
+			want:    map[string]string{"team": "platform", "compliant": "true", "revision": "3"},
+		},
+		{
+			name:    "nested json",
+			content: `{"audit": {"by": "security", "ticket": {"id": "SEC-1"}}, "team": "platform"}`,
+			want:    map[string]string{"audit.by": "security", "audit.ticket.id": "SEC-1", "team": "platform"},
+		},
+		{
+			name:    "large integers and decimals",
+			content: "build: 1234567\nratio: 0.75\nbig: 12345678901234\n",
+			want:    map[string]string{"build": "1234567", "ratio": "0.75", "big": "12345678901234"},
+		},
+		{
+			name:    "lists are rejected",
+			content: "owners:\n- alice\n- bob\n",
+			wantErr: true,
+		},
+		{
+			name:    "not a map",
+			content: "- foo\n",
+			wantErr: true,
+		},
+	}
+	for i, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			file := filepath.Join(dir, fmt.Sprintf("annotations-%d", i))
+			require.NoError(t, os.WriteFile(file, []byte(tt.content), 0600))
+
+			got, err := readAnnotationsFile(file)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestExportedSignatureRoundTrip(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "signatures")
+	digest := "sha256:0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"
+
+	written := &client.ImageSignOptions{
+		PublicKey: "-----BEGIN PUBLIC KEY-----\nfoo\n-----END PUBLIC KEY-----\n",
+	}
+	require.NoError(t, writeExportedSignature(dir, digest, []byte(`{"critical":{}}`), "c2lnbmF0dXJl", written))
+
+	_, err := os.Stat(filepath.Join(dir, "sha256-0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef.payload"))
+	require.NoError(t, err)
+
+	read := &client.ImageSignOptions{}
+	payload, signatureB64, err := readExportedSignature(dir, digest, read)
+	require.NoError(t, err)
+	assert.Equal(t, []byte(`{"critical":{}}`), payload)
+	assert.Equal(t, "c2lnbmF0dXJl", signatureB64)
+	assert.Equal(t, written.PublicKey, read.PublicKey)
+	assert.Empty(t, read.Certificate)
+
+	_, _, err = readExportedSignature(dir, "sha256:fedcba9876543210fedcba9876543210fedcba9876543210fedcba9876543210", read)
+	assert.Error(t, err)
+
+	// A keyless export followed by a key-based one into the same directory must not leave the certificate behind
+	keyless := &client.ImageSignOptions{
+		PublicKey:        written.PublicKey,
+		Certificate:      []byte("-----BEGIN CERTIFICATE-----\nfoo\n-----END CERTIFICATE-----\n"),
+		CertificateChain: []byte("-----BEGIN CERTIFICATE-----\nbar\n-----END CERTIFICATE-----\n"),
+		RekorBundle:      []byte(`{"SignedEntryTimestamp":"c2V0"}`),
+	}
+	require.NoError(t, writeExportedSignature(dir, digest, []byte(`{"critical":{}}`), "a2V5bGVzcw==", keyless))
+	require.NoError(t, writeExportedSignature(dir, digest, []byte(`{"critical":{}}`), "c2lnbmF0dXJl", written))
+
+	read = &client.ImageSignOptions{}
+	_, signatureB64, err = readExportedSignature(dir, digest, read)
+	require.NoError(t, err)
+	assert.Equal(t, "c2lnbmF0dXJl", signatureB64)
+	assert.Equal(t, written.PublicKey, read.PublicKey)
+	assert.Empty(t, read.Certificate)
+	assert.Empty(t, read.CertificateChain)
+	assert.Empty(t, read.RekorBundle)
+}
+
+func TestKeyFromSecret(t *testing.T) {
+	single := &testdata.MockClient{SecretItem: &apiv1.Secret{
+		Data: map[string][]byte{"cosign.key": []byte("private-key")},
+	}}
+	multi := &testdata.MockClient{SecretItem: &apiv1.Secret{
+		Data: map[string][]byte{"cosign.key": []byte("private-key"), "cosign.pub": []byte("public-key"), "empty": nil},
+	}}
+
+	tests := []struct {
+		name      string
+		client    client.Client
+		secretRef string
+		want      []byte
+		wantErr   string
+	}{
+		{
+			name:      "explicit key",
+			client:    multi,
+			secretRef: "signing-key.cosign.key",
+			want:      []byte("private-key"),
+		},
+		{
+			name:      "single key can be omitted",
+			client:    single,
+			secretRef: "signing-key",
+			want:      []byte("private-key"),
+		},
+		{
+			name:      "multiple keys require a key",
+			client:    multi,
+			secretRef: "signing-key",
+			wantErr:   "secret signing-key has 3 keys",
+		},
+		{
+			name:      "missing key",
+			client:    multi,
+			secretRef: "signing-key.dne",
+			wantErr:   "secret signing-key has no key dne",
+		},
+		{
+			name:      "empty key",
+			client:    multi,
+			secretRef: "signing-key.empty",
+			wantErr:   "key empty of secret signing-key is empty",
+		},
+		{
+			name:      "missing secret",
+			client:    &testdata.MockClient{},
+			secretRef: "dne",
+			wantErr:   "failed to get secret dne",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := keyFromSecret(context.Background(), tt.client, tt.secretRef)
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestImageSignPrintResult(t *testing.T) {
+	buf := &bytes.Buffer{}
+	cmd := &cobra.Command{}
+	cmd.SetOut(buf)
+
+	targetDigest, err := name.NewDigest("ghcr.io/acorn-io/test@sha256:0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef")
+	require.NoError(t, err)
+
+	a := &ImageSign{Output: "json"}
+	pld := []byte(`{"critical":{"identity":{"docker-reference":"ghcr.io/acorn-io/test"}},"optional":{"env":"prod"}}`)
+	require.NoError(t, a.printResult(cmd, "ghcr.io/acorn-io/test:v1", targetDigest, pld, &client.ImageSignOptions{PublicKey: "pem"}, &apiv1.ImageSignature{SignatureDigest: "sha256:fedcba", AttestationDigest: "sha256:abcdef"}))
+
+	result := imageSignResult{}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &result))
+	assert.Equal(t, imageSignResult{
+		Image:             "ghcr.io/acorn-io/test:v1",
+		Digest:            "sha256:0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef",
+		SignatureDigest:   "sha256:fedcba",
+		AttestationDigest: "sha256:abcdef",
+		PublicKey:         "pem",
+		Annotations:       map[string]any{"env": "prod"},
+	}, result)
+
+	// an identical signature existed already, so nothing was pushed
+	buf.Reset()
+	require.NoError(t, a.printResult(cmd, "ghcr.io/acorn-io/test:v1", targetDigest, pld, &client.ImageSignOptions{}, &apiv1.ImageSignature{SignatureDigest: "sha256:fedcba", Duplicate: true}))
+	result = imageSignResult{}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &result))
+	assert.True(t, result.Unchanged)
+
+	// with --force the identical signature was replaced
+	buf.Reset()
+	a.Force = true
+	require.NoError(t, a.printResult(cmd, "ghcr.io/acorn-io/test:v1", targetDigest, pld, &client.ImageSignOptions{}, &apiv1.ImageSignature{SignatureDigest: "sha256:fedcba", Duplicate: true}))
+	result = imageSignResult{}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &result))
+	assert.False(t, result.Unchanged)
+
+	// nothing is printed without -o json
+	buf.Reset()
+	a.Output = ""
+	require.NoError(t, a.printResult(cmd, "ghcr.io/acorn-io/test:v1", targetDigest, pld, &client.ImageSignOptions{}, &apiv1.ImageSignature{SignatureDigest: "sha256:fedcba"}))
+	assert.Empty(t, buf.String())
+}
+
+func TestImageSignAttachSbomValidation(t *testing.T) {
+	sbom := filepath.Join(t.TempDir(), "sbom.spdx.json")
+	require.NoError(t, os.WriteFile(sbom, []byte(`{"spdxVersion":"SPDX-2.3"}`), 0600))
+
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{
+			name:    "--sbom-type without --attach-sbom",
+			args:    []string{"sign", "ghcr.io/acorn-io/test:v1", "--key", "./key", "--sbom-type", "cyclonedx"},
+			wantErr: "--sbom-type requires --attach-sbom",
+		},
+		{
+			name:    "--attach-sbom with --keyless",
+			args:    []string{"sign", "ghcr.io/acorn-io/test:v1", "--keyless", "--attach-sbom", sbom},
+			wantErr: "--attach-sbom cannot be combined with --keyless, --tlog-upload, --all-tags, --from-dir, --output-dir or --oci-layout",
+		},
+		{
+			name:    "--attach-sbom with --all-tags",
+			args:    []string{"sign", "ghcr.io/acorn-io/test", "--key", "./key", "--all-tags", "--attach-sbom", sbom},
+			wantErr: "--attach-sbom cannot be combined with --keyless, --tlog-upload, --all-tags, --from-dir, --output-dir or --oci-layout",
+		},
+		{
+			name:    "invalid --sbom-type",
+			args:    []string{"sign", "ghcr.io/acorn-io/test:v1", "--key", "./key", "--attach-sbom", sbom, "--sbom-type", "syft"},
+			wantErr: "invalid SBOM type syft, must be one of spdx or cyclonedx",
+		},
+		{
+			name:    "--attach-sbom file does not exist",
+			args:    []string{"sign", "ghcr.io/acorn-io/test:v1", "--key", "./key", "--attach-sbom", filepath.Join(t.TempDir(), "dne.json")},
+			wantErr: "failed to read SBOM",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := NewImage(CommandContext{
+				ClientFactory: &testdata.MockClientFactory{},
+				StdOut:        io.Discard,
+				StdErr:        io.Discard,
+				StdIn:         strings.NewReader(""),
+			})
+			cmd.SetArgs(tt.args)
+			assert.ErrorContains(t, cmd.Execute(), tt.wantErr)
+		})
+	}
+}
+
+func TestImageSignSBOM(t *testing.T) {
+	priv, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	sv, err := sigsig.LoadECDSASignerVerifier(priv, crypto.SHA256)
+	require.NoError(t, err)
+
+	targetDigest, err := name.NewDigest("ghcr.io/acorn-io/test@sha256:0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name              string
+		sbomType          string
+		sbom              string
+		wantPredicateType string
+	}{
+		{
+			name:              "spdx json",
+			sbomType:          "spdx",
+			sbom:              `{"spdxVersion":"SPDX-2.3"}`,
+			wantPredicateType: "https://spdx.dev/Document",
+		},
+		{
+			name:              "spdx tag-value",
+			sbomType:          "spdx",
+			sbom:              "SPDXVersion: SPDX-2.3\n",
+			wantPredicateType: "https://spdx.dev/Document",
+		},
+		{
+			name:              "cyclonedx",
+			sbomType:          "cyclonedx",
+			sbom:              `{"bomFormat":"CycloneDX"}`,
+			wantPredicateType: "https://cyclonedx.org/bom",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := &cobra.Command{}
+			cmd.SetContext(context.Background())
+
+			a := &ImageSign{Output: "json", SbomType: tt.sbomType, sbom: []byte(tt.sbom), sigSigner: sv}
+			envelope, err := a.signSBOM(cmd, targetDigest)
+			require.NoError(t, err)
+
+			predicateType, err := acornsign.AttestationPredicateType(envelope)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantPredicateType, predicateType)
+		})
+	}
+}
+
+func TestImageSignParallelism(t *testing.T) {
+	a := &ImageSign{Parallel: 8}
+	assert.Equal(t, 8, a.parallelism())
+
+	a.Parallel = 0
+	assert.GreaterOrEqual(t, a.parallelism(), 1)
+	assert.LessOrEqual(t, a.parallelism(), 4)
+}
+
+func TestImageSignPrintResultToWorkerOutput(t *testing.T) {
+	stdout, workerOut := &bytes.Buffer{}, &bytes.Buffer{}
+	cmd := &cobra.Command{}
+	cmd.SetOut(stdout)
+
+	targetDigest, err := name.NewDigest("ghcr.io/acorn-io/test@sha256:0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef")
+	require.NoError(t, err)
+
+	// output of parallel workers is buffered, so that it can be printed in order of the tags
+	a := &ImageSign{Output: "json", out: workerOut}
+	require.NoError(t, a.printResult(cmd, "ghcr.io/acorn-io/test:v1", targetDigest, []byte(`{}`), &client.ImageSignOptions{}, &apiv1.ImageSignature{SignatureDigest: "sha256:fedcba"}))
+	assert.Empty(t, stdout.String())
+	assert.Contains(t, workerOut.String(), `"signatureDigest": "sha256:fedcba"`)
+}
+
+func TestCountForeignSignatures(t *testing.T) {
+	sigs := []apiv1.ImageSignatureEntry{
+		{Digest: "sha256:1", Fingerprint: "mine"},
+		{Digest: "sha256:2", Fingerprint: "theirs"},
+		// signatures from unknown keys can't be identified, so they have no fingerprint
+		{Digest: "sha256:3"},
+	}
+
+	assert.Equal(t, 2, countForeignSignatures(sigs, "mine"))
+	assert.Equal(t, 3, countForeignSignatures(sigs, "other"))
+	assert.Equal(t, 0, countForeignSignatures(nil, "mine"))
+}
+
+func TestProvenanceAnnotations(t *testing.T) {
+	assert.Equal(t, map[string]string{
+		"acorn.io/git-commit": "1234",
+		"acorn.io/build-url":  "https://ci.example.com/builds/1",
+	}, provenanceAnnotations(vcs.Provenance{Commit: "1234", BuildURL: "https://ci.example.com/builds/1"}))
+	assert.Empty(t, provenanceAnnotations(vcs.Provenance{}))
+}
+
+func TestImageSignDryRun(t *testing.T) {
+	pterm.DisableStyling()
+	defer pterm.EnableStyling()
+	// The test key isn't encrypted
+	t.Setenv("ACORN_IMAGE_SIGN_PASSWORD", "")
+
+	imageDigest := "sha256:0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"
+	existingDigest := "sha256:fedcba9876543210fedcba9876543210fedcba9876543210fedcba9876543210"
+	key := "../cosign/testdata/keys/openssh-rsa-nopw.key"
+
+	// existing returns the signatures of the image, with one identical to the new signature if identical is set
+	existing := func(identical bool) func(context.Context, string, *client.ImageSignaturesOptions) ([]apiv1.ImageSignatureEntry, error) {
+		return func(_ context.Context, _ string, opts *client.ImageSignaturesOptions) ([]apiv1.ImageSignatureEntry, error) {
+			pubKey, err := acornsign.UnmarshalPEMToPublicKey([]byte(opts.PublicKey))
+			require.NoError(t, err)
+			_, fingerprint, err := acornsign.PemEncodeCryptoPublicKey(pubKey)
+			require.NoError(t, err)
+
+			sigs := []apiv1.ImageSignatureEntry{
+				{Digest: "sha256:1111", Fingerprint: fingerprint, Annotations: map[string]string{"acorn.io/signed-name": "ghcr.io/acorn-io/test:v1", "env": "prod"}},
+				{Digest: "sha256:2222", Annotations: map[string]string{"acorn.io/signed-name": "ghcr.io/acorn-io/test:v1"}},
+			}
+			if identical {
+				sigs = append(sigs, apiv1.ImageSignatureEntry{Digest: existingDigest, Fingerprint: fingerprint, Annotations: map[string]string{"acorn.io/signed-name": "ghcr.io/acorn-io/test:v1"}})
+			}
+			return sigs, nil
+		}
+	}
+
+	tests := []struct {
+		name      string
+		args      []string
+		identical bool
+		wantOut   []string
+	}{
+		{
+			name:    "new signature",
+			args:    []string{"sign", "ghcr.io/acorn-io/test:v1", "--local", "--dry-run", "--key", key},
+			wantOut: []string{"Dry run: would have created signature sha256:"},
+		},
+		{
+			name:      "identical signature exists",
+			args:      []string{"sign", "ghcr.io/acorn-io/test:v1", "--local", "--dry-run", "--key", key},
+			identical: true,
+			wantOut:   []string{"Dry run: already signed (unchanged), identical signature exists in " + existingDigest},
+		},
+		{
+			name:      "identical signature is replaced with --force",
+			args:      []string{"sign", "ghcr.io/acorn-io/test:v1", "--local", "--dry-run", "--force", "--key", key},
+			identical: true,
+			wantOut:   []string{"Dry run: would have replaced identical signature in " + existingDigest},
+		},
+		{
+			name:      "json output",
+			args:      []string{"sign", "ghcr.io/acorn-io/test:v1", "--local", "--dry-run", "-o", "json", "--key", key},
+			identical: true,
+			wantOut:   []string{`"signatureDigest": "` + existingDigest + `"`, `"unchanged": true`},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mClient := mocks.NewMockClient(ctrl)
+			mClient.EXPECT().ImageDetails(gomock.Any(), "ghcr.io/acorn-io/test:v1", gomock.Any()).Return(&client.ImageDetails{
+				AppImage: internalv1.AppImage{ID: "ghcr.io/acorn-io/test:v1", Digest: imageDigest},
+			}, nil)
+			// Existing signatures are only read, ImageSign must never be called, so that nothing is written even by
+			// servers that don't know about dry runs
+			mClient.EXPECT().ImageSignatures(gomock.Any(), "ghcr.io/acorn-io/test:v1", gomock.Any()).DoAndReturn(existing(tt.identical))
+
+			r, w, _ := os.Pipe()
+			os.Stdout = w
+			pterm.SetDefaultOutput(w)
+			defer pterm.SetDefaultOutput(os.Stderr)
+			cmd := NewImage(CommandContext{
+				ClientFactory: &testdata.MockClientFactoryManual{
+					MockAcornConfigFile: "/fake-file",
+					Client:              mClient,
+				},
+				StdOut: w,
+				StdErr: w,
+				StdIn:  strings.NewReader(""),
+			})
+			cmd.SetOut(w)
+			cmd.SetArgs(tt.args)
+			require.NoError(t, cmd.Execute())
+
+			require.NoError(t, w.Close())
+			out, _ := io.ReadAll(r)
+			for _, want := range tt.wantOut {
+				assert.Contains(t, string(out), want)
+			}
+		})
+	}
+}
+
+func TestSameAnnotations(t *testing.T) {
+	assert.True(t, sameAnnotations(map[string]string{"env": "prod", "revision": "3"}, map[string]any{"env": "prod", "revision": float64(3)}))
+	assert.True(t, sameAnnotations(nil, map[string]any{"unset": nil}))
+	assert.False(t, sameAnnotations(map[string]string{"env": "prod"}, map[string]any{"env": "dev"}))
+	assert.False(t, sameAnnotations(map[string]string{"env": "prod", "team": "platform"}, map[string]any{"env": "prod"}))
+	assert.False(t, sameAnnotations(map[string]string{"env": "prod"}, map[string]any{"env": "prod", "team": "platform"}))
+}
+
+func TestImageSignAllTags(t *testing.T) {
+	pterm.DisableStyling()
+	defer pterm.EnableStyling()
+	// The test key isn't encrypted
+	t.Setenv("ACORN_IMAGE_SIGN_PASSWORD", "")
+
+	// A local image ID, so that no registry credentials are looked up
+	imageID := "0123456789ab"
+	imageDigest := "sha256:0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"
+	key := "../cosign/testdata/keys/openssh-rsa-nopw.key"
+
+	tests := []struct {
+		name       string
+		args       []string
+		tags       []string
+		confirm    bool
+		failPush   string
+		wantSigned []string
+		wantOut    []string
+		wantErr    string
+	}{
+		{
+			name:       "every tag in order",
+			args:       []string{"sign", imageID, "--all-tags", "--yes", "--key", key},
+			tags:       []string{"ghcr.io/acorn-io/test:v1", "docker.io/acorn/test:latest"},
+			wantSigned: []string{"docker.io/acorn/test:latest", "ghcr.io/acorn-io/test:v1"},
+			wantOut: []string{
+				"Created signature sha256:signature-of-docker.io/acorn/test:latest for tag docker.io/acorn/test:latest",
+				"Created signature sha256:signature-of-ghcr.io/acorn-io/test:v1 for tag ghcr.io/acorn-io/test:v1",
+			},
+		},
+		{
+			name:       "existing signatures of every tag are checked before signing",
+			args:       []string{"sign", imageID, "--all-tags", "--key", key},
+			tags:       []string{"ghcr.io/acorn-io/test:v1", "docker.io/acorn/test:latest"},
+			confirm:    true,
+			wantSigned: []string{"docker.io/acorn/test:latest", "ghcr.io/acorn-io/test:v1"},
+		},
+		{
+			name:       "failing tag doesn't stop the others",
+			args:       []string{"sign", imageID, "--all-tags", "--yes", "--parallel", "1", "--key", key},
+			tags:       []string{"ghcr.io/acorn-io/test:v1", "docker.io/acorn/test:latest"},
+			failPush:   "docker.io/acorn/test:latest",
+			wantSigned: []string{"docker.io/acorn/test:latest", "ghcr.io/acorn-io/test:v1"},
+			wantErr:    "failed to push signature for tag docker.io/acorn/test:latest: registry unavailable",
+		},
+		{
+			name:    "image without tags",
+			args:    []string{"sign", imageID, "--all-tags", "--yes", "--key", key},
+			wantErr: "image " + imageID + " has no tags",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mClient := mocks.NewMockClient(ctrl)
+			mClient.EXPECT().ImageDetails(gomock.Any(), imageID, gomock.Any()).Return(&client.ImageDetails{
+				AppImage: internalv1.AppImage{ID: imageID, Digest: imageDigest},
+			}, nil)
+			mClient.EXPECT().ImageGet(gomock.Any(), imageID).Return(&apiv1.Image{Tags: tt.tags}, nil)
+			if tt.confirm {
+				for _, tag := range tt.wantSigned {
+					mClient.EXPECT().ImageSignatures(gomock.Any(), tag, gomock.Any()).Return(nil, nil)
+				}
+			}
+			for _, tag := range tt.wantSigned {
+				tag := tag
+				mClient.EXPECT().ImageSign(gomock.Any(), tag, gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ string, payload []byte, _ string, _ *client.ImageSignOptions) (*apiv1.ImageSignature, error) {
+						// Each signature is for the repository of its tag, so that it's found under every name of the image
+						ref, err := name.ParseReference(tag)
+						require.NoError(t, err)
+						assert.Contains(t, string(payload), `"docker-reference":"`+ref.Context().Name()+`"`)
+						assert.Contains(t, string(payload), imageDigest)
+						if tag == tt.failPush {
+							return nil, fmt.Errorf("registry unavailable")
+						}
+						return &apiv1.ImageSignature{SignatureDigest: "sha256:signature-of-" + tag}, nil
+					})
+			}
+
+			r, w, _ := os.Pipe()
+			os.Stdout = w
+			pterm.SetDefaultOutput(w)
+			defer pterm.SetDefaultOutput(os.Stderr)
+			cmd := NewImage(CommandContext{
+				ClientFactory: &testdata.MockClientFactoryManual{
+					MockAcornConfigFile: "/fake-file",
+					Client:              mClient,
+				},
+				StdOut: w,
+				StdErr: w,
+				StdIn:  strings.NewReader(""),
+			})
+			cmd.SetOut(w)
+			cmd.SetArgs(tt.args)
+			err := cmd.Execute()
+			require.NoError(t, w.Close())
+			out, _ := io.ReadAll(r)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+			}
+
+			for _, want := range tt.wantOut {
+				assert.Contains(t, string(out), want)
+			}
+			if len(tt.wantOut) > 1 {
+				assert.Less(t, strings.Index(string(out), tt.wantOut[0]), strings.Index(string(out), tt.wantOut[1]), "tags must be reported in order")
+			}
+		})
+	}
+}
+
+func TestImageSignAllTagsValidation(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{
+			name:    "--all-tags with --output-dir",
+			args:    []string{"sign", "ghcr.io/acorn-io/test", "--key", "./key", "--all-tags", "--output-dir", t.TempDir()},
+			wantErr: "--all-tags cannot be combined with --from-dir or --output-dir",
+		},
+		{
+			name:    "--all-tags with --from-dir",
+			args:    []string{"sign", "ghcr.io/acorn-io/test", "--all-tags", "--from-dir", t.TempDir()},
+			wantErr: "--all-tags cannot be combined with --from-dir or --output-dir",
+		},
+		{
+			name:    "--parallel without --all-tags",
+			args:    []string{"sign", "ghcr.io/acorn-io/test", "--key", "./key", "--parallel", "2"},
+			wantErr: "--parallel can only be used with --all-tags",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := NewImage(CommandContext{
+				ClientFactory: &testdata.MockClientFactory{},
+				StdOut:        io.Discard,
+				StdErr:        io.Discard,
+				StdIn:         strings.NewReader(""),
+			})
+			cmd.SetArgs(tt.args)
+			assert.EqualError(t, cmd.Execute(), tt.wantErr)
+		})
+	}
+}
+
+func TestImageSignTlogUpload(t *testing.T) {
+	pterm.DisableStyling()
+	defer pterm.EnableStyling()
+	// The test key isn't encrypted
+	t.Setenv("ACORN_IMAGE_SIGN_PASSWORD", "")
+
+	imageDigest := "sha256:0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"
+	key := "../cosign/testdata/keys/openssh-rsa-nopw.key"
+
+	var uploads int
+	newRekor := func(reject bool) *httptest.Server {
+		return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodPost || r.URL.Path != "/api/v1/log/entries" {
+				http.NotFound(w, r)
+				return
+			}
+			uploads++
+			body, err := io.ReadAll(r.Body)
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			w.Header().Set("Content-Type", "application/json")
+			if reject {
+				w.WriteHeader(http.StatusBadRequest)
+				_, _ = w.Write([]byte(`{"code":400,"message":"invalid entry"}`))
+				return
+			}
+			w.WriteHeader(http.StatusCreated)
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"test-uuid": map[string]any{
+					"body":           base64.StdEncoding.EncodeToString(bytes.TrimSpace(body)),
+					"integratedTime": 1700000000,
+					"logID":          "test-log",
+					"logIndex":       42,
+					"verification": map[string]any{
+						"signedEntryTimestamp": base64.StdEncoding.EncodeToString([]byte("set")),
+					},
+				},
+			})
+		}))
+	}
+	rekor, rejectingRekor := newRekor(false), newRekor(true)
+	defer rekor.Close()
+	defer rejectingRekor.Close()
+
+	tests := []struct {
+		name       string
+		args       []string
+		wantUpload bool
+		wantOut    string
+		wantErr    string
+	}{
+		{
+			name:       "recorded with --tlog-upload",
+			args:       []string{"sign", "ghcr.io/acorn-io/test:v1", "--local", "--yes", "--key", key, "--tlog-upload", "--rekor-url", rekor.URL},
+			wantUpload: true,
+			wantOut:    "Recorded signature in transparency log (entry: ",
+		},
+		{
+			name: "not recorded by default",
+			args: []string{"sign", "ghcr.io/acorn-io/test:v1", "--local", "--yes", "--key", key, "--rekor-url", rekor.URL},
+		},
+		{
+			name:       "rejected by the transparency log",
+			args:       []string{"sign", "ghcr.io/acorn-io/test:v1", "--local", "--yes", "--key", key, "--tlog-upload", "--rekor-url", rejectingRekor.URL},
+			wantUpload: true,
+			wantErr:    "failed to upload signature to transparency log",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uploads = 0
+			ctrl := gomock.NewController(t)
+			mClient := mocks.NewMockClient(ctrl)
+			mClient.EXPECT().ImageDetails(gomock.Any(), "ghcr.io/acorn-io/test:v1", gomock.Any()).Return(&client.ImageDetails{
+				AppImage: internalv1.AppImage{ID: "ghcr.io/acorn-io/test:v1", Digest: imageDigest},
+			}, nil)
+			if tt.wantErr == "" {
+				mClient.EXPECT().ImageSign(gomock.Any(), "ghcr.io/acorn-io/test:v1", gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ string, _ []byte, _ string, opts *client.ImageSignOptions) (*apiv1.ImageSignature, error) {
+						// The bundle is attached to the signature, so that it can be verified offline
+						if tt.wantUpload {
+							assert.NotEmpty(t, opts.RekorBundle)
+							assert.NotEmpty(t, opts.TlogEntryUUID)
+							assert.Equal(t, int64(1700000000), opts.TlogIntegratedTime)
+						} else {
+							assert.Empty(t, opts.RekorBundle)
+							assert.Empty(t, opts.TlogEntryUUID)
+						}
+						return &apiv1.ImageSignature{SignatureDigest: "sha256:fedcba"}, nil
+					})
+			}
+
+			r, w, _ := os.Pipe()
+			os.Stdout = w
+			pterm.SetDefaultOutput(w)
+			defer pterm.SetDefaultOutput(os.Stderr)
+			cmd := NewImage(CommandContext{
+				ClientFactory: &testdata.MockClientFactoryManual{
+					MockAcornConfigFile: "/fake-file",
+					Client:              mClient,
+				},
+				StdOut: w,
+				StdErr: w,
+				StdIn:  strings.NewReader(""),
+			})
+			cmd.SetOut(w)
+			cmd.SetArgs(tt.args)
+			err := cmd.Execute()
+			require.NoError(t, w.Close())
+			out, _ := io.ReadAll(r)
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+				assert.Contains(t, string(out), tt.wantOut)
+			}
+
+			if tt.wantUpload {
+				assert.Equal(t, 1, uploads)
+			} else {
+				assert.Zero(t, uploads, "the signature must not be uploaded without --tlog-upload")
+			}
+		})
+	}
+}
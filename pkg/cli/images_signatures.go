@@ -0,0 +1,127 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	cli "github.com/acorn-io/runtime/pkg/cli/builder"
+	"github.com/acorn-io/runtime/pkg/cli/builder/table"
+	"github.com/acorn-io/runtime/pkg/client"
+	acornsign "github.com/acorn-io/runtime/pkg/cosign"
+	"github.com/acorn-io/runtime/pkg/tables"
+	"github.com/spf13/cobra"
+)
+
+const maxAnnotationsLength = 50
+
+func NewImageSignatures(c CommandContext) *cobra.Command {
+	cmd := cli.Command(&ImageSignatures{client: c.ClientFactory}, cobra.Command{
+		Use: "signatures IMAGE_NAME [flags]",
+		Example: `# List all signatures of an image
+acorn image signatures my-image
+
+# List all signatures of an image and identify those created by a given public key
+acorn image signatures my-image --key ./my-key.pub
+
+# Show the full signature details as JSON
+acorn image signatures my-image -o json
+`,
+		SilenceUsage:      true,
+		Short:             "List Image Signatures",
+		ValidArgsFunction: newCompletion(c.ClientFactory, imagesCompletion(true)).complete,
+		Args:              cobra.ExactArgs(1),
+		Hidden:            true,
+	})
+	_ = cmd.MarkFlagFilename("key")
+	return cmd
+}
+
+type ImageSignatures struct {
+	client  ClientFactory
+	Key     string `usage:"Public key used to identify the signer of key-based signatures" short:"k" local:"true"`
+	NoTrunc bool   `usage:"Don't truncate digests, fingerprints and annotations" local:"true"`
+	Output  string `usage:"Output format (json, yaml, {{gotemplate}})" short:"o" local:"true"`
+}
+
+func (a *ImageSignatures) Run(cmd *cobra.Command, args []string) error {
+	imageName := args[0]
+
+	c, err := a.client.CreateDefault()
+	if err != nil {
+		return err
+	}
+
+	auth, err := getAuthForImage(cmd.Context(), a.client, imageName)
+	if err != nil {
+		return err
+	}
+
+	publicKey, err := loadPublicKeyRef(a.Key)
+	if err != nil {
+		return err
+	}
+
+	sigs, err := c.ImageSignatures(cmd.Context(), imageName, &client.ImageSignaturesOptions{
+		PublicKey: publicKey,
+		Auth:      auth,
+	})
+	if err != nil {
+		return err
+	}
+
+	out := table.NewWriter(tables.ImageSignature, false, a.Output)
+	out.AddFormatFunc("trunc", func(str string) string {
+		if a.NoTrunc {
+			return str
+		}
+		return table.Trunc(str)
+	})
+	out.AddFormatFunc("annotations", func(annotations map[string]string) string {
+		keys := make([]string, 0, len(annotations))
+		for k := range annotations {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		pairs := make([]string, 0, len(keys))
+		for _, k := range keys {
+			pairs = append(pairs, k+"="+annotations[k])
+		}
+
+		s := strings.Join(pairs, ",")
+		if !a.NoTrunc && len(s) > maxAnnotationsLength {
+			s = s[:maxAnnotationsLength-3] + "..."
+		}
+		return s
+	})
+
+	for i := range sigs {
+		out.WriteFormatted(&sigs[i], nil)
+	}
+
+	return out.Err()
+}
+
+// loadPublicKeyRef returns the contents of the public key file if keyRef is a file, or keyRef itself if it is a remote reference
+func loadPublicKeyRef(keyRef string) (string, error) {
+	if keyRef == "" {
+		return "", nil
+	}
+
+	if _, err := os.Stat(keyRef); err != nil {
+		return keyRef, nil
+	}
+
+	keyFileBytes, err := os.ReadFile(keyRef)
+	if err != nil {
+		return "", err
+	}
+
+	if acornsign.PrivateKeyPattern.Match(keyFileBytes) {
+		return "", fmt.Errorf("key file %s is a private key, not a public key", keyRef)
+	}
+
+	return string(keyFileBytes), nil
+}
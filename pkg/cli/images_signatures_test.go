@@ -0,0 +1,113 @@
+package cli
+
+import (
+	"io"
+	"os"
+	"strings"
+	"testing"
+
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/cli/testdata"
+	"github.com/acorn-io/runtime/pkg/client"
+	"github.com/acorn-io/runtime/pkg/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestImageSignatures(t *testing.T) {
+	// A local image ID, so that no registry credentials are looked up
+	imageID := "0123456789ab"
+	publicKey, err := os.ReadFile("../cosign/testdata/keys/openssh-rsa-nopw.pub")
+	require.NoError(t, err)
+
+	sigs := []apiv1.ImageSignatureEntry{
+		{
+			Digest:      "sha256:0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef",
+			Fingerprint: "fedcba9876543210fedcba9876543210fedcba9876543210fedcba9876543210",
+			Annotations: map[string]string{"team": "platform", "approved-by": "security"},
+		},
+		{
+			Digest:      "sha256:fedcba9876543210fedcba9876543210fedcba9876543210fedcba9876543210",
+			Annotations: map[string]string{"description": strings.Repeat("x", 60)},
+		},
+	}
+
+	tests := []struct {
+		name          string
+		args          []string
+		wantPublicKey string
+		wantOut       string
+		wantErr       string
+	}{
+		{
+			name: "list signatures",
+			args: []string{"signatures", imageID},
+			wantOut: `DIGEST         FINGERPRINT    ANNOTATIONS
+0123456789ab   fedcba987654   approved-by=security,team=platform
+fedcba987654   <unknown>      description=xxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxx...
+`,
+		},
+		{
+			name: "list signatures without truncating",
+			args: []string{"signatures", imageID, "--no-trunc"},
+			wantOut: `DIGEST                                                                    FINGERPRINT                                                        ANNOTATIONS
+sha256:0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef   fedcba9876543210fedcba9876543210fedcba9876543210fedcba9876543210   approved-by=security,team=platform
+sha256:fedcba9876543210fedcba9876543210fedcba9876543210fedcba9876543210   <unknown>                                                          description=` + strings.Repeat("x", 60) + `
+`,
+		},
+		{
+			name:          "identify signers by a public key file",
+			args:          []string{"signatures", imageID, "--key", "../cosign/testdata/keys/openssh-rsa-nopw.pub", "-o", "{{.Digest}}"},
+			wantPublicKey: string(publicKey),
+			wantOut: `sha256:0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef
+sha256:fedcba9876543210fedcba9876543210fedcba9876543210fedcba9876543210
+`,
+		},
+		{
+			name:          "identify signers by a remote key",
+			args:          []string{"signatures", imageID, "--key", "gh://acorn-io", "-o", "{{.Digest}}"},
+			wantPublicKey: "gh://acorn-io",
+			wantOut: `sha256:0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef
+sha256:fedcba9876543210fedcba9876543210fedcba9876543210fedcba9876543210
+`,
+		},
+		{
+			name:    "private keys are rejected",
+			args:    []string{"signatures", imageID, "--key", "../cosign/testdata/keys/openssh-rsa-nopw.key"},
+			wantErr: "key file ../cosign/testdata/keys/openssh-rsa-nopw.key is a private key, not a public key",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mClient := mocks.NewMockClient(ctrl)
+			if tt.wantErr == "" {
+				mClient.EXPECT().ImageSignatures(gomock.Any(), imageID, &client.ImageSignaturesOptions{PublicKey: tt.wantPublicKey}).Return(sigs, nil)
+			}
+
+			r, w, _ := os.Pipe()
+			os.Stdout = w
+			cmd := NewImage(CommandContext{
+				ClientFactory: &testdata.MockClientFactoryManual{
+					MockAcornConfigFile: "/fake-file",
+					Client:              mClient,
+				},
+				StdOut: w,
+				StdErr: w,
+				StdIn:  strings.NewReader(""),
+			})
+			cmd.SetArgs(tt.args)
+			err := cmd.Execute()
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+
+			require.NoError(t, w.Close())
+			out, _ := io.ReadAll(r)
+			assert.Equal(t, tt.wantOut, string(out))
+		})
+	}
+}
@@ -426,6 +426,36 @@ func TestImage(t *testing.T) {
 			wantErr: false,
 			wantOut: "Untagged foo:v1\n",
 		},
+		{
+			name: "acorn image prune --force",
+			commandContext: CommandContext{
+				ClientFactory: &testdata.MockClientFactory{},
+				StdOut:        w,
+				StdErr:        w,
+				StdIn:         strings.NewReader(""),
+			},
+			args: args{
+				args:   []string{"prune", "--force"},
+				client: &testdata.MockClient{},
+			},
+			wantErr: false,
+			wantOut: "IMAGE-ID             TAGS      CREATED\nfound-image-no-tag   <none>    292y ago\nDeleted found-image-no-tag\n",
+		},
+		{
+			name: "acorn image prune --until invalid",
+			commandContext: CommandContext{
+				ClientFactory: &testdata.MockClientFactory{},
+				StdOut:        w,
+				StdErr:        w,
+				StdIn:         strings.NewReader(""),
+			},
+			args: args{
+				args:   []string{"prune", "--until", "yesterday", "--force"},
+				client: &testdata.MockClient{},
+			},
+			wantErr: true,
+			wantOut: "invalid --until yesterday: time: invalid duration \"yesterday\"",
+		},
 	}
 
 	for _, tt := range tests {
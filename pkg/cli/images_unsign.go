@@ -0,0 +1,136 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	cli "github.com/acorn-io/runtime/pkg/cli/builder"
+	"github.com/acorn-io/runtime/pkg/client"
+	acornsign "github.com/acorn-io/runtime/pkg/cosign"
+	"github.com/pterm/pterm"
+	"github.com/spf13/cobra"
+)
+
+func NewImageUnsign(c CommandContext) *cobra.Command {
+	cmd := cli.Command(&ImageUnsign{client: c.ClientFactory}, cobra.Command{
+		Use: "unsign IMAGE_NAME [flags]",
+		Example: `# Remove all signatures from an image
+acorn image unsign my-image --all
+
+# Remove all signatures created with a given key
+acorn image unsign my-image --key ./my-key.pub
+
+# Remove a single signature (see 'acorn image signatures')
+acorn image unsign my-image --signature-digest sha256:0123456789abcdef...
+`,
+		SilenceUsage:      true,
+		Short:             "Remove Image Signatures",
+		ValidArgsFunction: newCompletion(c.ClientFactory, imagesCompletion(true)).complete,
+		Args:              cobra.ExactArgs(1),
+		Hidden:            true,
+	})
+	_ = cmd.MarkFlagFilename("key")
+	return cmd
+}
+
+type ImageUnsign struct {
+	client          ClientFactory
+	All             bool   `usage:"Remove all signatures" local:"true"`
+	Key             string `usage:"Remove all signatures created with this public key" short:"k" local:"true"`
+	SignatureDigest string `usage:"Remove the signature with this digest" local:"true"`
+}
+
+func (a *ImageUnsign) Run(cmd *cobra.Command, args []string) error {
+	var selected int
+	for _, set := range []bool{a.All, a.Key != "", a.SignatureDigest != ""} {
+		if set {
+			selected++
+		}
+	}
+	if selected != 1 {
+		return fmt.Errorf("exactly one of --all, --key or --signature-digest is required")
+	}
+
+	imageName := args[0]
+
+	c, err := a.client.CreateDefault()
+	if err != nil {
+		return err
+	}
+
+	auth, err := getAuthForImage(cmd.Context(), a.client, imageName)
+	if err != nil {
+		return err
+	}
+
+	toRemove := []string{a.SignatureDigest}
+	if a.SignatureDigest == "" {
+		toRemove, err = a.selectSignatures(cmd, c, imageName, auth)
+		if err != nil {
+			return err
+		}
+		if len(toRemove) == 0 {
+			pterm.Info.Printf("No matching signatures found for image %s\n", imageName)
+			return nil
+		}
+	}
+
+	var errs []error
+	for _, sigDigest := range toRemove {
+		if err := c.ImageUnsign(cmd.Context(), imageName, sigDigest, &client.ImageUnsignOptions{Auth: auth}); err != nil {
+			errs = append(errs, fmt.Errorf("failed to remove signature %s: %w", sigDigest, err))
+			continue
+		}
+		pterm.Success.Printf("Removed signature %s\n", sigDigest)
+	}
+
+	return errors.Join(errs...)
+}
+
+// selectSignatures returns the digests of all signatures to remove, based on the --all and --key flags
+func (a *ImageUnsign) selectSignatures(cmd *cobra.Command, c client.Client, imageName string, auth *apiv1.RegistryAuth) ([]string, error) {
+	publicKey, err := loadPublicKeyRef(a.Key)
+	if err != nil {
+		return nil, err
+	}
+
+	sigs, err := c.ImageSignatures(cmd.Context(), imageName, &client.ImageSignaturesOptions{
+		PublicKey: publicKey,
+		Auth:      auth,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	fingerprints := map[string]struct{}{}
+	if publicKey != "" {
+		verifiers, err := acornsign.VerifiersFromPublicKeyRef(cmd.Context(), publicKey, "sha256")
+		if err != nil {
+			return nil, err
+		}
+		for _, v := range verifiers {
+			pubKey, err := v.PublicKey()
+			if err != nil {
+				return nil, err
+			}
+			_, fingerprint, err := acornsign.PemEncodeCryptoPublicKey(pubKey)
+			if err != nil {
+				return nil, err
+			}
+			fingerprints[fingerprint] = struct{}{}
+		}
+	}
+
+	var result []string
+	for _, sig := range sigs {
+		if !a.All {
+			if _, ok := fingerprints[sig.Fingerprint]; !ok {
+				continue
+			}
+		}
+		result = append(result, sig.Digest)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,153 @@
+package cli
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/cli/testdata"
+	"github.com/acorn-io/runtime/pkg/client"
+	"github.com/acorn-io/runtime/pkg/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/pterm/pterm"
+	"github.com/sigstore/sigstore/pkg/cryptoutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestImageUnsign(t *testing.T) {
+	pterm.DisableStyling()
+	defer pterm.EnableStyling()
+
+	// A local image ID, so that no registry credentials are looked up
+	imageID := "0123456789ab"
+
+	priv, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	publicKey, err := cryptoutils.MarshalPublicKeyToPEM(priv.Public())
+	require.NoError(t, err)
+	keyFile := filepath.Join(t.TempDir(), "cosign.pub")
+	require.NoError(t, os.WriteFile(keyFile, publicKey, 0600))
+	sum := sha256.Sum256(publicKey)
+	fingerprint := hex.EncodeToString(sum[:])
+
+	sigs := []apiv1.ImageSignatureEntry{
+		{Digest: "sha256:1111", Fingerprint: fingerprint},
+		{Digest: "sha256:2222"},
+		{Digest: "sha256:3333", Fingerprint: "fedcba9876543210"},
+		{Digest: "sha256:4444", Fingerprint: fingerprint},
+	}
+
+	tests := []struct {
+		name          string
+		args          []string
+		listed        bool
+		wantRemoved   []string
+		failRemoval   string
+		wantOut       string
+		wantErr       string
+		wantPublicKey string
+	}{
+		{
+			name:        "all signatures",
+			args:        []string{"unsign", imageID, "--all"},
+			listed:      true,
+			wantRemoved: []string{"sha256:1111", "sha256:2222", "sha256:3333", "sha256:4444"},
+		},
+		{
+			name:          "signatures of a key",
+			args:          []string{"unsign", imageID, "--key", keyFile},
+			listed:        true,
+			wantPublicKey: string(publicKey),
+			wantRemoved:   []string{"sha256:1111", "sha256:4444"},
+		},
+		{
+			name:          "no signatures of a key",
+			args:          []string{"unsign", imageID, "--key", "../cosign/testdata/keys/openssh-rsa-nopw.pub"},
+			listed:        true,
+			wantPublicKey: "ssh-rsa",
+			wantOut:       "No matching signatures found for image " + imageID,
+		},
+		{
+			name:        "single signature",
+			args:        []string{"unsign", imageID, "--signature-digest", "sha256:2222"},
+			wantRemoved: []string{"sha256:2222"},
+		},
+		{
+			name:        "removal of one signature fails",
+			args:        []string{"unsign", imageID, "--all"},
+			listed:      true,
+			wantRemoved: []string{"sha256:1111", "sha256:2222", "sha256:3333", "sha256:4444"},
+			failRemoval: "sha256:3333",
+			wantErr:     "failed to remove signature sha256:3333: registry unavailable",
+		},
+		{
+			name:    "no selection",
+			args:    []string{"unsign", imageID},
+			wantErr: "exactly one of --all, --key or --signature-digest is required",
+		},
+		{
+			name:    "conflicting selection",
+			args:    []string{"unsign", imageID, "--all", "--signature-digest", "sha256:2222"},
+			wantErr: "exactly one of --all, --key or --signature-digest is required",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mClient := mocks.NewMockClient(ctrl)
+			if tt.listed {
+				mClient.EXPECT().ImageSignatures(gomock.Any(), imageID, gomock.Any()).DoAndReturn(
+					func(_ context.Context, _ string, opts *client.ImageSignaturesOptions) ([]apiv1.ImageSignatureEntry, error) {
+						assert.True(t, strings.HasPrefix(opts.PublicKey, tt.wantPublicKey), "unexpected public key %q", opts.PublicKey)
+						return sigs, nil
+					})
+			}
+			for _, digest := range tt.wantRemoved {
+				var err error
+				if digest == tt.failRemoval {
+					err = fmt.Errorf("registry unavailable")
+				}
+				mClient.EXPECT().ImageUnsign(gomock.Any(), imageID, digest, gomock.Any()).Return(err)
+			}
+
+			r, w, _ := os.Pipe()
+			os.Stdout = w
+			pterm.SetDefaultOutput(w)
+			defer pterm.SetDefaultOutput(os.Stderr)
+			cmd := NewImage(CommandContext{
+				ClientFactory: &testdata.MockClientFactoryManual{
+					MockAcornConfigFile: "/fake-file",
+					Client:              mClient,
+				},
+				StdOut: w,
+				StdErr: w,
+				StdIn:  strings.NewReader(""),
+			})
+			cmd.SetArgs(tt.args)
+			err := cmd.Execute()
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+
+			require.NoError(t, w.Close())
+			out, _ := io.ReadAll(r)
+			for _, digest := range tt.wantRemoved {
+				assert.Contains(t, string(out), "Removed signature "+digest)
+			}
+			assert.Contains(t, string(out), tt.wantOut)
+		})
+	}
+}
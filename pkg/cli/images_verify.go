@@ -3,7 +3,9 @@ package cli
 import (
 	"fmt"
 	"os"
+	"sort"
 
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
 	cli "github.com/acorn-io/runtime/pkg/cli/builder"
 	"github.com/acorn-io/runtime/pkg/client"
 	acornsign "github.com/acorn-io/runtime/pkg/cosign"
@@ -24,6 +26,18 @@ acorn image verify my-image --key gh://ibuildthecloud
 
 # Verify using a public key belonging to an Acorn Manager Identity
 acorn image verify my-image --key acorn://ibuildthecloud
+
+# Only accept a signature that was recorded in the Rekor transparency log
+acorn image verify my-image --key ./my-key.pub --require-tlog
+
+# Verify that the signature was created for any 1.2.x release
+acorn image verify my-image --key ./my-key.pub -a release.version='1.2.*'
+
+# Verify a keyless signature by the identity it was issued to
+acorn image verify my-image --certificate-identity me@example.com --certificate-oidc-issuer https://github.com/login/oauth
+
+# Verify the SLSA provenance attestation of an image and write its predicate to a file
+acorn image verify my-image --key ./my-key.pub --type slsaprovenance --output-attestation provenance.json
 `,
 		SilenceUsage:      true,
 		Short:             "Verify Image Signatures",
@@ -36,15 +50,37 @@ acorn image verify my-image --key acorn://ibuildthecloud
 }
 
 type ImageVerify struct {
-	client       ClientFactory
-	Key          string            `usage:"Key to use for verifying" short:"k" local:"true"`
-	Annotations  map[string]string `usage:"Annotations to check for in the signature" short:"a" local:"true" name:"annotation"`
-	NoVerifyName bool              `usage:"Do not verify the image name in the signature" local:"true" default:"false"`
+	client                ClientFactory
+	Key                   string            `usage:"Key to use for verifying" short:"k" local:"true"`
+	CertificateIdentity   string            `usage:"Identity (e.g. email) the signing certificate of a keyless signature must be issued to" local:"true"`
+	CertificateOidcIssuer string            `usage:"OIDC issuer that must have confirmed the identity of a keyless signature" local:"true"`
+	Annotations           map[string]string `usage:"Annotations to check for in the signature (values may be glob patterns like 1.2.*)" short:"a" local:"true" name:"annotation"`
+	NoVerifyName          bool              `usage:"Do not verify the image name in the signature" local:"true" default:"false"`
+	RequireTlog           bool              `usage:"Only accept signatures recorded in the Rekor transparency log (always the case for keyless signatures)" local:"true"`
+	Type                  string            `usage:"Verify an attestation of this predicate type instead of the signature (slsaprovenance|spdx|spdxjson|cyclonedx|link|vuln|custom or an URI)" local:"true"`
+	OutputAttestation     string            `usage:"Write the predicate of the verified attestation to this file, requires --type" local:"true"`
 }
 
 func (a *ImageVerify) Run(cmd *cobra.Command, args []string) error {
-	if a.Key == "" {
-		return fmt.Errorf("key is required")
+	keyless := a.CertificateIdentity != "" || a.CertificateOidcIssuer != ""
+	if a.Key == "" && !keyless {
+		return fmt.Errorf("either --key or --certificate-identity and --certificate-oidc-issuer are required")
+	} else if a.Key != "" && keyless {
+		return fmt.Errorf("--key cannot be used together with --certificate-identity or --certificate-oidc-issuer")
+	} else if keyless && (a.CertificateIdentity == "" || a.CertificateOidcIssuer == "") {
+		return fmt.Errorf("--certificate-identity and --certificate-oidc-issuer must be used together")
+	}
+
+	if a.OutputAttestation != "" && a.Type == "" {
+		return fmt.Errorf("--output-attestation requires --type")
+	}
+	if a.Type != "" {
+		if _, err := acornsign.PredicateTypeURI(a.Type); err != nil {
+			return err
+		}
+		if len(a.Annotations) > 0 {
+			return fmt.Errorf("--annotation can not be combined with --type, attestations are only verified by key or certificate identity")
+		}
 	}
 
 	imageName := args[0]
@@ -54,16 +90,20 @@ func (a *ImageVerify) Run(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	auth, err := getAuthForImage(cmd.Context(), a.client, imageName)
-	if err != nil {
-		return err
-	}
-
 	// not failing here, since it could be a local image
 	ref, _ := name.ParseReference(imageName)
 
-	details, err := c.ImageDetails(cmd.Context(), args[0], &client.ImageDetailsOptions{
-		Auth: auth,
+	// Stale cached credentials are refreshed once, the ones that worked are used to verify the signature as well
+	var (
+		auth    *apiv1.RegistryAuth
+		details *client.ImageDetails
+	)
+	err = withAuthForImage(cmd.Context(), a.client, imageName, func(imageAuth *apiv1.RegistryAuth) (err error) {
+		auth = imageAuth
+		details, err = c.ImageDetails(cmd.Context(), args[0], &client.ImageDetailsOptions{
+			Auth: auth,
+		})
+		return err
 	})
 	if err != nil {
 		return err
@@ -74,10 +114,14 @@ func (a *ImageVerify) Run(cmd *cobra.Command, args []string) error {
 	logrus.Debugf("Verifying Image %s (digest: %s) using key %s and annotations: %#v\n", imageName, targetDigest, a.Key, a.Annotations)
 
 	vOpts := &client.ImageVerifyOptions{
-		Annotations:  a.Annotations,
-		PublicKey:    a.Key,
-		Auth:         auth,
-		NoVerifyName: a.NoVerifyName,
+		Annotations:    a.Annotations,
+		PublicKey:      a.Key,
+		CertIdentity:   a.CertificateIdentity,
+		CertOidcIssuer: a.CertificateOidcIssuer,
+		Auth:           auth,
+		NoVerifyName:   a.NoVerifyName,
+		RequireTlog:    a.RequireTlog,
+		PredicateType:  a.Type,
 	}
 
 	// load public key from file (if it is a file, not a remote reference)
@@ -107,12 +151,43 @@ func (a *ImageVerify) Run(cmd *cobra.Command, args []string) error {
 		vOpts.PublicKey = string(pem)
 	}
 
-	pterm.Info.Printf("Verifying Image %s (digest: %s) using key %s\n", imageName, targetDigest, a.Key)
+	if keyless {
+		pterm.Info.Printf("Verifying Image %s (digest: %s) using certificate identity %s (issuer: %s)\n", imageName, targetDigest, a.CertificateIdentity, a.CertificateOidcIssuer)
+	} else {
+		pterm.Info.Printf("Verifying Image %s (digest: %s) using key %s\n", imageName, targetDigest, a.Key)
+	}
+
+	sig, err := c.ImageVerify(cmd.Context(), imageName, vOpts)
+	if err != nil {
+		return err
+	}
+
+	if a.Type != "" {
+		pterm.Success.Printf("Attestation of type %s verified\n", a.Type)
+		if a.OutputAttestation == "" {
+			return nil
+		}
+		if err := os.WriteFile(a.OutputAttestation, sig.AttestationPredicate, 0644); err != nil {
+			return err
+		}
+		pterm.Info.Printf("Wrote the predicate of the attestation to %s\n", a.OutputAttestation)
+		return nil
+	}
 
-	_, err = c.ImageVerify(cmd.Context(), imageName, vOpts)
-	if err == nil {
+	if sig.VerifiedSignature == nil {
 		pterm.Success.Println("Signature verified")
+		return nil
+	}
+
+	pterm.Success.Printf("Signature %s verified\n", sig.VerifiedSignature.Digest)
+	keys := make([]string, 0, len(sig.VerifiedSignature.Annotations))
+	for k := range sig.VerifiedSignature.Annotations {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		pterm.Printf("  %s=%s\n", k, sig.VerifiedSignature.Annotations[k])
 	}
 
-	return err
+	return nil
 }
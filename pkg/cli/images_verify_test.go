@@ -0,0 +1,178 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"testing"
+
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	internalv1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/cli/testdata"
+	"github.com/acorn-io/runtime/pkg/client"
+	"github.com/acorn-io/runtime/pkg/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/pterm/pterm"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestImageVerifyCertIdentity(t *testing.T) {
+	pterm.DisableStyling()
+	defer pterm.EnableStyling()
+
+	// A local image ID, so that no registry credentials are looked up
+	imageID := "0123456789ab"
+	imageDigest := "sha256:0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"
+	sigDigest := "sha256:fedcba9876543210fedcba9876543210fedcba9876543210fedcba9876543210"
+
+	tests := []struct {
+		name      string
+		args      []string
+		verifyErr error
+		wantOut   []string
+		wantErr   string
+	}{
+		{
+			name: "signature of the identity",
+			args: []string{"verify", imageID, "--certificate-identity", "me@example.com", "--certificate-oidc-issuer", "https://github.com/login/oauth"},
+			wantOut: []string{
+				"using certificate identity me@example.com (issuer: https://github.com/login/oauth)",
+				"Signature " + sigDigest + " verified",
+				"  team=platform",
+			},
+		},
+		{
+			name:      "no signature of the identity",
+			args:      []string{"verify", imageID, "--certificate-identity", "someone-else@example.com", "--certificate-oidc-issuer", "https://github.com/login/oauth"},
+			verifyErr: fmt.Errorf("failed to find valid signature matching certificate identity someone-else@example.com"),
+			wantErr:   "failed to find valid signature matching certificate identity someone-else@example.com",
+		},
+		{
+			name:    "identity without issuer",
+			args:    []string{"verify", imageID, "--certificate-identity", "me@example.com"},
+			wantErr: "--certificate-identity and --certificate-oidc-issuer must be used together",
+		},
+		{
+			name:    "issuer without identity",
+			args:    []string{"verify", imageID, "--certificate-oidc-issuer", "https://github.com/login/oauth"},
+			wantErr: "--certificate-identity and --certificate-oidc-issuer must be used together",
+		},
+		{
+			name:    "identity and key",
+			args:    []string{"verify", imageID, "--key", "gh://acorn-io", "--certificate-identity", "me@example.com", "--certificate-oidc-issuer", "https://github.com/login/oauth"},
+			wantErr: "--key cannot be used together with --certificate-identity or --certificate-oidc-issuer",
+		},
+		{
+			name:    "neither identity nor key",
+			args:    []string{"verify", imageID},
+			wantErr: "either --key or --certificate-identity and --certificate-oidc-issuer are required",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mClient := mocks.NewMockClient(ctrl)
+			if tt.wantErr == "" || tt.verifyErr != nil {
+				mClient.EXPECT().ImageDetails(gomock.Any(), imageID, gomock.Any()).Return(&client.ImageDetails{
+					AppImage: internalv1.AppImage{ID: imageID, Digest: imageDigest},
+				}, nil)
+				mClient.EXPECT().ImageVerify(gomock.Any(), imageID, gomock.Any()).DoAndReturn(
+					func(_ context.Context, _ string, opts *client.ImageVerifyOptions) (*apiv1.ImageSignature, error) {
+						// The identity is verified by the server, the key must not be used instead
+						assert.Empty(t, opts.PublicKey)
+						assert.NotEmpty(t, opts.CertIdentity)
+						assert.Equal(t, "https://github.com/login/oauth", opts.CertOidcIssuer)
+						if tt.verifyErr != nil {
+							return nil, tt.verifyErr
+						}
+						return &apiv1.ImageSignature{VerifiedSignature: &apiv1.ImageSignatureEntry{
+							Digest:      sigDigest,
+							Annotations: map[string]string{"team": "platform"},
+						}}, nil
+					})
+			}
+
+			r, w, _ := os.Pipe()
+			os.Stdout = w
+			pterm.SetDefaultOutput(w)
+			defer pterm.SetDefaultOutput(os.Stderr)
+			cmd := NewImage(CommandContext{
+				ClientFactory: &testdata.MockClientFactoryManual{
+					MockAcornConfigFile: "/fake-file",
+					Client:              mClient,
+				},
+				StdOut: w,
+				StdErr: w,
+				StdIn:  strings.NewReader(""),
+			})
+			cmd.SetArgs(tt.args)
+			err := cmd.Execute()
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+
+			require.NoError(t, w.Close())
+			out, _ := io.ReadAll(r)
+			for _, want := range tt.wantOut {
+				assert.Contains(t, string(out), want)
+			}
+		})
+	}
+}
+
+func TestImageVerifyRequireTlog(t *testing.T) {
+	pterm.DisableStyling()
+	defer pterm.EnableStyling()
+
+	// A local image ID, so that no registry credentials are looked up
+	imageID := "0123456789ab"
+	imageDigest := "sha256:0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"
+
+	tests := []struct {
+		name            string
+		args            []string
+		wantRequireTlog bool
+	}{
+		{
+			name:            "required",
+			args:            []string{"verify", imageID, "--key", "gh://acorn-io", "--require-tlog"},
+			wantRequireTlog: true,
+		},
+		{
+			name: "not required by default",
+			args: []string{"verify", imageID, "--key", "gh://acorn-io"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mClient := mocks.NewMockClient(ctrl)
+			mClient.EXPECT().ImageDetails(gomock.Any(), imageID, gomock.Any()).Return(&client.ImageDetails{
+				AppImage: internalv1.AppImage{ID: imageID, Digest: imageDigest},
+			}, nil)
+			// The transparency log bundle is verified by the server
+			mClient.EXPECT().ImageVerify(gomock.Any(), imageID, gomock.Any()).DoAndReturn(
+				func(_ context.Context, _ string, opts *client.ImageVerifyOptions) (*apiv1.ImageSignature, error) {
+					assert.Equal(t, tt.wantRequireTlog, opts.RequireTlog)
+					return &apiv1.ImageSignature{}, nil
+				})
+
+			cmd := NewImage(CommandContext{
+				ClientFactory: &testdata.MockClientFactoryManual{
+					MockAcornConfigFile: "/fake-file",
+					Client:              mClient,
+				},
+				StdOut: io.Discard,
+				StdErr: io.Discard,
+				StdIn:  strings.NewReader(""),
+			})
+			cmd.SetArgs(tt.args)
+			require.NoError(t, cmd.Execute())
+		})
+	}
+}
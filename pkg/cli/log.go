@@ -22,14 +22,35 @@ func NewLogs(c CommandContext) *cobra.Command {
 }
 
 type Logs struct {
-	Follow    bool   `short:"f" usage:"Follow log output"`
-	Since     string `short:"s" usage:"Show logs since timestamp (e.g. 42m for 42 minutes)"`
-	Tail      int64  `short:"n" usage:"Number of lines in log output"`
-	Container string `short:"c" usage:"Container name or Job name within app to follow"`
-	client    ClientFactory
+	Follow     bool   `short:"f" usage:"Follow log output, reconnecting when the stream ends like when a pod restarts"`
+	Since      string `short:"s" usage:"Show logs since timestamp (e.g. 42m for 42 minutes ago or 2006-01-02T15:04:05Z)"`
+	Until      string `short:"u" usage:"Show logs until timestamp (e.g. 42m for 42 minutes ago or 2006-01-02T15:04:05Z), stops following once reached"`
+	Tail       int64  `short:"n" usage:"Number of lines in log output, taken from the end of the --since/--until time window"`
+	Container  string `short:"c" usage:"Container name or Job name within app to follow"`
+	Init       bool   `usage:"Include the logs of all init containers, prefixed with [init]"`
+	Output     string `short:"o" usage:"Output format (json), prints a JSON object per log line"`
+	Grep       string `usage:"Only show log lines matching the regular expression, filtered by the server (e.g. 'error|warn'), matched against the line without its container prefix"`
+	GrepInvert bool   `usage:"Only show log lines not matching --grep"`
+	client     ClientFactory
 }
 
 func (s *Logs) Run(cmd *cobra.Command, args []string) error {
+	var logger client.ContainerLogsWriter
+	switch s.Output {
+	case "":
+	case "json":
+		logger = log.NewJSONLogger(cmd.OutOrStdout())
+	default:
+		return fmt.Errorf("invalid output format %s, only json is supported", s.Output)
+	}
+
+	if s.GrepInvert && s.Grep == "" {
+		return fmt.Errorf("--grep-invert requires --grep")
+	}
+	if _, err := log.ParseGrep(s.Grep); err != nil {
+		return err
+	}
+
 	c, err := s.client.CreateDefault()
 	if err != nil {
 		return err
@@ -54,10 +75,15 @@ func (s *Logs) Run(cmd *cobra.Command, args []string) error {
 	}
 	return log.Output(cmd.Context(), c, args[0], &client.LogOptions{
 		LogOptions: apiv1.LogOptions{
-			Follow:    s.Follow,
-			Container: s.Container,
-			Tail:      tailLines,
-			Since:     s.Since,
+			Follow:     s.Follow,
+			Container:  s.Container,
+			Init:       s.Init,
+			Tail:       tailLines,
+			Since:      s.Since,
+			Until:      s.Until,
+			Grep:       s.Grep,
+			GrepInvert: s.GrepInvert,
 		},
+		Logger: logger,
 	})
 }
@@ -40,10 +40,12 @@ type Project struct {
 }
 
 type projectEntry struct {
-	Name          string   `json:"name,omitempty"`
-	Default       bool     `json:"default,omitempty"`
-	Regions       []string `json:"regions,omitempty"`
-	DefaultRegion string   `json:"default-region,omitempty"`
+	Name                string   `json:"name,omitempty"`
+	Default             bool     `json:"default,omitempty"`
+	Regions             []string `json:"regions,omitempty"`
+	DefaultRegion       string   `json:"default-region,omitempty"`
+	DefaultComputeClass string   `json:"default-compute-class,omitempty"`
+	DefaultVolumeClass  string   `json:"default-volume-class,omitempty"`
 }
 
 func (a *Project) Run(cmd *cobra.Command, args []string) error {
@@ -122,9 +124,11 @@ func (a *Project) Run(cmd *cobra.Command, args []string) error {
 			}
 
 			out.WriteFormatted(projectEntry{
-				Name:    projectName,
-				Default: defaultProject == projectName,
-				Regions: supportedRegions,
+				Name:                projectName,
+				Default:             defaultProject == projectName,
+				Regions:             supportedRegions,
+				DefaultComputeClass: projectItem.Project.Spec.DefaultComputeClass,
+				DefaultVolumeClass:  projectItem.Project.Spec.DefaultVolumeClass,
 			}, projectItem.Project)
 		}
 	}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	cli "github.com/acorn-io/runtime/pkg/cli/builder"
+	"github.com/acorn-io/runtime/pkg/client"
 	"github.com/acorn-io/runtime/pkg/project"
 	"github.com/spf13/cobra"
 )
@@ -17,6 +18,9 @@ acorn project create my-new-project
 
 # Create a project on remote service acorn.io
 acorn project create acorn.io/username/new-project
+
+# Create a project whose apps use the given compute and volume classes unless they specify their own
+acorn project create --default-compute-class small --default-volume-class fast my-new-project
 `,
 		SilenceUsage:      true,
 		Short:             "Create new project",
@@ -31,18 +35,31 @@ acorn project create acorn.io/username/new-project
 	if err := cmd.RegisterFlagCompletionFunc("supported-region", newCompletion(c.ClientFactory, regionsCompletion).complete); err != nil {
 		cmd.Printf("Error registering completion function for --supported-region flag: %v\n", err)
 	}
+	if err := cmd.RegisterFlagCompletionFunc("default-compute-class", newCompletion(c.ClientFactory, computeClassCompletion).complete); err != nil {
+		cmd.Printf("Error registering completion function for --default-compute-class flag: %v\n", err)
+	}
+	if err := cmd.RegisterFlagCompletionFunc("default-volume-class", newCompletion(c.ClientFactory, volumeClassCompletion).complete); err != nil {
+		cmd.Printf("Error registering completion function for --default-volume-class flag: %v\n", err)
+	}
 	return cmd
 }
 
 type ProjectCreate struct {
-	client           ClientFactory
-	DefaultRegion    string   `usage:"Default region for project resources"`
-	SupportedRegions []string `name:"supported-region" usage:"Supported regions for created project"`
+	client              ClientFactory
+	DefaultRegion       string   `usage:"Default region for project resources"`
+	SupportedRegions    []string `name:"supported-region" usage:"Supported regions for created project"`
+	DefaultComputeClass string   `usage:"Default compute class for workloads of apps in the project that don't specify one"`
+	DefaultVolumeClass  string   `usage:"Default volume class for volumes of apps in the project that don't specify one"`
 }
 
 func (a *ProjectCreate) Run(cmd *cobra.Command, args []string) error {
 	for _, projectName := range args {
-		if err := project.Create(cmd.Context(), a.client.Options(), projectName, a.DefaultRegion, a.SupportedRegions); err != nil {
+		if err := project.Create(cmd.Context(), a.client.Options(), projectName, &client.ProjectCreateOptions{
+			DefaultRegion:       a.DefaultRegion,
+			SupportedRegions:    a.SupportedRegions,
+			DefaultComputeClass: a.DefaultComputeClass,
+			DefaultVolumeClass:  a.DefaultVolumeClass,
+		}); err != nil {
 			return err
 		} else {
 			fmt.Println(projectName)
@@ -13,6 +13,9 @@ func NewProjectUpdate(c CommandContext) *cobra.Command {
 		Use: "update [flags] PROJECT_NAME",
 		Example: `
 acorn project update my-project
+
+# Only allow apps signed with the given key in the project
+acorn project update --signature-policy key=./cosign.pub my-project
 `,
 		SilenceUsage:      true,
 		Short:             "Update project",
@@ -34,6 +37,7 @@ type ProjectUpdate struct {
 	client           ClientFactory
 	DefaultRegion    string   `usage:"Default region for project resources"`
 	SupportedRegions []string `name:"supported-region" usage:"Supported regions for the created project"`
+	SignaturePolicy  *string  `usage:"Default signature policy for apps in the project, see 'acorn run --signature-policy' for the format (\"\" to clear)"`
 }
 
 func (a *ProjectUpdate) Run(cmd *cobra.Command, args []string) error {
@@ -41,6 +45,15 @@ func (a *ProjectUpdate) Run(cmd *cobra.Command, args []string) error {
 	if err != nil {
 		return err
 	}
+	if a.SignaturePolicy != nil && projectsDetails[0].Project != nil {
+		projectsDetails[0].Project.Spec.DefaultSignaturePolicy = nil
+		if *a.SignaturePolicy != "" {
+			projectsDetails[0].Project.Spec.DefaultSignaturePolicy, err = parseSignaturePolicy(*a.SignaturePolicy)
+			if err != nil {
+				return err
+			}
+		}
+	}
 	if err := project.Update(cmd.Context(), a.client.Options(), projectsDetails[0], a.DefaultRegion, a.SupportedRegions); err != nil {
 		return err
 	} else {
@@ -1,31 +1,59 @@
 package cli
 
 import (
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
 	cli "github.com/acorn-io/runtime/pkg/cli/builder"
 	"github.com/acorn-io/runtime/pkg/cli/builder/table"
 	"github.com/acorn-io/runtime/pkg/client"
+	"github.com/acorn-io/runtime/pkg/labels"
 	"github.com/acorn-io/runtime/pkg/tables"
 	"github.com/spf13/cobra"
 	"k8s.io/utils/strings/slices"
 )
 
 func NewPs(c CommandContext) *cobra.Command {
-	return cli.Command(&Ps{client: c.ClientFactory}, cobra.Command{
+	cmd := cli.Command(&Ps{client: c.ClientFactory}, cobra.Command{
 		Use:     "ps [flags] [ACORN_NAME...]",
 		Aliases: []string{"app", "apps", "a"},
 		Example: `
 acorn ps`,
 		SilenceUsage:      true,
 		Short:             "List or get apps",
+		Args:              cobra.ArbitraryArgs,
 		ValidArgsFunction: newCompletion(c.ClientFactory, appsCompletion).complete,
 	})
+	cmd.AddCommand(NewAppPause(c))
+	cmd.AddCommand(NewAppResume(c))
+	cmd.AddCommand(NewAppRename(c))
+	cmd.AddCommand(NewAppDiff(c))
+	cmd.AddCommand(NewAppExport(c))
+	cmd.AddCommand(NewAppImport(c))
+	cmd.AddCommand(NewAppRestart(c))
+	cmd.AddCommand(NewAppScale(c))
+	cmd.AddCommand(NewAppConfirmUpgrade(c))
+	cmd.AddCommand(NewAppStatus(c))
+	cmd.AddCommand(NewAppFQDN(c))
+	cmd.AddCommand(NewAppConnect(c))
+	cmd.AddCommand(NewAppGrant(c))
+	cmd.AddCommand(NewAppRevoke(c))
+	cmd.AddCommand(NewAppGrants(c))
+	cmd.AddCommand(NewAppEvents(c))
+	cmd.AddCommand(NewAppEnv(c))
+	cmd.AddCommand(NewAppDebug(c))
+	cmd.AddCommand(NewAppWait(c))
+	cmd.AddCommand(NewAppTop(c))
+	return cmd
 }
 
+// appGVK is set on every app written, so that the kind and API version of the apps in the json and yaml output don't
+// depend on how they were fetched
+var appGVK = apiv1.SchemeGroupVersion.WithKind("App")
+
 type Ps struct {
-	All         bool   `usage:"Include stopped apps" short:"a"`
-	AllProjects bool   `usage:"Include all projects in same Acorn instance as the current default project" short:"A"`
-	Quiet       bool   `usage:"Output only names" short:"q"`
-	Output      string `usage:"Output format (json, yaml, {{gotemplate}})" short:"o"`
+	All         bool   `usage:"Include stopped apps" short:"a" local:"true"`
+	AllProjects bool   `usage:"Include all projects in same Acorn instance as the current default project" short:"A" local:"true"`
+	Quiet       bool   `usage:"Output only names" short:"q" local:"true"`
+	Output      string `usage:"Output format (json, yaml, {{gotemplate}}, go-template={{gotemplate}})" short:"o" local:"true"`
 	client      ClientFactory
 }
 
@@ -34,6 +62,10 @@ func (a *Ps) Run(cmd *cobra.Command, args []string) error {
 		c   client.Client
 		err error
 	)
+	if err := table.ValidateFormat(a.Output, &apiv1.App{}); err != nil {
+		return err
+	}
+
 	if a.AllProjects {
 		c, err = a.client.CreateWithAllProjects()
 	} else {
@@ -50,6 +82,7 @@ func (a *Ps) Run(cmd *cobra.Command, args []string) error {
 		if err != nil {
 			return err
 		}
+		app.SetGroupVersionKind(appGVK)
 		out.Write(app)
 		return out.Err()
 	}
@@ -60,7 +93,10 @@ func (a *Ps) Run(cmd *cobra.Command, args []string) error {
 	}
 
 	for _, app := range apps {
-		if (app.Status.AppStatus.Stopped || app.Status.AppStatus.Completed) && !a.All {
+		app.SetGroupVersionKind(appGVK)
+		// Paused apps are listed, since they are meant to be resumed
+		paused := app.Annotations[labels.AcornPaused] == "true"
+		if ((app.Status.AppStatus.Stopped && !paused) || app.Status.AppStatus.Completed) && !a.All {
 			continue
 		}
 		if len(args) > 0 {
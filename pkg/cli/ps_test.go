@@ -1,14 +1,20 @@
 package cli
 
 import (
+	"encoding/json"
 	"io"
 	"os"
 	"strings"
 	"testing"
 
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
 	"github.com/acorn-io/runtime/pkg/cli/testdata"
+	"github.com/acorn-io/runtime/pkg/labels"
 	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 func TestApp(t *testing.T) {
@@ -90,6 +96,44 @@ func TestApp(t *testing.T) {
 			wantErr: true,
 			wantOut: "error: app dne does not exist",
 		},
+		{
+			name: "acorn app -o go-template", fields: fields{
+				All:    false,
+				Quiet:  false,
+				Output: "go-template={{.Name}} {{.Status.Namespace}}",
+			},
+			commandContext: CommandContext{
+				ClientFactory: &testdata.MockClientFactory{},
+				StdOut:        w,
+				StdErr:        w,
+				StdIn:         strings.NewReader(""),
+			},
+			args: args{
+				args:   []string{"-o", "go-template={{.Name}} {{.Status.Namespace}}"},
+				client: &testdata.MockClient{},
+			},
+			wantErr: false,
+			wantOut: "found \n",
+		},
+		{
+			name: "acorn app -o go-template with invalid field", fields: fields{
+				All:    false,
+				Quiet:  false,
+				Output: "go-template={{.Name}} {{.Stauts}}",
+			},
+			commandContext: CommandContext{
+				ClientFactory: &testdata.MockClientFactory{},
+				StdOut:        w,
+				StdErr:        w,
+				StdIn:         strings.NewReader(""),
+			},
+			args: args{
+				args:   []string{"-o", "go-template={{.Name}} {{.Stauts}}"},
+				client: &testdata.MockClient{},
+			},
+			wantErr: true,
+			wantOut: "invalid output format: template: :1:12: executing \"\" at <.Stauts>: can't evaluate field Stauts in type *v1.App",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -110,3 +154,52 @@ func TestApp(t *testing.T) {
 		})
 	}
 }
+
+func TestAppOutputJSON(t *testing.T) {
+	r, w, _ := os.Pipe()
+	os.Stdout = w
+
+	cmd := NewPs(CommandContext{
+		ClientFactory: &testdata.MockClientFactory{
+			AppList: []apiv1.App{{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "my-app",
+					Annotations: map[string]string{labels.AcornAppGeneration: "1", "team": "web"},
+				},
+				Status: v1.AppInstanceStatus{
+					Ready: true,
+					AppStatus: v1.AppStatus{
+						Containers: map[string]v1.ContainerStatus{
+							"web": {ReadyReplicaCount: 1, DesiredReplicaCount: 1},
+						},
+						Endpoints: []v1.Endpoint{{Target: "web", TargetPort: 80, Address: "web.example.com", Protocol: v1.ProtocolHTTP}},
+					},
+					Conditions: []v1.Condition{{Type: v1.AppInstanceConditionReady, Status: metav1.ConditionTrue, Success: true}},
+				},
+			}},
+		},
+		StdOut: w,
+		StdErr: w,
+		StdIn:  strings.NewReader(""),
+	})
+	cmd.SetArgs([]string{"-o", "json"})
+	require.NoError(t, cmd.Execute())
+	w.Close()
+	out, _ := io.ReadAll(r)
+
+	var result struct {
+		Items []apiv1.App `json:"items"`
+	}
+	require.NoError(t, json.Unmarshal(out, &result))
+	require.Len(t, result.Items, 1)
+
+	app := result.Items[0]
+	assert.Equal(t, "App", app.Kind)
+	assert.Equal(t, "api.acorn.io/v1", app.APIVersion)
+	assert.Equal(t, "my-app", app.Name)
+	assert.Equal(t, map[string]string{"team": "web"}, app.Annotations)
+	assert.True(t, app.Status.Ready)
+	assert.Equal(t, int32(1), app.Status.AppStatus.Containers["web"].ReadyReplicaCount)
+	assert.Equal(t, "web.example.com", app.Status.AppStatus.Endpoints[0].Address)
+	assert.Equal(t, v1.AppInstanceConditionReady, app.Status.Conditions[0].Type)
+}
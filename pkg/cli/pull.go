@@ -3,6 +3,7 @@ package cli
 import (
 	"fmt"
 
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
 	cli "github.com/acorn-io/runtime/pkg/cli/builder"
 	"github.com/acorn-io/runtime/pkg/client"
 	"github.com/acorn-io/runtime/pkg/progressbar"
@@ -24,6 +25,7 @@ type Pull struct {
 	Key          string            `usage:"Key to use for verifying" short:"k" local:"true" default:"./cosign.pub"`
 	Annotations  map[string]string `usage:"Annotations to check for during verification" short:"a" local:"true" name:"annotation"`
 	NoVerifyName bool              `usage:"Do not verify the image name in the signature" local:"true" default:"false"`
+	Quiet        bool              `usage:"Don't print the progress of the pull" short:"q" local:"true"`
 }
 
 func (s *Pull) Run(cmd *cobra.Command, args []string) error {
@@ -32,11 +34,6 @@ func (s *Pull) Run(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	auth, err := getAuthForImage(cmd.Context(), s.client, args[0])
-	if err != nil {
-		return err
-	}
-
 	if s.Verify {
 		v := ImageVerify{
 			client:       s.client,
@@ -49,12 +46,17 @@ func (s *Pull) Run(cmd *cobra.Command, args []string) error {
 		}
 	}
 
-	progress, err := c.ImagePull(cmd.Context(), args[0], &client.ImagePullOptions{
-		Auth: auth,
-	})
-	if err != nil {
-		return err
-	}
+	return withAuthForImage(cmd.Context(), s.client, args[0], func(auth *apiv1.RegistryAuth) error {
+		progress, err := c.ImagePull(cmd.Context(), args[0], &client.ImagePullOptions{
+			Auth: auth,
+		})
+		if err != nil {
+			return err
+		}
 
-	return progressbar.Print(progress)
+		if s.Quiet {
+			return progressbar.Wait(progress)
+		}
+		return progressbar.Print(progress)
+	})
 }
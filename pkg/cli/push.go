@@ -23,6 +23,7 @@ type Push struct {
 	Sign                 bool              `hidden:"true" usage:"Sign the image before pushing" short:"s" local:"true" default:"false" `
 	Key                  string            `hidden:"true" usage:"Key to use for signing" short:"k" local:"true" default:"./cosign.key"`
 	SignatureAnnotations map[string]string `hidden:"true" usage:"Annotations to add to the signature" short:"a" local:"true" name:"signature-annotation"`
+	Quiet                bool              `usage:"Don't print the progress of the push" short:"q" local:"true"`
 }
 
 func (s *Push) Run(cmd *cobra.Command, args []string) error {
@@ -56,9 +57,8 @@ func (s *Push) Run(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	if err := progressbar.Print(prog); err != nil {
-		return err
+	if s.Quiet {
+		return progressbar.Wait(prog)
 	}
-
-	return nil
+	return progressbar.Print(prog)
 }
@@ -1,8 +1,12 @@
 package cli
 
 import (
+	"context"
+
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
 	cli "github.com/acorn-io/runtime/pkg/cli/builder"
 	"github.com/acorn-io/runtime/pkg/cli/builder/table"
+	"github.com/acorn-io/runtime/pkg/client"
 	"github.com/acorn-io/runtime/pkg/tables"
 	"github.com/spf13/cobra"
 	"k8s.io/utils/strings/slices"
@@ -15,7 +19,7 @@ func NewRegions(c CommandContext) *cobra.Command {
 		Example: `
 acorn offering regions`,
 		SilenceUsage:      true,
-		Short:             "List available regions",
+		Short:             "List available regions and the compute and volume classes of the project that support them",
 		ValidArgsFunction: newCompletion(c.ClientFactory, regionsCompletion).complete,
 	})
 }
@@ -26,37 +30,75 @@ type Regions struct {
 	client ClientFactory
 }
 
+// regionOfferings is a region with the classes of the project that support it, so they can be shown in the table
+type regionOfferings struct {
+	*apiv1.Region
+	ComputeClasses []string
+	VolumeClasses  []string
+}
+
 func (a *Regions) Run(cmd *cobra.Command, args []string) error {
 	c, err := a.client.CreateDefault()
 	if err != nil {
 		return err
 	}
 
-	out := table.NewWriter(tables.Region, a.Quiet, a.Output)
-
+	var regions []apiv1.Region
 	if len(args) == 1 {
 		region, err := c.RegionGet(cmd.Context(), args[0])
 		if err != nil {
 			return err
 		}
-		out.Write(region)
-		return out.Err()
+		regions = append(regions, *region)
+	} else {
+		regions, err = c.RegionList(cmd.Context())
+		if err != nil {
+			return err
+		}
 	}
 
-	regions, err := c.RegionList(cmd.Context())
+	offerings, err := newRegionOfferings(cmd.Context(), c)
 	if err != nil {
 		return err
 	}
 
+	out := table.NewWriter(tables.RegionClient, a.Quiet, a.Output)
 	for _, region := range regions {
-		if len(args) > 0 {
-			if slices.Contains(args, region.Name) {
-				out.Write(&region)
-			}
-		} else {
-			out.Write(&region)
+		if len(args) <= 1 || slices.Contains(args, region.Name) {
+			out.WriteFormatted(offerings(&region), &region)
 		}
 	}
 
 	return out.Err()
 }
+
+// newRegionOfferings lists the classes of the project once and returns a function that adds the ones supporting a
+// region to it.
+func newRegionOfferings(ctx context.Context, c client.Client) (func(*apiv1.Region) regionOfferings, error) {
+	computeClasses, err := c.ComputeClassList(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	volumeClasses, err := c.VolumeClassList(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return func(region *apiv1.Region) regionOfferings {
+		result := regionOfferings{
+			Region: region,
+		}
+		for _, cc := range computeClasses {
+			if slices.Contains(cc.SupportedRegions, region.Name) {
+				result.ComputeClasses = append(result.ComputeClasses, cc.Name)
+			}
+		}
+		for _, vc := range volumeClasses {
+			if !vc.Inactive && slices.Contains(vc.SupportedRegions, region.Name) {
+				result.VolumeClasses = append(result.VolumeClasses, vc.Name)
+			}
+		}
+		return result
+	}, nil
+}
@@ -17,6 +17,8 @@ func TestRegions(t *testing.T) {
 	tests := []struct {
 		name            string
 		existingRegions []apiv1.Region
+		computeClasses  []apiv1.ComputeClass
+		volumeClasses   []apiv1.VolumeClass
 		quiet           bool
 		args            []string
 		wantErr         bool
@@ -39,7 +41,7 @@ func TestRegions(t *testing.T) {
 			args:    []string{},
 			quiet:   false,
 			wantErr: false,
-			wantOut: "NAME      ACCOUNT   REGION NAME   CREATED   DESCRIPTION\nlocal               us-east-2     10y ago   Test region\n",
+			wantOut: "NAME      ACCOUNT   REGION NAME   COMPUTE CLASSES   VOLUME CLASSES   CREATED   DESCRIPTION\nlocal               us-east-2                                        10y ago   Test region\n",
 		},
 		{
 			name: "acorn regions with one region with owner reference",
@@ -63,7 +65,7 @@ func TestRegions(t *testing.T) {
 			args:    []string{},
 			quiet:   false,
 			wantErr: false,
-			wantOut: "NAME      ACCOUNT     REGION NAME   CREATED   DESCRIPTION\nlocal     my-object   us-east-2     10y ago   Test region\n",
+			wantOut: "NAME      ACCOUNT     REGION NAME   COMPUTE CLASSES   VOLUME CLASSES   CREATED   DESCRIPTION\nlocal     my-object   us-east-2                                        10y ago   Test region\n",
 		},
 		{
 			name: "acorn regions with multiple regions",
@@ -92,7 +94,33 @@ func TestRegions(t *testing.T) {
 			args:    []string{},
 			quiet:   false,
 			wantErr: false,
-			wantOut: "NAME      ACCOUNT   REGION NAME   CREATED   DESCRIPTION\nlocal               us-east-2     10y ago   Test region\nlocal               us-west-2     10y ago   Another test region\n",
+			wantOut: "NAME      ACCOUNT   REGION NAME   COMPUTE CLASSES   VOLUME CLASSES   CREATED   DESCRIPTION\nlocal               us-east-2                                        10y ago   Test region\nlocal               us-west-2                                        10y ago   Another test region\n",
+		},
+		{
+			name: "acorn regions with the classes that support them",
+			existingRegions: []apiv1.Region{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:              apiv1.LocalRegion,
+						CreationTimestamp: metav1.NewTime(tenYearsAgo),
+					},
+					Spec: apiv1.RegionSpec{
+						Description: "Local Region",
+						RegionName:  apiv1.LocalRegion,
+					},
+				},
+			},
+			computeClasses: []apiv1.ComputeClass{
+				{ObjectMeta: metav1.ObjectMeta{Name: "small"}, SupportedRegions: []string{apiv1.LocalRegion}},
+				{ObjectMeta: metav1.ObjectMeta{Name: "large"}, SupportedRegions: []string{apiv1.LocalRegion}},
+				{ObjectMeta: metav1.ObjectMeta{Name: "remote"}, SupportedRegions: []string{"us-east-2"}},
+			},
+			volumeClasses: []apiv1.VolumeClass{
+				{ObjectMeta: metav1.ObjectMeta{Name: "fast"}, SupportedRegions: []string{apiv1.LocalRegion}},
+				{ObjectMeta: metav1.ObjectMeta{Name: "old"}, SupportedRegions: []string{apiv1.LocalRegion}, Inactive: true},
+			},
+			args:    []string{},
+			wantOut: "NAME      ACCOUNT   REGION NAME   COMPUTE CLASSES   VOLUME CLASSES   CREATED   DESCRIPTION\nlocal               local         small,large       fast             10y ago   Local Region\n",
 		},
 	}
 	for _, tt := range tests {
@@ -100,10 +128,14 @@ func TestRegions(t *testing.T) {
 			r, w, _ := os.Pipe()
 			os.Stdout = w
 			cmd := NewRegions(CommandContext{
-				ClientFactory: &testdata.MockClientFactory{RegionList: tt.existingRegions},
-				StdOut:        w,
-				StdErr:        w,
-				StdIn:         strings.NewReader(""),
+				ClientFactory: &testdata.MockClientFactory{
+					RegionList:       tt.existingRegions,
+					ComputeClassList: tt.computeClasses,
+					VolumeClassList:  tt.volumeClasses,
+				},
+				StdOut: w,
+				StdErr: w,
+				StdIn:  strings.NewReader(""),
 			})
 			cmd.SetArgs(tt.args)
 			err := cmd.Execute()
@@ -7,8 +7,11 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"sort"
 	"strings"
+	"time"
 
+	"github.com/acorn-io/baaah/pkg/typed"
 	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
 	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
 	"github.com/acorn-io/runtime/pkg/autoupgrade"
@@ -17,12 +20,16 @@ import (
 	"github.com/acorn-io/runtime/pkg/dev"
 	"github.com/acorn-io/runtime/pkg/imagerules"
 	"github.com/acorn-io/runtime/pkg/imagesource"
+	"github.com/acorn-io/runtime/pkg/ports"
+	"github.com/acorn-io/runtime/pkg/prompt"
 	"github.com/acorn-io/runtime/pkg/rulerequest"
 	"github.com/acorn-io/runtime/pkg/wait"
 	"github.com/acorn-io/z"
+	"github.com/pterm/pterm"
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
+	"golang.org/x/exp/slices"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"sigs.k8s.io/yaml"
 )
@@ -64,10 +71,21 @@ Publish Port Syntax
  - Publish container "myapp" using the hostname app.example.com
 	acorn run --publish app.example.com:myapp .
 
+ - Publish port 80 on host port 8080, overriding the port published in the Acornfile
+	acorn run -p 8080:80 .
+
+Profile Syntax
+ - Run with the "prod" and "debug" profiles of the Acornfile, which are merged in the order the Acornfile declares them
+	acorn run --profile prod,debug .
+
 Link Syntax
  - Link the running acorn application named "mydatabase" into the current app, replacing the container named "db"
 	acorn run --link mydatabase:db .
 
+ - Link the service named "db" to the external database at db.prod.example.com, e.g. a managed database, instead of
+   running the service the Acornfile declares (the Acornfile must declare "db" as a service, container or job)
+	acorn run --link db=db.prod.example.com .
+
 Secret Syntax
 - Bind the acorn secret named "mycredentials" into the current app, replacing the secret named "creds"
 	acorn run --secret mycredentials:creds .
@@ -77,7 +95,14 @@ Volume Syntax
 	acorn run --volume mydata,size=5G,class=fast .
  
 - Bind the acorn volume named "mydata" into the current app, replacing the volume named "data"
-	acorn run --volume mydata:data .`,
+	acorn run --volume mydata:data .
+
+Signature Policy Syntax
+ - Only run the app if it and all of its nested images are signed with the key in cosign.pub
+	acorn run --signature-policy key=./cosign.pub ghcr.io/acorn-io/hello-world
+
+ - Only run the app if it is signed keyless by the given identity, with the signature annotated with env=prod
+	acorn run --signature-policy identity=ci@acme.io,issuer=https://accounts.google.com,annotation=env=prod ghcr.io/acorn-io/hello-world`,
 	})
 
 	// These will produce an error if the flag doesn't exist or a completion function has already been registered for the
@@ -102,12 +127,19 @@ Volume Syntax
 
 type Run struct {
 	RunArgs
-	Dev               bool  `usage:"Enable interactive dev mode: build image, stream logs/status in the foreground and stop on exit" short:"i"`
-	BidirectionalSync bool  `usage:"In interactive mode download changes in addition to uploading" short:"b"`
-	Wait              *bool `usage:"Wait for app to become ready before command exiting (default: true)"`
-	Quiet             bool  `usage:"Do not print status" short:"q"`
-	Update            bool  `usage:"Update the app if it already exists" short:"u"`
-	Replace           bool  `usage:"Replace the app with only defined values, resetting undefined fields to default values" json:"replace,omitempty"` // Replace sets patchMode to false, resulting in a full update, resetting all undefined fields to their defaults
+	Dev               bool     `usage:"Enable interactive dev mode: build image, stream logs/status in the foreground and stop on exit" short:"i"`
+	BidirectionalSync bool     `usage:"In interactive mode download changes in addition to uploading" short:"b"`
+	Wait              *bool    `usage:"Wait for app to become ready before command exiting (default: true)"`
+	WaitTimeout       string   `usage:"Fail if the app isn't ready within this time (ex: 5m, 90s), printing the containers that aren't ready and the last events of the app"`
+	DetachTimeout     string   `usage:"Delete the app and fail if it isn't ready within this time (ex: 5m, 90s), to clean up runs that can't start"`
+	Quiet             bool     `usage:"Do not print status" short:"q"`
+	Update            bool     `usage:"Update the app if it already exists" short:"u"`
+	Profile           []string `usage:"Activate profiles of the Acornfile, merged in the order the Acornfile declares them (ex: prod or prod,debug)"`
+	Replace           bool     `usage:"Replace the app with only defined values, resetting undefined fields to default values" json:"replace,omitempty"` // Replace sets patchMode to false, resulting in a full update, resetting all undefined fields to their defaults
+
+	// confirmDiff and yes are set by acorn update --confirm-diff and --yes
+	confirmDiff bool
+	yes         bool
 
 	out    io.Writer
 	client ClientFactory
@@ -115,7 +147,7 @@ type Run struct {
 
 type RunArgs struct {
 	UpdateArgs
-	EnvFile string `usage:"Default env vars to apply" default:".acorn.env"`
+	EnvFile string `usage:"File of environment variables to set on running containers, one KEY=VALUE per line, -e takes precedence" default:".acorn.env"`
 	Name    string `usage:"Name of app to create" short:"n"`
 }
 
@@ -136,6 +168,11 @@ func (s RunArgs) ToOpts() (client.AppRunOptions, error) {
 		return opts, err
 	}
 
+	opts.CPU, err = v1.ParseCPU(s.CPU)
+	if err != nil {
+		return opts, err
+	}
+
 	opts.ComputeClasses, err = v1.ParseComputeClass(s.ComputeClass)
 	if err != nil {
 		return opts, err
@@ -164,7 +201,11 @@ func (s RunArgs) ToOpts() (client.AppRunOptions, error) {
 		} else if err != nil {
 			return opts, err
 		} else {
-			opts.Env = append(opts.Env, v1.ParseNameValues(false, strings.Split(string(envData), "\n")...)...)
+			fileEnv, err := v1.ParseEnvFile(string(envData))
+			if err != nil {
+				return opts, fmt.Errorf("parsing %s: %w", s.EnvFile, err)
+			}
+			opts.Env = mergeEnvFile(fileEnv, opts.Env)
 		}
 	}
 
@@ -183,6 +224,13 @@ func (s RunArgs) ToOpts() (client.AppRunOptions, error) {
 		return opts, err
 	}
 
+	if s.SignaturePolicy != "" {
+		opts.SignaturePolicy, err = parseSignaturePolicy(s.SignaturePolicy)
+		if err != nil {
+			return opts, err
+		}
+	}
+
 	if s.PublishAll != nil && *s.PublishAll {
 		opts.PublishMode = v1.PublishModeAll
 	} else if s.PublishAll != nil && !*s.PublishAll {
@@ -192,6 +240,17 @@ func (s RunArgs) ToOpts() (client.AppRunOptions, error) {
 	return opts, nil
 }
 
+// mergeEnvFile returns the variables of an env file followed by env, leaving out the ones of the file that env sets too.
+func mergeEnvFile(fileEnv, env []v1.NameValue) []v1.NameValue {
+	result := make([]v1.NameValue, 0, len(fileEnv)+len(env))
+	for _, nv := range fileEnv {
+		if !slices.ContainsFunc(env, func(e v1.NameValue) bool { return e.Name == nv.Name }) {
+			result = append(result, nv)
+		}
+	}
+	return append(result, env...)
+}
+
 func (s *Run) Run(cmd *cobra.Command, args []string) (err error) {
 	defer func() {
 		if errors.Is(err, pflag.ErrHelp) {
@@ -209,12 +268,32 @@ func (s *Run) Run(cmd *cobra.Command, args []string) (err error) {
 		app         *apiv1.App
 		updated     bool
 	)
+	imageSource.Profiles = s.Profile
 
 	opts, err := s.ToOpts()
 	if err != nil {
 		return err
 	}
 
+	waitTimeout, err := s.parseTimeout("--wait-timeout", s.WaitTimeout)
+	if err != nil {
+		return err
+	}
+
+	detachTimeout, err := s.parseTimeout("--detach-timeout", s.DetachTimeout)
+	if err != nil {
+		return err
+	}
+	if detachTimeout > 0 {
+		if waitTimeout > 0 {
+			return fmt.Errorf("--detach-timeout can not be combined with --wait-timeout")
+		}
+		// Only an app created by this run is deleted, never an existing one
+		if s.Update || s.Replace {
+			return fmt.Errorf("--detach-timeout can not be combined with --update or --replace")
+		}
+	}
+
 	// If auto-upgrade is not set, set it to true if auto-upgrade is implied
 	if !z.Dereference(opts.AutoUpgrade) && autoupgrade.Implied(imageSource.Image, s.Interval, z.Dereference(opts.NotifyUpgrade)) {
 		opts.AutoUpgrade = z.Pointer(true)
@@ -226,6 +305,18 @@ func (s *Run) Run(cmd *cobra.Command, args []string) (err error) {
 		return err
 	}
 
+	if err := validateComputeClasses(cmd.Context(), c, opts.ComputeClasses); err != nil {
+		return err
+	}
+
+	if err := validateRegion(cmd.Context(), c, opts.Region); err != nil {
+		return err
+	}
+
+	if err := validateHostPorts(cmd.Context(), c, s.Name, opts.Publish); err != nil {
+		return err
+	}
+
 	if s.Dev {
 		return dev.Dev(cmd.Context(), c, &dev.Options{
 			ImageSource:       imageSource,
@@ -242,6 +333,17 @@ func (s *Run) Run(cmd *cobra.Command, args []string) (err error) {
 			if getErr == nil && (app.GetStopped() || !app.DeletionTimestamp.IsZero()) {
 				return
 			}
+			if detachTimeout > 0 {
+				err = wait.AppTimeout(cmd.Context(), c, app.Name, s.Quiet, detachTimeout)
+				if err != nil && cmd.Context().Err() == nil {
+					err = deleteUnreadyApp(cmd.Context(), c, app.Name, err)
+				}
+				return
+			}
+			if waitTimeout > 0 {
+				err = wait.AppTimeout(cmd.Context(), c, app.Name, s.Quiet, waitTimeout)
+				return
+			}
 			_ = wait.App(cmd.Context(), c, app.Name, s.Quiet)
 		}
 	}()
@@ -300,6 +402,125 @@ func (s *Run) Run(cmd *cobra.Command, args []string) (err error) {
 	return nil
 }
 
+// parseTimeout parses the value of a flag that waits for the app to become ready, returning zero if it isn't set.
+func (s *Run) parseTimeout(flag, value string) (time.Duration, error) {
+	if value == "" {
+		return 0, nil
+	}
+	if s.Wait != nil && !*s.Wait {
+		return 0, fmt.Errorf("%s can not be combined with --wait=false", flag)
+	}
+	if s.Dev {
+		return 0, fmt.Errorf("%s can not be combined with --dev", flag)
+	}
+	timeout, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s %s: %w", flag, value, err)
+	} else if timeout <= 0 {
+		return 0, fmt.Errorf("invalid %s %s, must be greater than zero", flag, value)
+	}
+	return timeout, nil
+}
+
+// deleteUnreadyApp deletes the app that didn't become ready within --detach-timeout. The deletion is best-effort, the
+// returned error is always the reason the app isn't ready, extended with the reason the deletion failed if it did.
+func deleteUnreadyApp(ctx context.Context, c client.Client, appName string, appErr error) error {
+	if _, err := c.AppDelete(ctx, appName); err != nil {
+		return fmt.Errorf("%w, failed to delete app %s: %v", appErr, appName, err)
+	}
+	fmt.Printf("Deleted app %s\n", appName)
+	return appErr
+}
+
+// validateComputeClasses fails if any of the requested compute classes isn't available in the project, listing the ones
+// that are.
+func validateComputeClasses(ctx context.Context, c client.Client, computeClasses v1.ComputeClassMap) error {
+	if len(computeClasses) == 0 {
+		return nil
+	}
+
+	available, err := c.ComputeClassList(ctx)
+	if err != nil {
+		return err
+	}
+
+	names := make([]string, 0, len(available))
+	for _, cc := range available {
+		names = append(names, cc.Name)
+	}
+	sort.Strings(names)
+
+	for _, workload := range typed.SortedKeys(computeClasses) {
+		computeClass := computeClasses[workload]
+		if computeClass == "" || slices.Contains(names, computeClass) {
+			continue
+		}
+
+		if len(names) == 0 {
+			return fmt.Errorf("compute class %s does not exist, no compute classes are available in project %s", computeClass, c.GetProject())
+		}
+		return fmt.Errorf("compute class %s does not exist, valid compute classes are: %s", computeClass, strings.Join(names, ", "))
+	}
+
+	return nil
+}
+
+// validateRegion fails if the requested region isn't available, listing the ones that are.
+func validateRegion(ctx context.Context, c client.Client, region string) error {
+	if region == "" {
+		return nil
+	}
+
+	available, err := c.RegionList(ctx)
+	if err != nil {
+		return err
+	}
+
+	names := make([]string, 0, len(available))
+	for _, r := range available {
+		if r.Name == region {
+			return nil
+		}
+		names = append(names, r.Name)
+	}
+	sort.Strings(names)
+
+	if len(names) == 0 {
+		return fmt.Errorf("region %s does not exist, no regions are available", region)
+	}
+	return fmt.Errorf("region %s does not exist, valid regions are: %s", region, strings.Join(names, ", "))
+}
+
+// validateHostPorts fails if the bindings publish different targets on the same host port, or on a host port that
+// another app in the project already publishes on.
+func validateHostPorts(ctx context.Context, c client.Client, appName string, bindings []v1.PortBinding) error {
+	if err := ports.CheckHostPortConflicts(bindings); err != nil {
+		return err
+	}
+
+	if !slices.ContainsFunc(bindings, func(b v1.PortBinding) bool { return b.Port != 0 }) {
+		return nil
+	}
+
+	apps, err := c.AppList(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, binding := range bindings {
+		for _, app := range apps {
+			if app.Name == appName {
+				continue
+			}
+			if ep, inUse := ports.HostPortInUse(binding, app.Status.AppStatus.Endpoints); inUse {
+				return fmt.Errorf("host port [%d] is already published by app %s at %s", binding.Port, app.Name, ep.Address)
+			}
+		}
+	}
+
+	return nil
+}
+
 func (s *Run) update(ctx context.Context, c client.Client, imageSource imagesource.ImageSource, opts client.AppRunOptions) (*apiv1.App, bool, error) {
 	if s.Name == "" {
 		return nil, false, fmt.Errorf("--name is required for --update or --replace")
@@ -326,13 +547,19 @@ func (s *Run) update(ctx context.Context, c client.Client, imageSource imagesour
 		updateOpts.Image = image
 		updateOpts.DeployArgs = deployArgs
 		updateOpts.Profiles = profiles
-	} else if len(imageSource.Args) > 0 {
+	} else if len(imageSource.Args) > 0 || len(imageSource.Profiles) > 0 {
 		imageSource.Image = app.Status.AppImage.Name
 		if _, updateOpts.DeployArgs, updateOpts.Profiles, err = imageSource.GetImageAndDeployArgs(ctx, c); err != nil {
 			return nil, false, err
 		}
 	}
 
+	if s.confirmDiff {
+		if err := s.confirmUpdate(ctx, c, app.Name, &updateOpts); err != nil {
+			return nil, false, err
+		}
+	}
+
 	app, err = rulerequest.PromptUpdate(ctx, c, s.Dangerous, app.Name, updateOpts)
 	if err != nil {
 		return nil, false, err
@@ -341,6 +568,38 @@ func (s *Run) update(ctx context.Context, c client.Client, imageSource imagesour
 	return app, true, nil
 }
 
+// confirmUpdate prints how updateOpts would change the app and asks for confirmation before it is applied. Without a
+// terminal the diff is only printed, so that it ends up in the log of the pipeline running the update.
+func (s *Run) confirmUpdate(ctx context.Context, c client.Client, name string, updateOpts *client.AppUpdateOptions) error {
+	updated, err := client.ToAppUpdate(ctx, c, name, updateOpts)
+	if err != nil {
+		return err
+	}
+
+	diff, err := c.AppDiff(ctx, name, &updated.Spec)
+	if err != nil {
+		return err
+	}
+
+	if len(diff.Changes) == 0 {
+		fmt.Fprintf(s.out, "%s: no changes\n", name)
+	} else if _, err := io.WriteString(s.out, formatAppDiff(diff)); err != nil {
+		return err
+	}
+
+	if s.yes || !isTerm() {
+		return nil
+	}
+
+	if ok, err := prompt.Bool("Do you want to apply these changes?", false); err != nil {
+		return err
+	} else if !ok {
+		pterm.Warning.Println("Aborting update")
+		return fmt.Errorf("aborting update of app %s", name)
+	}
+	return nil
+}
+
 func outputApp(out io.Writer, format string, app *apiv1.App) error {
 	data, err := json.Marshal(app)
 	if err != nil {
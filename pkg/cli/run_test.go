@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 
@@ -40,6 +41,27 @@ func TestRunArgs_Env(t *testing.T) {
 	assert.Equal(t, "1", opts.Env[1].Value)
 }
 
+func TestRunArgs_EnvFile(t *testing.T) {
+	envFile := filepath.Join(t.TempDir(), ".acorn.env")
+	assert.NoError(t, os.WriteFile(envFile, []byte("# settings\nx=file\ny=file\n"), 0644))
+
+	runArgs := RunArgs{
+		UpdateArgs: UpdateArgs{
+			Env: []string{"y=flag"},
+		},
+		EnvFile: envFile,
+	}
+	opts, err := runArgs.ToOpts()
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, []v1.NameValue{{Name: "x", Value: "file"}, {Name: "y", Value: "flag"}}, opts.Env)
+
+	assert.NoError(t, os.WriteFile(envFile, []byte("x=file\n=file\n"), 0644))
+	_, err = runArgs.ToOpts()
+	assert.EqualError(t, err, "parsing "+envFile+": line 2: invalid variable name \"\"")
+}
+
 func TestRun(t *testing.T) {
 	baseMock := func(f *mocks.MockClient) {
 		f.EXPECT().AppGet(gomock.Any(), gomock.Any()).DoAndReturn(
@@ -151,6 +173,62 @@ func TestRun(t *testing.T) {
 			wantErr: true,
 			wantOut: "directory ./folder does not exist",
 		},
+		{
+			name: "acorn run --wait-timeout invalid found",
+			args: args{
+				args: []string{"--wait-timeout", "soon", "found"},
+			},
+			wantErr: true,
+			wantOut: "invalid --wait-timeout soon: time: invalid duration \"soon\"",
+		},
+		{
+			name: "acorn run --wait-timeout 0s found",
+			args: args{
+				args: []string{"--wait-timeout", "0s", "found"},
+			},
+			wantErr: true,
+			wantOut: "invalid --wait-timeout 0s, must be greater than zero",
+		},
+		{
+			name: "acorn run --wait=false --wait-timeout 5m found",
+			args: args{
+				args: []string{"--wait=false", "--wait-timeout", "5m", "found"},
+			},
+			wantErr: true,
+			wantOut: "--wait-timeout can not be combined with --wait=false",
+		},
+		{
+			name: "acorn run --detach-timeout invalid found",
+			args: args{
+				args: []string{"--detach-timeout", "soon", "found"},
+			},
+			wantErr: true,
+			wantOut: "invalid --detach-timeout soon: time: invalid duration \"soon\"",
+		},
+		{
+			name: "acorn run --wait=false --detach-timeout 5m found",
+			args: args{
+				args: []string{"--wait=false", "--detach-timeout", "5m", "found"},
+			},
+			wantErr: true,
+			wantOut: "--detach-timeout can not be combined with --wait=false",
+		},
+		{
+			name: "acorn run --wait-timeout 5m --detach-timeout 5m found",
+			args: args{
+				args: []string{"--wait-timeout", "5m", "--detach-timeout", "5m", "found"},
+			},
+			wantErr: true,
+			wantOut: "--detach-timeout can not be combined with --wait-timeout",
+		},
+		{
+			name: "acorn run --update --detach-timeout 5m found",
+			args: args{
+				args: []string{"--update", "--name", "found", "--detach-timeout", "5m", "found"},
+			},
+			wantErr: true,
+			wantOut: "--detach-timeout can not be combined with --update or --replace",
+		},
 		{
 			name: "acorn_run_pointed_at_working_dir_without_acornfile", fields: fields{
 				All:   false,
@@ -237,6 +315,99 @@ func TestRun(t *testing.T) {
 			wantErr: true,
 			wantOut: "Acornfile_temp is not a directory",
 		},
+		{
+			name: "acorn run --compute-class web=unknown found", fields: fields{
+				All:   false,
+				Force: true,
+			},
+			args: args{
+				args: []string{"--compute-class", "web=unknown", "found"},
+			},
+			prepare: func(t *testing.T, f *mocks.MockClient) {
+				t.Helper()
+				f.EXPECT().Info(gomock.Any()).Return([]apiv1.Info{{}}, nil)
+				f.EXPECT().ComputeClassList(gomock.Any()).Return([]apiv1.ComputeClass{
+					{ObjectMeta: metav1.ObjectMeta{Name: "small"}},
+					{ObjectMeta: metav1.ObjectMeta{Name: "large"}},
+				}, nil)
+			},
+			wantErr: true,
+			wantOut: "compute class unknown does not exist, valid compute classes are: large, small",
+		},
+		{
+			name: "acorn run --compute-class unknown found without compute classes", fields: fields{
+				All:   false,
+				Force: true,
+			},
+			args: args{
+				args: []string{"--compute-class", "unknown", "found"},
+			},
+			prepare: func(t *testing.T, f *mocks.MockClient) {
+				t.Helper()
+				f.EXPECT().Info(gomock.Any()).Return([]apiv1.Info{{}}, nil)
+				f.EXPECT().ComputeClassList(gomock.Any()).Return(nil, nil)
+				f.EXPECT().GetProject().Return("acorn")
+			},
+			wantErr: true,
+			wantOut: "compute class unknown does not exist, no compute classes are available in project acorn",
+		},
+		{
+			name: "acorn run --region unknown found", fields: fields{
+				All:   false,
+				Force: true,
+			},
+			args: args{
+				args: []string{"--region", "unknown", "found"},
+			},
+			prepare: func(t *testing.T, f *mocks.MockClient) {
+				t.Helper()
+				f.EXPECT().Info(gomock.Any()).Return([]apiv1.Info{{}}, nil)
+				f.EXPECT().RegionList(gomock.Any()).Return([]apiv1.Region{
+					{ObjectMeta: metav1.ObjectMeta{Name: "local"}},
+					{ObjectMeta: metav1.ObjectMeta{Name: "east"}},
+				}, nil)
+			},
+			wantErr: true,
+			wantOut: "region unknown does not exist, valid regions are: east, local",
+		},
+		{
+			name: "acorn run -p 8080:80 -p 8080:81 found", fields: fields{
+				All:   false,
+				Force: true,
+			},
+			args: args{
+				args: []string{"-p", "8080:80", "-p", "8080:81", "found"},
+			},
+			prepare: func(t *testing.T, f *mocks.MockClient) {
+				t.Helper()
+				f.EXPECT().Info(gomock.Any()).Return([]apiv1.Info{{}}, nil)
+			},
+			wantErr: true,
+			wantOut: "host port [8080] is published more than once, by [8080:80] and [8080:81]",
+		},
+		{
+			name: "acorn run -p 8080:80 found with host port in use", fields: fields{
+				All:   false,
+				Force: true,
+			},
+			args: args{
+				args: []string{"-p", "8080:80", "found"},
+			},
+			prepare: func(t *testing.T, f *mocks.MockClient) {
+				t.Helper()
+				f.EXPECT().Info(gomock.Any()).Return([]apiv1.Info{{}}, nil)
+				f.EXPECT().AppList(gomock.Any()).Return([]apiv1.App{{
+					ObjectMeta: metav1.ObjectMeta{Name: "other"},
+					Status: v1.AppInstanceStatus{
+						AppStatus: v1.AppStatus{
+							Endpoints: []v1.Endpoint{{Target: "db", TargetPort: 5432, Address: "10.0.0.1:8080", Protocol: v1.ProtocolTCP}},
+						},
+					},
+				}}, nil)
+			},
+			wantErr: true,
+			wantOut: "host port [8080] is already published by app other at 10.0.0.1:8080",
+		},
 		{
 			name: "acorn run --update --name dne", fields: fields{
 				All:   false,
@@ -300,3 +471,12 @@ func TestRun(t *testing.T) {
 		})
 	}
 }
+
+func TestDeleteUnreadyApp(t *testing.T) {
+	c := &testdata.MockClient{}
+	appErr := fmt.Errorf("app found did not become ready within 5m0s")
+
+	assert.Equal(t, appErr, deleteUnreadyApp(context.Background(), c, "found", appErr))
+	assert.EqualError(t, deleteUnreadyApp(context.Background(), c, "dne", appErr),
+		"app found did not become ready within 5m0s, failed to delete app dne: error: app dne does not exist")
+}
@@ -24,6 +24,9 @@ acorn secret`,
 	cmd.AddCommand(NewSecretReveal(c))
 	cmd.AddCommand(NewSecretEncrypt(c))
 	cmd.AddCommand(NewSecretEdit(c))
+	cmd.AddCommand(NewSecretCopy(c))
+	cmd.AddCommand(NewSecretDump(c))
+	cmd.AddCommand(NewSecretRotate(c))
 	return cmd
 }
 
@@ -0,0 +1,58 @@
+package cli
+
+import (
+	"fmt"
+
+	cli "github.com/acorn-io/runtime/pkg/cli/builder"
+	"github.com/acorn-io/runtime/pkg/client"
+	"github.com/spf13/cobra"
+)
+
+func NewSecretCopy(c CommandContext) *cobra.Command {
+	return cli.Command(&SecretCopy{client: c.ClientFactory}, cobra.Command{
+		Use: "copy [flags] SECRET_NAME [NEW_NAME]",
+		Example: `
+# Copy a secret to another project
+acorn secret copy --target-project other-project my-secret
+
+# Copy a secret to another project under a new name, replacing the secret if it exists already
+acorn secret copy --target-project other-project --overwrite my-secret my-new-secret
+
+# Copy a secret within the current project
+acorn secret copy my-secret my-new-secret`,
+		SilenceUsage:      true,
+		Aliases:           []string{"cp"},
+		Short:             "Copy a secret",
+		Args:              cobra.RangeArgs(1, 2),
+		ValidArgsFunction: newCompletion(c.ClientFactory, secretsCompletion).withShouldCompleteOptions(onlyNumArgs(1)).complete,
+	})
+}
+
+type SecretCopy struct {
+	TargetProject string `usage:"Project to copy the secret to, defaults to the current project"`
+	Overwrite     bool   `usage:"Replace the secret in the target project if it already exists"`
+	client        ClientFactory
+}
+
+func (a *SecretCopy) Run(cmd *cobra.Command, args []string) error {
+	c, err := a.client.CreateDefault()
+	if err != nil {
+		return err
+	}
+
+	opts := &client.SecretCopyOptions{
+		TargetProject: a.TargetProject,
+		Overwrite:     a.Overwrite,
+	}
+	if len(args) > 1 {
+		opts.TargetName = args[1]
+	}
+
+	secret, err := c.SecretCopy(cmd.Context(), args[0], opts)
+	if err != nil {
+		return fmt.Errorf("copying %s: %w", args[0], err)
+	}
+
+	fmt.Println(secret.Name)
+	return nil
+}
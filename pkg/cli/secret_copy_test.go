@@ -0,0 +1,73 @@
+package cli
+
+import (
+	"io"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/acorn-io/runtime/pkg/cli/testdata"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSecretCopy(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr bool
+		wantOut string
+	}{
+		{
+			name:    "acorn secret copy to project",
+			args:    []string{"copy", "--target-project", "other", "secret.withdata"},
+			wantOut: "other/secret.withdata\n",
+		},
+		{
+			name:    "acorn secret copy with new name",
+			args:    []string{"copy", "secret.withdata", "copied"},
+			wantOut: "copied\n",
+		},
+		{
+			name:    "acorn secret copy to existing",
+			args:    []string{"copy", "secret.withdata", "existing"},
+			wantErr: true,
+			wantOut: "copying secret.withdata: secret existing already exists in project ",
+		},
+		{
+			name:    "acorn secret copy overwrite existing",
+			args:    []string{"copy", "--overwrite", "secret.withdata", "existing"},
+			wantOut: "existing\n",
+		},
+		{
+			name:    "acorn secret copy dne",
+			args:    []string{"copy", "dne", "copied"},
+			wantErr: true,
+			wantOut: "copying dne: error: Secret dne does not exist",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, w, _ := os.Pipe()
+			os.Stdout = w
+			cmd := NewSecret(CommandContext{
+				ClientFactory: &testdata.MockClientFactory{},
+				StdOut:        w,
+				StdErr:        w,
+				StdIn:         strings.NewReader(""),
+			})
+			cmd.SetArgs(tt.args)
+			err := cmd.Execute()
+			if err != nil && !tt.wantErr {
+				assert.Failf(t, "got err when err not expected", "got err: %s", err.Error())
+			} else if err != nil && tt.wantErr {
+				assert.Equal(t, tt.wantOut, err.Error())
+			} else if tt.wantErr {
+				assert.Fail(t, "expected an error")
+			} else {
+				w.Close()
+				out, _ := io.ReadAll(r)
+				assert.Equal(t, tt.wantOut, string(out))
+			}
+		})
+	}
+}
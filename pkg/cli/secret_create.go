@@ -8,6 +8,7 @@ import (
 	"github.com/acorn-io/aml"
 	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
 	cli "github.com/acorn-io/runtime/pkg/cli/builder"
+	"github.com/acorn-io/runtime/pkg/encryption/nacl"
 	"github.com/spf13/cobra"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 )
@@ -23,7 +24,10 @@ acorn secret create --data key-name=value --data key-name2=value2 my-secret
 acorn secret create --file secret.yaml my-secret
 
 # Read key value from a file
-acorn secret create --data @key-name=secret.yaml my-secret`,
+acorn secret create --data @key-name=secret.yaml my-secret
+
+# Create a secret from a value encrypted with acorn secret encrypt, e.g. one committed to git
+acorn secret create --from-encrypted @password=password.enc my-secret`,
 		SilenceUsage: true,
 		Short:        "Create a secret",
 		Args:         cobra.ExactArgs(1),
@@ -32,9 +36,10 @@ acorn secret create --data @key-name=secret.yaml my-secret`,
 }
 
 type SecretFactory struct {
-	Data []string `usage:"Secret data format key=value or @key=filename to read from file"`
-	File string   `usage:"File to read for entire secret in aml/yaml/json format"`
-	Type string   `usage:"Secret type"`
+	Data          []string `usage:"Secret data format key=value or @key=filename to read from file"`
+	FromEncrypted []string `usage:"Secret data encrypted with acorn secret encrypt, format key=value or @key=filename to read from file"`
+	File          string   `usage:"File to read for entire secret in aml/yaml/json format"`
+	Type          string   `usage:"Secret type"`
 }
 
 type SecretCreate struct {
@@ -64,17 +69,25 @@ func (a *SecretFactory) buildSecret() (*apiv1.Secret, error) {
 	}
 
 	for _, kv := range a.Data {
-		key, value, ok := strings.Cut(kv, "=")
-		if !ok {
-			return nil, fmt.Errorf("invalid data format [%s] must be in key=value form", kv)
+		key, value, err := parseSecretData(kv)
+		if err != nil {
+			return nil, err
 		}
-		if strings.HasPrefix(key, "@") {
-			key = key[1:]
-			content, err := os.ReadFile(value)
-			if err != nil {
-				return nil, fmt.Errorf("reading %s: %w", value, err)
-			}
-			value = string(content)
+		if secret.Data == nil {
+			secret.Data = map[string][]byte{}
+		}
+		secret.Data[key] = []byte(value)
+	}
+
+	for _, kv := range a.FromEncrypted {
+		key, value, err := parseSecretData(kv)
+		if err != nil {
+			return nil, err
+		}
+		// Encrypted files usually end with a newline, which isn't part of the value
+		value = strings.TrimSpace(value)
+		if !nacl.IsAcornEncryptedData([]byte(value)) {
+			return nil, fmt.Errorf("invalid encrypted data for key %s: must be a value written by acorn secret encrypt", key)
 		}
 		if secret.Data == nil {
 			secret.Data = map[string][]byte{}
@@ -89,6 +102,23 @@ func (a *SecretFactory) buildSecret() (*apiv1.Secret, error) {
 	return &secret.Secret, nil
 }
 
+// parseSecretData parses secret data in the form key=value, or @key=filename to read the value from the file
+func parseSecretData(kv string) (string, string, error) {
+	key, value, ok := strings.Cut(kv, "=")
+	if !ok {
+		return "", "", fmt.Errorf("invalid data format [%s] must be in key=value form", kv)
+	}
+	if strings.HasPrefix(key, "@") {
+		key = key[1:]
+		content, err := os.ReadFile(value)
+		if err != nil {
+			return "", "", fmt.Errorf("reading %s: %w", value, err)
+		}
+		value = string(content)
+	}
+	return key, value, nil
+}
+
 func (a *SecretCreate) Run(cmd *cobra.Command, args []string) error {
 	client, err := a.client.CreateDefault()
 	if err != nil {
@@ -28,3 +28,27 @@ func TestBuildSecret(t *testing.T) {
 		"key4": []byte("value4"),
 	}, secret.Data)
 }
+
+func TestBuildSecretFromEncrypted(t *testing.T) {
+	c := SecretCreate{
+		SecretFactory: SecretFactory{
+			Data:          []string{"key1=value1"},
+			FromEncrypted: []string{"key2=ACORNENC:e30::", "@key3=testdata/secret/value3.enc"},
+		},
+	}
+
+	secret, err := c.buildSecret()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, map[string][]byte{
+		"key1": []byte("value1"),
+		"key2": []byte("ACORNENC:e30::"),
+		"key3": []byte("ACORNENC:eyJrZXkiOiJ2YWx1ZSJ9::"),
+	}, secret.Data)
+
+	c.FromEncrypted = []string{"key2=plaintext"}
+	_, err = c.buildSecret()
+	assert.EqualError(t, err, "invalid encrypted data for key key2: must be a value written by acorn secret encrypt")
+}
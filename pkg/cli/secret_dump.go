@@ -0,0 +1,154 @@
+package cli
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/acorn-io/baaah/pkg/typed"
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	cli "github.com/acorn-io/runtime/pkg/cli/builder"
+	"github.com/acorn-io/runtime/pkg/prompt"
+	"github.com/spf13/cobra"
+	"sigs.k8s.io/yaml"
+)
+
+func NewSecretDump(c CommandContext) *cobra.Command {
+	return cli.Command(&SecretDump{out: c.StdOut, err: c.StdErr, client: c.ClientFactory}, cobra.Command{
+		Use: "dump [flags]",
+		Example: `
+# Print the data of all secrets of the current project as SECRET_KEY=VALUE lines
+acorn secret dump --yes
+
+# Save all secrets in YAML documents that can be restored one by one with acorn secret create --file
+acorn secret dump --format yaml --yes > secrets.yaml`,
+		Long: `Reveal the data of all secrets of the project
+
+Prints the data of every secret of the project that can be revealed in plain text. Secrets whose data you are not
+authorized to reveal, or whose data is only available on the server, are skipped with a note.
+
+The env format prefixes every key with the name of its secret, so that the lines can be sourced by a shell. It is meant
+to be read, use the yaml format to back up secrets, it is the only one that acorn secret create --file can restore.`,
+		SilenceUsage: true,
+		Short:        "Reveal the data of all secrets of the project",
+		Args:         cobra.NoArgs,
+	})
+}
+
+type SecretDump struct {
+	Format string `usage:"Output format (env, yaml), only yaml can be restored with acorn secret create --file" default:"env"`
+	Yes    bool   `usage:"Don't ask for confirmation before printing secret data" short:"y"`
+	out    io.Writer
+	err    io.Writer
+	client ClientFactory
+}
+
+// dumpedSecret is a secret in the format read by acorn secret create --file
+type dumpedSecret struct {
+	Metadata   dumpedSecretMetadata `json:"metadata"`
+	Type       string               `json:"type,omitempty"`
+	StringData map[string]string    `json:"stringData,omitempty"`
+}
+
+type dumpedSecretMetadata struct {
+	Name string `json:"name"`
+}
+
+func (a *SecretDump) Run(cmd *cobra.Command, args []string) error {
+	if a.Format != "env" && a.Format != "yaml" {
+		return fmt.Errorf("invalid format %s, must be env or yaml", a.Format)
+	}
+
+	if !a.Yes && !isTerm() {
+		return fmt.Errorf("secret dump prints secret data in plain text, pass --yes to confirm")
+	}
+	fmt.Fprintln(a.err, "WARNING: the data of all secrets of the project will be printed in plain text")
+	if !a.Yes {
+		if ok, err := prompt.Bool("Do you want to continue?", false); err != nil || !ok {
+			return err
+		}
+	}
+
+	c, err := a.client.CreateDefault()
+	if err != nil {
+		return err
+	}
+
+	result, err := c.SecretRevealAll(cmd.Context())
+	if err != nil {
+		return err
+	}
+
+	for _, skipped := range result.Skipped {
+		fmt.Fprintf(a.err, "Skipped secret %s: %s\n", skipped.Name, skipped.Reason)
+	}
+
+	output := formatSecretsEnv(result.Secrets)
+	if a.Format == "yaml" {
+		output, err = formatSecretsYAML(result.Secrets)
+		if err != nil {
+			return err
+		}
+	}
+	_, err = io.WriteString(a.out, output)
+	return err
+}
+
+// formatSecretsEnv renders each secret as a comment naming the secret followed by a SECRET_KEY='VALUE' line per key,
+// so that keys of different secrets don't collide.
+func formatSecretsEnv(secrets []apiv1.Secret) string {
+	sb := &strings.Builder{}
+	for i, secret := range secrets {
+		if i > 0 {
+			sb.WriteString("\n")
+		}
+		fmt.Fprintf(sb, "# secret %s", secret.Name)
+		if secret.Type != "" {
+			fmt.Fprintf(sb, " (type %s)", secret.Type)
+		}
+		sb.WriteString("\n")
+		for _, entry := range typed.Sorted(secret.Data) {
+			fmt.Fprintf(sb, "%s='%s'\n", envName(secret.Name, entry.Key), strings.ReplaceAll(string(entry.Value), "'", `'\''`))
+		}
+	}
+	return sb.String()
+}
+
+// envName returns the variable name of a key of a secret, the secret name and key joined by an underscore, upper cased
+// and with every character that isn't allowed in a variable name replaced by an underscore.
+func envName(secretName, key string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '_':
+			return r
+		case r >= 'a' && r <= 'z':
+			return r - 'a' + 'A'
+		default:
+			return '_'
+		}
+	}, secretName+"_"+key)
+}
+
+// formatSecretsYAML renders each secret as a YAML document that acorn secret create --file accepts.
+func formatSecretsYAML(secrets []apiv1.Secret) (string, error) {
+	sb := &strings.Builder{}
+	for _, secret := range secrets {
+		dumped := dumpedSecret{
+			Metadata: dumpedSecretMetadata{Name: secret.Name},
+			Type:     secret.Type,
+		}
+		for key, value := range secret.Data {
+			if dumped.StringData == nil {
+				dumped.StringData = map[string]string{}
+			}
+			dumped.StringData[key] = string(value)
+		}
+		data, err := yaml.Marshal(dumped)
+		if err != nil {
+			return "", err
+		}
+		sb.WriteString("---\n")
+		sb.Write(data)
+	}
+	return sb.String(), nil
+}
@@ -0,0 +1,103 @@
+package cli
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/cli/testdata"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestSecretDump(t *testing.T) {
+	secrets := []apiv1.Secret{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "creds"},
+			Type:       "basic",
+			Data: map[string][]byte{
+				"username": []byte("admin"),
+				"password": []byte("it's secret"),
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "sealed"},
+			Type:       "opaque",
+			Keys:       []string{"key"},
+		},
+	}
+
+	tests := []struct {
+		name       string
+		args       []string
+		wantErr    bool
+		wantOut    string
+		wantErrOut string
+	}{
+		{
+			name: "acorn secret dump --yes",
+			args: []string{"dump", "--yes"},
+			wantOut: "# secret creds (type basic)\n" +
+				"CREDS_PASSWORD='it'\\''s secret'\n" +
+				"CREDS_USERNAME='admin'\n",
+			wantErrOut: "WARNING: the data of all secrets of the project will be printed in plain text\n" +
+				"Skipped secret sealed: its data is only available on the server\n",
+		},
+		{
+			name: "acorn secret dump --format yaml --yes",
+			args: []string{"dump", "--format", "yaml", "--yes"},
+			wantOut: "---\n" +
+				"metadata:\n" +
+				"  name: creds\n" +
+				"stringData:\n" +
+				"  password: it's secret\n" +
+				"  username: admin\n" +
+				"type: basic\n",
+			wantErrOut: "WARNING: the data of all secrets of the project will be printed in plain text\n" +
+				"Skipped secret sealed: its data is only available on the server\n",
+		},
+		{
+			name:    "acorn secret dump --format json --yes",
+			args:    []string{"dump", "--format", "json", "--yes"},
+			wantErr: true,
+			wantOut: "invalid format json, must be env or yaml",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out, errOut := &bytes.Buffer{}, &bytes.Buffer{}
+			cmd := NewSecret(CommandContext{
+				ClientFactory: &testdata.MockClientFactory{SecretList: secrets},
+				StdOut:        out,
+				StdErr:        errOut,
+				StdIn:         strings.NewReader(""),
+			})
+			cmd.SetArgs(tt.args)
+			err := cmd.Execute()
+			if err != nil && !tt.wantErr {
+				assert.Failf(t, "got err when err not expected", "got err: %s", err.Error())
+			} else if err != nil && tt.wantErr {
+				assert.Equal(t, tt.wantOut, err.Error())
+			} else if tt.wantErr {
+				assert.Fail(t, "expected an error")
+			} else {
+				assert.Equal(t, tt.wantOut, out.String())
+				assert.Equal(t, tt.wantErrOut, errOut.String())
+			}
+		})
+	}
+}
+
+func TestSecretDumpEnvNames(t *testing.T) {
+	secrets := []apiv1.Secret{
+		{ObjectMeta: metav1.ObjectMeta{Name: "db-creds"}, Data: map[string][]byte{"password": []byte("a")}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "api-creds"}, Data: map[string][]byte{"password": []byte("b"), ".dockerconfigjson": []byte("{}")}},
+	}
+	assert.Equal(t, "# secret db-creds\n"+
+		"DB_CREDS_PASSWORD='a'\n"+
+		"\n"+
+		"# secret api-creds\n"+
+		"API_CREDS__DOCKERCONFIGJSON='{}'\n"+
+		"API_CREDS_PASSWORD='b'\n", formatSecretsEnv(secrets))
+}
@@ -9,6 +9,8 @@ import (
 	"github.com/AlecAivazis/survey/v2"
 	cli "github.com/acorn-io/runtime/pkg/cli/builder"
 	"github.com/acorn-io/runtime/pkg/cli/builder/table"
+	"github.com/acorn-io/runtime/pkg/client"
+	acornsign "github.com/acorn-io/runtime/pkg/cosign"
 	"github.com/acorn-io/runtime/pkg/encryption/nacl"
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
@@ -20,7 +22,14 @@ const (
 
 func NewSecretEncrypt(c CommandContext) *cobra.Command {
 	cmd := cli.Command(&Encrypt{client: c.ClientFactory}, cobra.Command{
-		Use:          "encrypt [flags] STRING",
+		Use: "encrypt [flags] STRING",
+		Example: `
+# Encrypt a value with the public keys of the project
+acorn secret encrypt my-password
+
+# Save the public key of the project to encrypt values without access to the cluster later
+acorn secret encrypt --export-key > pub.pem
+acorn secret encrypt --key pub.pem my-password`,
 		SilenceUsage: true,
 		Short:        "Encrypt string information with clusters public key",
 		Args:         cobra.MaximumNArgs(1),
@@ -31,6 +40,8 @@ func NewSecretEncrypt(c CommandContext) *cobra.Command {
 type Encrypt struct {
 	PlaintextStdin bool     `usage:"Take the plaintext from stdin"`
 	PublicKey      []string `usage:"Pass one or more cluster publicKey values"`
+	Key            []string `usage:"PEM file of a public key to encrypt with, as written by --export-key"`
+	ExportKey      bool     `usage:"Print the public keys of the project PEM encoded instead of encrypting"`
 	client         ClientFactory
 }
 
@@ -43,6 +54,22 @@ func (e *Encrypt) Run(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	if e.ExportKey {
+		return exportPublicKeys(cmd, c)
+	}
+
+	for _, file := range e.Key {
+		pemBytes, err := os.ReadFile(file)
+		if err != nil {
+			return err
+		}
+		key, err := nacl.PublicKeyFromPEM(pemBytes)
+		if err != nil {
+			return fmt.Errorf("reading %s: %w", file, err)
+		}
+		e.PublicKey = append(e.PublicKey, key)
+	}
+
 	if e.PlaintextStdin && len(args) == 0 {
 		contents, err := io.ReadAll(os.Stdin)
 		if err != nil {
@@ -105,3 +132,29 @@ func (e *Encrypt) Run(cmd *cobra.Command, args []string) error {
 
 	return out.Err()
 }
+
+// exportPublicKeys prints the public keys of the regions of the project as PEM encoded X25519 keys
+func exportPublicKeys(cmd *cobra.Command, c client.Client) error {
+	fullInfo, err := c.Info(cmd.Context())
+	if err != nil {
+		return err
+	}
+	for _, info := range fullInfo {
+		for _, region := range info.Regions {
+			for _, key := range region.PublicKeys {
+				x25519Key, err := nacl.X25519PublicKey(key.KeyID)
+				if err != nil {
+					return fmt.Errorf("public key %s: %w", key.KeyID, err)
+				}
+				encoded, _, err := acornsign.PemEncodeCryptoPublicKey(x25519Key)
+				if err != nil {
+					return err
+				}
+				if _, err := cmd.OutOrStdout().Write(encoded); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
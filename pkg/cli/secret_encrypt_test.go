@@ -0,0 +1,72 @@
+package cli
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/acorn-io/runtime/pkg/cli/testdata"
+	acornsign "github.com/acorn-io/runtime/pkg/cosign"
+	"github.com/acorn-io/runtime/pkg/encryption/nacl"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/nacl/box"
+)
+
+func TestSecretEncryptWithKey(t *testing.T) {
+	publicKey, privateKey, err := box.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	b64Key := nacl.KeyBytesToB64String(publicKey)
+
+	x25519Key, err := nacl.X25519PublicKey(b64Key)
+	require.NoError(t, err)
+	encoded, _, err := acornsign.PemEncodeCryptoPublicKey(x25519Key)
+	require.NoError(t, err)
+	keyFile := filepath.Join(t.TempDir(), "pub.pem")
+	require.NoError(t, os.WriteFile(keyFile, encoded, 0600))
+
+	r, w, _ := os.Pipe()
+	stdout := os.Stdout
+	os.Stdout = w
+	defer func() { os.Stdout = stdout }()
+
+	cmd := NewSecret(CommandContext{
+		ClientFactory: &testdata.MockClientFactory{},
+		StdOut:        w,
+		StdErr:        w,
+		StdIn:         strings.NewReader(""),
+	})
+	cmd.SetArgs([]string{"encrypt", "--key", keyFile, "my-password"})
+	require.NoError(t, cmd.Execute())
+	w.Close()
+	out, _ := io.ReadAll(r)
+
+	envelope := strings.TrimSpace(string(out))
+	require.True(t, strings.HasPrefix(envelope, nacl.EncPrefix) && strings.HasSuffix(envelope, nacl.EncSuffix), envelope)
+	data, err := base64.RawURLEncoding.DecodeString(strings.TrimSuffix(strings.TrimPrefix(envelope, nacl.EncPrefix), nacl.EncSuffix))
+	require.NoError(t, err)
+
+	sealed := map[string]string{}
+	require.NoError(t, json.Unmarshal(data, &sealed))
+	require.Contains(t, sealed, b64Key)
+	box64, err := base64.RawURLEncoding.DecodeString(sealed[b64Key])
+	require.NoError(t, err)
+
+	plaintext, ok := box.OpenAnonymous(nil, box64, publicKey, privateKey)
+	require.True(t, ok)
+	assert.Equal(t, "my-password", string(plaintext))
+}
+
+func TestSecretEncryptWithInvalidKey(t *testing.T) {
+	cmd := NewSecret(CommandContext{
+		ClientFactory: &testdata.MockClientFactory{},
+		StdIn:         strings.NewReader(""),
+	})
+	cmd.SetArgs([]string{"encrypt", "--key", "testdata/secret/value2.txt", "my-password"})
+	assert.EqualError(t, cmd.Execute(), "reading testdata/secret/value2.txt: invalid public key: no PEM encoded PUBLIC KEY block found")
+}
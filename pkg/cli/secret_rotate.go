@@ -0,0 +1,54 @@
+package cli
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	cli "github.com/acorn-io/runtime/pkg/cli/builder"
+	"github.com/spf13/cobra"
+)
+
+func NewSecretRotate(c CommandContext) *cobra.Command {
+	return cli.Command(&SecretRotate{out: c.StdOut, client: c.ClientFactory}, cobra.Command{
+		Use: "rotate [flags] SECRET_NAME",
+		Example: `
+# Regenerate the password of the db secret of my-app
+acorn secret rotate my-app.db`,
+		Long: `Regenerate the data of a token or basic secret that an app generated
+
+Keys whose value the app sets explicitly keep their value. The containers that use the secret roll out the new value,
+containers that use it with onChange noAction are restarted. Secrets that were not generated by an app can not be rotated.`,
+		SilenceUsage:      true,
+		Short:             "Regenerate a generated secret",
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: newCompletion(c.ClientFactory, secretsCompletion).withShouldCompleteOptions(onlyNumArgs(1)).complete,
+	})
+}
+
+type SecretRotate struct {
+	out    io.Writer
+	client ClientFactory
+}
+
+func (a *SecretRotate) Run(cmd *cobra.Command, args []string) error {
+	c, err := a.client.CreateDefault()
+	if err != nil {
+		return err
+	}
+
+	result, err := c.SecretRotate(cmd.Context(), args[0])
+	if err != nil {
+		return err
+	}
+
+	if _, err := fmt.Fprintf(a.out, "%s: regenerated %s\n", args[0], strings.Join(result.Keys, ", ")); err != nil {
+		return err
+	}
+	if len(result.Apps) == 0 {
+		_, err = fmt.Fprintln(a.out, "No apps use the secret")
+	} else {
+		_, err = fmt.Fprintf(a.out, "Affected apps: %s\n", strings.Join(result.Apps, ", "))
+	}
+	return err
+}
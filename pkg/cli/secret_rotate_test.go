@@ -0,0 +1,69 @@
+package cli
+
+import (
+	"io"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/acorn-io/runtime/pkg/cli/testdata"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSecretRotate(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr bool
+		wantOut string
+	}{
+		{
+			name:    "acorn secret rotate found.db",
+			args:    []string{"rotate", "found.db"},
+			wantOut: "found.db: regenerated password\nAffected apps: found\n",
+		},
+		{
+			name:    "acorn secret rotate found.secret",
+			args:    []string{"rotate", "found.secret"},
+			wantErr: true,
+			wantOut: "secret found.secret was not generated by an app, rotation only applies to generated secrets of type token or basic",
+		},
+		{
+			name:    "acorn secret rotate dne",
+			args:    []string{"rotate", "dne"},
+			wantErr: true,
+			wantOut: "error: Secret dne does not exist",
+		},
+		{
+			name:    "acorn secret rotate",
+			args:    []string{"rotate"},
+			wantErr: true,
+			wantOut: "accepts 1 arg(s), received 0",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, w, _ := os.Pipe()
+			os.Stdout = w
+			cmd := NewSecret(CommandContext{
+				ClientFactory: &testdata.MockClientFactory{},
+				StdOut:        w,
+				StdErr:        w,
+				StdIn:         strings.NewReader(""),
+			})
+			cmd.SetArgs(tt.args)
+			err := cmd.Execute()
+			if err != nil && !tt.wantErr {
+				assert.Failf(t, "got err when err not expected", "got err: %s", err.Error())
+			} else if err != nil && tt.wantErr {
+				assert.Equal(t, tt.wantOut, err.Error())
+			} else if tt.wantErr {
+				assert.Fail(t, "expected an error")
+			} else {
+				w.Close()
+				out, _ := io.ReadAll(r)
+				assert.Equal(t, tt.wantOut, string(out))
+			}
+		})
+	}
+}
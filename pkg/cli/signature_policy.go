@@ -0,0 +1,77 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	acornsign "github.com/acorn-io/runtime/pkg/cosign"
+	signatureannotations "github.com/acorn-io/runtime/pkg/imageselector/signatures/annotations"
+)
+
+// parseSignaturePolicy parses the signature policy flag. A key that refers to a local file is replaced by the
+// file's content, since the policy is enforced by the API server.
+func parseSignaturePolicy(arg string) (*v1.SignaturePolicy, error) {
+	var (
+		policy      v1.SignaturePolicy
+		annotations = map[string]string{}
+	)
+
+	for _, part := range strings.Split(arg, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+
+		k, v, ok := strings.Cut(part, "=")
+		if !ok || v == "" {
+			return nil, fmt.Errorf("invalid signature policy [%s]: expected KEY=VALUE, got [%s]", arg, part)
+		}
+
+		switch k {
+		case "key":
+			policy.Key = v
+		case "identity":
+			policy.CertIdentity = v
+		case "issuer":
+			policy.CertOidcIssuer = v
+		case "annotation":
+			name, value, ok := strings.Cut(v, "=")
+			if !ok || name == "" {
+				return nil, fmt.Errorf("invalid signature policy [%s]: expected annotation=NAME=VALUE, got [%s]", arg, part)
+			}
+			annotations[name] = value
+		default:
+			return nil, fmt.Errorf("invalid signature policy [%s]: unknown option [%s], expected key, identity, issuer or annotation", arg, k)
+		}
+	}
+
+	keyless := policy.CertIdentity != "" || policy.CertOidcIssuer != ""
+	if policy.Key == "" && !keyless {
+		return nil, fmt.Errorf("invalid signature policy [%s]: key or identity and issuer required", arg)
+	} else if policy.Key != "" && keyless {
+		return nil, fmt.Errorf("invalid signature policy [%s]: key and identity cannot be used together", arg)
+	} else if keyless && (policy.CertIdentity == "" || policy.CertOidcIssuer == "") {
+		return nil, fmt.Errorf("invalid signature policy [%s]: both identity and issuer required", arg)
+	}
+
+	if policy.Key != "" {
+		if _, err := os.Stat(policy.Key); err == nil {
+			keyFileBytes, err := os.ReadFile(policy.Key)
+			if err != nil {
+				return nil, err
+			}
+			if acornsign.PrivateKeyPattern.Match(keyFileBytes) {
+				return nil, fmt.Errorf("key file %s is a private key, not a public key", policy.Key)
+			}
+			policy.Key = string(keyFileBytes)
+		}
+	}
+
+	if len(annotations) > 0 {
+		policy.Annotations = signatureannotations.ParseMatch(annotations)
+	}
+
+	return &policy, nil
+}
@@ -2,30 +2,46 @@ package cli
 
 import (
 	cli "github.com/acorn-io/runtime/pkg/cli/builder"
+	"github.com/acorn-io/runtime/pkg/client"
 	"github.com/spf13/cobra"
 )
 
 func NewTag(c CommandContext) *cobra.Command {
 	return cli.Command(&Tag{client: c.ClientFactory}, cobra.Command{
-		Use:               "tag [flags] SOURCE_IMAGE[:TAG] TARGET_IMAGE[:TAG]",
-		SilenceUsage:      true,
-		Short:             "Tag an image",
+		Use:          "tag [flags] SOURCE_IMAGE[:TAG] TARGET_IMAGE[:TAG]",
+		SilenceUsage: true,
+		Short:        "Tag an image",
+		Long: `Tag an image
+
+Cosign signatures are stored next to the image in its repository, keyed by the image digest, so they don't
+follow a tag that points to another repository. With --copy-signatures the signatures are copied to the
+repository of the new tag. Tagging never changes the digest, so the copied signatures stay valid. This only
+applies to tags in the registry the image is stored in.`,
+		Example: `
+# Tag an image
+acorn tag my-image my-repo/my-image:v1
+
+# Tag an image and copy its signatures, so they can be verified against the new tag
+acorn tag --copy-signatures my-image my-repo/my-image:v1`,
 		Args:              cobra.ExactArgs(2),
 		ValidArgsFunction: newCompletion(c.ClientFactory, imagesCompletion(true)).withShouldCompleteOptions(onlyNumArgs(2)).complete,
 	})
 }
 
 type Tag struct {
-	client ClientFactory
+	CopySignatures bool `usage:"Copy the cosign signatures of the image to the repository of the new tag"`
+	client         ClientFactory
 }
 
 func (s *Tag) Run(cmd *cobra.Command, args []string) error {
-	client, err := s.client.CreateDefault()
+	c, err := s.client.CreateDefault()
 	if err != nil {
 		return err
 	}
 
 	src, tag := args[0], args[1]
 
-	return client.ImageTag(cmd.Context(), src, tag)
+	return c.ImageTag(cmd.Context(), src, tag, &client.ImageTagOptions{
+		CopySignatures: s.CopySignatures,
+	})
 }
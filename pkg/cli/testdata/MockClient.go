@@ -3,6 +3,7 @@ package testdata
 import (
 	"context"
 	"fmt"
+	"io"
 	"net"
 
 	"github.com/acorn-io/baaah/pkg/typed"
@@ -12,10 +13,15 @@ import (
 	"github.com/acorn-io/runtime/pkg/client/term"
 	"github.com/acorn-io/runtime/pkg/labels"
 	"github.com/acorn-io/runtime/pkg/project"
+	"github.com/acorn-io/runtime/pkg/tags"
+	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
 )
 
 type MockClientFactoryManual struct {
@@ -172,6 +178,122 @@ func (m *MockClient) AppInfo(ctx context.Context, name string) (string, error) {
 	panic("implement me")
 }
 
+func (m *MockClient) AppMetrics(ctx context.Context, name string) (*apiv1.AppMetrics, error) {
+	switch name {
+	case "dne":
+		return nil, fmt.Errorf("error: app %s does not exist", name)
+	case "nometrics":
+		return nil, fmt.Errorf("the metrics API (metrics.k8s.io) is not available in the cluster, metrics-server needs to be installed to show the resource usage of apps")
+	case "found.container":
+		return &apiv1.AppMetrics{ObjectMeta: metav1.ObjectMeta{Name: name}}, nil
+	}
+	return &apiv1.AppMetrics{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Containers: []apiv1.ContainerReplicaMetrics{
+			{
+				Name:          "found.web-7f9c4-x2x8q",
+				ContainerName: "web",
+				Usage:         corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("5m"), corev1.ResourceMemory: resource.MustParse("20Mi")},
+				Requests:      corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("50m"), corev1.ResourceMemory: resource.MustParse("64Mi")},
+				Limits:        corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("128Mi")},
+			},
+			{
+				Name:          "found.db-5d8b6-r4k2p",
+				ContainerName: "db",
+				Usage:         corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("120m"), corev1.ResourceMemory: resource.MustParse("256Mi")},
+				Requests:      corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("250m"), corev1.ResourceMemory: resource.MustParse("512Mi")},
+				Limits:        corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("512Mi")},
+			},
+			{
+				Name:          "found.db-5d8b6-r4k2p:proxy",
+				ContainerName: "db",
+				SidecarName:   "proxy",
+				Usage:         corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1m"), corev1.ResourceMemory: resource.MustParse("300Mi")},
+			},
+			{
+				Name:          "found.web-7f9c4-zz9k1",
+				ContainerName: "web",
+			},
+		},
+	}, nil
+}
+
+func (m *MockClient) AppDiff(ctx context.Context, name string, newSpec *v1.AppInstanceSpec) (*client.AppDiff, error) {
+	app, err := m.AppGet(ctx, name)
+	if err != nil {
+		return nil, err
+	} else if app == nil {
+		return nil, fmt.Errorf("error: app %s does not exist", name)
+	}
+
+	diff := &client.AppDiff{Name: app.Name}
+	if len(newSpec.Environment) > len(app.Spec.Environment) {
+		change := client.AppFieldChange{Field: "environment"}
+		for _, env := range newSpec.Environment[len(app.Spec.Environment):] {
+			change.Added = append(change.Added, env.Name+"="+env.Value)
+		}
+		diff.Changes = append(diff.Changes, change)
+	}
+	return diff, nil
+}
+
+func (m *MockClient) AppContainerEnv(ctx context.Context, name, container string, opts *client.AppContainerEnvOptions) ([]client.AppContainerEnvVar, error) {
+	app, err := m.AppGet(ctx, name)
+	if err != nil {
+		return nil, err
+	} else if app == nil {
+		return nil, fmt.Errorf("error: app %s does not exist", name)
+	}
+	spec, ok := app.Status.AppSpec.Containers[container]
+	if !ok {
+		return nil, fmt.Errorf("app %s has no container %s", name, container)
+	}
+	var result []client.AppContainerEnvVar
+	for _, env := range spec.Environment {
+		if env.Secret.Name == "" {
+			result = append(result, client.AppContainerEnvVar{Name: env.Name, Value: env.Value})
+			continue
+		}
+		v := client.AppContainerEnvVar{
+			Name:   env.Name,
+			Masked: true,
+			Source: "secret " + name + "." + env.Secret.Name + "." + env.Secret.Key,
+		}
+		if opts != nil && opts.Reveal {
+			v.Value, v.Masked = "revealed", false
+		}
+		result = append(result, v)
+	}
+	return result, nil
+}
+
+func (m *MockClient) AppExport(ctx context.Context, name string, opts *client.AppExportOptions) ([]byte, error) {
+	app, err := m.AppGet(ctx, name)
+	if err != nil {
+		return nil, err
+	} else if app == nil {
+		return nil, fmt.Errorf("error: app %s does not exist", name)
+	}
+	return yaml.Marshal(client.AppBundle{Name: app.Name, Spec: app.Spec})
+}
+
+func (m *MockClient) AppImport(ctx context.Context, bundle []byte, opts *client.AppImportOptions) (*apiv1.App, error) {
+	var exported client.AppBundle
+	if err := yaml.Unmarshal(bundle, &exported); err != nil {
+		return nil, err
+	}
+	if opts != nil && opts.Name != "" {
+		exported.Name = opts.Name
+	}
+	if exported.Name == "found" {
+		return nil, fmt.Errorf("app %s already exists", exported.Name)
+	}
+	return &apiv1.App{
+		ObjectMeta: metav1.ObjectMeta{Name: exported.Name},
+		Spec:       exported.Spec,
+	}, nil
+}
+
 func (m *MockClient) AppPullImage(ctx context.Context, name string) error {
 	return nil
 }
@@ -207,6 +329,29 @@ func (m *MockClient) AppIgnoreDeleteCleanup(ctx context.Context, name string) er
 	return nil
 }
 
+func (m *MockClient) AppRestart(ctx context.Context, name string, opts *client.AppRestartOptions) error {
+	switch {
+	case name != "found":
+		return fmt.Errorf("error: app %s does not exist", name)
+	case opts != nil && opts.Container != "" && opts.Container != "found":
+		return fmt.Errorf("app %s has no container %s", name, opts.Container)
+	}
+	return nil
+}
+
+func (m *MockClient) AppStatusStream(ctx context.Context, name string) (<-chan apiv1.App, error) {
+	app, err := m.AppGet(ctx, name)
+	if err != nil {
+		return nil, err
+	} else if app == nil {
+		return nil, fmt.Errorf("error: app %s does not exist", name)
+	}
+	result := make(chan apiv1.App, 1)
+	result <- *app
+	close(result)
+	return result, nil
+}
+
 func (m *MockClient) AppGet(ctx context.Context, name string) (*apiv1.App, error) {
 	if m.AppItem != nil {
 		return m.AppItem, nil
@@ -256,6 +401,93 @@ func (m *MockClient) AppStart(ctx context.Context, name string) error {
 	return fmt.Errorf("error: app %s does not exist", name)
 }
 
+func (m *MockClient) AppPause(ctx context.Context, name string) error {
+	switch name {
+	case "found":
+		return nil
+	case "stopped":
+		return fmt.Errorf("app %s is stopped, start it before pausing it", name)
+	}
+	return fmt.Errorf("error: app %s does not exist", name)
+}
+
+func (m *MockClient) AppResume(ctx context.Context, name string) error {
+	switch name {
+	case "found":
+		return nil
+	case "running":
+		return fmt.Errorf("app %s is not paused", name)
+	}
+	return fmt.Errorf("error: app %s does not exist", name)
+}
+
+func (m *MockClient) AppRename(ctx context.Context, oldName, newName string) (*apiv1.App, error) {
+	switch {
+	case oldName != "found":
+		return nil, fmt.Errorf("error: app %s does not exist", oldName)
+	case newName == "found" || newName == "existing":
+		return nil, fmt.Errorf("app %s already exists", newName)
+	}
+	return &apiv1.App{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: newName,
+		},
+	}, nil
+}
+
+func (m *MockClient) ServiceGrant(ctx context.Context, publisher, consumer string) (*apiv1.App, error) {
+	if publisher != "found" {
+		return nil, fmt.Errorf("error: app %s does not exist", publisher)
+	}
+	return &apiv1.App{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: publisher,
+		},
+		Spec: v1.AppInstanceSpec{
+			ServiceGrants: &v1.ServiceGrants{
+				Consumers: []string{consumer},
+			},
+		},
+	}, nil
+}
+
+func (m *MockClient) ServiceRevoke(ctx context.Context, publisher, consumer string) (*apiv1.App, error) {
+	switch {
+	case publisher != "found":
+		return nil, fmt.Errorf("error: app %s does not exist", publisher)
+	case consumer != "granted":
+		return nil, fmt.Errorf("app %s has no grant to %s", publisher, consumer)
+	}
+	return &apiv1.App{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: publisher,
+		},
+		Spec: v1.AppInstanceSpec{
+			ServiceGrants: &v1.ServiceGrants{},
+		},
+	}, nil
+}
+
+func (m *MockClient) AppScale(ctx context.Context, name, container string, replicas int32) (*apiv1.App, error) {
+	if m.AppItem != nil {
+		return m.AppItem, nil
+	}
+	switch {
+	case name != "found":
+		return nil, fmt.Errorf("error: app %s does not exist", name)
+	case container != "web":
+		return nil, fmt.Errorf("app %s has no container %s", name, container)
+	}
+	return &apiv1.App{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+		},
+		Spec: v1.AppInstanceSpec{
+			Scale: map[string]int32{container: replicas},
+		},
+	}, nil
+}
+
 func (m *MockClient) AppRun(ctx context.Context, image string, opts *client.AppRunOptions) (*apiv1.App, error) {
 	if m.AppItem != nil {
 		return m.AppItem, nil
@@ -306,6 +538,10 @@ func (m *MockClient) AppUpdate(ctx context.Context, name string, opts *client.Ap
 	return nil, fmt.Errorf("error: app %s does not exist", name)
 }
 
+func (m *MockClient) AppLogsFollow(ctx context.Context, name string, opts *client.LogOptions) (<-chan apiv1.LogMessage, error) {
+	return m.AppLog(ctx, name, opts)
+}
+
 func (m *MockClient) AppLog(ctx context.Context, name string, opts *client.LogOptions) (<-chan apiv1.LogMessage, error) {
 	switch name {
 	case "found":
@@ -369,6 +605,24 @@ func (m *MockClient) CredentialUpdate(ctx context.Context, serverAddress, userna
 	return nil, nil
 }
 
+func (m *MockClient) CredentialValidate(ctx context.Context, serverAddress string, opts *client.CredentialValidateOptions) (*client.CredentialValidation, error) {
+	result := &client.CredentialValidation{
+		ServerAddress: serverAddress,
+	}
+	if opts != nil && opts.Auth != nil {
+		result.Username = opts.Auth.Username
+	}
+	if opts != nil && opts.Repository != "" {
+		result.Scopes = []string{"repository:" + opts.Repository + ":push,pull"}
+	}
+	if opts == nil || opts.Auth == nil || opts.Auth.Password != "valid" {
+		result.Error = "UNAUTHORIZED: authentication required"
+		return result, nil
+	}
+	result.Valid = true
+	return result, nil
+}
+
 func (m *MockClient) CredentialDelete(ctx context.Context, serverAddress string) (*apiv1.Credential, error) {
 	if m.CredentialItem != nil {
 		return m.CredentialItem, nil
@@ -474,6 +728,61 @@ func (m *MockClient) SecretDelete(ctx context.Context, name string) (*apiv1.Secr
 	return nil, nil
 }
 
+func (m *MockClient) SecretCopy(ctx context.Context, name string, opts *client.SecretCopyOptions) (*apiv1.Secret, error) {
+	secret, err := m.SecretReveal(ctx, name)
+	if err != nil {
+		return nil, err
+	} else if secret == nil {
+		return nil, fmt.Errorf("error: Secret %s does not exist", name)
+	}
+
+	targetName := opts.TargetName
+	if targetName == "" {
+		targetName = name
+	}
+	if targetName == "existing" && !opts.Overwrite {
+		return nil, fmt.Errorf("secret %s already exists in project %s", targetName, opts.TargetProject)
+	}
+	if opts.TargetProject != "" {
+		targetName = opts.TargetProject + "/" + targetName
+	}
+
+	copied := secret.DeepCopy()
+	copied.Name = targetName
+	return copied, nil
+}
+
+func (m *MockClient) SecretRevealAll(ctx context.Context) (*client.SecretRevealAllResult, error) {
+	secrets, err := m.SecretList(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &client.SecretRevealAllResult{}
+	for _, secret := range secrets {
+		if len(secret.Data) == 0 && len(secret.Keys) > 0 {
+			result.Skipped = append(result.Skipped, client.SkippedSecret{Name: secret.Name, Reason: "its data is only available on the server"})
+			continue
+		}
+		result.Secrets = append(result.Secrets, secret)
+	}
+	return result, nil
+}
+
+func (m *MockClient) SecretRotate(ctx context.Context, name string) (*apiv1.SecretRotate, error) {
+	switch name {
+	case "dne":
+		return nil, fmt.Errorf("error: Secret %s does not exist", name)
+	case "found.secret":
+		return nil, fmt.Errorf("secret %s was not generated by an app, rotation only applies to generated secrets of type token or basic", name)
+	}
+	return &apiv1.SecretRotate{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Keys:       []string{"password"},
+		Apps:       []string{"found"},
+	}, nil
+}
+
 func (m *MockClient) ContainerReplicaList(ctx context.Context, opts *client.ContainerReplicaListOptions) ([]apiv1.ContainerReplica, error) {
 	if m.Containers != nil {
 		if opts == nil {
@@ -651,6 +960,97 @@ func (m *MockClient) VolumeDelete(ctx context.Context, name string) (*apiv1.Volu
 	return nil, nil
 }
 
+func (m *MockClient) VolumeResize(ctx context.Context, name, newSize string) (*apiv1.Volume, error) {
+	vol, err := m.VolumeGet(ctx, name)
+	if err != nil {
+		return nil, err
+	} else if vol == nil {
+		return nil, fmt.Errorf("error: volume %s does not exist", name)
+	}
+	vol = vol.DeepCopy()
+	if vol.Spec.Capacity == nil {
+		vol.Spec.Capacity = resource.NewQuantity(10_000_000_000, resource.DecimalSI)
+	}
+	return vol, nil
+}
+
+func (m *MockClient) VolumeSnapshotCreate(ctx context.Context, volumeName, name string) (*apiv1.VolumeSnapshot, error) {
+	vol, err := m.VolumeGet(ctx, volumeName)
+	if err != nil {
+		return nil, err
+	} else if vol == nil {
+		return nil, fmt.Errorf("error: volume %s does not exist", volumeName)
+	}
+	if name == "" {
+		name = "snapshot-abcde"
+	}
+	return &apiv1.VolumeSnapshot{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: apiv1.VolumeSnapshotSpec{
+			Volume: vol.Name,
+		},
+		Status: apiv1.VolumeSnapshotStatus{
+			AppName:    vol.Status.AppName,
+			VolumeName: vol.Status.VolumeName,
+		},
+	}, nil
+}
+
+func (m *MockClient) VolumeSnapshotList(ctx context.Context) ([]apiv1.VolumeSnapshot, error) {
+	snapshot, err := m.VolumeSnapshotGet(ctx, "found-snapshot")
+	if err != nil {
+		return nil, err
+	}
+	return []apiv1.VolumeSnapshot{*snapshot}, nil
+}
+
+func (m *MockClient) VolumeSnapshotGet(ctx context.Context, name string) (*apiv1.VolumeSnapshot, error) {
+	snapshot := &apiv1.VolumeSnapshot{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: apiv1.VolumeSnapshotSpec{
+			Volume: "found.vol",
+		},
+		Status: apiv1.VolumeSnapshotStatus{
+			AppName:    "found",
+			VolumeName: "vol",
+		},
+	}
+
+	switch name {
+	case "found-snapshot":
+		snapshot.Status.Ready = true
+		snapshot.Status.Size = resource.NewQuantity(10_000_000_000, resource.DecimalSI)
+		return snapshot, nil
+	case "pending-snapshot":
+		return snapshot, nil
+	}
+	return nil, fmt.Errorf("error: volume snapshot %s does not exist", name)
+}
+
+func (m *MockClient) VolumeSnapshotDelete(ctx context.Context, name string) (*apiv1.VolumeSnapshot, error) {
+	snapshot, err := m.VolumeSnapshotGet(ctx, name)
+	if err != nil {
+		return nil, nil
+	}
+	return snapshot, nil
+}
+
+func (m *MockClient) VolumeSnapshotRestore(ctx context.Context, volumeName, snapshotName string) (*apiv1.Volume, error) {
+	vol, err := m.VolumeGet(ctx, volumeName)
+	if err != nil {
+		return nil, err
+	} else if vol == nil {
+		return nil, fmt.Errorf("error: volume %s does not exist", volumeName)
+	}
+	snapshot, err := m.VolumeSnapshotGet(ctx, snapshotName)
+	if err != nil {
+		return nil, err
+	} else if !snapshot.Status.Ready {
+		return nil, fmt.Errorf("snapshot %s is not ready yet", snapshotName)
+	}
+	return vol, nil
+}
+
 func (m *MockClient) ImageList(ctx context.Context) ([]apiv1.Image, error) {
 	if m.Images != nil {
 		return m.Images, nil
@@ -672,6 +1072,20 @@ func (m *MockClient) ImageList(ctx context.Context) ([]apiv1.Image, error) {
 	}}, nil
 }
 
+func (m *MockClient) ImagePrune(ctx context.Context, opts *client.ImagePruneOptions) ([]apiv1.Image, error) {
+	images, err := m.ImageList(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result []apiv1.Image
+	for _, image := range images {
+		if len(image.Tags) == 0 {
+			result = append(result, image)
+		}
+	}
+	return result, nil
+}
+
 func (m *MockClient) ImageGet(ctx context.Context, name string) (*apiv1.Image, error) {
 	if m.ImageItem != nil {
 		return m.ImageItem, nil
@@ -744,7 +1158,14 @@ func (m *MockClient) ImagePull(ctx context.Context, name string, opts *client.Im
 	}
 }
 
-func (m *MockClient) ImageTag(ctx context.Context, image, tag string) error {
+func (m *MockClient) ImageIndexCreate(ctx context.Context, tag string, entries []client.ImageIndexEntry, opts *client.ImageIndexCreateOptions) (string, error) {
+	if len(entries) == 0 {
+		return "", fmt.Errorf("at least one image is required to create an index")
+	}
+	return "ghcr.io/acme/app@sha256:1234567890abcdef1234567890abcdef1234567890abcdef1234567890abcdef", nil
+}
+
+func (m *MockClient) ImageTag(ctx context.Context, image, tag string, opts *client.ImageTagOptions) error {
 	switch image {
 	case "dne":
 		return fmt.Errorf("error: tag %s does not exist", image)
@@ -755,7 +1176,18 @@ func (m *MockClient) ImageTag(ctx context.Context, image, tag string) error {
 }
 
 func (m *MockClient) ImageDetails(ctx context.Context, imageName string, opts *client.ImageDetailsOptions) (*client.ImageDetails, error) {
+	if opts != nil && opts.Local && !tags.HasNoSpecifiedRegistry(imageName) {
+		return nil, fmt.Errorf("image %s was not found in the local image store", imageName)
+	}
+	var images []apiv1.ContainedImage
+	if opts != nil && opts.ListImages {
+		images = []apiv1.ContainedImage{
+			{Name: "test-image-running-container", Type: "container", Repo: "ghcr.io/acorn-io/test", Digest: "sha256:1234567890", Size: 12345678},
+			{Name: "db", Type: "acorn", Repo: "ghcr.io/acorn-io/db", Digest: "sha256:0987654321", Size: 2048},
+		}
+	}
 	return &client.ImageDetails{
+		Images: images,
 		AppImage: v1.AppImage{ID: imageName, ImageData: v1.ImagesData{
 			Containers: map[string]v1.ContainerData{"test-image-running-container": {
 				Image:    "test-image-running-container",
@@ -770,18 +1202,96 @@ func (m *MockClient) ImageDetails(ctx context.Context, imageName string, opts *c
 	}, nil
 }
 
+func (m *MockClient) ImageExtractFile(ctx context.Context, imageName, filePath string, out io.Writer, opts *client.ImageExtractOptions) error {
+	if imageName == "dne" {
+		return fmt.Errorf("error: image %s does not exist", imageName)
+	}
+	_, err := fmt.Fprintf(out, "content of %s in %s", filePath, imageName)
+	return err
+}
+
+func (m *MockClient) ImageHistory(ctx context.Context, imageName string, opts *client.ImageHistoryOptions) (*client.ImageHistory, error) {
+	if imageName == "dne" {
+		return nil, fmt.Errorf("error: image %s does not exist", imageName)
+	}
+	history := &client.ImageHistory{
+		Image:  imageName,
+		Digest: "sha256:1234567890",
+		Layers: []client.ImageHistoryLayer{{
+			Container: "web",
+			Digest:    "sha256:abcdef1234567890abcdef1234567890abcdef1234567890abcdef1234567890",
+			CreatedBy: "/bin/sh -c #(nop) ADD file:1234567890abcdef1234567890abcdef in / ",
+			Size:      3400000,
+		}, {
+			Container:  "web",
+			CreatedBy:  `CMD ["/bin/sh"]`,
+			Comment:    "buildkit.dockerfile.v0",
+			EmptyLayer: true,
+		}},
+	}
+	if imageName == "provenance" {
+		history.Provenance = []client.ImageProvenance{{
+			Container:     "web",
+			PredicateType: "https://slsa.dev/provenance/v0.2",
+			Source:        "buildkit",
+			Digest:        "sha256:fedcba0987654321fedcba0987654321fedcba0987654321fedcba0987654321",
+		}}
+	}
+	return history, nil
+}
+
+func (m *MockClient) ImageScan(ctx context.Context, imageName string, opts *client.ImageScanOptions) (*client.ImageScanResult, error) {
+	if imageName == "dne" {
+		return nil, fmt.Errorf("error: image %s does not exist", imageName)
+	}
+	return &client.ImageScanResult{
+		Image:   imageName,
+		Digest:  "sha256:1234567890",
+		Scanner: "trivy",
+		Findings: []client.ImageScanFinding{{
+			ID:               "CVE-2023-0001",
+			Target:           "ghcr.io/acorn-io/test (alpine 3.18)",
+			Package:          "openssl",
+			InstalledVersion: "3.1.0",
+			FixedVersion:     "3.1.1",
+			Severity:         "HIGH",
+			Title:            "openssl: buffer overflow",
+			URL:              "https://avd.aquasec.com/nvd/cve-2023-0001",
+		}, {
+			ID:               "CVE-2023-0002",
+			Target:           "ghcr.io/acorn-io/test (alpine 3.18)",
+			Package:          "busybox",
+			InstalledVersion: "1.36.0",
+			Severity:         "LOW",
+			Title:            "busybox: out of bounds read",
+		}},
+	}, nil
+}
+
 func (m *MockClient) ImageSign(ctx context.Context, image string, payload []byte, signatureB64 string, opts *client.ImageSignOptions) (*apiv1.ImageSignature, error) {
-	return &apiv1.ImageSignature{
+	sig := &apiv1.ImageSignature{
 		TypeMeta:        metav1.TypeMeta{},
 		ObjectMeta:      metav1.ObjectMeta{Name: "found-image1234567"},
 		SignatureDigest: "1234abcd",
-	}, nil
+	}
+	if opts != nil && len(opts.Attestation) > 0 {
+		sig.AttestationDigest = "5678efgh"
+	}
+	return sig, nil
 }
 
 func (m *MockClient) ImageVerify(ctx context.Context, image string, opts *client.ImageVerifyOptions) (*apiv1.ImageSignature, error) {
 	return nil, nil
 }
 
+func (m *MockClient) ImageSignatures(ctx context.Context, image string, opts *client.ImageSignaturesOptions) ([]apiv1.ImageSignatureEntry, error) {
+	return nil, nil
+}
+
+func (m *MockClient) ImageUnsign(ctx context.Context, image string, signatureDigest string, opts *client.ImageUnsignOptions) error {
+	return nil
+}
+
 func (m *MockClient) BuilderCreate(ctx context.Context) (*apiv1.Builder, error) { return nil, nil }
 
 func (m *MockClient) BuilderGet(ctx context.Context) (*apiv1.Builder, error) { return nil, nil }
@@ -881,7 +1391,7 @@ func (m *MockClient) ProjectGet(ctx context.Context, name string) (*apiv1.Projec
 	return nil, nil
 }
 
-func (m *MockClient) ProjectCreate(ctx context.Context, name string, region string, supportedRegions []string) (*apiv1.Project, error) {
+func (m *MockClient) ProjectCreate(ctx context.Context, name string, opts *client.ProjectCreateOptions) (*apiv1.Project, error) {
 	// TODO implement me
 	panic("implement me")
 }
@@ -938,7 +1448,18 @@ func (m *MockClient) RegionGet(_ context.Context, name string) (*apiv1.Region, e
 	}, name)
 }
 
-func (m *MockClient) EventStream(context.Context, *client.EventStreamOptions) (<-chan apiv1.Event, error) {
-	// TODO: Implement me
-	return nil, nil
+func (m *MockClient) EventStream(_ context.Context, opts *client.EventStreamOptions) (<-chan apiv1.Event, error) {
+	selector, err := fields.ParseSelector(opts.FieldSelector)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(chan apiv1.Event, len(m.Events))
+	for _, event := range m.Events {
+		if selector.Matches(fields.Set{"appName": event.AppName}) {
+			result <- event
+		}
+	}
+	close(result)
+	return result, nil
 }
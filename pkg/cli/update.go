@@ -1,11 +1,18 @@
 package cli
 
 import (
+	"context"
 	"fmt"
 	"io"
+	"strings"
+	"time"
 
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
 	cli "github.com/acorn-io/runtime/pkg/cli/builder"
+	"github.com/acorn-io/runtime/pkg/client"
 	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/util/sets"
 )
 
 func NewUpdate(c CommandContext) *cobra.Command {
@@ -22,7 +29,13 @@ func NewUpdate(c CommandContext) *cobra.Command {
     acorn update --image . my-app
 
   # Enable auto-upgrade on an Acorn called "my-app"
-    acorn update --auto-upgrade my-app`,
+    acorn update --auto-upgrade my-app
+
+  # Resolve the tag of the image of an Acorn called "my-app" again, to deploy an image pushed to the same tag
+    acorn update --pull my-app
+
+  # Show how the update would change an Acorn called "my-app" and ask for confirmation before applying it
+    acorn update --confirm-diff --image <new image> my-app`,
 	})
 
 	cmd.Flags().SetInterspersed(false)
@@ -30,34 +43,38 @@ func NewUpdate(c CommandContext) *cobra.Command {
 }
 
 type UpdateArgs struct {
-	Region        string   `usage:"Region in which to deploy the app, immutable"`
-	File          string   `short:"f" usage:"Name of the build file (default \"DIRECTORY/Acornfile\")"`
-	ArgsFile      string   `usage:"Default args to apply to run/update command" default:".args.acorn"`
-	Volume        []string `usage:"Bind an existing volume (format existing:vol-name,field=value) (ex: pvc-name:app-data)" short:"v" split:"false"`
-	Secret        []string `usage:"Bind an existing secret (format existing:sec-name) (ex: sec-name:app-secret)" short:"s"`
-	Link          []string `usage:"Link external app as a service in the current app (format app-name:container-name)"`
-	PublishAll    *bool    `usage:"Publish all (true) or none (false) of the defined ports of application" short:"P"`
-	Publish       []string `usage:"Publish port of application (format [public:]private) (ex 81:80)" short:"p"`
-	Env           []string `usage:"Environment variables to set on running containers" short:"e"`
-	Label         []string `usage:"Add labels to the app and the resources it creates (format [type:][name:]key=value) (ex k=v, containers:k=v)" short:"l"`
-	Annotation    []string `usage:"Add annotations to the app and the resources it creates (format [type:][name:]key=value) (ex k=v, containers:k=v)"`
-	Dangerous     bool     `usage:"Automatically approve all privileges requested by the application"`
-	Output        string   `usage:"Output API request without creating app (json, yaml)" short:"o"`
-	NotifyUpgrade *bool    `usage:"If true and the app is configured for auto-upgrades, you will be notified in the CLI when an upgrade is available and must confirm it"`
-	AutoUpgrade   *bool    `usage:"Enabled automatic upgrades."`
-	Interval      string   `usage:"If configured for auto-upgrade, this is the time interval at which to check for new releases (ex: 1h, 5m)"`
-	Memory        []string `usage:"Set memory for a workload in the format of workload=memory. Only specify an amount to set all workloads. (ex foo=512Mi or 512Mi)" short:"m"`
-	ComputeClass  []string `usage:"Set computeclass for a workload in the format of workload=computeclass. Specify a single computeclass to set all workloads. (ex foo=example-class or example-class)"`
+	Region          string   `usage:"Region in which to deploy the app, immutable"`
+	File            string   `short:"f" usage:"Name of the build file (default \"DIRECTORY/Acornfile\")"`
+	ArgsFile        string   `usage:"Default args to apply to run/update command" default:".args.acorn"`
+	Volume          []string `usage:"Bind an existing volume (format existing:vol-name,field=value) (ex: pvc-name:app-data)" short:"v" split:"false"`
+	Secret          []string `usage:"Bind an existing secret (format existing:sec-name) (ex: sec-name:app-secret)" short:"s"`
+	Link            []string `usage:"Link external app as a service in the current app (format app-name:container-name), or a service to an external hostname or IP address (format service-name=address)"`
+	PublishAll      *bool    `usage:"Publish all (true) or none (false) of the defined ports of application" short:"P"`
+	Publish         []string `usage:"Publish port of application (format [public:]private) (ex 81:80)" short:"p"`
+	Env             []string `usage:"Environment variables to set on running containers" short:"e"`
+	Label           []string `usage:"Add labels to the app and the resources it creates (format [type:][name:]key=value) (ex k=v, containers:k=v)" short:"l"`
+	Annotation      []string `usage:"Add annotations to the app and the resources it creates (format [type:][name:]key=value) (ex k=v, containers:k=v)"`
+	Dangerous       bool     `usage:"Automatically approve all privileges requested by the application"`
+	Output          string   `usage:"Output API request without creating app (json, yaml)" short:"o"`
+	NotifyUpgrade   *bool    `usage:"If true and the app is configured for auto-upgrades, you will be notified in the CLI when an upgrade is available and must confirm it"`
+	AutoUpgrade     *bool    `usage:"Enabled automatic upgrades."`
+	Interval        string   `usage:"If configured for auto-upgrade, this is the time interval at which to check for new releases (ex: 1h, 5m)"`
+	Memory          []string `usage:"Set memory for a workload in the format of workload=memory. Only specify an amount to set all workloads. (ex foo=512Mi or 512Mi)" short:"m"`
+	CPU             []string `usage:"Set the CPU request for a workload in the format of workload=cpu. Only specify an amount to set all workloads. (ex foo=500m or 1)"`
+	ComputeClass    []string `usage:"Set computeclass for a workload in the format of workload=computeclass. Specify a single computeclass to set all workloads. (ex foo=example-class or example-class)"`
+	SignaturePolicy string   `usage:"Only run the app if it and all of its nested images are signed (format key=KEY or identity=IDENTITY,issuer=ISSUER, optionally followed by annotation=NAME=VALUE to require annotations) (ex key=./cosign.pub,annotation=env=prod)"`
 }
 
 type Update struct {
 	UpdateArgs
-	EnvFile        string `usage:"Default env vars to apply to update command" default:""`
+	EnvFile        string `usage:"File of environment variables to set on running containers, one KEY=VALUE per line, -e takes precedence" default:""`
 	Image          string `usage:"Acorn image name"`
 	ConfirmUpgrade bool   `usage:"When an auto-upgrade app is marked as having an upgrade available, pass this flag to confirm the upgrade. Used in conjunction with --notify-upgrade."`
-	Pull           bool   `usage:"Re-pull the app's image, which will cause the app to re-deploy if the image has changed"`
+	Pull           bool   `usage:"Re-pull the app's image and its nested images, which will cause the app to re-deploy if the digest of any of them has changed"`
 	Wait           *bool  `usage:"Wait for app to become ready before command exiting (default: true)"`
 	Quiet          bool   `usage:"Do not print status" short:"q"`
+	ConfirmDiff    bool   `usage:"Print how the update would change the app and ask for confirmation before applying it, without a terminal the diff is printed and the update applied"`
+	Yes            bool   `usage:"Don't ask for confirmation of the diff printed by --confirm-diff" short:"y"`
 
 	out    io.Writer
 	client ClientFactory
@@ -82,6 +99,14 @@ func (s *Update) Run(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("only --confirm-upgrade or --pull can be set at once")
 	}
 
+	if s.Yes && !s.ConfirmDiff {
+		return fmt.Errorf("--yes requires --confirm-diff")
+	}
+
+	if s.ConfirmDiff && (s.ConfirmUpgrade || s.Pull) {
+		return fmt.Errorf("--confirm-diff can not be combined with --confirm-upgrade or --pull")
+	}
+
 	if s.ConfirmUpgrade {
 		err := c.AppConfirmUpgrade(cmd.Context(), name)
 		if err != nil {
@@ -92,21 +117,18 @@ func (s *Update) Run(cmd *cobra.Command, args []string) error {
 	}
 
 	if s.Pull {
-		err := c.AppPullImage(cmd.Context(), name)
-		if err != nil {
-			return err
-		}
-		fmt.Println(name)
-		return nil
+		return s.pull(cmd.Context(), c, name)
 	}
 
 	r := Run{
-		RunArgs: s.getRunArgs(name),
-		Wait:    s.Wait,
-		Quiet:   s.Quiet,
-		Update:  true,
-		out:     s.out,
-		client:  s.client,
+		RunArgs:     s.getRunArgs(name),
+		Wait:        s.Wait,
+		Quiet:       s.Quiet,
+		Update:      true,
+		confirmDiff: s.ConfirmDiff,
+		yes:         s.Yes,
+		out:         s.out,
+		client:      s.client,
 	}
 	return r.Run(cmd, append([]string{s.Image}, args...))
 }
@@ -118,3 +140,128 @@ func (s *Update) getRunArgs(name string) RunArgs {
 		EnvFile:    s.EnvFile,
 	}
 }
+
+// pull resolves the tag of the image of the app again and, unless --wait=false is set, waits for the pull to finish
+// and prints how the digests of the app image and its nested images changed.
+func (s *Update) pull(ctx context.Context, c client.Client, name string) error {
+	app, err := c.AppGet(ctx, name)
+	if err != nil {
+		return err
+	}
+	before := stagedAppImage(app)
+
+	if err := c.AppPullImage(ctx, name); err != nil {
+		return err
+	}
+
+	if s.Quiet || (s.Wait != nil && !*s.Wait) {
+		fmt.Println(name)
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, pullTimeout)
+	defer cancel()
+
+	updates, err := c.AppStatusStream(ctx, name)
+	if err != nil {
+		return err
+	}
+
+	for app := range updates {
+		if app.Status.AvailableAppImage != "" {
+			if cond := app.Status.Condition(v1.AppInstanceConditionPulled); cond.Error {
+				return fmt.Errorf("failed to pull image of app %s: %s", name, cond.Message)
+			}
+			continue
+		}
+
+		after := stagedAppImage(&app)
+		changes := imageDigestChanges(before, after)
+		if len(changes) == 0 {
+			fmt.Fprintf(s.out, "%s: image %s is up to date (%s)\n", name, after.Name, shortDigest(after.Digest))
+			return nil
+		}
+		fmt.Fprintf(s.out, "%s: pulled image %s\n", name, after.Name)
+		for _, change := range changes {
+			fmt.Fprintf(s.out, "  %s: %s -> %s\n", change.Image, shortDigest(change.Old), shortDigest(change.New))
+		}
+		return nil
+	}
+
+	if err := ctx.Err(); err != nil {
+		return fmt.Errorf("timed out waiting for the image of app %s to be pulled: %w", name, err)
+	}
+	return fmt.Errorf("stopped watching app %s before its image was pulled", name)
+}
+
+// pullTimeout is how long acorn update --pull waits for the controller to pull the image of the app
+const pullTimeout = 5 * time.Minute
+
+// stagedAppImage returns the app image that the last pull of the app resolved to
+func stagedAppImage(app *apiv1.App) v1.AppImage {
+	if app.Status.Staged.AppImage.ID != "" {
+		return app.Status.Staged.AppImage
+	}
+	return app.Status.AppImage
+}
+
+type imageDigestChange struct {
+	Image string
+	Old   string
+	New   string
+}
+
+// imageDigestChanges lists the app image and the nested images whose digest differs between before and after. Images
+// that were added or removed have an empty Old or New digest.
+func imageDigestChanges(before, after v1.AppImage) (result []imageDigestChange) {
+	if before.Digest != after.Digest {
+		result = append(result, imageDigestChange{Image: "app " + after.Name, Old: before.Digest, New: after.Digest})
+	}
+
+	oldImages, newImages := nestedImages(before.ImageData), nestedImages(after.ImageData)
+	for _, key := range sets.StringKeySet(oldImages).Union(sets.StringKeySet(newImages)).List() {
+		if oldImages[key] != newImages[key] {
+			result = append(result, imageDigestChange{Image: key, Old: oldImages[key], New: newImages[key]})
+		}
+	}
+	return result
+}
+
+// nestedImages returns the images of the containers, sidecars, functions, jobs, images and nested acorns of an app
+// image, keyed by a description like "sidecar web.proxy"
+func nestedImages(data v1.ImagesData) map[string]string {
+	result := map[string]string{}
+	addContainers := func(kind string, containers map[string]v1.ContainerData) {
+		for name, container := range containers {
+			result[kind+" "+name] = container.Image
+			for sidecarName, sidecar := range container.Sidecars {
+				result["sidecar "+name+"."+sidecarName] = sidecar.Image
+			}
+		}
+	}
+	addContainers("container", data.Containers)
+	addContainers("function", data.Functions)
+	addContainers("job", data.Jobs)
+	for name, image := range data.Images {
+		result["image "+name] = image.Image
+	}
+	for name, acorn := range data.Acorns {
+		result["acorn "+name] = acorn.Image
+	}
+	return result
+}
+
+// shortDigest abbreviates an image digest or reference to the first 12 characters of its hash, like docker does
+func shortDigest(image string) string {
+	if image == "" {
+		return "none"
+	}
+	if i := strings.LastIndex(image, "@"); i >= 0 {
+		image = image[i+1:]
+	}
+	algorithm, hash, ok := strings.Cut(image, ":")
+	if !ok || len(hash) <= 12 {
+		return image
+	}
+	return algorithm + ":" + hash[:12]
+}
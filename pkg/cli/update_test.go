@@ -6,9 +6,12 @@ import (
 	"strings"
 	"testing"
 
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
 	"github.com/acorn-io/runtime/pkg/cli/testdata"
 	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 func TestUpdate(t *testing.T) {
@@ -69,6 +72,44 @@ func TestUpdate(t *testing.T) {
 			wantErr: true,
 			wantOut: "error: app dne does not exist",
 		},
+		{
+			name: "acorn update --yes found", fields: fields{
+				All:    false,
+				Quiet:  false,
+				Output: "",
+			},
+			commandContext: CommandContext{
+				ClientFactory: &testdata.MockClientFactory{},
+				StdOut:        w,
+				StdErr:        w,
+				StdIn:         strings.NewReader(""),
+			},
+			args: args{
+				args:   []string{"--yes", "found"},
+				client: &testdata.MockClient{},
+			},
+			wantErr: true,
+			wantOut: "--yes requires --confirm-diff",
+		},
+		{
+			name: "acorn update --confirm-diff --pull found", fields: fields{
+				All:    false,
+				Quiet:  false,
+				Output: "",
+			},
+			commandContext: CommandContext{
+				ClientFactory: &testdata.MockClientFactory{},
+				StdOut:        w,
+				StdErr:        w,
+				StdIn:         strings.NewReader(""),
+			},
+			args: args{
+				args:   []string{"--confirm-diff", "--pull", "found"},
+				client: &testdata.MockClient{},
+			},
+			wantErr: true,
+			wantOut: "--confirm-diff can not be combined with --confirm-upgrade or --pull",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -90,3 +131,93 @@ func TestUpdate(t *testing.T) {
 		})
 	}
 }
+
+func TestUpdatePull(t *testing.T) {
+	app := &apiv1.App{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-app"},
+		Status: v1.AppInstanceStatus{
+			Staged: v1.AppStatusStaged{
+				AppImage: v1.AppImage{
+					ID:     "0123456789abcdef",
+					Name:   "ghcr.io/org/app:v1",
+					Digest: "sha256:0123456789abcdef0123456789abcdef",
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name    string
+		args    []string
+		wantOut string
+	}{
+		{
+			name:    "acorn update --pull my-app",
+			args:    []string{"--pull", "my-app"},
+			wantOut: "my-app: image ghcr.io/org/app:v1 is up to date (sha256:0123456789ab)\n",
+		},
+		{
+			name:    "acorn update --pull --wait=false my-app",
+			args:    []string{"--pull", "--wait=false", "my-app"},
+			wantOut: "my-app\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, w, _ := os.Pipe()
+			os.Stdout = w
+			cmd := NewUpdate(CommandContext{
+				ClientFactory: &testdata.MockClientFactory{AppItem: app},
+				StdOut:        w,
+				StdErr:        w,
+				StdIn:         strings.NewReader(""),
+			})
+			cmd.SetArgs(tt.args)
+			assert.NoError(t, cmd.Execute())
+			w.Close()
+			out, _ := io.ReadAll(r)
+			assert.Equal(t, tt.wantOut, string(out))
+		})
+	}
+}
+
+func TestImageDigestChanges(t *testing.T) {
+	before := v1.AppImage{
+		Name:   "ghcr.io/org/app:v1",
+		Digest: "sha256:aaaaaaaaaaaaaaaaaaaa",
+		ImageData: v1.ImagesData{
+			Containers: map[string]v1.ContainerData{
+				"web": {
+					Image: "sha256:bbbbbbbbbbbbbbbbbbbb",
+					Sidecars: map[string]v1.ImageData{
+						"proxy": {Image: "sha256:cccccccccccccccccccc"},
+					},
+				},
+			},
+			Jobs: map[string]v1.ContainerData{
+				"migrate": {Image: "sha256:dddddddddddddddddddd"},
+			},
+		},
+	}
+	after := *before.DeepCopy()
+	after.Digest = "sha256:eeeeeeeeeeeeeeeeeeee"
+	after.ImageData.Containers["web"].Sidecars["proxy"] = v1.ImageData{Image: "sha256:ffffffffffffffffffff"}
+	delete(after.ImageData.Jobs, "migrate")
+	after.ImageData.Acorns = map[string]v1.ImageData{"db": {Image: "sha256:1111111111111111111"}}
+
+	assert.Equal(t, []imageDigestChange{
+		{Image: "app ghcr.io/org/app:v1", Old: "sha256:aaaaaaaaaaaaaaaaaaaa", New: "sha256:eeeeeeeeeeeeeeeeeeee"},
+		{Image: "acorn db", New: "sha256:1111111111111111111"},
+		{Image: "job migrate", Old: "sha256:dddddddddddddddddddd"},
+		{Image: "sidecar web.proxy", Old: "sha256:cccccccccccccccccccc", New: "sha256:ffffffffffffffffffff"},
+	}, imageDigestChanges(before, after))
+
+	assert.Empty(t, imageDigestChanges(before, before))
+}
+
+func TestShortDigest(t *testing.T) {
+	assert.Equal(t, "sha256:0123456789ab", shortDigest("sha256:0123456789abcdef"))
+	assert.Equal(t, "sha256:0123456789ab", shortDigest("ghcr.io/org/app@sha256:0123456789abcdef"))
+	assert.Equal(t, "0123456789abcdef", shortDigest("0123456789abcdef"))
+	assert.Equal(t, "none", shortDigest(""))
+}
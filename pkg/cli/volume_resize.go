@@ -0,0 +1,50 @@
+package cli
+
+import (
+	"fmt"
+
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	cli "github.com/acorn-io/runtime/pkg/cli/builder"
+	"github.com/spf13/cobra"
+)
+
+func NewVolumeResize(c CommandContext) *cobra.Command {
+	return cli.Command(&VolumeResize{client: c.ClientFactory}, cobra.Command{
+		Use: "resize [flags] VOLUME_NAME SIZE",
+		Example: `
+# Grow a volume to 20G, the storage class of the volume must allow expansion
+acorn volume resize my-app.data 20G`,
+		SilenceUsage:      true,
+		Short:             "Grow a volume",
+		Args:              cobra.ExactArgs(2),
+		ValidArgsFunction: newCompletion(c.ClientFactory, volumesCompletion).withShouldCompleteOptions(onlyNumArgs(1)).complete,
+	})
+}
+
+type VolumeResize struct {
+	client ClientFactory
+}
+
+func (a *VolumeResize) Run(cmd *cobra.Command, args []string) error {
+	c, err := a.client.CreateDefault()
+	if err != nil {
+		return err
+	}
+
+	size, err := v1.ParseQuantity(args[1])
+	if err != nil {
+		return err
+	}
+
+	volume, err := c.VolumeResize(cmd.Context(), args[0], string(size))
+	if err != nil {
+		return fmt.Errorf("resizing %s: %w", args[0], err)
+	}
+
+	actual := "unknown"
+	if volume.Spec.Capacity != nil {
+		actual = volume.Spec.Capacity.String()
+	}
+	fmt.Printf("%s: requested %s, actual %s\n", args[0], size, actual)
+	return nil
+}
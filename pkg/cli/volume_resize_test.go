@@ -0,0 +1,68 @@
+package cli
+
+import (
+	"io"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/acorn-io/runtime/pkg/cli/testdata"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVolumeResize(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr bool
+		wantOut string
+	}{
+		{
+			name:    "acorn volume resize found.vol 20G",
+			args:    []string{"resize", "found.vol", "20G"},
+			wantOut: "found.vol: requested 20G, actual 10G\n",
+		},
+		{
+			name:    "acorn volume resize without unit",
+			args:    []string{"resize", "found.vol", "20"},
+			wantOut: "found.vol: requested 20G, actual 10G\n",
+		},
+		{
+			name:    "acorn volume resize dne",
+			args:    []string{"resize", "dne", "20G"},
+			wantErr: true,
+			wantOut: "resizing dne: error: volume dne does not exist",
+		},
+		{
+			name:    "acorn volume resize without size",
+			args:    []string{"resize", "found.vol"},
+			wantErr: true,
+			wantOut: "accepts 2 arg(s), received 1",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, w, _ := os.Pipe()
+			os.Stdout = w
+			cmd := NewVolume(CommandContext{
+				ClientFactory: &testdata.MockClientFactory{},
+				StdOut:        w,
+				StdErr:        w,
+				StdIn:         strings.NewReader(""),
+			})
+			cmd.SetArgs(tt.args)
+			err := cmd.Execute()
+			if err != nil && !tt.wantErr {
+				assert.Failf(t, "got err when err not expected", "got err: %s", err.Error())
+			} else if err != nil && tt.wantErr {
+				assert.Equal(t, tt.wantOut, err.Error())
+			} else if tt.wantErr {
+				assert.Fail(t, "expected an error")
+			} else {
+				w.Close()
+				out, _ := io.ReadAll(r)
+				assert.Equal(t, tt.wantOut, string(out))
+			}
+		})
+	}
+}
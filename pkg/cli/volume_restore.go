@@ -0,0 +1,53 @@
+package cli
+
+import (
+	"fmt"
+
+	cli "github.com/acorn-io/runtime/pkg/cli/builder"
+	"github.com/spf13/cobra"
+)
+
+func NewVolumeRestore(c CommandContext) *cobra.Command {
+	cmd := cli.Command(&VolumeRestore{client: c.ClientFactory}, cobra.Command{
+		Use: "restore [flags] VOLUME_NAME --from SNAPSHOT_NAME",
+		Example: `
+# Replace the volume of an app with a new volume that has the data of a snapshot
+acorn volume restore my-app.data --from before-upgrade`,
+		Long: `Restore a volume from a snapshot
+
+The volume is replaced by a new volume with the data of the snapshot, and the containers using it are restarted. The
+snapshot must be ready and taken of a volume of the same app. The replaced volume is kept and listed under the name of
+its PersistentVolume.`,
+		SilenceUsage:      true,
+		Short:             "Restore a volume from a snapshot",
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: newCompletion(c.ClientFactory, volumesCompletion).withShouldCompleteOptions(onlyNumArgs(1)).complete,
+	})
+	if err := cmd.RegisterFlagCompletionFunc("from", newCompletion(c.ClientFactory, volumeSnapshotsCompletion).complete); err != nil {
+		cmd.Printf("Error registering completion function for --from flag: %v\n", err)
+	}
+	return cmd
+}
+
+type VolumeRestore struct {
+	From   string `usage:"Name of the snapshot to restore the volume from"`
+	client ClientFactory
+}
+
+func (a *VolumeRestore) Run(cmd *cobra.Command, args []string) error {
+	if a.From == "" {
+		return fmt.Errorf("--from is required")
+	}
+
+	c, err := a.client.CreateDefault()
+	if err != nil {
+		return err
+	}
+
+	if _, err := c.VolumeSnapshotRestore(cmd.Context(), args[0], a.From); err != nil {
+		return fmt.Errorf("restoring %s: %w", args[0], err)
+	}
+
+	fmt.Printf("%s: restoring from snapshot %s\n", args[0], a.From)
+	return nil
+}
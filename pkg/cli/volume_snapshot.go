@@ -0,0 +1,139 @@
+package cli
+
+import (
+	"fmt"
+
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	cli "github.com/acorn-io/runtime/pkg/cli/builder"
+	"github.com/acorn-io/runtime/pkg/cli/builder/table"
+	"github.com/acorn-io/runtime/pkg/tables"
+	"github.com/spf13/cobra"
+	"k8s.io/utils/strings/slices"
+)
+
+func NewVolumeSnapshot(c CommandContext) *cobra.Command {
+	cmd := cli.Command(&VolumeSnapshot{client: c.ClientFactory}, cobra.Command{
+		Use: "snapshot [flags] VOLUME_NAME",
+		Example: `
+# Take a snapshot of a volume, the storage class of the volume must be provisioned by a CSI driver that supports snapshots
+acorn volume snapshot my-app.data --name before-upgrade
+
+# Check whether the snapshot is ready to be restored
+acorn volume snapshot ls`,
+		SilenceUsage:      true,
+		Short:             "Take a snapshot of a volume",
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: newCompletion(c.ClientFactory, volumesCompletion).withShouldCompleteOptions(onlyNumArgs(1)).complete,
+	})
+	cmd.AddCommand(NewVolumeSnapshotList(c))
+	cmd.AddCommand(NewVolumeSnapshotDelete(c))
+	return cmd
+}
+
+type VolumeSnapshot struct {
+	Name   string `usage:"Name of the snapshot, generated if not set" short:"n" local:"true"`
+	client ClientFactory
+}
+
+func (a *VolumeSnapshot) Run(cmd *cobra.Command, args []string) error {
+	c, err := a.client.CreateDefault()
+	if err != nil {
+		return err
+	}
+
+	snapshot, err := c.VolumeSnapshotCreate(cmd.Context(), args[0], a.Name)
+	if err != nil {
+		return fmt.Errorf("snapshotting %s: %w", args[0], err)
+	}
+
+	fmt.Println(snapshot.Name)
+	return nil
+}
+
+func NewVolumeSnapshotList(c CommandContext) *cobra.Command {
+	return cli.Command(&VolumeSnapshotList{client: c.ClientFactory}, cobra.Command{
+		Use:     "ls [flags] [SNAPSHOT_NAME...]",
+		Aliases: []string{"list"},
+		Example: `
+acorn volume snapshot ls`,
+		SilenceUsage:      true,
+		Short:             "List volume snapshots, their readiness and size",
+		ValidArgsFunction: newCompletion(c.ClientFactory, volumeSnapshotsCompletion).complete,
+	})
+}
+
+type VolumeSnapshotList struct {
+	Quiet  bool   `usage:"Output only names" short:"q"`
+	Output string `usage:"Output format (json, yaml, {{gotemplate}}, go-template={{gotemplate}})" short:"o"`
+	client ClientFactory
+}
+
+func (a *VolumeSnapshotList) Run(cmd *cobra.Command, args []string) error {
+	if err := table.ValidateFormat(a.Output, &apiv1.VolumeSnapshot{}); err != nil {
+		return err
+	}
+
+	c, err := a.client.CreateDefault()
+	if err != nil {
+		return err
+	}
+
+	out := table.NewWriter(tables.VolumeSnapshot, a.Quiet, a.Output)
+
+	if len(args) == 1 {
+		snapshot, err := c.VolumeSnapshotGet(cmd.Context(), args[0])
+		if err != nil {
+			return err
+		}
+		out.Write(snapshot)
+		return out.Err()
+	}
+
+	snapshots, err := c.VolumeSnapshotList(cmd.Context())
+	if err != nil {
+		return err
+	}
+
+	for _, snapshot := range snapshots {
+		if len(args) == 0 || slices.Contains(args, snapshot.Name) {
+			out.Write(&snapshot)
+		}
+	}
+
+	return out.Err()
+}
+
+func NewVolumeSnapshotDelete(c CommandContext) *cobra.Command {
+	return cli.Command(&VolumeSnapshotDelete{client: c.ClientFactory}, cobra.Command{
+		Use:               "rm [SNAPSHOT_NAME...]",
+		Example:           `acorn volume snapshot rm before-upgrade`,
+		SilenceUsage:      true,
+		Short:             "Delete a volume snapshot",
+		ValidArgsFunction: newCompletion(c.ClientFactory, volumeSnapshotsCompletion).complete,
+	})
+}
+
+type VolumeSnapshotDelete struct {
+	client ClientFactory
+}
+
+func (a *VolumeSnapshotDelete) Run(cmd *cobra.Command, args []string) error {
+	c, err := a.client.CreateDefault()
+	if err != nil {
+		return err
+	}
+
+	for _, snapshot := range args {
+		deleted, err := c.VolumeSnapshotDelete(cmd.Context(), snapshot)
+		if err != nil {
+			return fmt.Errorf("deleting %s: %w", snapshot, err)
+		}
+		if deleted != nil {
+			fmt.Println(snapshot)
+		} else {
+			fmt.Printf("Error: No such volume snapshot: %s\n", snapshot)
+		}
+	}
+
+	return nil
+}
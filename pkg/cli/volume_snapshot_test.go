@@ -0,0 +1,89 @@
+package cli
+
+import (
+	"io"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/acorn-io/runtime/pkg/cli/testdata"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVolumeSnapshot(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr bool
+		wantOut string
+	}{
+		{
+			name:    "acorn volume snapshot found.vol --name backup",
+			args:    []string{"snapshot", "found.vol", "--name", "backup"},
+			wantOut: "backup\n",
+		},
+		{
+			name:    "acorn volume snapshot found.vol",
+			args:    []string{"snapshot", "found.vol"},
+			wantOut: "snapshot-abcde\n",
+		},
+		{
+			name:    "acorn volume snapshot dne",
+			args:    []string{"snapshot", "dne"},
+			wantErr: true,
+			wantOut: "snapshotting dne: error: volume dne does not exist",
+		},
+		{
+			name:    "acorn volume snapshot ls",
+			args:    []string{"snapshot", "ls", "-o", "{{.Name}} {{.Spec.Volume}} {{.Status.Ready}} {{.Status.Size}}"},
+			wantOut: "found-snapshot found.vol true 10G\n",
+		},
+		{
+			name:    "acorn volume snapshot rm found-snapshot dne",
+			args:    []string{"snapshot", "rm", "found-snapshot", "dne"},
+			wantOut: "found-snapshot\nError: No such volume snapshot: dne\n",
+		},
+		{
+			name:    "acorn volume restore found.vol --from found-snapshot",
+			args:    []string{"restore", "found.vol", "--from", "found-snapshot"},
+			wantOut: "found.vol: restoring from snapshot found-snapshot\n",
+		},
+		{
+			name:    "acorn volume restore found.vol --from pending-snapshot",
+			args:    []string{"restore", "found.vol", "--from", "pending-snapshot"},
+			wantErr: true,
+			wantOut: "restoring found.vol: snapshot pending-snapshot is not ready yet",
+		},
+		{
+			name:    "acorn volume restore without --from",
+			args:    []string{"restore", "found.vol"},
+			wantErr: true,
+			wantOut: "--from is required",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, w, _ := os.Pipe()
+			os.Stdout = w
+			cmd := NewVolume(CommandContext{
+				ClientFactory: &testdata.MockClientFactory{},
+				StdOut:        w,
+				StdErr:        w,
+				StdIn:         strings.NewReader(""),
+			})
+			cmd.SetArgs(tt.args)
+			err := cmd.Execute()
+			if err != nil && !tt.wantErr {
+				assert.Failf(t, "got err when err not expected", "got err: %s", err.Error())
+			} else if err != nil && tt.wantErr {
+				assert.Equal(t, tt.wantOut, err.Error())
+			} else if tt.wantErr {
+				assert.Fail(t, "expected an error")
+			} else {
+				w.Close()
+				out, _ := io.ReadAll(r)
+				assert.Equal(t, tt.wantOut, string(out))
+			}
+		})
+	}
+}
@@ -1,8 +1,10 @@
 package cli
 
 import (
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
 	cli "github.com/acorn-io/runtime/pkg/cli/builder"
 	"github.com/acorn-io/runtime/pkg/cli/builder/table"
+	"github.com/acorn-io/runtime/pkg/client"
 	"github.com/acorn-io/runtime/pkg/tables"
 	"github.com/spf13/cobra"
 	"k8s.io/utils/strings/slices"
@@ -13,27 +15,45 @@ func NewVolume(c CommandContext) *cobra.Command {
 		Use:     "volume [flags] [VOLUME_NAME...]",
 		Aliases: []string{"volumes", "v"},
 		Example: `
-acorn volume`,
+acorn volume
+
+# List the volumes that are not bound to any existing app, e.g. left behind by deleted apps
+acorn volume --orphaned
+
+# Delete all orphaned volumes, which frees their storage if their reclaim policy is delete
+acorn volume rm $(acorn volume --orphaned -q)`,
 		SilenceUsage:      true,
 		Short:             "Manage volumes",
 		ValidArgsFunction: newCompletion(c.ClientFactory, volumesCompletion).complete,
 	})
 	cmd.AddCommand(NewVolumeDelete(c))
+	cmd.AddCommand(NewVolumeResize(c))
+	cmd.AddCommand(NewVolumeSnapshot(c))
+	cmd.AddCommand(NewVolumeRestore(c))
 	return cmd
 }
 
 type Volume struct {
-	Quiet  bool   `usage:"Output only names" short:"q"`
-	Output string `usage:"Output format (json, yaml, {{gotemplate}})" short:"o"`
-	client ClientFactory
+	Quiet    bool   `usage:"Output only names" short:"q"`
+	Output   string `usage:"Output format (json, yaml, {{gotemplate}}, go-template={{gotemplate}})" short:"o"`
+	Orphaned bool   `usage:"Only list volumes not bound to any existing app, with the app they were last bound to and their reclaim policy" local:"true"`
+	client   ClientFactory
 }
 
 func (a *Volume) Run(cmd *cobra.Command, args []string) error {
+	if err := table.ValidateFormat(a.Output, &apiv1.Volume{}); err != nil {
+		return err
+	}
+
 	c, err := a.client.CreateDefault()
 	if err != nil {
 		return err
 	}
 
+	if a.Orphaned {
+		return a.listOrphaned(cmd, c, args)
+	}
+
 	out := table.NewWriter(tables.Volume, a.Quiet, a.Output)
 
 	if len(args) == 1 {
@@ -62,3 +82,31 @@ func (a *Volume) Run(cmd *cobra.Command, args []string) error {
 
 	return out.Err()
 }
+
+// listOrphaned lists the volumes of apps that don't exist anymore, e.g. because the app was deleted but its volumes
+// were kept. Volumes that were never bound to an app aren't orphaned.
+func (a *Volume) listOrphaned(cmd *cobra.Command, c client.Client, args []string) error {
+	volumes, err := c.VolumeList(cmd.Context())
+	if err != nil {
+		return err
+	}
+
+	apps, err := c.AppList(cmd.Context())
+	if err != nil {
+		return err
+	}
+	existing := make(map[string]bool, len(apps))
+	for _, app := range apps {
+		existing[app.Name] = true
+	}
+
+	out := table.NewWriter(tables.OrphanedVolume, a.Quiet, a.Output)
+	for _, volume := range volumes {
+		if volume.Status.AppName == "" || existing[volume.Status.AppName] || (len(args) > 0 && !slices.Contains(args, volume.Name)) {
+			continue
+		}
+		out.Write(&volume)
+	}
+
+	return out.Err()
+}
@@ -206,6 +206,33 @@ func TestVolume(t *testing.T) {
 			},
 			wantOut: "NAME        BOUND-VOLUME   CAPACITY   VOLUME-CLASS   STATUS    ACCESS-MODES   CREATED\nmy-volume                  <nil>      my-class                                10y ago\n",
 		},
+		{
+			name: "acorn volume --orphaned -q", fields: fields{},
+			prepare: func(f *mocks.MockClient) {
+				f.EXPECT().VolumeList(gomock.Any()).Return(
+					[]apiv1.Volume{
+						{
+							ObjectMeta: metav1.ObjectMeta{Name: "found.vol"},
+							Status:     apiv1.VolumeStatus{AppPublicName: "found", AppName: "found", VolumeName: "vol"},
+						},
+						{
+							ObjectMeta: metav1.ObjectMeta{Name: "deleted.vol"},
+							Status:     apiv1.VolumeStatus{AppPublicName: "deleted", AppName: "deleted", VolumeName: "vol", ReclaimPolicy: "retain"},
+						},
+						{
+							ObjectMeta: metav1.ObjectMeta{Name: "unbound"},
+							Status:     apiv1.VolumeStatus{ReclaimPolicy: "retain"},
+						},
+					}, nil).AnyTimes()
+				f.EXPECT().AppList(gomock.Any()).Return(
+					[]apiv1.App{{ObjectMeta: metav1.ObjectMeta{Name: "found"}}}, nil).AnyTimes()
+			},
+			args: args{
+				args:   []string{"--orphaned", "-q"},
+				client: &testdata.MockClient{},
+			},
+			wantOut: "deleted.vol\n",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"sort"
 	"strings"
 	"time"
@@ -15,6 +16,7 @@ import (
 	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
 	"github.com/acorn-io/runtime/pkg/imagerules"
 	"github.com/acorn-io/runtime/pkg/images"
+	"github.com/acorn-io/runtime/pkg/labels"
 	"github.com/acorn-io/runtime/pkg/publicname"
 	"github.com/acorn-io/runtime/pkg/run"
 	"github.com/acorn-io/runtime/pkg/scheme"
@@ -67,7 +69,11 @@ func ToApp(namespace, image string, opts *AppRunOptions) *apiv1.App {
 			NotifyUpgrade:       opts.NotifyUpgrade,
 			AutoUpgradeInterval: opts.AutoUpgradeInterval,
 			Memory:              opts.Memory,
+			CPU:                 opts.CPU,
 			ComputeClasses:      opts.ComputeClasses,
+			SignaturePolicy:     opts.SignaturePolicy,
+			Scale:               opts.Scale,
+			ServiceGrants:       opts.ServiceGrants,
 		},
 	}
 }
@@ -126,6 +132,10 @@ func ToAppUpdate(ctx context.Context, c Client, name string, opts *AppUpdateOpti
 		o := opts.ToRun()
 		nApp := ToApp(app.Namespace, opts.Image, &o)
 		nApp.Name = app.Name
+		// The region of an app can't change, so an app stays pinned to it even if the update doesn't set it
+		if nApp.Spec.Region == "" {
+			nApp.Spec.Region = app.Spec.Region
+		}
 		nApp.ObjectMeta.UID = app.ObjectMeta.UID
 		nApp.ObjectMeta.ResourceVersion = app.ObjectMeta.ResourceVersion
 		return nApp, nil
@@ -165,12 +175,18 @@ func ToAppUpdate(ctx context.Context, c Client, name string, opts *AppUpdateOpti
 	if len(opts.Memory) != 0 {
 		app.Spec.Memory = opts.Memory
 	}
+	if len(opts.CPU) != 0 {
+		app.Spec.CPU = opts.CPU
+	}
 	if len(opts.ComputeClasses) != 0 {
 		app.Spec.ComputeClasses = opts.ComputeClasses
 	}
 	if opts.Region != "" {
 		app.Spec.Region = opts.Region
 	}
+	if opts.SignaturePolicy != nil {
+		app.Spec.SignaturePolicy = opts.SignaturePolicy
+	}
 
 	return app, nil
 }
@@ -358,6 +374,10 @@ func (c *DefaultClient) AppLog(ctx context.Context, name string, opts *LogOption
 	return result, nil
 }
 
+func (c *DefaultClient) AppLogsFollow(ctx context.Context, name string, opts *LogOptions) (<-chan apiv1.LogMessage, error) {
+	return followAppLogs(ctx, c, name, opts)
+}
+
 func mergeEnv(appEnv, optsEnv []v1.NameValue) []v1.NameValue {
 	for _, newEnv := range optsEnv {
 		found := false
@@ -545,6 +565,8 @@ func (c *DefaultClient) appStart(ctx context.Context, name string) error {
 	}
 	if app.Spec.Stop != nil && *app.Spec.Stop {
 		app.Spec.Stop = new(bool)
+		// Starting a paused app resumes it
+		delete(app.Annotations, labels.AcornPaused)
 		return c.Client.Update(ctx, app)
 	}
 	return nil
@@ -576,6 +598,101 @@ func (c *DefaultClient) appStop(ctx context.Context, name string) error {
 	}
 	return nil
 }
+
+func (c *DefaultClient) AppPause(ctx context.Context, name string) (err error) {
+	for i := 0; i < 5; i++ {
+		err = c.appPause(ctx, name)
+		if apierrors.IsConflict(err) {
+			continue
+		}
+		return
+	}
+	return
+}
+
+func (c *DefaultClient) appPause(ctx context.Context, name string) error {
+	app := &apiv1.App{}
+	err := c.Client.Get(ctx, kclient.ObjectKey{
+		Name:      name,
+		Namespace: c.Namespace,
+	}, app)
+	if err != nil {
+		return err
+	}
+	if changed, err := pauseApp(app); err != nil || !changed {
+		return err
+	}
+	return c.Client.Update(ctx, app)
+}
+
+// pauseApp stops the app and records the current replica count of each of its containers, so that resuming the app
+// restores them. It returns false if the app is already paused.
+func pauseApp(app *apiv1.App) (bool, error) {
+	if app.Annotations[labels.AcornPaused] == "true" {
+		return false, nil
+	}
+	if z.Dereference(app.Spec.Stop) {
+		return false, fmt.Errorf("app %s is stopped, start it before pausing it", app.Name)
+	}
+
+	replicas := make(map[string]int32, len(app.Status.AppStatus.Containers))
+	for name, status := range app.Status.AppStatus.Containers {
+		if status.DesiredReplicaCount > 0 {
+			replicas[name] = status.DesiredReplicaCount
+		}
+	}
+
+	data, err := json.Marshal(replicas)
+	if err != nil {
+		return false, err
+	}
+
+	if app.Annotations == nil {
+		app.Annotations = map[string]string{}
+	}
+	app.Annotations[labels.AcornPaused] = "true"
+	app.Annotations[labels.AcornPausedReplicas] = string(data)
+	app.Spec.Stop = z.Pointer(true)
+	return true, nil
+}
+
+func (c *DefaultClient) AppResume(ctx context.Context, name string) (err error) {
+	for i := 0; i < 5; i++ {
+		err = c.appResume(ctx, name)
+		if apierrors.IsConflict(err) {
+			continue
+		}
+		return
+	}
+	return
+}
+
+func (c *DefaultClient) appResume(ctx context.Context, name string) error {
+	app := &apiv1.App{}
+	err := c.Client.Get(ctx, kclient.ObjectKey{
+		Name:      name,
+		Namespace: c.Namespace,
+	}, app)
+	if err != nil {
+		return err
+	}
+	if err := resumeApp(app); err != nil {
+		return err
+	}
+	return c.Client.Update(ctx, app)
+}
+
+// resumeApp starts a paused app. The replica counts recorded when pausing it are kept, the controller scales the
+// containers back to them and removes them once the containers are ready.
+func resumeApp(app *apiv1.App) error {
+	if app.Annotations[labels.AcornPaused] != "true" {
+		return fmt.Errorf("app %s is not paused", app.Name)
+	}
+
+	delete(app.Annotations, labels.AcornPaused)
+	app.Spec.Stop = new(bool)
+	return nil
+}
 func (c *DefaultClient) AppConfirmUpgrade(ctx context.Context, name string) error {
 	app := &apiv1.App{}
 	err := c.Client.Get(ctx, kclient.ObjectKey{
@@ -614,6 +731,26 @@ func (c *DefaultClient) AppInfo(ctx context.Context, name string) (string, error
 	return info.Info, err
 }
 
+func (c *DefaultClient) AppMetrics(ctx context.Context, name string) (*apiv1.AppMetrics, error) {
+	app := &apiv1.App{}
+	err := c.Client.Get(ctx, kclient.ObjectKey{
+		Name:      name,
+		Namespace: c.Namespace,
+	}, app)
+	if err != nil {
+		return nil, err
+	}
+
+	metrics := &apiv1.AppMetrics{}
+	err = c.RESTClient.Get().
+		Namespace(app.Namespace).
+		Resource("apps").
+		Name(app.Name).
+		SubResource("metrics").
+		Do(ctx).Into(metrics)
+	return metrics, err
+}
+
 func (c *DefaultClient) AppPullImage(ctx context.Context, name string) error {
 	app := &apiv1.App{}
 	err := c.Client.Get(ctx, kclient.ObjectKey{
@@ -640,3 +777,16 @@ func (c *DefaultClient) AppIgnoreDeleteCleanup(ctx context.Context, name string)
 		SubResource("ignorecleanup").
 		Body(&apiv1.IgnoreCleanup{}).Do(ctx).Error()
 }
+
+func (c *DefaultClient) AppRestart(ctx context.Context, name string, opts *AppRestartOptions) error {
+	if opts == nil {
+		opts = &AppRestartOptions{}
+	}
+
+	return c.RESTClient.Post().
+		Namespace(c.Namespace).
+		Resource("apps").
+		Name(name).
+		SubResource("restart").
+		Body(&apiv1.AppRestart{Container: opts.Container}).Do(ctx).Error()
+}
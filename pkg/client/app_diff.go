@@ -0,0 +1,282 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/acorn-io/baaah/pkg/typed"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"golang.org/x/exp/slices"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+func (c *DefaultClient) AppDiff(ctx context.Context, name string, newSpec *v1.AppInstanceSpec) (*AppDiff, error) {
+	app, err := c.AppGet(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	if newSpec == nil {
+		newSpec = &app.Spec
+	}
+
+	return &AppDiff{
+		Name:    app.Name,
+		Changes: diffAppSpec(&app.Spec, newSpec),
+	}, nil
+}
+
+// diffAppSpec returns the changes of every field of the app spec that users set, from the image to the service grants,
+// between oldSpec and newSpec, in the order of the fields below. Fields that don't change are left out. Only the
+// permissions granted implicitly to the image aren't compared, since users don't set them.
+func diffAppSpec(oldSpec, newSpec *v1.AppInstanceSpec) (result []AppFieldChange) {
+	fields := []struct {
+		name     string
+		old, new []string
+	}{
+		{"image", nonEmpty(oldSpec.Image), nonEmpty(newSpec.Image)},
+		{"stop", nonEmpty(formatBool(oldSpec.Stop)), nonEmpty(formatBool(newSpec.Stop))},
+		{"profiles", nonEmpty(strings.Join(oldSpec.Profiles, ",")), nonEmpty(strings.Join(newSpec.Profiles, ","))},
+		{"deployArgs", formatDeployArgs(oldSpec.DeployArgs), formatDeployArgs(newSpec.DeployArgs)},
+		{"environment", formatEnv(oldSpec.Environment), formatEnv(newSpec.Environment)},
+		{"volumes", formatVolumes(oldSpec.Volumes), formatVolumes(newSpec.Volumes)},
+		{"secrets", formatSecrets(oldSpec.Secrets), formatSecrets(newSpec.Secrets)},
+		{"links", formatLinks(oldSpec.Links), formatLinks(newSpec.Links)},
+		{"ports", formatPorts(oldSpec.Publish), formatPorts(newSpec.Publish)},
+		{"publishMode", nonEmpty(string(oldSpec.PublishMode)), nonEmpty(string(newSpec.PublishMode))},
+		{"scale", formatWorkloads(oldSpec.Scale, formatReplicas), formatWorkloads(newSpec.Scale, formatReplicas)},
+		{"memory", formatWorkloads(oldSpec.Memory, formatMemory), formatWorkloads(newSpec.Memory, formatMemory)},
+		{"cpu", formatWorkloads(oldSpec.CPU, formatCPU), formatWorkloads(newSpec.CPU, formatCPU)},
+		{"computeClass", formatWorkloads(oldSpec.ComputeClasses, nil), formatWorkloads(newSpec.ComputeClasses, nil)},
+		{"region", nonEmpty(oldSpec.Region), nonEmpty(newSpec.Region)},
+		{"labels", formatScopedLabels(oldSpec.Labels), formatScopedLabels(newSpec.Labels)},
+		{"annotations", formatScopedLabels(oldSpec.Annotations), formatScopedLabels(newSpec.Annotations)},
+		{"permissions", formatJSONItems(oldSpec.GrantedPermissions), formatJSONItems(newSpec.GrantedPermissions)},
+		{"autoUpgrade", nonEmpty(formatBool(oldSpec.AutoUpgrade)), nonEmpty(formatBool(newSpec.AutoUpgrade))},
+		{"notifyUpgrade", nonEmpty(formatBool(oldSpec.NotifyUpgrade)), nonEmpty(formatBool(newSpec.NotifyUpgrade))},
+		{"autoUpgradeInterval", nonEmpty(oldSpec.AutoUpgradeInterval), nonEmpty(newSpec.AutoUpgradeInterval)},
+		{"signaturePolicy", formatJSONItems(nonNil(oldSpec.SignaturePolicy)), formatJSONItems(nonNil(newSpec.SignaturePolicy))},
+		{"serviceGrants", formatServiceGrants(oldSpec.ServiceGrants), formatServiceGrants(newSpec.ServiceGrants)},
+	}
+
+	for _, field := range fields {
+		change := AppFieldChange{
+			Field:   field.name,
+			Removed: missingFrom(field.old, field.new),
+			Added:   missingFrom(field.new, field.old),
+		}
+		if len(change.Removed) > 0 || len(change.Added) > 0 {
+			result = append(result, change)
+		}
+	}
+	return result
+}
+
+// missingFrom returns the values of values that are not in other.
+func missingFrom(values, other []string) (result []string) {
+	for _, value := range values {
+		if !slices.Contains(other, value) {
+			result = append(result, value)
+		}
+	}
+	return result
+}
+
+func nonEmpty(value string) []string {
+	if value == "" {
+		return nil
+	}
+	return []string{value}
+}
+
+func formatBool(value *bool) string {
+	if value == nil {
+		return ""
+	}
+	return strconv.FormatBool(*value)
+}
+
+// nonNil returns a list with value if it is set, and an empty list if it isn't.
+func nonNil[T any](value *T) []T {
+	if value == nil {
+		return nil
+	}
+	return []T{*value}
+}
+
+// formatJSONItems formats each item as JSON, for fields that have no flag syntax to format them with.
+func formatJSONItems[T any](items []T) (result []string) {
+	for _, item := range items {
+		data, err := json.Marshal(item)
+		if err != nil {
+			data = []byte(fmt.Sprint(item))
+		}
+		result = append(result, string(data))
+	}
+	sort.Strings(result)
+	return result
+}
+
+func formatDeployArgs(args *v1.GenericMap) (result []string) {
+	data := args.GetData()
+	for _, key := range typed.SortedKeys(data) {
+		value, err := json.Marshal(data[key])
+		if err != nil {
+			value = []byte(fmt.Sprint(data[key]))
+		}
+		result = append(result, key+"="+string(value))
+	}
+	return result
+}
+
+func formatEnv(env []v1.NameValue) (result []string) {
+	for _, nv := range env {
+		result = append(result, nv.Name+"="+nv.Value)
+	}
+	sort.Strings(result)
+	return result
+}
+
+// formatVolumes formats the bindings like the --volume flag, existing:vol-name,field=value.
+func formatVolumes(volumes []v1.VolumeBinding) (result []string) {
+	for _, volume := range volumes {
+		var (
+			name  = volume.Target
+			attrs []string
+		)
+		if volume.Volume != "" {
+			name = volume.Volume + ":" + volume.Target
+		}
+		if volume.Size != "" {
+			attrs = append(attrs, "size="+string(volume.Size))
+		}
+		if volume.Class != "" {
+			attrs = append(attrs, "class="+volume.Class)
+		}
+		for _, accessMode := range volume.AccessModes {
+			attrs = append(attrs, "accessMode="+string(accessMode))
+		}
+		result = append(result, strings.Join(append([]string{name}, attrs...), ","))
+	}
+	sort.Strings(result)
+	return result
+}
+
+// formatSecrets formats the bindings like the --secret flag, existing:secret-name.
+func formatSecrets(secrets []v1.SecretBinding) (result []string) {
+	for _, secret := range secrets {
+		result = append(result, secret.Secret+":"+secret.Target)
+	}
+	sort.Strings(result)
+	return result
+}
+
+// formatLinks formats the bindings like the --link flag, existing:service-name or service-name=address.
+func formatLinks(links []v1.ServiceBinding) (result []string) {
+	for _, link := range links {
+		if link.Address != "" {
+			result = append(result, link.Target+"="+link.Address)
+		} else {
+			result = append(result, link.Service+":"+link.Target)
+		}
+	}
+	sort.Strings(result)
+	return result
+}
+
+// formatServiceGrants lists the apps that can link to the services of the app, with a placeholder if no app can, so
+// that it differs from not restricting them at all.
+func formatServiceGrants(grants *v1.ServiceGrants) []string {
+	if grants == nil {
+		return nil
+	}
+	if len(grants.Consumers) == 0 {
+		return []string{"(no app)"}
+	}
+	result := append([]string(nil), grants.Consumers...)
+	sort.Strings(result)
+	return result
+}
+
+// formatPorts formats the bindings like the --publish flag, [public:]private[/protocol].
+func formatPorts(ports []v1.PortBinding) (result []string) {
+	for _, port := range ports {
+		var (
+			public  string
+			private = strconv.Itoa(int(port.TargetPort))
+		)
+		if port.Hostname != "" {
+			public = port.Hostname
+		} else if port.Port != 0 {
+			public = strconv.Itoa(int(port.Port))
+		}
+		if port.TargetServiceName != "" {
+			private = port.TargetServiceName + ":" + private
+		}
+		if public != "" {
+			private = public + ":" + private
+		}
+		if port.Protocol != "" {
+			private += "/" + string(port.Protocol)
+		}
+		result = append(result, private)
+	}
+	sort.Strings(result)
+	return result
+}
+
+// formatWorkloads formats the values set per workload like the --memory flag, workload=value, or just the value if it
+// applies to all workloads. A nil format uses the value as is.
+func formatWorkloads[V any](values map[string]V, format func(V) string) (result []string) {
+	for _, workload := range typed.SortedKeys(values) {
+		var value string
+		if format == nil {
+			value = fmt.Sprint(values[workload])
+		} else {
+			value = format(values[workload])
+		}
+		if workload != "" {
+			value = workload + "=" + value
+		}
+		result = append(result, value)
+	}
+	return result
+}
+
+func formatReplicas(replicas int32) string {
+	return strconv.Itoa(int(replicas))
+}
+
+func formatMemory(memory *int64) string {
+	if memory == nil {
+		return ""
+	}
+	return resource.NewQuantity(*memory, resource.BinarySI).String()
+}
+
+func formatCPU(milliCPU *int64) string {
+	if milliCPU == nil {
+		return ""
+	}
+	return resource.NewMilliQuantity(*milliCPU, resource.DecimalSI).String()
+}
+
+// formatScopedLabels formats the labels like the --label flag, [type:][name:]key=value.
+func formatScopedLabels(labels []v1.ScopedLabel) (result []string) {
+	for _, label := range labels {
+		var scope []string
+		if label.ResourceType != "" {
+			scope = append(scope, label.ResourceType)
+		}
+		if label.ResourceName != "" {
+			scope = append(scope, label.ResourceName)
+		}
+		result = append(result, strings.Join(append(scope, label.Key+"="+label.Value), ":"))
+	}
+	sort.Strings(result)
+	return result
+}
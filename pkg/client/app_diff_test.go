@@ -0,0 +1,120 @@
+package client
+
+import (
+	"reflect"
+	"testing"
+
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/z"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiffAppSpec(t *testing.T) {
+	oldSpec := &v1.AppInstanceSpec{
+		Image:       "app:v1",
+		Environment: []v1.NameValue{{Name: "a", Value: "1"}, {Name: "b", Value: "2"}},
+		Volumes:     []v1.VolumeBinding{{Target: "data", Size: "10G"}},
+		Publish:     []v1.PortBinding{{Port: 81, TargetPort: 80}},
+		DeployArgs:  v1.NewGenericMap(map[string]any{"replicas": 1}),
+	}
+	newSpec := &v1.AppInstanceSpec{
+		Image:       "app:v2",
+		Stop:        z.Pointer(false),
+		Environment: []v1.NameValue{{Name: "b", Value: "2"}, {Name: "a", Value: "3"}},
+		Volumes:     []v1.VolumeBinding{{Target: "data", Size: "10G"}},
+		Publish:     []v1.PortBinding{{Port: 81, TargetPort: 80}, {Hostname: "example.com", TargetServiceName: "web", TargetPort: 80, Protocol: v1.ProtocolHTTP}},
+		DeployArgs:  v1.NewGenericMap(map[string]any{"replicas": 3}),
+	}
+
+	assert.Equal(t, []AppFieldChange{
+		{Field: "image", Removed: []string{"app:v1"}, Added: []string{"app:v2"}},
+		{Field: "stop", Added: []string{"false"}},
+		{Field: "deployArgs", Removed: []string{"replicas=1"}, Added: []string{"replicas=3"}},
+		{Field: "environment", Removed: []string{"a=1"}, Added: []string{"a=3"}},
+		{Field: "ports", Added: []string{"example.com:web:80/http"}},
+	}, diffAppSpec(oldSpec, newSpec))
+
+	assert.Empty(t, diffAppSpec(oldSpec, oldSpec))
+}
+
+func TestDiffAppSpecResources(t *testing.T) {
+	oldSpec := &v1.AppInstanceSpec{
+		Profiles:       []string{"prod"},
+		Scale:          map[string]int32{"web": 1},
+		Memory:         v1.MemoryMap{"": z.Pointer[int64](512 * 1024 * 1024)},
+		CPU:            v1.CPUMap{"web": z.Pointer[int64](250)},
+		ComputeClasses: v1.ComputeClassMap{"": "small"},
+		Labels:         []v1.ScopedLabel{{Key: "team", Value: "a"}},
+	}
+	newSpec := &v1.AppInstanceSpec{
+		Profiles:       []string{"prod", "debug"},
+		Scale:          map[string]int32{"web": 3},
+		Memory:         v1.MemoryMap{"": z.Pointer[int64](512 * 1024 * 1024), "db": z.Pointer[int64](1024 * 1024 * 1024)},
+		CPU:            v1.CPUMap{"web": z.Pointer[int64](1000)},
+		ComputeClasses: v1.ComputeClassMap{"": "large"},
+		Labels:         []v1.ScopedLabel{{Key: "team", Value: "a"}, {ResourceType: "container", ResourceName: "web", Key: "tier", Value: "front"}},
+	}
+
+	assert.Equal(t, []AppFieldChange{
+		{Field: "profiles", Removed: []string{"prod"}, Added: []string{"prod,debug"}},
+		{Field: "scale", Removed: []string{"web=1"}, Added: []string{"web=3"}},
+		{Field: "memory", Added: []string{"db=1Gi"}},
+		{Field: "cpu", Removed: []string{"web=250m"}, Added: []string{"web=1"}},
+		{Field: "computeClass", Removed: []string{"small"}, Added: []string{"large"}},
+		{Field: "labels", Added: []string{"container:web:tier=front"}},
+	}, diffAppSpec(oldSpec, newSpec))
+
+	assert.Empty(t, diffAppSpec(newSpec, newSpec))
+}
+
+func TestDiffAppSpecAllFields(t *testing.T) {
+	spec := v1.AppInstanceSpec{
+		Region:              "us-east",
+		Labels:              []v1.ScopedLabel{{Key: "team", Value: "a"}},
+		Annotations:         []v1.ScopedLabel{{ResourceType: "container", Key: "note", Value: "b"}},
+		Image:               "app:v1",
+		Stop:                z.Pointer(true),
+		Profiles:            []string{"prod"},
+		Volumes:             []v1.VolumeBinding{{Target: "data", Size: "10G"}},
+		Secrets:             []v1.SecretBinding{{Secret: "creds", Target: "creds"}},
+		Environment:         []v1.NameValue{{Name: "LOG_LEVEL", Value: "debug"}},
+		PublishMode:         v1.PublishModeAll,
+		Links:               []v1.ServiceBinding{{Target: "db", Address: "db.example.com"}},
+		Publish:             []v1.PortBinding{{Port: 81, TargetPort: 80}},
+		DeployArgs:          v1.NewGenericMap(map[string]any{"replicas": 3}),
+		GrantedPermissions:  []v1.Permissions{{ServiceName: "web"}},
+		AutoUpgrade:         z.Pointer(true),
+		NotifyUpgrade:       z.Pointer(true),
+		AutoUpgradeInterval: "5m",
+		ComputeClasses:      v1.ComputeClassMap{"web": "large"},
+		Memory:              v1.MemoryMap{"": z.Pointer[int64](1024)},
+		CPU:                 v1.CPUMap{"web": z.Pointer[int64](500)},
+		Scale:               map[string]int32{"web": 3},
+		SignaturePolicy:     &v1.SignaturePolicy{Key: "cosign.pub"},
+		ServiceGrants:       &v1.ServiceGrants{},
+	}
+
+	// Setting any single field the user sets has to show up in the diff, so that a field added to the spec fails this
+	// test until it is diffed
+	notSetByUsers := map[string]bool{"ImageGrantedPermissions": true}
+	fields := reflect.ValueOf(spec)
+	for i := 0; i < fields.NumField(); i++ {
+		name := fields.Type().Field(i).Name
+		if notSetByUsers[name] {
+			continue
+		}
+		if fields.Field(i).IsZero() {
+			t.Errorf("field %s of the test spec is not set", name)
+			continue
+		}
+		newSpec := v1.AppInstanceSpec{}
+		reflect.ValueOf(&newSpec).Elem().Field(i).Set(fields.Field(i))
+		assert.NotEmpty(t, diffAppSpec(&v1.AppInstanceSpec{}, &newSpec), "field %s is not diffed", name)
+	}
+
+	assert.Equal(t, []AppFieldChange{
+		{Field: "secrets", Added: []string{"creds:creds"}},
+		{Field: "links", Added: []string{"db=db.example.com"}},
+		{Field: "serviceGrants", Added: []string{"(no app)"}},
+	}, diffAppSpec(&v1.AppInstanceSpec{}, &v1.AppInstanceSpec{Secrets: spec.Secrets, Links: spec.Links, ServiceGrants: spec.ServiceGrants}))
+}
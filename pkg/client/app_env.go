@@ -0,0 +1,273 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/replace"
+	"golang.org/x/exp/slices"
+)
+
+func (c *DefaultClient) AppContainerEnv(ctx context.Context, name, container string, opts *AppContainerEnvOptions) ([]AppContainerEnvVar, error) {
+	return appContainerEnv(ctx, c, name, container, opts)
+}
+
+// appContainerEnv resolves the environment of the container like the runtime does when it creates its pods: the
+// environment set on the app overrides the one of the Acornfile and references to secrets are replaced by their
+// values. Expressions that refer to services, like the ones of links, are left as written since only the runtime can
+// resolve them.
+func appContainerEnv(ctx context.Context, c Client, name, container string, opts *AppContainerEnvOptions) ([]AppContainerEnvVar, error) {
+	if opts == nil {
+		opts = &AppContainerEnvOptions{}
+	}
+
+	app, err := c.AppGet(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	spec, ok := app.Status.AppSpec.Containers[container]
+	if !ok {
+		return nil, fmt.Errorf("app %s has no container %s", name, container)
+	}
+
+	r := &envResolver{
+		ctx:     ctx,
+		c:       c,
+		app:     app,
+		reveal:  opts.Reveal,
+		secrets: map[string]*apiv1.Secret{},
+	}
+
+	appEnv := map[string]string{}
+	var appEnvNames []string
+	for _, env := range app.Spec.Environment {
+		envName := strings.TrimPrefix(env.Name, container+".")
+		if envName == "" || hasUnescapedDot(envName) {
+			// Set for another container
+			continue
+		}
+		if _, ok := appEnv[envName]; !ok {
+			appEnvNames = append(appEnvNames, envName)
+		}
+		appEnv[envName] = env.Value
+	}
+
+	// Variables of whole secrets come first, the ones set one by one take precedence over them
+	var fromSecrets, result []AppContainerEnvVar
+	for _, env := range spec.Environment {
+		if env.Secret.Name != "" && env.Secret.Key == "" {
+			vars, err := r.secretVars(env.Value, env.Secret.Name)
+			if err != nil {
+				return nil, err
+			}
+			fromSecrets = append(fromSecrets, vars...)
+			continue
+		}
+		if env.Name == "" {
+			continue
+		}
+		if _, ok := appEnv[env.Name]; ok {
+			continue
+		}
+
+		var v AppContainerEnvVar
+		if env.Secret.Name != "" {
+			v, err = r.secretVar(env.Name, env.Secret.Name, env.Secret.Key)
+		} else {
+			v, err = r.interpolate(env.Name, env.Value)
+		}
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, v)
+	}
+	for _, envName := range appEnvNames {
+		v, err := r.interpolate(envName, appEnv[envName])
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, v)
+	}
+
+	result = append(fromSecrets, result...)
+	for i := range result {
+		result[i].Name = strings.ReplaceAll(result[i].Name, "\\.", ".") // restore dots that were escaped during unmarshalling
+	}
+	// Like in the pod, the last variable with the same name wins
+	for i := len(result) - 1; i >= 0; i-- {
+		if slices.ContainsFunc(result[i+1:], func(v AppContainerEnvVar) bool {
+			return v.Name == result[i].Name
+		}) {
+			result = slices.Delete(result, i, i+1)
+		}
+	}
+	return result, nil
+}
+
+// envResolver resolves the values of environment variables of an app, getting each secret they refer to once.
+type envResolver struct {
+	ctx     context.Context
+	c       Client
+	app     *apiv1.App
+	reveal  bool
+	secrets map[string]*apiv1.Secret
+}
+
+// secretName returns the name of the secret of the app in the project.
+func (r *envResolver) secretName(name string) string {
+	return r.app.Name + "." + name
+}
+
+func (r *envResolver) secret(name string) (*apiv1.Secret, error) {
+	if secret, ok := r.secrets[name]; ok {
+		return secret, nil
+	}
+
+	var (
+		secret *apiv1.Secret
+		err    error
+	)
+	if r.reveal {
+		secret, err = r.c.SecretReveal(r.ctx, name)
+	} else {
+		secret, err = r.c.SecretGet(r.ctx, name)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("getting secret %s: %w", name, err)
+	}
+	if r.reveal && len(secret.Data) == 0 && len(secret.Keys) > 0 {
+		return nil, fmt.Errorf("secret %s can not be revealed, its data is only available on the server", name)
+	}
+
+	r.secrets[name] = secret
+	return secret, nil
+}
+
+// secretValue returns the value of the key of the secret, or false if it is masked.
+func (r *envResolver) secretValue(name, key string) (string, bool, error) {
+	if !r.reveal {
+		return "", false, nil
+	}
+	secret, err := r.secret(name)
+	if err != nil {
+		return "", false, err
+	}
+	value, ok := secret.Data[key]
+	if !ok {
+		return "", false, fmt.Errorf("secret %s has no key %s", name, key)
+	}
+	return string(value), true, nil
+}
+
+func (r *envResolver) secretVar(envName, secretName, key string) (AppContainerEnvVar, error) {
+	name := r.secretName(secretName)
+	value, ok, err := r.secretValue(name, key)
+	return AppContainerEnvVar{
+		Name:   envName,
+		Value:  value,
+		Masked: !ok,
+		Source: "secret " + name + "." + key,
+	}, err
+}
+
+// secretVars returns a variable for each key of the secret, named after the key with the prefix.
+func (r *envResolver) secretVars(prefix, secretName string) ([]AppContainerEnvVar, error) {
+	secret, err := r.secret(r.secretName(secretName))
+	if err != nil {
+		return nil, err
+	}
+
+	var result []AppContainerEnvVar
+	for _, key := range secret.Keys {
+		v, err := r.secretVar(prefix+key, secretName, key)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, v)
+	}
+	return result, nil
+}
+
+// interpolate replaces the references to secrets in value. The value is masked if it refers to any secret that isn't
+// revealed.
+func (r *envResolver) interpolate(envName, value string) (AppContainerEnvVar, error) {
+	var (
+		sources []string
+		masked  bool
+	)
+	resolved, err := replace.Replace(value, "@{", "}", func(expr string) (string, bool, error) {
+		secretName, key, ok := secretExpression(expr)
+		if ok {
+			name := r.secretName(secretName)
+			sources = append(sources, "secret "+name+"."+key)
+			value, ok, err := r.secretValue(name, key)
+			masked = masked || !ok
+			return value, ok, err
+		}
+
+		if service, ok := serviceExpression(expr); ok {
+			sources = append(sources, r.serviceSource(service))
+		}
+		return "", false, nil
+	})
+	if err != nil {
+		return AppContainerEnvVar{}, err
+	}
+
+	if masked {
+		resolved = ""
+	}
+	return AppContainerEnvVar{
+		Name:   envName,
+		Value:  resolved,
+		Masked: masked,
+		Source: strings.Join(sources, ", "),
+	}, nil
+}
+
+// serviceSource describes the service an expression refers to, and the service it is linked to if it is a link.
+func (r *envResolver) serviceSource(service string) string {
+	for _, link := range r.app.Spec.Links {
+		if link.Target == service && link.Address != "" {
+			return fmt.Sprintf("link %s to address %s", service, link.Address)
+		} else if link.Target == service {
+			return fmt.Sprintf("link %s to %s", service, link.Service)
+		}
+	}
+	return "service " + service
+}
+
+// secretExpression returns the secret and key an expression like secrets.NAME.KEY or secret://NAME/KEY refers to.
+func secretExpression(expr string) (string, string, bool) {
+	expr = strings.TrimSpace(expr)
+	if scheme, tail, ok := strings.Cut(expr, "://"); ok {
+		if scheme != "secret" && scheme != "secrets" {
+			return "", "", false
+		}
+		name, key, ok := strings.Cut(tail, "/")
+		return name, key, ok && name != "" && key != "" && !strings.Contains(key, "/")
+	}
+
+	parts := strings.Split(expr, ".")
+	if len(parts) < 3 || (parts[0] != "secret" && parts[0] != "secrets") {
+		return "", "", false
+	}
+	return strings.Join(parts[1:len(parts)-1], "."), parts[len(parts)-1], true
+}
+
+// serviceExpression returns the service an expression like services.NAME.address refers to.
+func serviceExpression(expr string) (string, bool) {
+	parts := strings.Split(strings.TrimSpace(expr), ".")
+	if len(parts) < 3 || (parts[0] != "service" && parts[0] != "services") {
+		return "", false
+	}
+	return parts[1], true
+}
+
+// hasUnescapedDot returns true if s has a dot that isn't escaped by a backslash.
+func hasUnescapedDot(s string) bool {
+	return strings.Contains(strings.ReplaceAll(s, "\\.", ""), ".")
+}
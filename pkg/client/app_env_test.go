@@ -0,0 +1,89 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestAppContainerEnv(t *testing.T) {
+	c := &appProject{
+		secretProject: &secretProject{
+			project: "acorn",
+			secrets: map[string]apiv1.Secret{
+				"app.db": {
+					ObjectMeta: metav1.ObjectMeta{Name: "app.db"},
+					Keys:       []string{"password", "username"},
+					Data:       map[string][]byte{"password": []byte("hunter2"), "username": []byte("admin")},
+				},
+				"app.config": {
+					ObjectMeta: metav1.ObjectMeta{Name: "app.config"},
+					Keys:       []string{"LEVEL"},
+					Data:       map[string][]byte{"LEVEL": []byte("info")},
+				},
+			},
+		},
+		apps: map[string]apiv1.App{
+			"app": {
+				ObjectMeta: metav1.ObjectMeta{Name: "app"},
+				Spec: v1.AppInstanceSpec{
+					Environment: []v1.NameValue{
+						{Name: "MODE", Value: "debug"},
+						{Name: "web.PORT", Value: "8080"},
+						{Name: "worker.PORT", Value: "9090"},
+					},
+					Links: []v1.ServiceBinding{{Target: "db", Service: "postgres"}},
+				},
+				Status: v1.AppInstanceStatus{
+					AppSpec: v1.AppSpec{
+						Containers: map[string]v1.Container{
+							"web": {
+								Environment: []v1.EnvVar{
+									{Value: "LOG_", Secret: v1.SecretReference{Name: "config"}},
+									{Name: "LOG_LEVEL", Value: "warn"},
+									{Name: "MODE", Value: "production"},
+									{Name: "DB_USER", Secret: v1.SecretReference{Name: "db", Key: "username"}},
+									{Name: "DB_URL", Value: "postgres://@{secrets.db.username}:@{secrets.db.password}@@{services.db.address}/app"},
+									{Name: "DB_HOST", Value: "@{services.db.address}"},
+									{Name: "app\\.name", Value: "app"},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	masked, err := appContainerEnv(context.Background(), c, "app", "web", nil)
+	require.NoError(t, err)
+	assert.Equal(t, []AppContainerEnvVar{
+		{Name: "LOG_LEVEL", Value: "warn"},
+		{Name: "DB_USER", Masked: true, Source: "secret app.db.username"},
+		{Name: "DB_URL", Masked: true, Source: "secret app.db.username, secret app.db.password, link db to postgres"},
+		{Name: "DB_HOST", Value: "@{services.db.address}", Source: "link db to postgres"},
+		{Name: "app.name", Value: "app"},
+		{Name: "MODE", Value: "debug"},
+		{Name: "PORT", Value: "8080"},
+	}, masked)
+
+	revealed, err := appContainerEnv(context.Background(), c, "app", "web", &AppContainerEnvOptions{Reveal: true})
+	require.NoError(t, err)
+	assert.Equal(t, []AppContainerEnvVar{
+		{Name: "LOG_LEVEL", Value: "warn"},
+		{Name: "DB_USER", Value: "admin", Source: "secret app.db.username"},
+		{Name: "DB_URL", Value: "postgres://admin:hunter2@@{services.db.address}/app", Source: "secret app.db.username, secret app.db.password, link db to postgres"},
+		{Name: "DB_HOST", Value: "@{services.db.address}", Source: "link db to postgres"},
+		{Name: "app.name", Value: "app"},
+		{Name: "MODE", Value: "debug"},
+		{Name: "PORT", Value: "8080"},
+	}, revealed)
+
+	_, err = appContainerEnv(context.Background(), c, "app", "worker", nil)
+	assert.EqualError(t, err, "app app has no container worker")
+}
@@ -0,0 +1,236 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/acorn-io/baaah/pkg/typed"
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"golang.org/x/exp/slices"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/yaml"
+)
+
+func (c *DefaultClient) AppExport(ctx context.Context, name string, opts *AppExportOptions) ([]byte, error) {
+	return exportApp(ctx, c, name, opts)
+}
+
+func (c *DefaultClient) AppImport(ctx context.Context, bundle []byte, opts *AppImportOptions) (*apiv1.App, error) {
+	return importApp(ctx, c, bundle, opts)
+}
+
+func exportApp(ctx context.Context, c Client, name string, opts *AppExportOptions) ([]byte, error) {
+	if opts == nil {
+		opts = &AppExportOptions{}
+	}
+
+	app, err := c.AppGet(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	bundle := AppBundle{
+		Name: app.Name,
+		Spec: app.Spec,
+	}
+	// Permissions granted to the image are granted again when the app is imported
+	bundle.Spec.ImageGrantedPermissions = nil
+
+	for _, binding := range app.Spec.Secrets {
+		secret, err := exportSecret(ctx, c, binding.Secret, opts.IncludeSecrets)
+		if err != nil {
+			return nil, err
+		}
+		bundle.Secrets = append(bundle.Secrets, *secret)
+	}
+
+	volumes, err := c.VolumeList(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, volume := range volumes {
+		if volume.Status.AppName != app.Name || volume.Status.VolumeName == "" {
+			continue
+		}
+		exported := AppBundleVolume{
+			Name:        volume.Status.VolumeName,
+			Class:       volume.Spec.Class,
+			AccessModes: volume.Spec.AccessModes,
+		}
+		if volume.Spec.Capacity != nil {
+			exported.Capacity = volume.Spec.Capacity.String()
+		}
+		bundle.Volumes = append(bundle.Volumes, exported)
+	}
+	sort.Slice(bundle.Volumes, func(i, j int) bool {
+		return bundle.Volumes[i].Name < bundle.Volumes[j].Name
+	})
+
+	return yaml.Marshal(bundle)
+}
+
+func exportSecret(ctx context.Context, c Client, name string, includeData bool) (*AppBundleSecret, error) {
+	if !includeData {
+		secret, err := c.SecretGet(ctx, name)
+		if err != nil {
+			return nil, fmt.Errorf("exporting secret %s: %w", name, err)
+		}
+		return &AppBundleSecret{
+			Name: name,
+			Type: secret.Type,
+			Keys: secret.Keys,
+		}, nil
+	}
+
+	secret, err := c.SecretReveal(ctx, name)
+	if apierrors.IsForbidden(err) {
+		return nil, fmt.Errorf("secret %s can not be exported, its data can not be revealed: %w", name, err)
+	} else if err != nil {
+		return nil, fmt.Errorf("exporting secret %s: %w", name, err)
+	}
+	if len(secret.Data) == 0 && len(secret.Keys) > 0 {
+		return nil, fmt.Errorf("secret %s can not be exported, its data is only available on the server", name)
+	}
+	return &AppBundleSecret{
+		Name: name,
+		Type: secret.Type,
+		Keys: secret.Keys,
+		Data: secret.Data,
+	}, nil
+}
+
+func importApp(ctx context.Context, c Client, data []byte, opts *AppImportOptions) (*apiv1.App, error) {
+	if opts == nil {
+		opts = &AppImportOptions{}
+	}
+
+	bundle := AppBundle{}
+	if err := yaml.Unmarshal(data, &bundle); err != nil {
+		return nil, fmt.Errorf("parsing app bundle: %w", err)
+	}
+	if bundle.Spec.Image == "" {
+		return nil, fmt.Errorf("invalid app bundle, it has no image")
+	}
+
+	name := opts.Name
+	if name == "" {
+		name = bundle.Name
+	}
+
+	volumes := importVolumes(bundle.Spec.Volumes, bundle.Volumes)
+	if err := checkImportClasses(ctx, c, bundle.Spec.ComputeClasses, volumes); err != nil {
+		return nil, err
+	}
+
+	// Check all secrets before creating any, so a failed import doesn't leave secrets behind
+	var toCreate []AppBundleSecret
+	for _, secret := range bundle.Secrets {
+		_, err := c.SecretGet(ctx, secret.Name)
+		if apierrors.IsNotFound(err) {
+			if secret.Data == nil {
+				return nil, fmt.Errorf("secret %s was exported without its data and does not exist in project %s, "+
+					"create it or export the app with --include-secrets", secret.Name, c.GetProject())
+			}
+			toCreate = append(toCreate, secret)
+		} else if err != nil {
+			return nil, err
+		} else if secret.Data != nil {
+			return nil, fmt.Errorf("secret %s already exists in project %s", secret.Name, c.GetProject())
+		}
+	}
+	for _, secret := range toCreate {
+		if _, err := c.SecretCreate(ctx, secret.Name, secret.Type, secret.Data); err != nil {
+			return nil, fmt.Errorf("importing secret %s: %w", secret.Name, err)
+		}
+	}
+
+	return c.AppRun(ctx, bundle.Spec.Image, &AppRunOptions{
+		Name:                name,
+		Region:              bundle.Spec.Region,
+		Annotations:         bundle.Spec.Annotations,
+		Labels:              bundle.Spec.Labels,
+		PublishMode:         bundle.Spec.PublishMode,
+		Volumes:             volumes,
+		Secrets:             bundle.Spec.Secrets,
+		Links:               bundle.Spec.Links,
+		Publish:             bundle.Spec.Publish,
+		Env:                 bundle.Spec.Environment,
+		Profiles:            bundle.Spec.Profiles,
+		DeployArgs:          bundle.Spec.DeployArgs.GetData(),
+		Stop:                bundle.Spec.Stop,
+		Permissions:         bundle.Spec.GrantedPermissions,
+		AutoUpgrade:         bundle.Spec.AutoUpgrade,
+		NotifyUpgrade:       bundle.Spec.NotifyUpgrade,
+		AutoUpgradeInterval: bundle.Spec.AutoUpgradeInterval,
+		Memory:              bundle.Spec.Memory,
+		CPU:                 bundle.Spec.CPU,
+		ComputeClasses:      bundle.Spec.ComputeClasses,
+		SignaturePolicy:     bundle.Spec.SignaturePolicy,
+		Scale:               bundle.Spec.Scale,
+		ServiceGrants:       bundle.Spec.ServiceGrants,
+	})
+}
+
+// importVolumes adds a binding for each exported volume that isn't bound already, so the volumes are recreated with
+// the same size, class and access modes.
+func importVolumes(bindings []v1.VolumeBinding, volumes []AppBundleVolume) []v1.VolumeBinding {
+	result := append([]v1.VolumeBinding(nil), bindings...)
+	for _, volume := range volumes {
+		if slices.ContainsFunc(bindings, func(binding v1.VolumeBinding) bool {
+			return binding.Target == volume.Name
+		}) {
+			continue
+		}
+		result = append(result, v1.VolumeBinding{
+			Target:      volume.Name,
+			Size:        v1.Quantity(volume.Capacity),
+			Class:       volume.Class,
+			AccessModes: volume.AccessModes,
+		})
+	}
+	return result
+}
+
+// checkImportClasses fails if any of the compute or volume classes used by the app doesn't exist in the project.
+func checkImportClasses(ctx context.Context, c Client, computeClasses v1.ComputeClassMap, volumes []v1.VolumeBinding) error {
+	if len(computeClasses) > 0 {
+		available, err := c.ComputeClassList(ctx)
+		if err != nil {
+			return err
+		}
+		for _, workload := range typed.SortedKeys(computeClasses) {
+			computeClass := computeClasses[workload]
+			if computeClass == "" || slices.ContainsFunc(available, func(cc apiv1.ComputeClass) bool {
+				return cc.Name == computeClass
+			}) {
+				continue
+			}
+			if workload == "" {
+				return fmt.Errorf("compute class %s of all workloads does not exist in project %s", computeClass, c.GetProject())
+			}
+			return fmt.Errorf("compute class %s of workload %s does not exist in project %s", computeClass, workload, c.GetProject())
+		}
+	}
+
+	var available []apiv1.VolumeClass
+	for _, volume := range volumes {
+		if volume.Class == "" {
+			continue
+		}
+		if available == nil {
+			var err error
+			if available, err = c.VolumeClassList(ctx); err != nil {
+				return err
+			}
+		}
+		if !slices.ContainsFunc(available, func(vc apiv1.VolumeClass) bool {
+			return vc.Name == volume.Class
+		}) {
+			return fmt.Errorf("volume class %s of volume %s does not exist in project %s", volume.Class, volume.Target, c.GetProject())
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,180 @@
+package client
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/z"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// appProject is a Client for a single project that only supports the operations used to export and import apps.
+type appProject struct {
+	*secretProject
+	apps           map[string]apiv1.App
+	volumes        []apiv1.Volume
+	computeClasses []apiv1.ComputeClass
+	volumeClasses  []apiv1.VolumeClass
+}
+
+func (a *appProject) AppGet(_ context.Context, name string) (*apiv1.App, error) {
+	app := a.apps[name]
+	return &app, nil
+}
+
+func (a *appProject) AppRun(_ context.Context, image string, opts *AppRunOptions) (*apiv1.App, error) {
+	app := ToApp(a.project, image, opts)
+	a.apps[app.Name] = *app
+	return app, nil
+}
+
+func (a *appProject) VolumeList(context.Context) ([]apiv1.Volume, error) {
+	return a.volumes, nil
+}
+
+func (a *appProject) ComputeClassList(context.Context) ([]apiv1.ComputeClass, error) {
+	return a.computeClasses, nil
+}
+
+func (a *appProject) VolumeClassList(context.Context) ([]apiv1.VolumeClass, error) {
+	return a.volumeClasses, nil
+}
+
+func TestExportImportApp(t *testing.T) {
+	capacity := resource.MustParse("10G")
+	src := &appProject{
+		secretProject: &secretProject{
+			project: "src",
+			secrets: map[string]apiv1.Secret{
+				"creds": {ObjectMeta: metav1.ObjectMeta{Name: "creds"}, Type: "basic", Data: map[string][]byte{"password": []byte("secret")}},
+			},
+		},
+		apps: map[string]apiv1.App{
+			"app": {
+				ObjectMeta: metav1.ObjectMeta{Name: "app"},
+				Spec: v1.AppInstanceSpec{
+					Image:          "app:v1",
+					Secrets:        []v1.SecretBinding{{Secret: "creds", Target: "creds"}},
+					ComputeClasses: v1.ComputeClassMap{"web": "large"},
+					ServiceGrants:  &v1.ServiceGrants{Consumers: []string{"frontend"}},
+				},
+			},
+		},
+		volumes: []apiv1.Volume{
+			{
+				Spec:   apiv1.VolumeSpec{Capacity: &capacity, Class: "fast", AccessModes: []v1.AccessMode{v1.AccessModeReadWriteOnce}},
+				Status: apiv1.VolumeStatus{AppName: "app", VolumeName: "data"},
+			},
+			{
+				Status: apiv1.VolumeStatus{AppName: "other", VolumeName: "data"},
+			},
+		},
+	}
+
+	withoutSecrets, err := exportApp(context.Background(), src, "app", nil)
+	require.NoError(t, err)
+	assert.NotContains(t, string(withoutSecrets), "password")
+
+	withSecrets, err := exportApp(context.Background(), src, "app", &AppExportOptions{IncludeSecrets: true})
+	require.NoError(t, err)
+
+	dst := func() *appProject {
+		return &appProject{
+			secretProject:  &secretProject{project: "dst", secrets: map[string]apiv1.Secret{}},
+			apps:           map[string]apiv1.App{},
+			computeClasses: []apiv1.ComputeClass{{ObjectMeta: metav1.ObjectMeta{Name: "large"}}},
+			volumeClasses:  []apiv1.VolumeClass{{ObjectMeta: metav1.ObjectMeta{Name: "fast"}}},
+		}
+	}
+
+	_, err = importApp(context.Background(), dst(), withoutSecrets, nil)
+	assert.EqualError(t, err, "secret creds was exported without its data and does not exist in project dst, "+
+		"create it or export the app with --include-secrets")
+
+	noComputeClass := dst()
+	noComputeClass.computeClasses = nil
+	_, err = importApp(context.Background(), noComputeClass, withSecrets, nil)
+	assert.EqualError(t, err, "compute class large of workload web does not exist in project dst")
+
+	noVolumeClass := dst()
+	noVolumeClass.volumeClasses = nil
+	_, err = importApp(context.Background(), noVolumeClass, withSecrets, nil)
+	assert.EqualError(t, err, "volume class fast of volume data does not exist in project dst")
+
+	target := dst()
+	app, err := importApp(context.Background(), target, withSecrets, &AppImportOptions{Name: "copy"})
+	require.NoError(t, err)
+	assert.Equal(t, "copy", app.Name)
+	assert.Equal(t, "app:v1", app.Spec.Image)
+	assert.Equal(t, []v1.VolumeBinding{{Target: "data", Size: "10G", Class: "fast", AccessModes: []v1.AccessMode{v1.AccessModeReadWriteOnce}}}, app.Spec.Volumes)
+	assert.Equal(t, &v1.ServiceGrants{Consumers: []string{"frontend"}}, app.Spec.ServiceGrants)
+	assert.Equal(t, map[string][]byte{"password": []byte("secret")}, target.secrets["creds"].Data)
+}
+
+func TestExportImportAppSpec(t *testing.T) {
+	spec := v1.AppInstanceSpec{
+		Region:              "us-east",
+		Labels:              []v1.ScopedLabel{{Key: "team", Value: "a"}},
+		Annotations:         []v1.ScopedLabel{{ResourceType: "container", Key: "note", Value: "b"}},
+		Image:               "app:v1",
+		Stop:                z.Pointer(true),
+		Profiles:            []string{"prod"},
+		Volumes:             []v1.VolumeBinding{{Target: "data", Size: "10G", Class: "fast"}},
+		Secrets:             []v1.SecretBinding{{Secret: "creds", Target: "creds"}},
+		Environment:         []v1.NameValue{{Name: "LOG_LEVEL", Value: "debug"}},
+		PublishMode:         v1.PublishModeAll,
+		Links:               []v1.ServiceBinding{{Target: "db", Service: "postgres"}},
+		Publish:             []v1.PortBinding{{Port: 81, TargetPort: 80}},
+		DeployArgs:          v1.NewGenericMap(map[string]any{"replicas": int64(3)}),
+		GrantedPermissions:  []v1.Permissions{{ServiceName: "web", Rules: []v1.PolicyRule{{PolicyRule: rbacv1.PolicyRule{Verbs: []string{"get"}}}}}},
+		AutoUpgrade:         z.Pointer(true),
+		NotifyUpgrade:       z.Pointer(true),
+		AutoUpgradeInterval: "5m",
+		ComputeClasses:      v1.ComputeClassMap{"web": "large"},
+		Memory:              v1.MemoryMap{"": z.Pointer[int64](1024)},
+		CPU:                 v1.CPUMap{"web": z.Pointer[int64](500)},
+		Scale:               map[string]int32{"web": 3},
+		SignaturePolicy:     &v1.SignaturePolicy{Key: "cosign.pub"},
+		ServiceGrants:       &v1.ServiceGrants{Consumers: []string{"frontend"}},
+	}
+
+	// Every field has to be set, so that a field added to the spec fails this test until it is carried over on import
+	notExported := map[string]bool{"ImageGrantedPermissions": true}
+	fields := reflect.ValueOf(spec)
+	for i := 0; i < fields.NumField(); i++ {
+		if name := fields.Type().Field(i).Name; fields.Field(i).IsZero() && !notExported[name] {
+			t.Errorf("field %s of the test spec is not set", name)
+		}
+	}
+
+	src := &appProject{
+		secretProject: &secretProject{
+			project: "src",
+			secrets: map[string]apiv1.Secret{
+				"creds": {ObjectMeta: metav1.ObjectMeta{Name: "creds"}, Data: map[string][]byte{"password": []byte("secret")}},
+			},
+		},
+		apps: map[string]apiv1.App{
+			"app": {ObjectMeta: metav1.ObjectMeta{Name: "app"}, Spec: spec},
+		},
+	}
+	bundle, err := exportApp(context.Background(), src, "app", &AppExportOptions{IncludeSecrets: true})
+	require.NoError(t, err)
+
+	dst := &appProject{
+		secretProject:  &secretProject{project: "dst", secrets: map[string]apiv1.Secret{}},
+		apps:           map[string]apiv1.App{},
+		computeClasses: []apiv1.ComputeClass{{ObjectMeta: metav1.ObjectMeta{Name: "large"}}},
+		volumeClasses:  []apiv1.VolumeClass{{ObjectMeta: metav1.ObjectMeta{Name: "fast"}}},
+	}
+	app, err := importApp(context.Background(), dst, bundle, nil)
+	require.NoError(t, err)
+	assert.Equal(t, spec, app.Spec)
+}
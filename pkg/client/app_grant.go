@@ -0,0 +1,78 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"golang.org/x/exp/slices"
+	"k8s.io/client-go/util/retry"
+)
+
+// ServiceGrant allows the app consumer to link to the services of the app publisher. The first grant restricts the
+// services of publisher to the granted apps, so the apps already linking to them are granted access too.
+func (c *DefaultClient) ServiceGrant(ctx context.Context, publisher, consumer string) (*apiv1.App, error) {
+	var app *apiv1.App
+	return app, retry.RetryOnConflict(retry.DefaultRetry, func() (err error) {
+		app, err = c.AppGet(ctx, publisher)
+		if err != nil {
+			return err
+		}
+
+		if app.Spec.ServiceGrants == nil {
+			apps, err := c.AppList(ctx)
+			if err != nil {
+				return err
+			}
+			app.Spec.ServiceGrants = &v1.ServiceGrants{
+				Consumers: linkingApps(apps, publisher),
+			}
+		}
+
+		if slices.Contains(app.Spec.ServiceGrants.Consumers, consumer) {
+			return nil
+		}
+		app.Spec.ServiceGrants.Consumers = append(app.Spec.ServiceGrants.Consumers, consumer)
+		slices.Sort(app.Spec.ServiceGrants.Consumers)
+		return c.Client.Update(ctx, app)
+	})
+}
+
+// ServiceRevoke removes the grant of the app consumer to link to the services of the app publisher. The existing
+// links of consumer to them stop working.
+func (c *DefaultClient) ServiceRevoke(ctx context.Context, publisher, consumer string) (*apiv1.App, error) {
+	var app *apiv1.App
+	return app, retry.RetryOnConflict(retry.DefaultRetry, func() (err error) {
+		app, err = c.AppGet(ctx, publisher)
+		if err != nil {
+			return err
+		}
+
+		if app.Spec.ServiceGrants == nil || !slices.Contains(app.Spec.ServiceGrants.Consumers, consumer) {
+			return fmt.Errorf("app %s has no grant to %s", publisher, consumer)
+		}
+		app.Spec.ServiceGrants.Consumers = slices.DeleteFunc(app.Spec.ServiceGrants.Consumers, func(name string) bool {
+			return name == consumer
+		})
+		return c.Client.Update(ctx, app)
+	})
+}
+
+// linkingApps returns the sorted names of the apps with links to the services of the app publisher
+func linkingApps(apps []apiv1.App, publisher string) (result []string) {
+	for _, app := range apps {
+		if app.Name == publisher {
+			continue
+		}
+		for _, link := range app.Spec.Links {
+			if appName, _, _ := strings.Cut(link.Service, "."); appName == publisher {
+				result = append(result, app.Name)
+				break
+			}
+		}
+	}
+	slices.Sort(result)
+	return result
+}
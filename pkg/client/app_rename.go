@@ -0,0 +1,221 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/exp/slices"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/util/retry"
+)
+
+// AppRename replaces the app oldName with an identical app named newName. The volumes and secrets of the old app are
+// bound to the new one, so that no data is lost, and links of other apps to the old app are updated to the new one.
+// Grants of other apps to the old app are moved to the new one. The delete jobs of the old app are not run.
+func (c *DefaultClient) AppRename(ctx context.Context, oldName, newName string) (*apiv1.App, error) {
+	if oldName == newName {
+		return nil, fmt.Errorf("app %s already has the name %s", oldName, newName)
+	}
+
+	if _, err := c.AppGet(ctx, newName); err == nil {
+		return nil, fmt.Errorf("app %s already exists", newName)
+	} else if !apierrors.IsNotFound(err) {
+		return nil, err
+	}
+
+	app, err := c.AppGet(ctx, oldName)
+	if err != nil {
+		return nil, err
+	}
+
+	volumes, err := c.VolumeList(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	secrets, err := c.SecretList(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	apps, err := c.AppList(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// The new app must be granted access to the services it links to before it is created
+	var publishers []string
+	for _, other := range apps {
+		if other.Name != oldName && other.Spec.ServiceGrants != nil && slices.Contains(other.Spec.ServiceGrants.Consumers, oldName) {
+			if _, err := c.ServiceGrant(ctx, other.Name, newName); err != nil {
+				return nil, fmt.Errorf("granting app %s access to the services of app %s: %w", newName, other.Name, err)
+			}
+			publishers = append(publishers, other.Name)
+		}
+	}
+
+	renamed := renameApp(app, newName, volumes, secrets)
+	if err := translateErr(c.Client.Create(ctx, renamed)); err != nil {
+		return nil, err
+	}
+
+	if _, err := c.AppDelete(ctx, oldName); err != nil {
+		return renamed, fmt.Errorf("deleting app %s after creating %s: %w", oldName, newName, err)
+	}
+
+	// The old app is deleted as part of the rename, not removed, so it must not run its delete jobs. The server only
+	// accepts this once it sees the app being deleted, so retry until it does.
+	if err := retry.OnError(wait.Backoff{
+		Steps:    5,
+		Duration: 500 * time.Millisecond,
+		Factor:   2,
+		Jitter:   0.1,
+	}, func(err error) bool {
+		var statusErr *apierrors.StatusError
+		return errors.As(err, &statusErr) && statusErr.Status().Code == http.StatusBadRequest && strings.HasSuffix(statusErr.Status().Message, "it is not being deleted")
+	}, func() error {
+		return c.AppIgnoreDeleteCleanup(ctx, oldName)
+	}); err != nil && !apierrors.IsNotFound(err) {
+		return renamed, fmt.Errorf("skipping cleanup for app %s: %w", oldName, err)
+	}
+
+	var errs []error
+	for _, other := range apps {
+		if other.Name == oldName {
+			continue
+		}
+
+		if _, changed := renameLinks(other.Spec.Links, oldName, newName); !changed {
+			continue
+		}
+
+		if err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+			return c.updateLinks(ctx, other.Name, oldName, newName)
+		}); err != nil {
+			errs = append(errs, fmt.Errorf("updating links of app %s to %s: %w", other.Name, oldName, err))
+			continue
+		}
+
+		logrus.Infof("Updated the links of app %s from %s to %s", other.Name, oldName, newName)
+	}
+
+	for _, publisher := range publishers {
+		if _, err := c.ServiceRevoke(ctx, publisher, oldName); err != nil {
+			errs = append(errs, fmt.Errorf("revoking grant of app %s to %s: %w", publisher, oldName, err))
+		}
+	}
+
+	return renamed, errors.Join(errs...)
+}
+
+func (c *DefaultClient) updateLinks(ctx context.Context, name, oldName, newName string) error {
+	app, err := c.AppGet(ctx, name)
+	if err != nil {
+		return err
+	}
+
+	links, changed := renameLinks(app.Spec.Links, oldName, newName)
+	if !changed {
+		return nil
+	}
+
+	app.Spec.Links = links
+	return c.Client.Update(ctx, app)
+}
+
+// renameApp returns a copy of app named newName, with the volumes and secrets of app bound to it.
+func renameApp(app *apiv1.App, newName string, volumes []apiv1.Volume, secrets []apiv1.Secret) *apiv1.App {
+	renamed := &apiv1.App{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        newName,
+			Namespace:   app.Namespace,
+			Labels:      app.Labels,
+			Annotations: app.Annotations,
+		},
+		Spec: *app.Spec.DeepCopy(),
+	}
+
+	bound := map[string]bool{}
+	for _, binding := range renamed.Spec.Volumes {
+		if binding.Volume != "" {
+			bound[binding.Target] = true
+		}
+	}
+	for _, volume := range volumes {
+		target := volume.Status.VolumeName
+		if volume.Status.AppName != app.Name || bound[target] {
+			continue
+		}
+		if _, ok := app.Status.AppSpec.Volumes[target]; !ok {
+			continue
+		}
+
+		renamed.Spec.Volumes = setVolumeBinding(renamed.Spec.Volumes, target, volume.Name)
+		bound[target] = true
+	}
+
+	bound = map[string]bool{}
+	for _, binding := range renamed.Spec.Secrets {
+		bound[binding.Target] = true
+	}
+	for _, secret := range secrets {
+		// Only the secrets of the app itself, nested apps get new secrets
+		target, ok := strings.CutPrefix(secret.Name, app.Name+".")
+		if !ok || strings.ContainsRune(target, '.') || bound[target] {
+			continue
+		}
+		if _, ok := app.Status.AppSpec.Secrets[target]; !ok {
+			continue
+		}
+
+		renamed.Spec.Secrets = append(renamed.Spec.Secrets, v1.SecretBinding{
+			Secret: secret.Name,
+			Target: target,
+		})
+		bound[target] = true
+	}
+
+	return renamed
+}
+
+// setVolumeBinding binds the volume to target, keeping the other settings of an existing binding for target.
+func setVolumeBinding(bindings []v1.VolumeBinding, target, volume string) []v1.VolumeBinding {
+	for i, binding := range bindings {
+		if binding.Target == target {
+			bindings[i].Volume = volume
+			return bindings
+		}
+	}
+	return append(bindings, v1.VolumeBinding{
+		Volume: volume,
+		Target: target,
+	})
+}
+
+// renameLinks returns the links with services of the app oldName, or of its nested apps, pointing to newName instead.
+func renameLinks(links []v1.ServiceBinding, oldName, newName string) ([]v1.ServiceBinding, bool) {
+	var (
+		result  = make([]v1.ServiceBinding, 0, len(links))
+		changed bool
+	)
+	for _, link := range links {
+		if link.Service == oldName {
+			link.Service = newName
+			changed = true
+		} else if rest, ok := strings.CutPrefix(link.Service, oldName+"."); ok {
+			link.Service = newName + "." + rest
+			changed = true
+		}
+		result = append(result, link)
+	}
+	return result, changed
+}
@@ -0,0 +1,97 @@
+package client
+
+import (
+	"testing"
+
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestRenameApp(t *testing.T) {
+	app := &apiv1.App{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "old",
+			Namespace:   "acorn",
+			Labels:      map[string]string{"team": "a"},
+			Annotations: map[string]string{"note": "b"},
+			Finalizers:  []string{"acorn.io/app"},
+		},
+		Spec: v1.AppInstanceSpec{
+			Image: "image",
+			Volumes: []v1.VolumeBinding{
+				{Target: "data", Size: "10G"},
+				{Target: "bound", Volume: "pv-bound"},
+			},
+			Secrets: []v1.SecretBinding{
+				{Target: "bound", Secret: "other.bound"},
+			},
+		},
+		Status: v1.AppInstanceStatus{
+			AppSpec: v1.AppSpec{
+				Volumes: map[string]v1.VolumeRequest{
+					"data":  {},
+					"cache": {},
+					"bound": {},
+				},
+				Secrets: map[string]v1.Secret{
+					"password": {},
+					"bound":    {},
+				},
+			},
+		},
+	}
+
+	volumes := []apiv1.Volume{
+		{ObjectMeta: metav1.ObjectMeta{Name: "pv-data"}, Status: apiv1.VolumeStatus{AppName: "old", VolumeName: "data"}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "pv-cache"}, Status: apiv1.VolumeStatus{AppName: "old", VolumeName: "cache"}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "pv-bound-bind"}, Status: apiv1.VolumeStatus{AppName: "old", VolumeName: "bound-bind"}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "pv-other"}, Status: apiv1.VolumeStatus{AppName: "other", VolumeName: "data"}},
+	}
+	secrets := []apiv1.Secret{
+		{ObjectMeta: metav1.ObjectMeta{Name: "old.password"}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "old.bound"}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "old.nested.password"}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "older.password"}},
+	}
+
+	renamed := renameApp(app, "new", volumes, secrets)
+	assert.Equal(t, metav1.ObjectMeta{
+		Name:        "new",
+		Namespace:   "acorn",
+		Labels:      map[string]string{"team": "a"},
+		Annotations: map[string]string{"note": "b"},
+	}, renamed.ObjectMeta)
+	assert.Equal(t, "image", renamed.Spec.Image)
+	assert.Equal(t, []v1.VolumeBinding{
+		{Target: "data", Size: "10G", Volume: "pv-data"},
+		{Target: "bound", Volume: "pv-bound"},
+		{Target: "cache", Volume: "pv-cache"},
+	}, renamed.Spec.Volumes)
+	assert.Equal(t, []v1.SecretBinding{
+		{Target: "bound", Secret: "other.bound"},
+		{Target: "password", Secret: "old.password"},
+	}, renamed.Spec.Secrets)
+
+	// The old app is left untouched
+	assert.Len(t, app.Spec.Volumes, 2)
+	assert.Empty(t, app.Spec.Volumes[0].Volume)
+}
+
+func TestRenameLinks(t *testing.T) {
+	links, changed := renameLinks([]v1.ServiceBinding{
+		{Target: "db", Service: "old"},
+		{Target: "cache", Service: "old.redis"},
+		{Target: "web", Service: "older"},
+	}, "old", "new")
+	assert.True(t, changed)
+	assert.Equal(t, []v1.ServiceBinding{
+		{Target: "db", Service: "new"},
+		{Target: "cache", Service: "new.redis"},
+		{Target: "web", Service: "older"},
+	}, links)
+
+	_, changed = renameLinks([]v1.ServiceBinding{{Target: "web", Service: "older"}}, "old", "new")
+	assert.False(t, changed)
+}
@@ -0,0 +1,38 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	"k8s.io/client-go/util/retry"
+)
+
+// AppScale sets the number of replicas of the container of the app, overriding the scale defined in its Acornfile.
+// Only the scale of the app is changed, the app isn't rendered again.
+func (c *DefaultClient) AppScale(ctx context.Context, name, container string, replicas int32) (*apiv1.App, error) {
+	if replicas < 0 {
+		return nil, fmt.Errorf("invalid replicas %d for container %s: must not be negative", replicas, container)
+	}
+
+	var app *apiv1.App
+	return app, retry.RetryOnConflict(retry.DefaultRetry, func() (err error) {
+		app, err = c.AppGet(ctx, name)
+		if err != nil {
+			return err
+		}
+
+		if _, ok := app.Status.AppSpec.Containers[container]; !ok {
+			return fmt.Errorf("app %s has no container %s", name, container)
+		}
+
+		if current, ok := app.Spec.Scale[container]; ok && current == replicas {
+			return nil
+		}
+		if app.Spec.Scale == nil {
+			app.Spec.Scale = map[string]int32{}
+		}
+		app.Spec.Scale[container] = replicas
+		return c.Client.Update(ctx, app)
+	})
+}
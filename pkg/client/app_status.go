@@ -0,0 +1,113 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	objwatcher "github.com/acorn-io/baaah/pkg/watcher"
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/channels"
+	"github.com/acorn-io/runtime/pkg/streams"
+	"github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+// appStatusInterval is the minimum time between two updates sent by AppStatusStream, updates arriving faster are
+// coalesced into the last one.
+const appStatusInterval = 250 * time.Millisecond
+
+func (c *DefaultClient) AppStatusStream(ctx context.Context, name string) (<-chan apiv1.App, error) {
+	app, err := c.AppGet(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	updates := make(chan apiv1.App)
+	go func() {
+		defer close(updates)
+		if err := c.watchAppStatus(ctx, app.Name, updates); !channels.NilOrCanceled(err) {
+			streams.CurrentOutput().MustWriteErr(fmt.Errorf("failed to watch status of app %s: %w", app.Name, err))
+		}
+	}()
+
+	return coalesceApps(ctx, updates, appStatusInterval), nil
+}
+
+// watchAppStatus sends the app to result every time it changes, until the app is deleted or the context is closed.
+// Watches that expire are started again from the current state of the app.
+func (c *DefaultClient) watchAppStatus(ctx context.Context, name string, result chan<- apiv1.App) error {
+	w := objwatcher.New[*apiv1.App](c.Client)
+	for {
+		_, err := w.ByName(ctx, c.Namespace, name, func(app *apiv1.App) (bool, error) {
+			if err := channels.Send(ctx, result, *app); err != nil {
+				return false, err
+			}
+			return !app.DeletionTimestamp.IsZero() && len(app.Finalizers) == 0, nil
+		})
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if !apierrors.IsGone(err) && !apierrors.IsResourceExpired(err) {
+			return err
+		}
+		logrus.Debugf("Status watch of app %s expired, restarting it: %v", name, err)
+	}
+}
+
+// coalesceApps forwards the apps received from in, but at most one per interval. If more arrive within the interval,
+// only the last of them is forwarded once the interval is over. Apps whose status didn't change since the last one
+// forwarded are dropped.
+func coalesceApps(ctx context.Context, in <-chan apiv1.App, interval time.Duration) <-chan apiv1.App {
+	out := make(chan apiv1.App)
+	go func() {
+		defer close(out)
+
+		var (
+			last, pending *apiv1.App
+			timer         <-chan time.Time
+		)
+		send := func(app *apiv1.App) bool {
+			if err := channels.Send(ctx, out, *app); err != nil {
+				return false
+			}
+			last, pending, timer = app, nil, time.After(interval)
+			return true
+		}
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case app, ok := <-in:
+				if !ok {
+					if pending != nil {
+						send(pending)
+					}
+					return
+				}
+				if last != nil && sameAppStatus(last, &app) {
+					pending = nil
+					continue
+				}
+				pending = &app
+				if timer == nil && !send(pending) {
+					return
+				}
+			case <-timer:
+				timer = nil
+				if pending != nil && !send(pending) {
+					return
+				}
+			}
+		}
+	}()
+	return out
+}
+
+func sameAppStatus(a, b *apiv1.App) bool {
+	return a.Generation == b.Generation &&
+		a.DeletionTimestamp.IsZero() == b.DeletionTimestamp.IsZero() &&
+		equality.Semantic.DeepEqual(a.Status, b.Status)
+}
@@ -0,0 +1,72 @@
+package client
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestCoalesceApps(t *testing.T) {
+	app := func(rv, msg string) apiv1.App {
+		return apiv1.App{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-app", ResourceVersion: rv},
+			Status:     v1.AppInstanceStatus{Columns: v1.AppColumns{Message: msg}},
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	in := make(chan apiv1.App)
+	out := coalesceApps(ctx, in, time.Hour)
+
+	go func() {
+		defer close(in)
+		for _, a := range []apiv1.App{
+			app("1", "pending"),
+			// Within the interval, only the last of these is sent
+			app("2", "pending"),
+			app("3", "creating"),
+			app("4", "deploying"),
+		} {
+			in <- a
+		}
+	}()
+
+	var got []string
+	for a := range out {
+		got = append(got, a.ResourceVersion)
+	}
+	assert.Equal(t, []string{"1", "4"}, got)
+}
+
+func TestCoalesceAppsDropsUnchanged(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	in := make(chan apiv1.App)
+	out := coalesceApps(ctx, in, time.Millisecond)
+
+	go func() {
+		defer close(in)
+		for _, rv := range []string{"1", "2", "3"} {
+			in <- apiv1.App{ObjectMeta: metav1.ObjectMeta{Name: "my-app", ResourceVersion: rv}}
+			time.Sleep(10 * time.Millisecond)
+		}
+		in <- apiv1.App{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-app", ResourceVersion: "4"},
+			Status:     v1.AppInstanceStatus{Ready: true},
+		}
+	}()
+
+	var got []string
+	for a := range out {
+		got = append(got, a.ResourceVersion)
+	}
+	assert.Equal(t, []string{"1", "4"}, got)
+}
@@ -3,8 +3,13 @@ package client
 import (
 	"testing"
 
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
 	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/labels"
+	"github.com/acorn-io/z"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 func TestMergeEnv(t *testing.T) {
@@ -88,3 +93,44 @@ func TestLabelsAndAnnotations(t *testing.T) {
 	assert.Equal(t, "v2", app.Annotations["anno2"])
 	assert.NotContains(t, app.Annotations, "anno3")
 }
+
+func TestPauseResumeApp(t *testing.T) {
+	app := &apiv1.App{
+		ObjectMeta: metav1.ObjectMeta{Name: "app"},
+		Status: v1.AppInstanceStatus{
+			AppStatus: v1.AppStatus{
+				Containers: map[string]v1.ContainerStatus{
+					"web":    {DesiredReplicaCount: 3},
+					"worker": {DesiredReplicaCount: 1},
+					"idle":   {},
+				},
+			},
+		},
+	}
+
+	assert.EqualError(t, resumeApp(app), "app app is not paused")
+
+	changed, err := pauseApp(app)
+	require.NoError(t, err)
+	assert.True(t, changed)
+	assert.True(t, *app.Spec.Stop)
+	assert.Equal(t, "true", app.Annotations[labels.AcornPaused])
+	assert.JSONEq(t, `{"web":3,"worker":1}`, app.Annotations[labels.AcornPausedReplicas])
+
+	// Pausing again doesn't record the replicas of the stopped containers
+	app.Status.AppStatus.Containers["web"] = v1.ContainerStatus{}
+	changed, err = pauseApp(app)
+	require.NoError(t, err)
+	assert.False(t, changed)
+	assert.JSONEq(t, `{"web":3,"worker":1}`, app.Annotations[labels.AcornPausedReplicas])
+
+	require.NoError(t, resumeApp(app))
+	assert.False(t, *app.Spec.Stop)
+	assert.NotContains(t, app.Annotations, labels.AcornPaused)
+	assert.JSONEq(t, `{"web":3,"worker":1}`, app.Annotations[labels.AcornPausedReplicas])
+
+	// Stopped apps can't be paused, their replicas are gone already
+	app.Spec.Stop = z.Pointer(true)
+	_, err = pauseApp(app)
+	assert.EqualError(t, err, "app app is stopped, start it before pausing it")
+}
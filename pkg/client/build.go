@@ -90,6 +90,8 @@ func (c *DefaultClient) AcornImageBuild(ctx context.Context, file string, opts *
 			Args:            v1.NewGenericMap(opts.Args),
 			Profiles:        opts.Profiles,
 			VCS:             vcs,
+			CacheFrom:       opts.CacheFrom,
+			CacheTo:         opts.CacheTo,
 		},
 	}
 
@@ -114,5 +116,5 @@ func (c *DefaultClient) AcornImageBuild(ctx context.Context, file string, opts *
 	}
 
 	logrus.Debugf("Building with URL: %s", build.Status.BuildURL)
-	return buildclient.Stream(ctx, opts.Cwd, opts.Streams, dialer, (buildclient.CredentialLookup)(opts.Credentials), build)
+	return buildclient.Stream(ctx, opts.Cwd, opts.Streams, dialer, (buildclient.CredentialLookup)(opts.Credentials), (buildclient.BuildSecretLookup)(opts.Secrets), build)
 }
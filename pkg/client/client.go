@@ -10,6 +10,7 @@ import (
 	"net/http"
 	"os"
 	"strconv"
+	"time"
 
 	"github.com/acorn-io/baaah/pkg/restconfig"
 	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
@@ -107,8 +108,10 @@ type AppUpdateOptions struct {
 	NotifyUpgrade            *bool
 	AutoUpgradeInterval      string
 	Memory                   v1.MemoryMap
+	CPU                      v1.CPUMap
 	ComputeClasses           v1.ComputeClassMap
 	Region                   string
+	SignaturePolicy          *v1.SignaturePolicy
 	DevSessionClient         *v1.DevSessionInstanceClient
 	DevSessionTimeoutSeconds int32
 }
@@ -142,7 +145,11 @@ type AppRunOptions struct {
 	NotifyUpgrade       *bool
 	AutoUpgradeInterval string
 	Memory              v1.MemoryMap
+	CPU                 v1.CPUMap
 	ComputeClasses      v1.ComputeClassMap
+	SignaturePolicy     *v1.SignaturePolicy
+	Scale               map[string]int32
+	ServiceGrants       *v1.ServiceGrants
 }
 
 func (a AppRunOptions) ToUpdate() AppUpdateOptions {
@@ -163,8 +170,10 @@ func (a AppRunOptions) ToUpdate() AppUpdateOptions {
 		NotifyUpgrade:       a.NotifyUpgrade,
 		AutoUpgradeInterval: a.AutoUpgradeInterval,
 		Memory:              a.Memory,
+		CPU:                 a.CPU,
 		ComputeClasses:      a.ComputeClasses,
 		Region:              a.Region,
+		SignaturePolicy:     a.SignaturePolicy,
 	}
 }
 
@@ -185,7 +194,10 @@ func (a AppUpdateOptions) ToRun() AppRunOptions {
 		NotifyUpgrade:       a.NotifyUpgrade,
 		AutoUpgradeInterval: a.AutoUpgradeInterval,
 		Memory:              a.Memory,
+		CPU:                 a.CPU,
 		ComputeClasses:      a.ComputeClasses,
+		Region:              a.Region,
+		SignaturePolicy:     a.SignaturePolicy,
 	}
 }
 
@@ -197,15 +209,124 @@ type ImageProgress struct {
 }
 
 type ImageDetails struct {
-	AppImage        v1.AppImage         `json:"appImage,omitempty"`
-	AppSpec         *v1.AppSpec         `json:"appSpec,omitempty"`
-	Params          *v1.ParamSpec       `json:"params,omitempty"`
-	ImageName       string              `json:"imageName,omitempty"`
-	SignatureDigest string              `json:"signatureDigest,omitempty"`
-	Readme          string              `json:"readme,omitempty"`
-	ParseError      string              `json:"parseError,omitempty"`
-	Permissions     []v1.Permissions    `json:"permissions,omitempty"`
-	NestedImages    []apiv1.NestedImage `json:"nestedImages,omitempty"`
+	AppImage        v1.AppImage            `json:"appImage,omitempty"`
+	AppSpec         *v1.AppSpec            `json:"appSpec,omitempty"`
+	Params          *v1.ParamSpec          `json:"params,omitempty"`
+	ImageName       string                 `json:"imageName,omitempty"`
+	SignatureDigest string                 `json:"signatureDigest,omitempty"`
+	Readme          string                 `json:"readme,omitempty"`
+	ParseError      string                 `json:"parseError,omitempty"`
+	Permissions     []v1.Permissions       `json:"permissions,omitempty"`
+	NestedImages    []apiv1.NestedImage    `json:"nestedImages,omitempty"`
+	Images          []apiv1.ContainedImage `json:"images,omitempty"`
+}
+
+// CredentialValidation is the outcome of authenticating against a registry.
+type CredentialValidation struct {
+	ServerAddress string `json:"serverAddress,omitempty"`
+	// Username is empty if the registry was accessed anonymously
+	Username string `json:"username,omitempty"`
+	// Scopes are the token scopes that were requested from the registry, beyond access to the registry itself
+	Scopes []string `json:"scopes,omitempty"`
+	Valid  bool     `json:"valid"`
+	Error  string   `json:"error,omitempty"`
+}
+
+// ImageScanResult is the vulnerability report of a scanner for an image digest.
+type ImageScanResult struct {
+	Image    string             `json:"image,omitempty"`
+	Digest   string             `json:"digest,omitempty"`
+	Scanner  string             `json:"scanner,omitempty"`
+	Findings []ImageScanFinding `json:"findings,omitempty"`
+}
+
+// ImageHistory is the layer history of the images of an app image and the SLSA provenance attested for them.
+type ImageHistory struct {
+	Image      string              `json:"image,omitempty"`
+	Digest     string              `json:"digest,omitempty"`
+	Layers     []ImageHistoryLayer `json:"layers,omitempty"`
+	Provenance []ImageProvenance   `json:"provenance,omitempty"`
+}
+
+type ImageHistoryLayer struct {
+	// Container is the container, sidecar (CONTAINER.SIDECAR), function, job or image of the app image the layer is in
+	Container  string      `json:"container,omitempty"`
+	Digest     string      `json:"digest,omitempty"`
+	Created    metav1.Time `json:"created,omitempty"`
+	CreatedBy  string      `json:"createdBy,omitempty"`
+	Comment    string      `json:"comment,omitempty"`
+	Size       int64       `json:"size,omitempty"`
+	EmptyLayer bool        `json:"emptyLayer,omitempty"`
+}
+
+type ImageProvenance struct {
+	// Container is the image of the app image the provenance is attested for, empty for the app image itself
+	Container     string `json:"container,omitempty"`
+	PredicateType string `json:"predicateType,omitempty"`
+	// Source is the tool that attached the attestation, buildkit or cosign
+	Source string `json:"source,omitempty"`
+	// Digest is the digest of the attestation manifest
+	Digest string `json:"digest,omitempty"`
+}
+
+type ImageScanFinding struct {
+	ID               string `json:"id,omitempty"`
+	Target           string `json:"target,omitempty"`
+	Package          string `json:"package,omitempty"`
+	InstalledVersion string `json:"installedVersion,omitempty"`
+	FixedVersion     string `json:"fixedVersion,omitempty"`
+	Severity         string `json:"severity,omitempty"`
+	Title            string `json:"title,omitempty"`
+	URL              string `json:"url,omitempty"`
+}
+
+// AppDiff is the difference between the spec of a running app and the spec it would be updated to.
+type AppDiff struct {
+	Name    string           `json:"name,omitempty"`
+	Changes []AppFieldChange `json:"changes,omitempty"`
+}
+
+// AppFieldChange lists the values of one field of the app spec, like its image or environment, that would be
+// removed and added by an update.
+type AppFieldChange struct {
+	Field   string   `json:"field,omitempty"`
+	Removed []string `json:"removed,omitempty"`
+	Added   []string `json:"added,omitempty"`
+}
+
+// AppBundle is the state of an app as written by AppExport, enough to recreate the app in another project or
+// cluster with AppImport.
+type AppBundle struct {
+	Name    string             `json:"name,omitempty"`
+	Spec    v1.AppInstanceSpec `json:"spec,omitempty"`
+	Secrets []AppBundleSecret  `json:"secrets,omitempty"`
+	Volumes []AppBundleVolume  `json:"volumes,omitempty"`
+}
+
+// AppBundleSecret is a secret bound to an exported app. Data is only set if the app was exported with
+// AppExportOptions.IncludeSecrets.
+type AppBundleSecret struct {
+	Name string            `json:"name,omitempty"`
+	Type string            `json:"type,omitempty"`
+	Keys []string          `json:"keys,omitempty"`
+	Data map[string][]byte `json:"data,omitempty"`
+}
+
+// AppBundleVolume is a volume of an exported app, named like in its Acornfile.
+type AppBundleVolume struct {
+	Name        string          `json:"name,omitempty"`
+	Capacity    string          `json:"capacity,omitempty"`
+	Class       string          `json:"class,omitempty"`
+	AccessModes []v1.AccessMode `json:"accessModes,omitempty"`
+}
+
+// AppContainerEnvVar is an environment variable of a container as returned by AppContainerEnv. Source describes where
+// the value comes from, like "secret my-app.db.password", and is empty for values that are set as is.
+type AppContainerEnvVar struct {
+	Name   string `json:"name,omitempty"`
+	Value  string `json:"value,omitempty"`
+	Masked bool   `json:"masked,omitempty"`
+	Source string `json:"source,omitempty"`
 }
 
 type PortForwardDialer func(ctx context.Context) (net.Conn, error)
@@ -216,13 +337,29 @@ type Client interface {
 	AppGet(ctx context.Context, name string) (*apiv1.App, error)
 	AppStop(ctx context.Context, name string) error
 	AppStart(ctx context.Context, name string) error
+	AppPause(ctx context.Context, name string) error
+	AppResume(ctx context.Context, name string) error
+	AppRename(ctx context.Context, oldName, newName string) (*apiv1.App, error)
+	ServiceGrant(ctx context.Context, publisher, consumer string) (*apiv1.App, error)
+	ServiceRevoke(ctx context.Context, publisher, consumer string) (*apiv1.App, error)
+	AppScale(ctx context.Context, name, container string, replicas int32) (*apiv1.App, error)
 	AppRun(ctx context.Context, image string, opts *AppRunOptions) (*apiv1.App, error)
 	AppUpdate(ctx context.Context, name string, opts *AppUpdateOptions) (*apiv1.App, error)
+	AppDiff(ctx context.Context, name string, newSpec *v1.AppInstanceSpec) (*AppDiff, error)
+	AppExport(ctx context.Context, name string, opts *AppExportOptions) ([]byte, error)
+	AppContainerEnv(ctx context.Context, name, container string, opts *AppContainerEnvOptions) ([]AppContainerEnvVar, error)
+	AppImport(ctx context.Context, bundle []byte, opts *AppImportOptions) (*apiv1.App, error)
 	AppLog(ctx context.Context, name string, opts *LogOptions) (<-chan apiv1.LogMessage, error)
+	// AppLogsFollow is AppLog, but when following it reconnects streams that end, like when the pod they're read from restarts
+	AppLogsFollow(ctx context.Context, name string, opts *LogOptions) (<-chan apiv1.LogMessage, error)
 	AppInfo(ctx context.Context, name string) (string, error)
+	// AppMetrics returns the current CPU and memory usage of the container replicas of an app from the metrics API
+	AppMetrics(ctx context.Context, name string) (*apiv1.AppMetrics, error)
 	AppConfirmUpgrade(ctx context.Context, name string) error
 	AppPullImage(ctx context.Context, name string) error
 	AppIgnoreDeleteCleanup(ctx context.Context, name string) error
+	AppRestart(ctx context.Context, name string, opts *AppRestartOptions) error
+	AppStatusStream(ctx context.Context, name string) (<-chan apiv1.App, error)
 
 	DevSessionRenew(ctx context.Context, name string, client v1.DevSessionInstanceClient) error
 	DevSessionRelease(ctx context.Context, name string) error
@@ -232,6 +369,7 @@ type Client interface {
 	CredentialGet(ctx context.Context, serverAddress string) (*apiv1.Credential, error)
 	CredentialUpdate(ctx context.Context, serverAddress, username, password string, skipChecks bool) (*apiv1.Credential, error)
 	CredentialDelete(ctx context.Context, serverAddress string) (*apiv1.Credential, error)
+	CredentialValidate(ctx context.Context, serverAddress string, opts *CredentialValidateOptions) (*CredentialValidation, error)
 
 	SecretCreate(ctx context.Context, name, secretType string, data map[string][]byte) (*apiv1.Secret, error)
 	SecretList(ctx context.Context) ([]apiv1.Secret, error)
@@ -239,6 +377,9 @@ type Client interface {
 	SecretReveal(ctx context.Context, name string) (*apiv1.Secret, error)
 	SecretUpdate(ctx context.Context, name string, data map[string][]byte) (*apiv1.Secret, error)
 	SecretDelete(ctx context.Context, name string) (*apiv1.Secret, error)
+	SecretCopy(ctx context.Context, name string, opts *SecretCopyOptions) (*apiv1.Secret, error)
+	SecretRevealAll(ctx context.Context) (*SecretRevealAllResult, error)
+	SecretRotate(ctx context.Context, name string) (*apiv1.SecretRotate, error)
 
 	ContainerReplicaList(ctx context.Context, opts *ContainerReplicaListOptions) ([]apiv1.ContainerReplica, error)
 	ContainerReplicaGet(ctx context.Context, name string) (*apiv1.ContainerReplica, error)
@@ -253,17 +394,31 @@ type Client interface {
 	VolumeList(ctx context.Context) ([]apiv1.Volume, error)
 	VolumeGet(ctx context.Context, name string) (*apiv1.Volume, error)
 	VolumeDelete(ctx context.Context, name string) (*apiv1.Volume, error)
+	VolumeResize(ctx context.Context, name, newSize string) (*apiv1.Volume, error)
+
+	VolumeSnapshotCreate(ctx context.Context, volumeName, name string) (*apiv1.VolumeSnapshot, error)
+	VolumeSnapshotList(ctx context.Context) ([]apiv1.VolumeSnapshot, error)
+	VolumeSnapshotGet(ctx context.Context, name string) (*apiv1.VolumeSnapshot, error)
+	VolumeSnapshotDelete(ctx context.Context, name string) (*apiv1.VolumeSnapshot, error)
+	VolumeSnapshotRestore(ctx context.Context, volumeName, snapshotName string) (*apiv1.Volume, error)
 
 	ImageList(ctx context.Context) ([]apiv1.Image, error)
+	ImagePrune(ctx context.Context, opts *ImagePruneOptions) ([]apiv1.Image, error)
 	ImageGet(ctx context.Context, name string) (*apiv1.Image, error)
 	ImageDelete(ctx context.Context, name string, opts *ImageDeleteOptions) (*apiv1.Image, []string, error) // returns the modified/deleted image and a list of deleted tags
 	ImagePush(ctx context.Context, tagName string, opts *ImagePushOptions) (<-chan ImageProgress, error)
 	ImagePull(ctx context.Context, name string, opts *ImagePullOptions) (<-chan ImageProgress, error)
-	ImageTag(ctx context.Context, image, tag string) error
+	ImageTag(ctx context.Context, image, tag string, opts *ImageTagOptions) error
+	ImageIndexCreate(ctx context.Context, tag string, entries []ImageIndexEntry, opts *ImageIndexCreateOptions) (string, error)
 	ImageDetails(ctx context.Context, imageName string, opts *ImageDetailsOptions) (*ImageDetails, error)
+	ImageScan(ctx context.Context, imageName string, opts *ImageScanOptions) (*ImageScanResult, error)
+	ImageExtractFile(ctx context.Context, imageName, filePath string, out io.Writer, opts *ImageExtractOptions) error
+	ImageHistory(ctx context.Context, imageName string, opts *ImageHistoryOptions) (*ImageHistory, error)
 
 	ImageSign(ctx context.Context, image string, payload []byte, signatureB64 string, opts *ImageSignOptions) (*apiv1.ImageSignature, error)
 	ImageVerify(ctx context.Context, image string, opts *ImageVerifyOptions) (*apiv1.ImageSignature, error)
+	ImageSignatures(ctx context.Context, image string, opts *ImageSignaturesOptions) ([]apiv1.ImageSignatureEntry, error)
+	ImageUnsign(ctx context.Context, image string, signatureDigest string, opts *ImageUnsignOptions) error
 
 	AcornImageBuildGet(ctx context.Context, name string) (*apiv1.AcornImageBuild, error)
 	AcornImageBuildList(ctx context.Context) ([]apiv1.AcornImageBuild, error)
@@ -272,7 +427,7 @@ type Client interface {
 
 	ProjectGet(ctx context.Context, name string) (*apiv1.Project, error)
 	ProjectList(ctx context.Context) ([]apiv1.Project, error)
-	ProjectCreate(ctx context.Context, name, defaultRegion string, supportedRegions []string) (*apiv1.Project, error)
+	ProjectCreate(ctx context.Context, name string, opts *ProjectCreateOptions) (*apiv1.Project, error)
 	ProjectUpdate(ctx context.Context, project *apiv1.Project, defaultRegion string, supportedRegions []string) (*apiv1.Project, error)
 	ProjectDelete(ctx context.Context, name string) (*apiv1.Project, error)
 
@@ -299,11 +454,17 @@ type Client interface {
 
 type CredentialLookup func(ctx context.Context, serverAddress string) (*apiv1.RegistryAuth, bool, error)
 
+// BuildSecretLookup returns the value of a build secret, mounted into RUN --mount=type=secret,id=ID instructions
+type BuildSecretLookup func(ctx context.Context, id string) ([]byte, bool, error)
+
 type AcornImageBuildOptions struct {
 	BuilderName string
 	Credentials CredentialLookup
+	Secrets     BuildSecretLookup
 	Cwd         string
 	Platforms   []v1.Platform
+	CacheFrom   []v1.RemoteCache
+	CacheTo     []v1.RemoteCache
 	Args        map[string]any
 	Profiles    []string
 	Streams     *streams.Output
@@ -326,6 +487,20 @@ func (a *AcornImageBuildOptions) complete() (_ *AcornImageBuildOptions, err erro
 	return &newOpt, nil
 }
 
+type ProjectCreateOptions struct {
+	DefaultRegion    string   `json:"defaultRegion,omitempty"`
+	SupportedRegions []string `json:"supportedRegions,omitempty"`
+	// DefaultComputeClass is used for the workloads of apps in the project that don't specify a compute class
+	DefaultComputeClass string `json:"defaultComputeClass,omitempty"`
+	// DefaultVolumeClass is used for the volumes of apps in the project that don't specify a volume class
+	DefaultVolumeClass string `json:"defaultVolumeClass,omitempty"`
+}
+
+type ImageTagOptions struct {
+	// CopySignatures also copies the cosign signatures of the image to the repository of the new tag
+	CopySignatures bool `json:"copySignatures,omitempty"`
+}
+
 type ImagePullOptions struct {
 	Auth *apiv1.RegistryAuth `json:"auth,omitempty"`
 }
@@ -334,14 +509,84 @@ type ImagePushOptions struct {
 	Auth *apiv1.RegistryAuth `json:"auth,omitempty"`
 }
 
+type ImageCopyOptions struct {
+	SourceAuth *apiv1.RegistryAuth `json:"sourceAuth,omitempty"`
+	DestAuth   *apiv1.RegistryAuth `json:"destAuth,omitempty"`
+	// SignArtifacts also copies the cosign signature of the image
+	SignArtifacts bool `json:"signArtifacts,omitempty"`
+	// AllSignaturesAndAttestations also copies all artifacts that refer to the image: the cosign signature, attestation
+	// and SBOM tags and the artifacts returned by the OCI referrers API. Each is verified to have the same digest in dst.
+	AllSignaturesAndAttestations bool `json:"allSignaturesAndAttestations,omitempty"`
+	// Atomic doesn't copy the image if any of its artifacts fails to copy. Artifacts are copied before the image.
+	Atomic bool `json:"atomic,omitempty"`
+	// Force overwrites the destination if it exists already with another digest
+	Force bool `json:"force,omitempty"`
+}
+
+// ImageIndexEntry is an image to add to an index with ImageIndexCreate. Image is the digest of an image in the
+// repository of the index or a reference by digest to an image in another repository of the same registry.
+type ImageIndexEntry struct {
+	Image    string `json:"image,omitempty"`
+	Platform string `json:"platform,omitempty"`
+}
+
+type ImageIndexCreateOptions struct {
+	Auth *apiv1.RegistryAuth `json:"auth,omitempty"`
+	// CopySignatures also copies the cosign signatures of images from other repositories into the repository of the
+	// index, so that the images can still be verified there
+	CopySignatures bool `json:"copySignatures,omitempty"`
+}
+
+type CredentialValidateOptions struct {
+	// Auth is the credential to validate, the registry is accessed anonymously if nil
+	Auth *apiv1.RegistryAuth `json:"auth,omitempty"`
+	// Repository, if set, also validates that the credential can push to and pull from this repository of the registry
+	Repository string `json:"repository,omitempty"`
+}
+
+type ImageScanOptions struct {
+	Auth *apiv1.RegistryAuth `json:"auth,omitempty"`
+	// Scanner is the scanner command to run, trivy if empty. It's called with the arguments of the trivy CLI and must
+	// print a trivy JSON report.
+	Scanner string `json:"scanner,omitempty"`
+}
+
+type ImageExtractOptions struct {
+	Auth *apiv1.RegistryAuth `json:"auth,omitempty"`
+	// Container is the container, sidecar (CONTAINER.SIDECAR), function, job or image of the app image to extract
+	// from. It can be left empty if the app image has only one.
+	Container string `json:"container,omitempty"`
+}
+
+type ImageHistoryOptions struct {
+	Auth *apiv1.RegistryAuth `json:"auth,omitempty"`
+}
+
 type ImageDetailsOptions struct {
 	NestedDigest  string
 	Profiles      []string
 	DeployArgs    map[string]any
 	Auth          *apiv1.RegistryAuth
 	IncludeNested bool
+	// ListImages - if true, the images contained in the app image and its nested images are listed with their sizes
+	ListImages bool
 	// NoDefaultRegistry - if true, indicates that no default container registry should be assumed when getting image details
 	NoDefaultRegistry bool
+	// Local - if true, the image is only resolved from the local image store, failing if it isn't there
+	Local bool
+}
+
+type ImagePruneOptions struct {
+	// All also prunes images with tags, by default only images without tags are pruned
+	All bool `json:"all,omitempty"`
+	// DanglingOnly only prunes images without tags, even if All is set
+	DanglingOnly bool `json:"danglingOnly,omitempty"`
+	// Images only prunes the images with these IDs, like the ones returned by a dry run, if they are still unused
+	Images []string `json:"images,omitempty"`
+	// Until only prunes images created longer ago than this
+	Until time.Duration `json:"until,omitempty"`
+	// DryRun returns the images that would be pruned without deleting them
+	DryRun bool `json:"dryRun,omitempty"`
 }
 
 type ImageDeleteOptions struct {
@@ -349,7 +594,12 @@ type ImageDeleteOptions struct {
 }
 
 type ContainerReplicaExecOptions struct {
-	DebugImage string `json:"debugImage,omitempty"`
+	DebugImage string   `json:"debugImage,omitempty"`
+	Rows       uint16   `json:"rows,omitempty"`
+	Cols       uint16   `json:"cols,omitempty"`
+	Env        []string `json:"env,omitempty"`
+	WorkingDir string   `json:"workingDir,omitempty"`
+	NoStdin    bool     `json:"noStdin,omitempty"`
 }
 
 type ContainerReplicaListOptions struct {
@@ -364,6 +614,45 @@ type JobListOptions struct {
 	App string `json:"app,omitempty"`
 }
 
+type AppRestartOptions struct {
+	// Container restricts the restart to one container, otherwise all containers of the app are restarted
+	Container string `json:"container,omitempty"`
+}
+
+type AppExportOptions struct {
+	// IncludeSecrets exports the data of the bound secrets, otherwise only their names and types are exported
+	IncludeSecrets bool `json:"includeSecrets,omitempty"`
+}
+
+type AppContainerEnvOptions struct {
+	// Reveal resolves the values that come from secrets, otherwise they are masked
+	Reveal bool `json:"reveal,omitempty"`
+}
+
+type AppImportOptions struct {
+	// Name defaults to the name of the exported app
+	Name string `json:"name,omitempty"`
+}
+
+type SecretCopyOptions struct {
+	// TargetProject defaults to the project of the secret
+	TargetProject string `json:"targetProject,omitempty"`
+	// TargetName defaults to the name of the secret
+	TargetName string `json:"targetName,omitempty"`
+	Overwrite  bool   `json:"overwrite,omitempty"`
+}
+
+// SecretRevealAllResult holds the revealed secrets of a project and the ones whose data could not be revealed
+type SecretRevealAllResult struct {
+	Secrets []apiv1.Secret  `json:"secrets,omitempty"`
+	Skipped []SkippedSecret `json:"skipped,omitempty"`
+}
+
+type SkippedSecret struct {
+	Name   string `json:"name,omitempty"`
+	Reason string `json:"reason,omitempty"`
+}
+
 type EventStreamOptions struct {
 	Tail            int    `json:"tail,omitempty"`
 	Follow          bool   `json:"follow,omitempty"`
@@ -371,21 +660,50 @@ type EventStreamOptions struct {
 	Since           string `json:"since,omitempty"`
 	Until           string `json:"until,omitempty"`
 	ResourceVersion string `json:"resourceVersion,omitempty"`
+	// FieldSelector is evaluated by the server, e.g. "involved.kind=app,involved.name=foo"
+	FieldSelector string `json:"fieldSelector,omitempty"`
 }
 
 type ImageSignOptions struct {
-	PublicKey string              `json:"publicKeys,omitempty"`
-	Auth      *apiv1.RegistryAuth `json:"auth,omitempty"`
+	PublicKey        string              `json:"publicKeys,omitempty"`
+	Auth             *apiv1.RegistryAuth `json:"auth,omitempty"`
+	Certificate      []byte              `json:"certificate,omitempty"`
+	CertificateChain []byte              `json:"certificateChain,omitempty"`
+	RekorBundle      []byte              `json:"rekorBundle,omitempty"`
+	// Force replaces an identical existing signature (same key and annotations), by default the new one is skipped
+	Force bool `json:"force,omitempty"`
+	// Attestation is the JSON encoded DSSE envelope of a signed in-toto attestation to attach to the image in
+	// addition to the signature
+	Attestation []byte `json:"attestation,omitempty"`
+
+	// Transparency log entry the RekorBundle was created from, for informational purposes only
+	TlogEntryUUID      string `json:"tlogEntryUUID,omitempty"`
+	TlogIntegratedTime int64  `json:"tlogIntegratedTime,omitempty"`
 }
 
 type ImageVerifyOptions struct {
-	PublicKey    string              `json:"publicKeys,omitempty"`
-	Annotations  map[string]string   `json:"annotations,omitempty"`
-	Auth         *apiv1.RegistryAuth `json:"auth,omitempty"`
-	NoVerifyName bool                `json:"noVerifyName,omitempty"`
+	PublicKey      string              `json:"publicKeys,omitempty"`
+	CertIdentity   string              `json:"certIdentity,omitempty"`
+	CertOidcIssuer string              `json:"certOidcIssuer,omitempty"`
+	RequireTlog    bool                `json:"requireTlog,omitempty"`
+	Annotations    map[string]string   `json:"annotations,omitempty"`
+	Auth           *apiv1.RegistryAuth `json:"auth,omitempty"`
+	NoVerifyName   bool                `json:"noVerifyName,omitempty"`
+	// PredicateType verifies an attestation of this type instead of a signature, its predicate is returned in the
+	// AttestationPredicate of the result
+	PredicateType string `json:"predicateType,omitempty"`
+}
+
+type ImageSignaturesOptions struct {
+	PublicKey string              `json:"publicKey,omitempty"`
+	Auth      *apiv1.RegistryAuth `json:"auth,omitempty"`
+}
+
+type ImageUnsignOptions struct {
+	Auth *apiv1.RegistryAuth `json:"auth,omitempty"`
 }
 
-func (o EventStreamOptions) ListOptions() *kclient.ListOptions {
+func (o EventStreamOptions) ListOptions() (*kclient.ListOptions, error) {
 	fieldSet := make(fields.Set)
 	if o.Prefix != "" {
 		fieldSet["prefix"] = o.Prefix
@@ -400,13 +718,22 @@ func (o EventStreamOptions) ListOptions() *kclient.ListOptions {
 	// Set details selector to get details from older runtime APIs that don't return details by default.
 	fieldSet["details"] = strconv.FormatBool(true)
 
+	selector := fieldSet.AsSelector()
+	if o.FieldSelector != "" {
+		custom, err := fields.ParseSelector(o.FieldSelector)
+		if err != nil {
+			return nil, fmt.Errorf("invalid field selector %q: %w", o.FieldSelector, err)
+		}
+		selector = fields.AndSelectors(selector, custom)
+	}
+
 	return &kclient.ListOptions{
 		Limit:         int64(o.Tail),
-		FieldSelector: fieldSet.AsSelector(),
+		FieldSelector: selector,
 		Raw: &metav1.ListOptions{
 			ResourceVersion: o.ResourceVersion,
 		},
-	}
+	}, nil
 }
 
 type DefaultClient struct {
@@ -2,9 +2,15 @@ package client
 
 import (
 	"context"
+	"fmt"
+	"net/http"
 	"sort"
 
 	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/imagesystem"
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/remote/transport"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	kclient "sigs.k8s.io/controller-runtime/pkg/client"
@@ -86,3 +92,69 @@ func (c *DefaultClient) CredentialDelete(ctx context.Context, serverAddress stri
 	}
 	return credential, err
 }
+
+func (c *DefaultClient) CredentialValidate(ctx context.Context, serverAddress string, opts *CredentialValidateOptions) (*CredentialValidation, error) {
+	return validateCredential(ctx, http.DefaultTransport, serverAddress, opts)
+}
+
+// validateCredential requests a token for the registry, and the repository if one is given, and then makes an
+// authenticated call to the registry's /v2/ endpoint. Rejected credentials, missing permissions and unreachable
+// registries result in an invalid validation rather than an error.
+func validateCredential(ctx context.Context, rt http.RoundTripper, serverAddress string, opts *CredentialValidateOptions) (*CredentialValidation, error) {
+	if opts == nil {
+		opts = &CredentialValidateOptions{}
+	}
+
+	reg, err := name.NewRegistry(imagesystem.NormalizeServerAddress(serverAddress))
+	if err != nil {
+		return nil, err
+	}
+
+	result := &CredentialValidation{
+		ServerAddress: reg.RegistryStr(),
+	}
+
+	auth := authn.Anonymous
+	if opts.Auth != nil {
+		result.Username = opts.Auth.Username
+		auth = &authn.Basic{
+			Username: opts.Auth.Username,
+			Password: opts.Auth.Password,
+		}
+	}
+
+	if opts.Repository != "" {
+		repo, err := name.NewRepository(reg.RegistryStr() + "/" + opts.Repository)
+		if err != nil {
+			return nil, err
+		}
+		result.Scopes = []string{repo.Scope(transport.PushScope)}
+	}
+
+	tr, err := transport.NewWithContext(ctx, reg, auth, rt, result.Scopes)
+	if err != nil {
+		return result.invalid(err), nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s://%s/v2/", reg.Scheme(), reg.RegistryStr()), nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := (&http.Client{Transport: tr}).Do(req)
+	if err != nil {
+		return result.invalid(err), nil
+	}
+	defer resp.Body.Close()
+
+	if err := transport.CheckError(resp, http.StatusOK); err != nil {
+		return result.invalid(err), nil
+	}
+
+	result.Valid = true
+	return result, nil
+}
+
+func (v *CredentialValidation) invalid(err error) *CredentialValidation {
+	v.Error = err.Error()
+	return v
+}
@@ -0,0 +1,49 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateCredential(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if username, password, ok := r.BasicAuth(); ok && username == "alice" && password == "secret" {
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		w.Header().Set("WWW-Authenticate", `Basic realm="test"`)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusUnauthorized)
+		_, _ = w.Write([]byte(`{"errors":[{"code":"UNAUTHORIZED","message":"authentication required"}]}`))
+	}))
+	defer srv.Close()
+	registry := strings.TrimPrefix(srv.URL, "http://")
+
+	result, err := validateCredential(context.Background(), http.DefaultTransport, registry, &CredentialValidateOptions{
+		Auth:       &apiv1.RegistryAuth{Username: "alice", Password: "secret"},
+		Repository: "acme/app",
+	})
+	require.NoError(t, err)
+	assert.True(t, result.Valid, result.Error)
+	assert.Equal(t, "alice", result.Username)
+	assert.Equal(t, []string{"repository:acme/app:push,pull"}, result.Scopes)
+
+	result, err = validateCredential(context.Background(), http.DefaultTransport, registry, &CredentialValidateOptions{
+		Auth: &apiv1.RegistryAuth{Username: "alice", Password: "wrong"},
+	})
+	require.NoError(t, err)
+	assert.False(t, result.Valid)
+	assert.Contains(t, result.Error, "UNAUTHORIZED")
+
+	result, err = validateCredential(context.Background(), http.DefaultTransport, registry, nil)
+	require.NoError(t, err)
+	assert.False(t, result.Valid, "anonymous access is rejected")
+	assert.Empty(t, result.Username)
+}
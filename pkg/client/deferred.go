@@ -2,6 +2,7 @@ package client
 
 import (
 	"context"
+	"io"
 	"sync"
 
 	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
@@ -66,6 +67,76 @@ func (d *DeferredClient) AppStart(ctx context.Context, name string) error {
 	return d.Client.AppStart(ctx, name)
 }
 
+func (d *DeferredClient) AppPause(ctx context.Context, name string) error {
+	if err := d.create(); err != nil {
+		return err
+	}
+	return d.Client.AppPause(ctx, name)
+}
+
+func (d *DeferredClient) AppResume(ctx context.Context, name string) error {
+	if err := d.create(); err != nil {
+		return err
+	}
+	return d.Client.AppResume(ctx, name)
+}
+
+func (d *DeferredClient) AppRename(ctx context.Context, oldName, newName string) (*apiv1.App, error) {
+	if err := d.create(); err != nil {
+		return nil, err
+	}
+	return d.Client.AppRename(ctx, oldName, newName)
+}
+
+func (d *DeferredClient) ServiceGrant(ctx context.Context, publisher, consumer string) (*apiv1.App, error) {
+	if err := d.create(); err != nil {
+		return nil, err
+	}
+	return d.Client.ServiceGrant(ctx, publisher, consumer)
+}
+
+func (d *DeferredClient) ServiceRevoke(ctx context.Context, publisher, consumer string) (*apiv1.App, error) {
+	if err := d.create(); err != nil {
+		return nil, err
+	}
+	return d.Client.ServiceRevoke(ctx, publisher, consumer)
+}
+
+func (d *DeferredClient) AppScale(ctx context.Context, name, container string, replicas int32) (*apiv1.App, error) {
+	if err := d.create(); err != nil {
+		return nil, err
+	}
+	return d.Client.AppScale(ctx, name, container, replicas)
+}
+
+func (d *DeferredClient) AppDiff(ctx context.Context, name string, newSpec *v1.AppInstanceSpec) (*AppDiff, error) {
+	if err := d.create(); err != nil {
+		return nil, err
+	}
+	return d.Client.AppDiff(ctx, name, newSpec)
+}
+
+func (d *DeferredClient) AppContainerEnv(ctx context.Context, name, container string, opts *AppContainerEnvOptions) ([]AppContainerEnvVar, error) {
+	if err := d.create(); err != nil {
+		return nil, err
+	}
+	return d.Client.AppContainerEnv(ctx, name, container, opts)
+}
+
+func (d *DeferredClient) AppExport(ctx context.Context, name string, opts *AppExportOptions) ([]byte, error) {
+	if err := d.create(); err != nil {
+		return nil, err
+	}
+	return d.Client.AppExport(ctx, name, opts)
+}
+
+func (d *DeferredClient) AppImport(ctx context.Context, bundle []byte, opts *AppImportOptions) (*apiv1.App, error) {
+	if err := d.create(); err != nil {
+		return nil, err
+	}
+	return d.Client.AppImport(ctx, bundle, opts)
+}
+
 func (d *DeferredClient) AppRun(ctx context.Context, image string, opts *AppRunOptions) (*apiv1.App, error) {
 	if err := d.create(); err != nil {
 		return nil, err
@@ -80,6 +151,13 @@ func (d *DeferredClient) AppInfo(ctx context.Context, name string) (string, erro
 	return d.Client.AppInfo(ctx, name)
 }
 
+func (d *DeferredClient) AppMetrics(ctx context.Context, name string) (*apiv1.AppMetrics, error) {
+	if err := d.create(); err != nil {
+		return nil, err
+	}
+	return d.Client.AppMetrics(ctx, name)
+}
+
 func (d *DeferredClient) AppUpdate(ctx context.Context, name string, opts *AppUpdateOptions) (*apiv1.App, error) {
 	if err := d.create(); err != nil {
 		return nil, err
@@ -94,6 +172,13 @@ func (d *DeferredClient) AppLog(ctx context.Context, name string, opts *LogOptio
 	return d.Client.AppLog(ctx, name, opts)
 }
 
+func (d *DeferredClient) AppLogsFollow(ctx context.Context, name string, opts *LogOptions) (<-chan apiv1.LogMessage, error) {
+	if err := d.create(); err != nil {
+		return nil, err
+	}
+	return d.Client.AppLogsFollow(ctx, name, opts)
+}
+
 func (d *DeferredClient) AppConfirmUpgrade(ctx context.Context, name string) error {
 	if err := d.create(); err != nil {
 		return err
@@ -115,6 +200,20 @@ func (d *DeferredClient) AppIgnoreDeleteCleanup(ctx context.Context, name string
 	return d.Client.AppIgnoreDeleteCleanup(ctx, name)
 }
 
+func (d *DeferredClient) AppRestart(ctx context.Context, name string, opts *AppRestartOptions) error {
+	if err := d.create(); err != nil {
+		return err
+	}
+	return d.Client.AppRestart(ctx, name, opts)
+}
+
+func (d *DeferredClient) AppStatusStream(ctx context.Context, name string) (<-chan apiv1.App, error) {
+	if err := d.create(); err != nil {
+		return nil, err
+	}
+	return d.Client.AppStatusStream(ctx, name)
+}
+
 func (d *DeferredClient) DevSessionRenew(ctx context.Context, name string, client v1.DevSessionInstanceClient) error {
 	if err := d.create(); err != nil {
 		return err
@@ -283,6 +382,76 @@ func (d *DeferredClient) VolumeDelete(ctx context.Context, name string) (*apiv1.
 	return d.Client.VolumeDelete(ctx, name)
 }
 
+func (d *DeferredClient) SecretRevealAll(ctx context.Context) (*SecretRevealAllResult, error) {
+	if err := d.create(); err != nil {
+		return nil, err
+	}
+	return d.Client.SecretRevealAll(ctx)
+}
+
+func (d *DeferredClient) SecretCopy(ctx context.Context, name string, opts *SecretCopyOptions) (*apiv1.Secret, error) {
+	if err := d.create(); err != nil {
+		return nil, err
+	}
+	return d.Client.SecretCopy(ctx, name, opts)
+}
+
+func (d *DeferredClient) SecretRotate(ctx context.Context, name string) (*apiv1.SecretRotate, error) {
+	if err := d.create(); err != nil {
+		return nil, err
+	}
+	return d.Client.SecretRotate(ctx, name)
+}
+
+func (d *DeferredClient) VolumeResize(ctx context.Context, name, newSize string) (*apiv1.Volume, error) {
+	if err := d.create(); err != nil {
+		return nil, err
+	}
+	return d.Client.VolumeResize(ctx, name, newSize)
+}
+
+func (d *DeferredClient) VolumeSnapshotCreate(ctx context.Context, volumeName, name string) (*apiv1.VolumeSnapshot, error) {
+	if err := d.create(); err != nil {
+		return nil, err
+	}
+	return d.Client.VolumeSnapshotCreate(ctx, volumeName, name)
+}
+
+func (d *DeferredClient) VolumeSnapshotList(ctx context.Context) ([]apiv1.VolumeSnapshot, error) {
+	if err := d.create(); err != nil {
+		return nil, err
+	}
+	return d.Client.VolumeSnapshotList(ctx)
+}
+
+func (d *DeferredClient) VolumeSnapshotGet(ctx context.Context, name string) (*apiv1.VolumeSnapshot, error) {
+	if err := d.create(); err != nil {
+		return nil, err
+	}
+	return d.Client.VolumeSnapshotGet(ctx, name)
+}
+
+func (d *DeferredClient) VolumeSnapshotDelete(ctx context.Context, name string) (*apiv1.VolumeSnapshot, error) {
+	if err := d.create(); err != nil {
+		return nil, err
+	}
+	return d.Client.VolumeSnapshotDelete(ctx, name)
+}
+
+func (d *DeferredClient) VolumeSnapshotRestore(ctx context.Context, volumeName, snapshotName string) (*apiv1.Volume, error) {
+	if err := d.create(); err != nil {
+		return nil, err
+	}
+	return d.Client.VolumeSnapshotRestore(ctx, volumeName, snapshotName)
+}
+
+func (d *DeferredClient) ImagePrune(ctx context.Context, opts *ImagePruneOptions) ([]apiv1.Image, error) {
+	if err := d.create(); err != nil {
+		return nil, err
+	}
+	return d.Client.ImagePrune(ctx, opts)
+}
+
 func (d *DeferredClient) ImageList(ctx context.Context) ([]apiv1.Image, error) {
 	if err := d.create(); err != nil {
 		return nil, err
@@ -318,11 +487,18 @@ func (d *DeferredClient) ImagePull(ctx context.Context, name string, opts *Image
 	return d.Client.ImagePull(ctx, name, opts)
 }
 
-func (d *DeferredClient) ImageTag(ctx context.Context, image, tag string) error {
+func (d *DeferredClient) ImageTag(ctx context.Context, image, tag string, opts *ImageTagOptions) error {
 	if err := d.create(); err != nil {
 		return err
 	}
-	return d.Client.ImageTag(ctx, image, tag)
+	return d.Client.ImageTag(ctx, image, tag, opts)
+}
+
+func (d *DeferredClient) ImageIndexCreate(ctx context.Context, tag string, entries []ImageIndexEntry, opts *ImageIndexCreateOptions) (string, error) {
+	if err := d.create(); err != nil {
+		return "", err
+	}
+	return d.Client.ImageIndexCreate(ctx, tag, entries, opts)
 }
 
 func (d *DeferredClient) ImageDetails(ctx context.Context, imageName string, opts *ImageDetailsOptions) (*ImageDetails, error) {
@@ -332,6 +508,34 @@ func (d *DeferredClient) ImageDetails(ctx context.Context, imageName string, opt
 	return d.Client.ImageDetails(ctx, imageName, opts)
 }
 
+func (d *DeferredClient) CredentialValidate(ctx context.Context, serverAddress string, opts *CredentialValidateOptions) (*CredentialValidation, error) {
+	if err := d.create(); err != nil {
+		return nil, err
+	}
+	return d.Client.CredentialValidate(ctx, serverAddress, opts)
+}
+
+func (d *DeferredClient) ImageScan(ctx context.Context, imageName string, opts *ImageScanOptions) (*ImageScanResult, error) {
+	if err := d.create(); err != nil {
+		return nil, err
+	}
+	return d.Client.ImageScan(ctx, imageName, opts)
+}
+
+func (d *DeferredClient) ImageExtractFile(ctx context.Context, imageName, filePath string, out io.Writer, opts *ImageExtractOptions) error {
+	if err := d.create(); err != nil {
+		return err
+	}
+	return d.Client.ImageExtractFile(ctx, imageName, filePath, out, opts)
+}
+
+func (d *DeferredClient) ImageHistory(ctx context.Context, imageName string, opts *ImageHistoryOptions) (*ImageHistory, error) {
+	if err := d.create(); err != nil {
+		return nil, err
+	}
+	return d.Client.ImageHistory(ctx, imageName, opts)
+}
+
 func (d *DeferredClient) ImageSign(ctx context.Context, image string, payload []byte, signatureB64 string, opts *ImageSignOptions) (*apiv1.ImageSignature, error) {
 	if err := d.create(); err != nil {
 		return nil, err
@@ -346,6 +550,20 @@ func (d *DeferredClient) ImageVerify(ctx context.Context, image string, opts *Im
 	return d.Client.ImageVerify(ctx, image, opts)
 }
 
+func (d *DeferredClient) ImageSignatures(ctx context.Context, image string, opts *ImageSignaturesOptions) ([]apiv1.ImageSignatureEntry, error) {
+	if err := d.create(); err != nil {
+		return nil, err
+	}
+	return d.Client.ImageSignatures(ctx, image, opts)
+}
+
+func (d *DeferredClient) ImageUnsign(ctx context.Context, image string, signatureDigest string, opts *ImageUnsignOptions) error {
+	if err := d.create(); err != nil {
+		return err
+	}
+	return d.Client.ImageUnsign(ctx, image, signatureDigest, opts)
+}
+
 func (d *DeferredClient) AcornImageBuildGet(ctx context.Context, name string) (*apiv1.AcornImageBuild, error) {
 	if err := d.create(); err != nil {
 		return nil, err
@@ -388,11 +606,11 @@ func (d *DeferredClient) ProjectList(ctx context.Context) ([]apiv1.Project, erro
 	return d.Client.ProjectList(ctx)
 }
 
-func (d *DeferredClient) ProjectCreate(ctx context.Context, name, defaultRegion string, supportedRegions []string) (*apiv1.Project, error) {
+func (d *DeferredClient) ProjectCreate(ctx context.Context, name string, opts *ProjectCreateOptions) (*apiv1.Project, error) {
 	if err := d.create(); err != nil {
 		return nil, err
 	}
-	return d.Client.ProjectCreate(ctx, name, defaultRegion, supportedRegions)
+	return d.Client.ProjectCreate(ctx, name, opts)
 }
 
 func (d *DeferredClient) ProjectUpdate(ctx context.Context, project *apiv1.Project, defaultRegion string, supportedRegions []string) (*apiv1.Project, error) {
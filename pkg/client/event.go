@@ -3,33 +3,45 @@ package client
 import (
 	"context"
 	"fmt"
+	"time"
 
 	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
 	"github.com/acorn-io/runtime/pkg/channels"
 	"github.com/acorn-io/runtime/pkg/streams"
+	"github.com/sirupsen/logrus"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	kwatch "k8s.io/apimachinery/pkg/watch"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// eventWatchMinBackoff and eventWatchMaxBackoff bound the delay between attempts to reconnect an event watch
+	eventWatchMinBackoff = time.Second
+	eventWatchMaxBackoff = 30 * time.Second
 )
 
 func (c *DefaultClient) EventStream(ctx context.Context, opts *EventStreamOptions) (<-chan apiv1.Event, error) {
 	var initial apiv1.EventList
-	listOpts := opts.ListOptions()
+	listOpts, err := opts.ListOptions()
+	if err != nil {
+		return nil, err
+	}
 	listOpts.Namespace = c.Namespace
-	if opts.ResourceVersion == "" {
+	resourceVersion := opts.ResourceVersion
+	if resourceVersion == "" {
 		if err := c.Client.List(ctx, &initial, listOpts); err != nil {
 			return nil, err
 		}
 
 		// Set options s.t. the watch starts *after* the list
+		resourceVersion = initial.ResourceVersion
 		listOpts.Raw = &metav1.ListOptions{
-			ResourceVersion: initial.ResourceVersion,
+			ResourceVersion: resourceVersion,
 		}
 	}
 
-	var (
-		w   kwatch.Interface
-		err error
-	)
+	var w kwatch.Interface
 	if opts.Follow {
 		w, err = c.Client.Watch(ctx, &apiv1.EventList{}, listOpts)
 	}
@@ -53,21 +65,58 @@ func (c *DefaultClient) EventStream(ctx context.Context, opts *EventStreamOption
 			return
 		}
 
-		defer w.Stop()
+		if err := c.watchEvents(ctx, w, listOpts, resourceVersion, result); !channels.NilOrCanceled(err) {
+			out.MustWriteErr(fmt.Errorf("failed to stream ongoing events for project [%s]: [%w]", c.GetProject(), err))
+		}
+	}()
+
+	return result, nil
+}
 
-		if err := channels.ForEach(ctx, w.ResultChan(), func(e kwatch.Event) error {
+// watchEvents forwards the events added while following w to result. If the watch is closed or fails with a transient
+// error, it reconnects from the resource version of the last event seen, so that no events are missed or duplicated.
+//
+// It blocks until the context is closed or the watch fails with an error that isn't transient.
+func (c *DefaultClient) watchEvents(ctx context.Context, w kwatch.Interface, listOpts *kclient.ListOptions, resourceVersion string, result chan<- apiv1.Event) error {
+	backoff := eventWatchMinBackoff
+	for {
+		err := channels.ForEach(ctx, w.ResultChan(), func(e kwatch.Event) error {
 			switch e.Type {
 			case kwatch.Error:
-				return fmt.Errorf("watch error: [%v]", e)
+				return apierrors.FromObject(e.Object)
 			case kwatch.Added:
-				return channels.Send(ctx, result, *e.Object.(*apiv1.Event))
+				event := e.Object.(*apiv1.Event)
+				resourceVersion = event.ResourceVersion
+				backoff = eventWatchMinBackoff
+				return channels.Send(ctx, result, *event)
 			}
 
 			return nil
-		}); !channels.NilOrCanceled(err) {
-			out.MustWriteErr(fmt.Errorf("failed to stream ongoing events for project [%s]: [%w]", c.GetProject(), err))
-		}
-	}()
+		})
+		w.Stop()
 
-	return result, nil
+		for {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			if err != nil && !IsRetryable(err) {
+				return fmt.Errorf("watch error: [%w]", err)
+			}
+
+			logrus.Debugf("Event watch for project [%s] disconnected, reconnecting from resource version [%s] in %s: %v", c.GetProject(), resourceVersion, backoff, err)
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(backoff):
+			}
+			backoff = min(2*backoff, eventWatchMaxBackoff)
+
+			listOpts.Raw = &metav1.ListOptions{
+				ResourceVersion: resourceVersion,
+			}
+			if w, err = c.Client.Watch(ctx, &apiv1.EventList{}, listOpts); err == nil {
+				break
+			}
+		}
+	}
 }
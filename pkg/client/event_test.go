@@ -0,0 +1,95 @@
+package client
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/selection"
+	kwatch "k8s.io/apimachinery/pkg/watch"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// watchRecorder hands out the given watches in order and records the resource version each one was started from
+type watchRecorder struct {
+	kclient.WithWatch
+	watches          []kwatch.Interface
+	resourceVersions []string
+}
+
+func (w *watchRecorder) Watch(_ context.Context, _ kclient.ObjectList, opts ...kclient.ListOption) (kwatch.Interface, error) {
+	listOpts := &kclient.ListOptions{}
+	listOpts.ApplyOptions(opts)
+	w.resourceVersions = append(w.resourceVersions, listOpts.Raw.ResourceVersion)
+
+	next := w.watches[0]
+	w.watches = w.watches[1:]
+	return next, nil
+}
+
+func testEvent(name, resourceVersion string) *apiv1.Event {
+	return &apiv1.Event{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            name,
+			ResourceVersion: resourceVersion,
+		},
+	}
+}
+
+func TestWatchEventsReconnects(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	first, second, third := kwatch.NewFakeWithChanSize(2, false), kwatch.NewFakeWithChanSize(2, false), kwatch.NewFakeWithChanSize(1, false)
+
+	// The first watch is closed by the server, the second fails with a transient error and the last one is
+	// permanently broken.
+	first.Add(testEvent("a", "2"))
+	first.Stop()
+	second.Add(testEvent("b", "3"))
+	second.Error(&apierrors.NewServiceUnavailable("unavailable").ErrStatus)
+	third.Error(&apierrors.NewGone("too old").ErrStatus)
+
+	recorder := &watchRecorder{
+		watches: []kwatch.Interface{second, third},
+	}
+	c := &DefaultClient{
+		Client: recorder,
+	}
+
+	result := make(chan apiv1.Event, 2)
+	err := c.watchEvents(ctx, first, &kclient.ListOptions{}, "1", result)
+	require.Error(t, err)
+	assert.True(t, apierrors.IsGone(err))
+
+	close(result)
+	var names []string
+	for e := range result {
+		names = append(names, e.Name)
+	}
+	assert.Equal(t, []string{"a", "b"}, names)
+	assert.Equal(t, []string{"2", "3"}, recorder.resourceVersions)
+}
+
+func TestEventStreamOptionsFieldSelector(t *testing.T) {
+	opts, err := EventStreamOptions{
+		Prefix:        "app/",
+		FieldSelector: "involved.kind=app,involved.name!=foo",
+	}.ListOptions()
+	require.NoError(t, err)
+	assert.ElementsMatch(t, fields.Requirements{
+		{Operator: selection.Equals, Field: "details", Value: "true"},
+		{Operator: selection.Equals, Field: "prefix", Value: "app/"},
+		{Operator: selection.Equals, Field: "involved.kind", Value: "app"},
+		{Operator: selection.NotEquals, Field: "involved.name", Value: "foo"},
+	}, opts.FieldSelector.Requirements())
+
+	_, err = EventStreamOptions{FieldSelector: "involved.kind"}.ListOptions()
+	assert.Error(t, err)
+}
@@ -17,8 +17,13 @@ func (c *DefaultClient) execContainer(ctx context.Context, container *apiv1.Cont
 		SubResource("exec").
 		VersionedParams(&apiv1.ContainerReplicaExecOptions{
 			TTY:        tty,
+			Stdin:      !opts.NoStdin,
 			Command:    args,
 			DebugImage: opts.DebugImage,
+			Rows:       opts.Rows,
+			Cols:       opts.Cols,
+			Env:        opts.Env,
+			WorkingDir: opts.WorkingDir,
 		}, scheme.ParameterCodec)
 
 	logrus.Debugf("Exec URL: %s", req.URL().String())
@@ -27,7 +32,15 @@ func (c *DefaultClient) execContainer(ctx context.Context, container *apiv1.Cont
 		return nil, err
 	}
 
-	return conn.ToExecIO(tty), nil
+	execIO := conn.ToExecIO(tty)
+	if tty && opts.Rows > 0 && opts.Cols > 0 {
+		// Send the initial terminal size right away, commands other than the default shell would start at 80x24 otherwise
+		if err := execIO.Resize(term.Size{Height: opts.Rows, Width: opts.Cols}); err != nil {
+			logrus.Debugf("failed to set initial terminal size: %v", err)
+		}
+	}
+
+	return execIO, nil
 }
 
 func (c *DefaultClient) ContainerReplicaExec(ctx context.Context, containerName string, args []string, tty bool, opts *ContainerReplicaExecOptions) (*term.ExecIO, error) {
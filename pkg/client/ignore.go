@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 
 	"github.com/AlecAivazis/survey/v2"
 	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
@@ -105,6 +106,48 @@ func (c IgnoreUninstalled) AppStart(ctx context.Context, name string) error {
 	return c.Client.AppStart(ctx, name)
 }
 
+func (c IgnoreUninstalled) AppPause(ctx context.Context, name string) error {
+	return c.Client.AppPause(ctx, name)
+}
+
+func (c IgnoreUninstalled) AppResume(ctx context.Context, name string) error {
+	return c.Client.AppResume(ctx, name)
+}
+
+func (c IgnoreUninstalled) AppRename(ctx context.Context, oldName, newName string) (*apiv1.App, error) {
+	return c.Client.AppRename(ctx, oldName, newName)
+}
+
+func (c IgnoreUninstalled) ServiceGrant(ctx context.Context, publisher, consumer string) (*apiv1.App, error) {
+	return c.Client.ServiceGrant(ctx, publisher, consumer)
+}
+
+func (c IgnoreUninstalled) ServiceRevoke(ctx context.Context, publisher, consumer string) (*apiv1.App, error) {
+	return c.Client.ServiceRevoke(ctx, publisher, consumer)
+}
+
+func (c IgnoreUninstalled) AppScale(ctx context.Context, name, container string, replicas int32) (*apiv1.App, error) {
+	return c.Client.AppScale(ctx, name, container, replicas)
+}
+
+func (c IgnoreUninstalled) AppDiff(ctx context.Context, name string, newSpec *v1.AppInstanceSpec) (*AppDiff, error) {
+	return c.Client.AppDiff(ctx, name, newSpec)
+}
+
+func (c IgnoreUninstalled) AppContainerEnv(ctx context.Context, name, container string, opts *AppContainerEnvOptions) ([]AppContainerEnvVar, error) {
+	return c.Client.AppContainerEnv(ctx, name, container, opts)
+}
+
+func (c IgnoreUninstalled) AppExport(ctx context.Context, name string, opts *AppExportOptions) ([]byte, error) {
+	return c.Client.AppExport(ctx, name, opts)
+}
+
+func (c IgnoreUninstalled) AppImport(ctx context.Context, bundle []byte, opts *AppImportOptions) (*apiv1.App, error) {
+	return promptInstall(ctx, func() (*apiv1.App, error) {
+		return c.Client.AppImport(ctx, bundle, opts)
+	})
+}
+
 func (c IgnoreUninstalled) AppRun(ctx context.Context, image string, opts *AppRunOptions) (*apiv1.App, error) {
 	return promptInstall(ctx, func() (*apiv1.App, error) {
 		return c.Client.AppRun(ctx, image, opts)
@@ -127,10 +170,26 @@ func (c *IgnoreUninstalled) AppLog(ctx context.Context, name string, opts *LogOp
 	return c.Client.AppLog(ctx, name, opts)
 }
 
+func (c IgnoreUninstalled) AppLogsFollow(ctx context.Context, name string, opts *LogOptions) (<-chan apiv1.LogMessage, error) {
+	return c.Client.AppLogsFollow(ctx, name, opts)
+}
+
 func (c *IgnoreUninstalled) AppSkipDeleteCleanup(ctx context.Context, name string) error {
 	return c.Client.AppIgnoreDeleteCleanup(ctx, name)
 }
 
+func (c IgnoreUninstalled) AppRestart(ctx context.Context, name string, opts *AppRestartOptions) error {
+	return c.Client.AppRestart(ctx, name, opts)
+}
+
+func (c IgnoreUninstalled) AppStatusStream(ctx context.Context, name string) (<-chan apiv1.App, error) {
+	return c.Client.AppStatusStream(ctx, name)
+}
+
+func (c IgnoreUninstalled) AppMetrics(ctx context.Context, name string) (*apiv1.AppMetrics, error) {
+	return c.Client.AppMetrics(ctx, name)
+}
+
 func (c *IgnoreUninstalled) DevSessionRenew(ctx context.Context, name string, client v1.DevSessionInstanceClient) error {
 	return c.Client.DevSessionRenew(ctx, name, client)
 }
@@ -171,10 +230,50 @@ func (c IgnoreUninstalled) VolumeDelete(ctx context.Context, name string) (*apiv
 	return ignoreUninstalled(c.Client.VolumeDelete(ctx, name))
 }
 
+func (c IgnoreUninstalled) SecretCopy(ctx context.Context, name string, opts *SecretCopyOptions) (*apiv1.Secret, error) {
+	return c.Client.SecretCopy(ctx, name, opts)
+}
+
+func (c IgnoreUninstalled) SecretRevealAll(ctx context.Context) (*SecretRevealAllResult, error) {
+	return c.Client.SecretRevealAll(ctx)
+}
+
+func (c IgnoreUninstalled) SecretRotate(ctx context.Context, name string) (*apiv1.SecretRotate, error) {
+	return c.Client.SecretRotate(ctx, name)
+}
+
+func (c IgnoreUninstalled) VolumeResize(ctx context.Context, name, newSize string) (*apiv1.Volume, error) {
+	return c.Client.VolumeResize(ctx, name, newSize)
+}
+
+func (c IgnoreUninstalled) VolumeSnapshotCreate(ctx context.Context, volumeName, name string) (*apiv1.VolumeSnapshot, error) {
+	return c.Client.VolumeSnapshotCreate(ctx, volumeName, name)
+}
+
+func (c IgnoreUninstalled) VolumeSnapshotList(ctx context.Context) ([]apiv1.VolumeSnapshot, error) {
+	return ignoreUninstalled(c.Client.VolumeSnapshotList(ctx))
+}
+
+func (c IgnoreUninstalled) VolumeSnapshotGet(ctx context.Context, name string) (*apiv1.VolumeSnapshot, error) {
+	return c.Client.VolumeSnapshotGet(ctx, name)
+}
+
+func (c IgnoreUninstalled) VolumeSnapshotDelete(ctx context.Context, name string) (*apiv1.VolumeSnapshot, error) {
+	return ignoreUninstalled(c.Client.VolumeSnapshotDelete(ctx, name))
+}
+
+func (c IgnoreUninstalled) VolumeSnapshotRestore(ctx context.Context, volumeName, snapshotName string) (*apiv1.Volume, error) {
+	return c.Client.VolumeSnapshotRestore(ctx, volumeName, snapshotName)
+}
+
 func (c IgnoreUninstalled) ImageList(ctx context.Context) ([]apiv1.Image, error) {
 	return ignoreUninstalled(c.Client.ImageList(ctx))
 }
 
+func (c IgnoreUninstalled) ImagePrune(ctx context.Context, opts *ImagePruneOptions) ([]apiv1.Image, error) {
+	return ignoreUninstalled(c.Client.ImagePrune(ctx, opts))
+}
+
 func (c IgnoreUninstalled) ImageGet(ctx context.Context, name string) (*apiv1.Image, error) {
 	return c.Client.ImageGet(ctx, name)
 }
@@ -197,8 +296,12 @@ func (c IgnoreUninstalled) ImagePull(ctx context.Context, name string, opts *Ima
 	})
 }
 
-func (c IgnoreUninstalled) ImageTag(ctx context.Context, image, tag string) error {
-	return c.Client.ImageTag(ctx, image, tag)
+func (c IgnoreUninstalled) ImageTag(ctx context.Context, image, tag string, opts *ImageTagOptions) error {
+	return c.Client.ImageTag(ctx, image, tag, opts)
+}
+
+func (c IgnoreUninstalled) ImageIndexCreate(ctx context.Context, tag string, entries []ImageIndexEntry, opts *ImageIndexCreateOptions) (string, error) {
+	return c.Client.ImageIndexCreate(ctx, tag, entries, opts)
 }
 
 func (c IgnoreUninstalled) ImageDetails(ctx context.Context, imageName string, opts *ImageDetailsOptions) (*ImageDetails, error) {
@@ -207,6 +310,27 @@ func (c IgnoreUninstalled) ImageDetails(ctx context.Context, imageName string, o
 	})
 }
 
+func (c IgnoreUninstalled) CredentialValidate(ctx context.Context, serverAddress string, opts *CredentialValidateOptions) (*CredentialValidation, error) {
+	// Validating talks to the registry only, so it works without acorn being installed
+	return c.Client.CredentialValidate(ctx, serverAddress, opts)
+}
+
+func (c IgnoreUninstalled) ImageScan(ctx context.Context, imageName string, opts *ImageScanOptions) (*ImageScanResult, error) {
+	return promptInstall(ctx, func() (*ImageScanResult, error) {
+		return c.Client.ImageScan(ctx, imageName, opts)
+	})
+}
+
+func (c IgnoreUninstalled) ImageExtractFile(ctx context.Context, imageName, filePath string, out io.Writer, opts *ImageExtractOptions) error {
+	return c.Client.ImageExtractFile(ctx, imageName, filePath, out, opts)
+}
+
+func (c IgnoreUninstalled) ImageHistory(ctx context.Context, imageName string, opts *ImageHistoryOptions) (*ImageHistory, error) {
+	return promptInstall(ctx, func() (*ImageHistory, error) {
+		return c.Client.ImageHistory(ctx, imageName, opts)
+	})
+}
+
 func (c IgnoreUninstalled) AcornImageBuild(ctx context.Context, file string, opts *AcornImageBuildOptions) (*v1.AppImage, error) {
 	return promptInstall(ctx, func() (*v1.AppImage, error) {
 		return c.Client.AcornImageBuild(ctx, file, opts)
@@ -281,9 +405,9 @@ func (c *IgnoreUninstalled) ProjectList(ctx context.Context) ([]apiv1.Project, e
 	return ignoreUninstalled(c.Client.ProjectList(ctx))
 }
 
-func (c *IgnoreUninstalled) ProjectCreate(ctx context.Context, name, defaultRegion string, supportedRegions []string) (*apiv1.Project, error) {
+func (c *IgnoreUninstalled) ProjectCreate(ctx context.Context, name string, opts *ProjectCreateOptions) (*apiv1.Project, error) {
 	return promptInstall(ctx, func() (*apiv1.Project, error) {
-		return c.Client.ProjectCreate(ctx, name, defaultRegion, supportedRegions)
+		return c.Client.ProjectCreate(ctx, name, opts)
 	})
 }
 
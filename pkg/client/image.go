@@ -18,7 +18,7 @@ import (
 	"k8s.io/utils/strings/slices"
 )
 
-func (c *DefaultClient) ImageTag(ctx context.Context, imageName, tag string) error {
+func (c *DefaultClient) ImageTag(ctx context.Context, imageName, tag string, opts *ImageTagOptions) error {
 	image, err := c.ImageGet(ctx, imageName)
 	if apierrors.IsNotFound(err) {
 		return err
@@ -30,7 +30,8 @@ func (c *DefaultClient) ImageTag(ctx context.Context, imageName, tag string) err
 		Name(image.Name).
 		SubResource("tag").
 		Body(&apiv1.ImageTag{
-			Tag: tag,
+			Tag:            tag,
+			CopySignatures: opts != nil && opts.CopySignatures,
 		}).Do(ctx).Into(tagResult)
 	return err
 }
@@ -46,7 +47,9 @@ func (c *DefaultClient) ImageDetails(ctx context.Context, imageName string, opts
 		detailsResult.NestedDigest = opts.NestedDigest
 		detailsResult.Auth = opts.Auth
 		detailsResult.NoDefaultRegistry = opts.NoDefaultRegistry
+		detailsResult.Local = opts.Local
 		detailsResult.IncludeNested = opts.IncludeNested
+		detailsResult.ListImages = opts.ListImages
 	}
 
 	err := c.RESTClient.Post().
@@ -69,6 +72,7 @@ func (c *DefaultClient) ImageDetails(ctx context.Context, imageName string, opts
 		ParseError:      detailsResult.GetParseError(),
 		SignatureDigest: detailsResult.SignatureDigest,
 		NestedImages:    detailsResult.NestedImages,
+		Images:          detailsResult.Images,
 		Permissions:     detailsResult.Permissions,
 	}, nil
 }
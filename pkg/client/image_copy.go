@@ -0,0 +1,230 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	acornsign "github.com/acorn-io/runtime/pkg/cosign"
+	"github.com/acorn-io/runtime/pkg/images"
+	"github.com/google/go-containerregistry/pkg/name"
+	ggcrv1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/remote/transport"
+)
+
+// ImageCopy copies the image src to dst, both in remote registries. The image is copied by digest, so that it keeps
+// its digest in dst and signatures of src stay valid for it. The copy happens locally, it doesn't involve the
+// Acorn API or its internal registry, so no project is needed.
+func ImageCopy(ctx context.Context, src, dst string, opts *ImageCopyOptions) (<-chan ImageProgress, error) {
+	if opts == nil {
+		opts = &ImageCopyOptions{}
+	}
+
+	source, err := parseRemoteReference(src)
+	if err != nil {
+		return nil, err
+	}
+	dest, err := parseRemoteReference(dst)
+	if err != nil {
+		return nil, err
+	}
+
+	sourceOpts := remoteOptions(ctx, source, opts.SourceAuth)
+	destOpts := remoteOptions(ctx, dest, opts.DestAuth)
+
+	sourceDigest, err := acornsign.SimpleDigest(source, sourceOpts...)
+	if err != nil {
+		return nil, err
+	}
+	sourceByDigest := source.Context().Digest(sourceDigest)
+
+	sourceIndex, err := remote.Index(sourceByDigest, sourceOpts...)
+	if err != nil {
+		return nil, err
+	}
+
+	if !opts.Force {
+		if err := images.ErrIfImageExistsAndIsDifferent(sourceIndex, dest, destOpts); err != nil {
+			return nil, err
+		}
+	}
+
+	var (
+		sigTag    name.Tag
+		sig       ggcrv1.Image
+		sigDest   name.Tag
+		artifacts []imageArtifact
+	)
+	if opts.AllSignaturesAndAttestations {
+		artifacts, err = findImageArtifacts(sourceByDigest, dest.Context(), sourceOpts)
+		if err != nil {
+			return nil, err
+		}
+	} else if opts.SignArtifacts {
+		tag, img, err := acornsign.FindSignatureImage(sourceByDigest, sourceOpts...)
+		if err != nil {
+			return nil, err
+		}
+		if img != nil {
+			sigTag, sig, sigDest = tag, img, dest.Context().Tag(tag.TagStr())
+		}
+	}
+
+	// metachannel is used to send another channel with updates for each image to be copied
+	metachannel := make(chan images.SimpleUpdate)
+	progress := make(chan images.ImageProgress)
+
+	go func() {
+		defer close(progress)
+		images.ForwardUpdates(progress, metachannel)
+	}()
+
+	go func() {
+		defer close(metachannel)
+		if opts.AllSignaturesAndAttestations {
+			copyWithArtifacts(metachannel, dest, sourceIndex, fmt.Sprintf("Copying %s to %s", src, dst), artifacts, opts.Atomic, destOpts)
+			return
+		}
+
+		images.RemoteWrite(metachannel, dest, sourceIndex, fmt.Sprintf("Copying %s to %s", src, dst), nil, destOpts...)
+
+		if sig != nil {
+			images.RemoteWrite(metachannel, sigDest, sig, fmt.Sprintf("Copying %s to %s", sigTag.String(), sigDest.String()), nil, destOpts...)
+		}
+	}()
+
+	result := make(chan ImageProgress)
+	go func() {
+		defer close(result)
+		for p := range progress {
+			result <- ImageProgress{
+				Total:       p.Total,
+				Complete:    p.Complete,
+				Error:       p.Error,
+				CurrentTask: p.CurrentTask,
+			}
+		}
+	}()
+
+	return result, nil
+}
+
+// imageArtifact is an artifact that refers to an image, like a signature or an attestation.
+type imageArtifact struct {
+	kind     string
+	source   name.Reference
+	dest     name.Reference
+	artifact any
+}
+
+// cosignArtifactKinds are the suffixes of the tags that cosign attaches artifacts to an image with, by the kind of the
+// artifacts.
+var cosignArtifactKinds = [][2]string{
+	{"sig", "signature"},
+	{"att", "attestation"},
+	{"sbom", "SBOM"},
+}
+
+// findImageArtifacts returns the artifacts that refer to image: the ones cosign attached with tags derived from its
+// digest and the ones returned by the OCI referrers API. The destination of each is in the repository dest.
+func findImageArtifacts(image name.Digest, dest name.Repository, opts []remote.Option) ([]imageArtifact, error) {
+	var result []imageArtifact
+
+	tagPrefix := strings.Replace(image.DigestStr(), ":", "-", 1)
+	for _, kind := range cosignArtifactKinds {
+		tag := image.Context().Tag(tagPrefix + "." + kind[0])
+		artifact, err := remoteArtifact(tag, opts)
+		if err != nil {
+			return nil, fmt.Errorf("getting %s %s: %w", kind[1], tag, err)
+		} else if artifact == nil {
+			continue
+		}
+		result = append(result, imageArtifact{
+			kind:     kind[1],
+			source:   tag,
+			dest:     dest.Tag(tag.TagStr()),
+			artifact: artifact,
+		})
+	}
+
+	referrers, err := remote.Referrers(image, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("listing the artifacts referring to %s: %w", image, err)
+	}
+	manifest, err := referrers.IndexManifest()
+	if err != nil {
+		return nil, err
+	}
+	for _, desc := range manifest.Manifests {
+		ref := image.Context().Digest(desc.Digest.String())
+		artifact, err := remoteArtifact(ref, opts)
+		if err != nil {
+			return nil, fmt.Errorf("getting artifact %s: %w", ref, err)
+		} else if artifact == nil {
+			return nil, fmt.Errorf("getting artifact %s: not found", ref)
+		}
+		kind := "artifact"
+		if desc.ArtifactType != "" {
+			kind = "artifact " + desc.ArtifactType
+		}
+		result = append(result, imageArtifact{
+			kind:     kind,
+			source:   ref,
+			dest:     dest.Digest(desc.Digest.String()),
+			artifact: artifact,
+		})
+	}
+
+	return result, nil
+}
+
+// remoteArtifact returns the image or index of ref, or nil if it doesn't exist.
+func remoteArtifact(ref name.Reference, opts []remote.Option) (any, error) {
+	desc, err := remote.Get(ref, opts...)
+	var terr *transport.Error
+	if errors.As(err, &terr) && terr.StatusCode == http.StatusNotFound {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	if desc.MediaType.IsIndex() {
+		return desc.ImageIndex()
+	}
+	return desc.Image()
+}
+
+// copyWithArtifacts copies the artifacts that refer to the image before the image itself, so that if atomic is set the
+// image isn't copied when any of them failed to copy. Each copy is verified to have the digest of its source.
+func copyWithArtifacts(progress chan<- images.SimpleUpdate, dest name.Reference, index ggcrv1.ImageIndex, description string, artifacts []imageArtifact, atomic bool, opts []remote.Option) {
+	for _, artifact := range artifacts {
+		err := images.RemoteCopy(progress, artifact.dest, artifact.artifact, fmt.Sprintf("Copying %s %s to %s", artifact.kind, artifact.source, artifact.dest), opts...)
+		if err != nil && atomic {
+			images.ReportError(progress, description, fmt.Errorf("not copying %s since copying %s %s failed: %w", dest, artifact.kind, artifact.source, err))
+			return
+		}
+	}
+	_ = images.RemoteCopy(progress, dest, index, description, opts...)
+}
+
+func parseRemoteReference(image string) (name.Reference, error) {
+	ref, err := name.ParseReference(image, name.WithDefaultRegistry(images.NoDefaultRegistry))
+	if err != nil {
+		return nil, err
+	}
+	if ref.Context().RegistryStr() == images.NoDefaultRegistry {
+		return nil, fmt.Errorf("image %s has no specified registry", image)
+	}
+	return ref, nil
+}
+
+func remoteOptions(ctx context.Context, ref name.Reference, auth *apiv1.RegistryAuth) []remote.Option {
+	opts := []remote.Option{remote.WithContext(ctx)}
+	if auth != nil {
+		opts = append(opts, remote.WithAuthFromKeychain(images.NewSimpleKeychain(ref.Context(), *auth, nil)))
+	}
+	return opts
+}
@@ -0,0 +1,142 @@
+package client
+
+import (
+	"context"
+	"io"
+	"log"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/acorn-io/runtime/pkg/images"
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/registry"
+	ggcrv1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/random"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestImageCopyAllSignaturesAndAttestations(t *testing.T) {
+	s := httptest.NewServer(registry.New(registry.Logger(log.New(io.Discard, "", 0))))
+	defer s.Close()
+	host := strings.TrimPrefix(s.URL, "http://")
+
+	index, err := random.Index(64, 1, 1)
+	require.NoError(t, err)
+	source, err := name.ParseReference(host + "/acme/app:v1")
+	require.NoError(t, err)
+	require.NoError(t, remote.WriteIndex(source, index))
+
+	digest, err := index.Digest()
+	require.NoError(t, err)
+	signature, err := random.Image(64, 1)
+	require.NoError(t, err)
+	signatureTag := source.Context().Tag(strings.Replace(digest.String(), ":", "-", 1) + ".sig")
+	require.NoError(t, remote.Write(signatureTag, signature))
+
+	indexManifest, err := index.IndexManifest()
+	require.NoError(t, err)
+	referrer, err := random.Image(64, 1)
+	require.NoError(t, err)
+	referrer = mutate.Subject(referrer, ggcrv1.Descriptor{
+		MediaType: indexManifest.MediaType,
+		Digest:    digest,
+		Size:      mustSize(t, index),
+	}).(ggcrv1.Image)
+	referrerDigest, err := referrer.Digest()
+	require.NoError(t, err)
+	require.NoError(t, remote.Write(source.Context().Digest(referrerDigest.String()), referrer))
+
+	progress, err := ImageCopy(context.Background(), source.String(), host+"/promoted/app:v1", &ImageCopyOptions{
+		AllSignaturesAndAttestations: true,
+		Atomic:                       true,
+	})
+	require.NoError(t, err)
+	var tasks []string
+	for p := range progress {
+		require.Empty(t, p.Error)
+		if len(tasks) == 0 || tasks[len(tasks)-1] != p.CurrentTask {
+			tasks = append(tasks, p.CurrentTask)
+		}
+	}
+	assert.Equal(t, []string{
+		"Copying signature " + signatureTag.String() + " to " + host + "/promoted/app:" + signatureTag.TagStr(),
+		"Copying artifact application/vnd.docker.container.image.v1+json " + host + "/acme/app@" + referrerDigest.String() + " to " + host + "/promoted/app@" + referrerDigest.String(),
+		"Copying " + source.String() + " to " + host + "/promoted/app:v1",
+	}, tasks)
+
+	dest, err := name.NewRepository(host + "/promoted/app")
+	require.NoError(t, err)
+	desc, err := remote.Head(dest.Tag("v1"))
+	require.NoError(t, err)
+	assert.Equal(t, digest, desc.Digest)
+	desc, err = remote.Head(dest.Tag(signatureTag.TagStr()))
+	require.NoError(t, err)
+	assert.Equal(t, mustDigest(t, signature), desc.Digest)
+	referrers, err := remote.Referrers(dest.Digest(digest.String()))
+	require.NoError(t, err)
+	referrersManifest, err := referrers.IndexManifest()
+	require.NoError(t, err)
+	require.Len(t, referrersManifest.Manifests, 1)
+	assert.Equal(t, referrerDigest, referrersManifest.Manifests[0].Digest)
+}
+
+func TestCopyWithArtifactsAtomic(t *testing.T) {
+	s := httptest.NewServer(registry.New(registry.Logger(log.New(io.Discard, "", 0))))
+	defer s.Close()
+	host := strings.TrimPrefix(s.URL, "http://")
+
+	index, err := random.Index(64, 1, 1)
+	require.NoError(t, err)
+	dest, err := name.ParseReference(host + "/promoted/app:v1")
+	require.NoError(t, err)
+	source, err := name.ParseReference(host + "/acme/app:sha256-1234.att")
+	require.NoError(t, err)
+
+	metachannel := make(chan images.SimpleUpdate)
+	progress := make(chan images.ImageProgress)
+	go func() {
+		defer close(progress)
+		images.ForwardUpdates(progress, metachannel)
+	}()
+	go func() {
+		defer close(metachannel)
+		copyWithArtifacts(metachannel, dest, index, "Copying app", []imageArtifact{{
+			kind:     "attestation",
+			source:   source,
+			dest:     dest.Context().Tag("sha256-1234.att"),
+			artifact: "not an image",
+		}}, true, nil)
+	}()
+
+	var errs []string
+	for p := range progress {
+		if p.Error != "" {
+			errs = append(errs, p.Error)
+		}
+	}
+	assert.Equal(t, []string{
+		"unsupported source type: string",
+		"not copying " + dest.String() + " since copying attestation " + source.String() + " failed: unsupported source type: string",
+	}, errs)
+
+	_, err = remote.Head(dest)
+	assert.Error(t, err)
+}
+
+func mustSize(t *testing.T, index ggcrv1.ImageIndex) int64 {
+	t.Helper()
+	size, err := index.Size()
+	require.NoError(t, err)
+	return size
+}
+
+func mustDigest(t *testing.T, img ggcrv1.Image) ggcrv1.Hash {
+	t.Helper()
+	digest, err := img.Digest()
+	require.NoError(t, err)
+	return digest
+}
@@ -0,0 +1,235 @@
+package client
+
+import (
+	"archive/tar"
+	"context"
+	"fmt"
+	"io"
+	"path"
+	"runtime"
+	"strings"
+
+	"github.com/acorn-io/baaah/pkg/typed"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/tags"
+	"github.com/google/go-containerregistry/pkg/name"
+	ggcrv1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+)
+
+// ImageExtractFile writes the file at filePath in an image of the app image to out. If filePath is a directory, a tar
+// archive of it is written instead. Like for ImageScan, the image is read on the client from a remote registry.
+func (c *DefaultClient) ImageExtractFile(ctx context.Context, imageName, filePath string, out io.Writer, opts *ImageExtractOptions) error {
+	return extractImageFile(ctx, c, imageName, filePath, out, opts)
+}
+
+func extractImageFile(ctx context.Context, c Client, imageName, filePath string, out io.Writer, opts *ImageExtractOptions) error {
+	if opts == nil {
+		opts = &ImageExtractOptions{}
+	}
+
+	details, err := c.ImageDetails(ctx, imageName, &ImageDetailsOptions{
+		Auth: opts.Auth,
+	})
+	if err != nil {
+		return err
+	}
+
+	image, err := extractSourceImage(details.AppImage.ImageData, opts.Container)
+	if err != nil {
+		return err
+	}
+
+	appRef, err := remoteReference(ctx, c, imageName, details.AppImage, "extract files from it")
+	if err != nil {
+		return err
+	}
+
+	var ref name.Reference = appRef.Context().Digest(image)
+	if !tags.IsImageDigest(image) {
+		ref, err = name.ParseReference(image)
+		if err != nil {
+			return err
+		}
+	}
+
+	img, err := pullPlatformImage(ref, remoteOptions(ctx, ref, opts.Auth))
+	if err != nil {
+		return err
+	}
+
+	fs := mutate.Extract(img)
+	defer fs.Close()
+
+	return extractPath(fs, filePath, out)
+}
+
+// extractSourceImage returns the image of the container, sidecar (CONTAINER.SIDECAR), function, job or image of the
+// app image with the given name. The name can be left empty if the app image has only one image.
+func extractSourceImage(imageData v1.ImagesData, container string) (string, error) {
+	names, images := sourceImages(imageData)
+
+	if container != "" {
+		if image, ok := images[container]; ok {
+			return image, nil
+		}
+		return "", fmt.Errorf("no container named %s in the image, available are: %s", container, strings.Join(names, ", "))
+	}
+
+	switch len(names) {
+	case 0:
+		return "", fmt.Errorf("the image contains no container images to extract from")
+	case 1:
+		return images[names[0]], nil
+	}
+	return "", fmt.Errorf("the image contains multiple container images, specify the one to extract from: %s", strings.Join(names, ", "))
+}
+
+// sourceImages returns the names of the containers, sidecars (CONTAINER.SIDECAR), functions, jobs and images of the app
+// image in order and their images by name.
+func sourceImages(imageData v1.ImagesData) (names []string, images map[string]string) {
+	images = map[string]string{}
+	add := func(name, image string) {
+		names = append(names, name)
+		images[name] = image
+	}
+	addContainers := func(containers map[string]v1.ContainerData) {
+		for _, entry := range typed.Sorted(containers) {
+			add(entry.Key, entry.Value.Image)
+			for _, sidecar := range typed.Sorted(entry.Value.Sidecars) {
+				add(entry.Key+"."+sidecar.Key, sidecar.Value.Image)
+			}
+		}
+	}
+
+	addContainers(imageData.Containers)
+	addContainers(imageData.Functions)
+	addContainers(imageData.Jobs)
+	for _, entry := range typed.Sorted(imageData.Images) {
+		add(entry.Key, entry.Value.Image)
+	}
+	return names, images
+}
+
+// pullPlatformImage returns the image of ref. If ref is an index, the image for the platform of the client is
+// returned, or the first image if the index has none for it.
+func pullPlatformImage(ref name.Reference, remoteOpts []remote.Option) (ggcrv1.Image, error) {
+	desc, err := remote.Get(ref, remoteOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return platformImage(ref, desc)
+}
+
+// platformImage returns the image of desc, the descriptor of ref, like pullPlatformImage.
+func platformImage(ref name.Reference, desc *remote.Descriptor) (ggcrv1.Image, error) {
+	if !desc.MediaType.IsIndex() {
+		return desc.Image()
+	}
+
+	index, err := desc.ImageIndex()
+	if err != nil {
+		return nil, err
+	}
+	indexManifest, err := index.IndexManifest()
+	if err != nil {
+		return nil, err
+	}
+
+	var first *ggcrv1.Descriptor
+	for i, m := range indexManifest.Manifests {
+		if !m.MediaType.IsImage() {
+			continue
+		}
+		if m.Platform != nil && m.Platform.OS == "linux" && m.Platform.Architecture == runtime.GOARCH {
+			return index.Image(m.Digest)
+		}
+		if first == nil {
+			first = &indexManifest.Manifests[i]
+		}
+	}
+	if first == nil {
+		return nil, fmt.Errorf("no image found in index %s", ref)
+	}
+	return index.Image(first.Digest)
+}
+
+// extractPath reads the tar of a file system from r and writes the content of the file at filePath to out. If
+// filePath is a directory, a tar of it with the paths relative to its parent directory is written. Symlinks aren't
+// followed.
+func extractPath(r io.Reader, filePath string, out io.Writer) error {
+	filePath = cleanTarPath(filePath)
+	base := path.Base(filePath)
+	if filePath == "" {
+		base = ""
+	}
+
+	var (
+		tr = tar.NewReader(r)
+		tw *tar.Writer
+	)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return err
+		}
+
+		rel, ok := relTarPath(filePath, cleanTarPath(header.Name))
+		if !ok || (rel == "" && filePath == "") {
+			continue
+		} else if rel == "" {
+			switch header.Typeflag {
+			case tar.TypeReg:
+				_, err := io.Copy(out, tr)
+				return err
+			case tar.TypeSymlink:
+				return fmt.Errorf("%s is a symlink to %s, extract that path instead", filePath, header.Linkname)
+			case tar.TypeDir:
+			default:
+				return fmt.Errorf("%s is neither a regular file nor a directory", filePath)
+			}
+		}
+
+		if tw == nil {
+			tw = tar.NewWriter(out)
+		}
+
+		header.Name = path.Join(base, rel)
+		if header.Typeflag == tar.TypeDir {
+			header.Name += "/"
+		}
+		if header.Typeflag == tar.TypeLink {
+			if rel, ok := relTarPath(filePath, cleanTarPath(header.Linkname)); ok {
+				header.Linkname = path.Join(base, rel)
+			}
+		}
+		if err := tw.WriteHeader(header); err != nil {
+			return err
+		}
+		if _, err := io.Copy(tw, tr); err != nil {
+			return err
+		}
+	}
+
+	if tw == nil {
+		return fmt.Errorf("%s not found in the image", filePath)
+	}
+	return tw.Close()
+}
+
+// cleanTarPath returns p relative to the root of the file system, the root itself is the empty string
+func cleanTarPath(p string) string {
+	return strings.TrimPrefix(path.Clean("/"+p), "/")
+}
+
+// relTarPath returns entry relative to dir, and whether entry is dir or in it
+func relTarPath(dir, entry string) (string, bool) {
+	if dir == "" || entry == dir {
+		return strings.TrimPrefix(entry, dir), true
+	}
+	rel, ok := strings.CutPrefix(entry, dir+"/")
+	return rel, ok
+}
@@ -0,0 +1,122 @@
+package client
+
+import (
+	"archive/tar"
+	"bytes"
+	"io"
+	"testing"
+
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testFileSystem(t *testing.T) []byte {
+	t.Helper()
+
+	buf := &bytes.Buffer{}
+	tw := tar.NewWriter(buf)
+	for _, entry := range []struct {
+		name, content, link string
+		typeflag            byte
+	}{
+		{name: "./etc/app/config.yaml", content: "key: value\n", typeflag: tar.TypeReg},
+		{name: "etc/app/", typeflag: tar.TypeDir},
+		{name: "etc/app/conf.d/extra.yaml", content: "extra: true\n", typeflag: tar.TypeReg},
+		{name: "etc/app/current", link: "config.yaml", typeflag: tar.TypeSymlink},
+		{name: "etc/app/copy.yaml", link: "etc/app/config.yaml", typeflag: tar.TypeLink},
+		{name: "etc/hosts", content: "127.0.0.1 localhost\n", typeflag: tar.TypeReg},
+	} {
+		require.NoError(t, tw.WriteHeader(&tar.Header{
+			Name:     entry.name,
+			Linkname: entry.link,
+			Typeflag: entry.typeflag,
+			Size:     int64(len(entry.content)),
+			Mode:     0644,
+		}))
+		_, err := tw.Write([]byte(entry.content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, tw.Close())
+	return buf.Bytes()
+}
+
+func TestExtractPathFile(t *testing.T) {
+	fs := testFileSystem(t)
+
+	out := &bytes.Buffer{}
+	require.NoError(t, extractPath(bytes.NewReader(fs), "/etc/app/config.yaml", out))
+	assert.Equal(t, "key: value\n", out.String())
+
+	out.Reset()
+	require.NoError(t, extractPath(bytes.NewReader(fs), "etc/hosts", out))
+	assert.Equal(t, "127.0.0.1 localhost\n", out.String())
+
+	assert.EqualError(t, extractPath(bytes.NewReader(fs), "/etc/missing", io.Discard), "etc/missing not found in the image")
+	assert.EqualError(t, extractPath(bytes.NewReader(fs), "/etc/app/current", io.Discard), "etc/app/current is a symlink to config.yaml, extract that path instead")
+}
+
+func TestExtractPathDirectory(t *testing.T) {
+	out := &bytes.Buffer{}
+	require.NoError(t, extractPath(bytes.NewReader(testFileSystem(t)), "/etc/app/", out))
+
+	var (
+		names    []string
+		links    = map[string]string{}
+		contents = map[string]string{}
+		tr       = tar.NewReader(out)
+	)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		names = append(names, header.Name)
+		if header.Linkname != "" {
+			links[header.Name] = header.Linkname
+		}
+		content, err := io.ReadAll(tr)
+		require.NoError(t, err)
+		if len(content) > 0 {
+			contents[header.Name] = string(content)
+		}
+	}
+
+	assert.Equal(t, []string{"app/config.yaml", "app/", "app/conf.d/extra.yaml", "app/current", "app/copy.yaml"}, names)
+	assert.Equal(t, map[string]string{"app/current": "config.yaml", "app/copy.yaml": "app/config.yaml"}, links)
+	assert.Equal(t, map[string]string{"app/config.yaml": "key: value\n", "app/conf.d/extra.yaml": "extra: true\n"}, contents)
+}
+
+func TestExtractSourceImage(t *testing.T) {
+	imageData := v1.ImagesData{
+		Containers: map[string]v1.ContainerData{
+			"web": {
+				Image: "sha256:web",
+				Sidecars: map[string]v1.ImageData{
+					"proxy": {Image: "sha256:proxy"},
+				},
+			},
+		},
+		Jobs: map[string]v1.ContainerData{
+			"migrate": {Image: "sha256:migrate"},
+		},
+	}
+
+	image, err := extractSourceImage(imageData, "web.proxy")
+	require.NoError(t, err)
+	assert.Equal(t, "sha256:proxy", image)
+
+	_, err = extractSourceImage(imageData, "db")
+	assert.EqualError(t, err, "no container named db in the image, available are: web, web.proxy, migrate")
+
+	_, err = extractSourceImage(imageData, "")
+	assert.EqualError(t, err, "the image contains multiple container images, specify the one to extract from: web, web.proxy, migrate")
+
+	image, err = extractSourceImage(v1.ImagesData{Jobs: imageData.Jobs}, "")
+	require.NoError(t, err)
+	assert.Equal(t, "sha256:migrate", image)
+
+	_, err = extractSourceImage(v1.ImagesData{}, "")
+	assert.EqualError(t, err, "the image contains no container images to extract from")
+}
@@ -0,0 +1,248 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/acorn-io/runtime/pkg/tags"
+	"github.com/google/go-containerregistry/pkg/name"
+	ggcrv1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/remote/transport"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// slsaProvenancePrefix is the prefix of the in-toto predicate types of all versions of SLSA provenance
+	slsaProvenancePrefix = "https://slsa.dev/provenance/"
+
+	// buildkitAttestationAnnotation marks the manifests in an index that are attestations of another manifest
+	buildkitAttestationAnnotation = "vnd.docker.reference.type"
+	buildkitPredicateAnnotation   = "in-toto.io/predicate-type"
+	cosignPredicateAnnotation     = "predicateType"
+)
+
+// ImageHistory returns the layers of the images of the app image, in the order they were built, and the SLSA
+// provenance attested for them. Like for ImageScan, the image is read on the client from a remote registry.
+func (c *DefaultClient) ImageHistory(ctx context.Context, imageName string, opts *ImageHistoryOptions) (*ImageHistory, error) {
+	return imageHistory(ctx, c, imageName, opts)
+}
+
+func imageHistory(ctx context.Context, c Client, imageName string, opts *ImageHistoryOptions) (*ImageHistory, error) {
+	if opts == nil {
+		opts = &ImageHistoryOptions{}
+	}
+
+	details, err := c.ImageDetails(ctx, imageName, &ImageDetailsOptions{
+		Auth: opts.Auth,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	appRef, err := remoteReference(ctx, c, imageName, details.AppImage, "show its history")
+	if err != nil {
+		return nil, err
+	}
+
+	result := &ImageHistory{
+		Image:  imageName,
+		Digest: details.AppImage.Digest,
+	}
+
+	remoteOpts := remoteOptions(ctx, appRef, opts.Auth)
+	provenance, err := cosignProvenance(appRef, remoteOpts)
+	if err != nil {
+		return nil, err
+	}
+	result.Provenance = append(result.Provenance, provenance...)
+
+	names, images := sourceImages(details.AppImage.ImageData)
+	for _, container := range names {
+		var ref name.Reference = appRef.Context().Digest(images[container])
+		if !tags.IsImageDigest(images[container]) {
+			ref, err = name.ParseReference(images[container])
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		layers, provenance, err := containerImageHistory(ref, remoteOptions(ctx, ref, opts.Auth))
+		if err != nil {
+			return nil, err
+		}
+		for i := range layers {
+			layers[i].Container = container
+		}
+		for i := range provenance {
+			provenance[i].Container = container
+		}
+		result.Layers = append(result.Layers, layers...)
+		result.Provenance = append(result.Provenance, provenance...)
+	}
+
+	return result, nil
+}
+
+// containerImageHistory returns the layers of the image of ref for the platform of the client and the SLSA provenance
+// attested for it by buildkit or cosign.
+func containerImageHistory(ref name.Reference, remoteOpts []remote.Option) ([]ImageHistoryLayer, []ImageProvenance, error) {
+	desc, err := remote.Get(ref, remoteOpts...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var provenance []ImageProvenance
+	if desc.MediaType.IsIndex() {
+		provenance, err = buildkitProvenance(desc)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	digestRef := ref.Context().Digest(desc.Digest.String())
+	cosign, err := cosignProvenance(digestRef, remoteOpts)
+	if err != nil {
+		return nil, nil, err
+	}
+	provenance = append(provenance, cosign...)
+
+	img, err := platformImage(ref, desc)
+	if err != nil {
+		return nil, nil, err
+	}
+	layers, err := historyLayers(img)
+	if err != nil {
+		return nil, nil, err
+	}
+	return layers, provenance, nil
+}
+
+// historyLayers returns an entry for each step of the history of img, the steps that created a layer have its digest
+// and compressed size.
+func historyLayers(img ggcrv1.Image) ([]ImageHistoryLayer, error) {
+	config, err := img.ConfigFile()
+	if err != nil {
+		return nil, err
+	}
+	layers, err := img.Layers()
+	if err != nil {
+		return nil, err
+	}
+
+	var result []ImageHistoryLayer
+	for _, history := range config.History {
+		entry := ImageHistoryLayer{
+			CreatedBy:  history.CreatedBy,
+			Comment:    history.Comment,
+			EmptyLayer: history.EmptyLayer,
+		}
+		if !history.Created.IsZero() {
+			entry.Created = metav1.NewTime(history.Created.Time)
+		}
+		if !history.EmptyLayer && len(layers) > 0 {
+			digest, err := layers[0].Digest()
+			if err != nil {
+				return nil, err
+			}
+			size, err := layers[0].Size()
+			if err != nil {
+				return nil, err
+			}
+			entry.Digest, entry.Size = digest.String(), size
+			layers = layers[1:]
+		}
+		result = append(result, entry)
+	}
+
+	// Images built without history still list their layers
+	for _, layer := range layers {
+		digest, err := layer.Digest()
+		if err != nil {
+			return nil, err
+		}
+		size, err := layer.Size()
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, ImageHistoryLayer{
+			Digest: digest.String(),
+			Size:   size,
+		})
+	}
+	return result, nil
+}
+
+// buildkitProvenance returns the SLSA provenance in the attestation manifests that buildkit adds to the index of desc.
+func buildkitProvenance(desc *remote.Descriptor) (result []ImageProvenance, _ error) {
+	index, err := desc.ImageIndex()
+	if err != nil {
+		return nil, err
+	}
+	indexManifest, err := index.IndexManifest()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, m := range indexManifest.Manifests {
+		if m.Annotations[buildkitAttestationAnnotation] != "attestation-manifest" {
+			continue
+		}
+		img, err := index.Image(m.Digest)
+		if err != nil {
+			return nil, err
+		}
+		manifest, err := img.Manifest()
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, slsaProvenance(manifest, buildkitPredicateAnnotation, "buildkit", m.Digest.String())...)
+	}
+	return result, nil
+}
+
+// cosignProvenance returns the SLSA provenance that cosign attached to the image of ref in its attestation tag. An
+// image without the tag has none.
+func cosignProvenance(ref name.Digest, remoteOpts []remote.Option) ([]ImageProvenance, error) {
+	_, hex, ok := strings.Cut(ref.DigestStr(), ":")
+	if !ok {
+		return nil, nil
+	}
+
+	img, err := remote.Image(ref.Context().Tag("sha256-"+hex+".att"), remoteOpts...)
+	var terr *transport.Error
+	if errors.As(err, &terr) && terr.StatusCode == http.StatusNotFound {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	digest, err := img.Digest()
+	if err != nil {
+		return nil, err
+	}
+	manifest, err := img.Manifest()
+	if err != nil {
+		return nil, err
+	}
+	return slsaProvenance(manifest, cosignPredicateAnnotation, "cosign", digest.String()), nil
+}
+
+// slsaProvenance returns an entry for each layer of the attestation manifest whose predicate type, given by the
+// annotation, is SLSA provenance.
+func slsaProvenance(manifest *ggcrv1.Manifest, annotation, source, digest string) (result []ImageProvenance) {
+	for _, layer := range manifest.Layers {
+		predicateType := layer.Annotations[annotation]
+		if !strings.HasPrefix(predicateType, slsaProvenancePrefix) {
+			continue
+		}
+		result = append(result, ImageProvenance{
+			PredicateType: predicateType,
+			Source:        source,
+			Digest:        digest,
+		})
+	}
+	return result
+}
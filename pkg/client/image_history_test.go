@@ -0,0 +1,70 @@
+package client
+
+import (
+	"testing"
+	"time"
+
+	ggcrv1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/random"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHistoryLayers(t *testing.T) {
+	layer, err := random.Layer(64, "application/vnd.oci.image.layer.v1.tar+gzip")
+	require.NoError(t, err)
+	created := time.Date(2023, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	img, err := mutate.Append(empty.Image,
+		mutate.Addendum{
+			Layer: layer,
+			History: ggcrv1.History{
+				Created:   ggcrv1.Time{Time: created},
+				CreatedBy: "COPY . /app",
+			},
+		},
+		mutate.Addendum{
+			History: ggcrv1.History{
+				CreatedBy:  `CMD ["/app/server"]`,
+				EmptyLayer: true,
+			},
+		},
+	)
+	require.NoError(t, err)
+
+	digest, err := layer.Digest()
+	require.NoError(t, err)
+	size, err := layer.Size()
+	require.NoError(t, err)
+
+	layers, err := historyLayers(img)
+	require.NoError(t, err)
+	require.Len(t, layers, 2)
+	assert.Equal(t, digest.String(), layers[0].Digest)
+	assert.Equal(t, size, layers[0].Size)
+	assert.Equal(t, "COPY . /app", layers[0].CreatedBy)
+	assert.True(t, created.Equal(layers[0].Created.Time))
+	assert.Equal(t, ImageHistoryLayer{
+		CreatedBy:  `CMD ["/app/server"]`,
+		EmptyLayer: true,
+	}, layers[1])
+}
+
+func TestSLSAProvenance(t *testing.T) {
+	manifest := &ggcrv1.Manifest{
+		Layers: []ggcrv1.Descriptor{
+			{Annotations: map[string]string{buildkitPredicateAnnotation: "https://slsa.dev/provenance/v0.2"}},
+			{Annotations: map[string]string{buildkitPredicateAnnotation: "https://spdx.dev/Document"}},
+			{},
+		},
+	}
+
+	assert.Equal(t, []ImageProvenance{{
+		PredicateType: "https://slsa.dev/provenance/v0.2",
+		Source:        "buildkit",
+		Digest:        "sha256:1234",
+	}}, slsaProvenance(manifest, buildkitPredicateAnnotation, "buildkit", "sha256:1234"))
+	assert.Empty(t, slsaProvenance(manifest, cosignPredicateAnnotation, "cosign", "sha256:1234"))
+}
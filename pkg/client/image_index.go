@@ -0,0 +1,150 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	acornsign "github.com/acorn-io/runtime/pkg/cosign"
+	"github.com/google/go-containerregistry/pkg/name"
+	ggcrv1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/types"
+)
+
+// ImageIndexCreate pushes an OCI image index with the images of entries to tag and returns the reference to the index
+// by digest. Each image has to exist and be built for the platform of its entry. Images from other repositories are
+// copied into the repository of tag, since an index can only refer to images in its own repository. Like ImageCopy,
+// this happens on the client, without involving the Acorn API.
+func (c *DefaultClient) ImageIndexCreate(ctx context.Context, tag string, entries []ImageIndexEntry, opts *ImageIndexCreateOptions) (string, error) {
+	if opts == nil {
+		opts = &ImageIndexCreateOptions{}
+	}
+	if len(entries) == 0 {
+		return "", fmt.Errorf("at least one image is required to create an index")
+	}
+
+	ref, err := parseRemoteReference(tag)
+	if err != nil {
+		return "", err
+	}
+	indexTag, ok := ref.(name.Tag)
+	if !ok {
+		return "", fmt.Errorf("%s is not a tag", tag)
+	}
+	remoteOpts := remoteOptions(ctx, indexTag, opts.Auth)
+
+	var (
+		adds      []mutate.IndexAddendum
+		platforms = map[string]string{}
+	)
+	for _, entry := range entries {
+		platform, err := ggcrv1.ParsePlatform(entry.Platform)
+		if err != nil {
+			return "", fmt.Errorf("invalid platform %s: %w", entry.Platform, err)
+		}
+		if other, ok := platforms[platform.String()]; ok {
+			return "", fmt.Errorf("images %s and %s are both for platform %s", other, entry.Image, platform)
+		}
+		platforms[platform.String()] = entry.Image
+
+		image, err := indexEntryReference(indexTag.Repository, entry.Image)
+		if err != nil {
+			return "", err
+		}
+		img, err := indexEntryImage(image, *platform, remoteOpts)
+		if err != nil {
+			return "", err
+		}
+
+		if image.Context() != indexTag.Context() {
+			if err := remote.Write(indexTag.Context().Digest(image.DigestStr()), img, remoteOpts...); err != nil {
+				return "", fmt.Errorf("copying %s into %s: %w", image, indexTag.Context(), err)
+			}
+			if opts.CopySignatures {
+				if err := copySignature(image, indexTag.Repository, remoteOpts); err != nil {
+					return "", err
+				}
+			}
+		}
+
+		cfg, err := img.ConfigFile()
+		if err != nil {
+			return "", err
+		}
+		adds = append(adds, mutate.IndexAddendum{
+			Add: img,
+			Descriptor: ggcrv1.Descriptor{
+				Platform: cfg.Platform(),
+			},
+		})
+	}
+
+	index := mutate.AppendManifests(mutate.IndexMediaType(empty.Index, types.OCIImageIndex), adds...)
+	if err := remote.WriteIndex(indexTag, index, remoteOpts...); err != nil {
+		return "", err
+	}
+
+	digest, err := index.Digest()
+	if err != nil {
+		return "", err
+	}
+	return indexTag.Context().Digest(digest.String()).String(), nil
+}
+
+// indexEntryReference returns the reference to the image of an index entry, which is either a digest of an image in
+// repo or a reference by digest to an image in another repository of the same registry.
+func indexEntryReference(repo name.Repository, image string) (name.Digest, error) {
+	if !strings.Contains(image, "@") {
+		image = repo.Name() + "@" + image
+	}
+	ref, err := name.NewDigest(image)
+	if err != nil {
+		return name.Digest{}, fmt.Errorf("invalid image %s, must be a digest or a reference by digest: %w", image, err)
+	}
+	if ref.Context().RegistryStr() != repo.RegistryStr() {
+		return name.Digest{}, fmt.Errorf("image %s must be in registry %s of the index", image, repo.RegistryStr())
+	}
+	return ref, nil
+}
+
+// indexEntryImage returns the image of ref, failing if it doesn't exist, is an index or isn't built for platform.
+func indexEntryImage(ref name.Digest, platform ggcrv1.Platform, opts []remote.Option) (ggcrv1.Image, error) {
+	desc, err := remote.Get(ref, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("getting image %s: %w", ref, err)
+	}
+	if !desc.MediaType.IsImage() {
+		return nil, fmt.Errorf("%s is not an image but a %s, only images can be added to an index", ref, desc.MediaType)
+	}
+	img, err := desc.Image()
+	if err != nil {
+		return nil, err
+	}
+	cfg, err := img.ConfigFile()
+	if err != nil {
+		return nil, fmt.Errorf("reading the config of image %s: %w", ref, err)
+	}
+	if actual := cfg.Platform(); actual == nil {
+		return nil, fmt.Errorf("image %s has no platform, it can not be added as %s", ref, platform.String())
+	} else if !actual.Satisfies(platform) {
+		return nil, fmt.Errorf("image %s is for platform %s, not %s", ref, actual, platform.String())
+	}
+	return img, nil
+}
+
+// copySignature copies the cosign signature of image, if it has one, into repo.
+func copySignature(image name.Digest, repo name.Repository, opts []remote.Option) error {
+	sigTag, sig, err := acornsign.FindSignatureImage(image, opts...)
+	if err != nil {
+		return fmt.Errorf("finding the signature of %s: %w", image, err)
+	} else if sig == nil {
+		return nil
+	}
+	if err := remote.Write(repo.Tag(sigTag.TagStr()), sig, opts...); err != nil {
+		return fmt.Errorf("copying signature %s into %s: %w", sigTag, repo, err)
+	}
+	return nil
+}
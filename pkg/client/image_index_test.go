@@ -0,0 +1,128 @@
+package client
+
+import (
+	"context"
+	"io"
+	"log"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/registry"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/random"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestImageIndexCreate(t *testing.T) {
+	s := httptest.NewServer(registry.New(registry.Logger(log.New(io.Discard, "", 0))))
+	defer s.Close()
+	host := strings.TrimPrefix(s.URL, "http://")
+
+	amd64 := pushPlatformImage(t, host+"/acme/app", "amd64")
+	arm64 := pushPlatformImage(t, host+"/acme/app-arm64", "arm64")
+	signature, err := random.Image(64, 1)
+	require.NoError(t, err)
+	signatureTag := strings.Replace(arm64.DigestStr(), ":", "-", 1) + ".sig"
+	require.NoError(t, remote.Write(arm64.Context().Tag(signatureTag), signature))
+
+	index, err := (&DefaultClient{}).ImageIndexCreate(context.Background(), host+"/acme/app:v1", []ImageIndexEntry{
+		{Image: amd64.DigestStr(), Platform: "linux/amd64"},
+		{Image: arm64.String(), Platform: "linux/arm64"},
+	}, &ImageIndexCreateOptions{CopySignatures: true})
+	require.NoError(t, err)
+
+	repo, err := name.NewRepository(host + "/acme/app")
+	require.NoError(t, err)
+	idx, err := remote.Index(repo.Tag("v1"))
+	require.NoError(t, err)
+	digest, err := idx.Digest()
+	require.NoError(t, err)
+	assert.Equal(t, repo.Digest(digest.String()).String(), index)
+
+	manifest, err := idx.IndexManifest()
+	require.NoError(t, err)
+	require.Len(t, manifest.Manifests, 2)
+	assert.Equal(t, amd64.DigestStr(), manifest.Manifests[0].Digest.String())
+	assert.Equal(t, "linux/amd64", manifest.Manifests[0].Platform.String())
+	assert.Equal(t, arm64.DigestStr(), manifest.Manifests[1].Digest.String())
+	assert.Equal(t, "linux/arm64", manifest.Manifests[1].Platform.String())
+
+	// The image and its signature from the other repository were copied
+	_, err = remote.Head(repo.Digest(arm64.DigestStr()))
+	assert.NoError(t, err)
+	_, err = remote.Head(repo.Tag(signatureTag))
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		tag     string
+		entries []ImageIndexEntry
+		wantErr string
+	}{
+		{
+			name:    "wrong platform",
+			tag:     host + "/acme/app:v2",
+			entries: []ImageIndexEntry{{Image: amd64.DigestStr(), Platform: "linux/arm64"}},
+			wantErr: "image " + amd64.String() + " is for platform linux/amd64, not linux/arm64",
+		},
+		{
+			name: "same platform twice",
+			tag:  host + "/acme/app:v2",
+			entries: []ImageIndexEntry{
+				{Image: amd64.DigestStr(), Platform: "linux/amd64"},
+				{Image: arm64.DigestStr(), Platform: "linux/amd64"},
+			},
+			wantErr: "images " + amd64.DigestStr() + " and " + arm64.DigestStr() + " are both for platform linux/amd64",
+		},
+		{
+			name:    "other registry",
+			tag:     host + "/acme/app:v2",
+			entries: []ImageIndexEntry{{Image: "ghcr.io/acme/app@" + amd64.DigestStr(), Platform: "linux/amd64"}},
+			wantErr: "image ghcr.io/acme/app@" + amd64.DigestStr() + " must be in registry " + host + " of the index",
+		},
+		{
+			name:    "index",
+			tag:     host + "/acme/app:v2",
+			entries: []ImageIndexEntry{{Image: digest.String(), Platform: "linux/amd64"}},
+			wantErr: repo.Digest(digest.String()).String() + " is not an image but a application/vnd.oci.image.index.v1+json, only images can be added to an index",
+		},
+		{
+			name:    "not a tag",
+			tag:     index,
+			entries: []ImageIndexEntry{{Image: amd64.DigestStr(), Platform: "linux/amd64"}},
+			wantErr: index + " is not a tag",
+		},
+		{
+			name:    "no images",
+			tag:     host + "/acme/app:v2",
+			wantErr: "at least one image is required to create an index",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := (&DefaultClient{}).ImageIndexCreate(context.Background(), tt.tag, tt.entries, nil)
+			assert.EqualError(t, err, tt.wantErr)
+		})
+	}
+}
+
+// pushPlatformImage pushes a random image built for linux/arch to repo and returns its reference by digest.
+func pushPlatformImage(t *testing.T, repo, arch string) name.Digest {
+	t.Helper()
+	img, err := random.Image(64, 1)
+	require.NoError(t, err)
+	cfg, err := img.ConfigFile()
+	require.NoError(t, err)
+	cfg.OS, cfg.Architecture = "linux", arch
+	img, err = mutate.ConfigFile(img, cfg)
+	require.NoError(t, err)
+	digest := mustDigest(t, img)
+	ref, err := name.NewDigest(repo + "@" + digest.String())
+	require.NoError(t, err)
+	require.NoError(t, remote.Write(ref, img))
+	return ref
+}
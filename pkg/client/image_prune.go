@@ -0,0 +1,77 @@
+package client
+
+import (
+	"context"
+	"time"
+
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/images"
+	"golang.org/x/exp/slices"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+func (c *DefaultClient) ImagePrune(ctx context.Context, opts *ImagePruneOptions) ([]apiv1.Image, error) {
+	if opts == nil {
+		opts = &ImagePruneOptions{}
+	}
+
+	imageList, err := c.ImageList(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	apps, err := c.AppList(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	prune := imagesToPrune(imageList, apps, opts, time.Now())
+	if opts.DryRun {
+		return prune, nil
+	}
+
+	pruned := make([]apiv1.Image, 0, len(prune))
+	for _, image := range prune {
+		if err := c.Client.Delete(ctx, &image); apierrors.IsNotFound(err) {
+			continue
+		} else if err != nil {
+			return pruned, err
+		}
+		pruned = append(pruned, image)
+	}
+	return pruned, nil
+}
+
+// imagesToPrune returns the images that aren't used by any app, including stopped apps, and match opts. An app uses
+// the image it runs, and the image its spec refers to by ID, digest or tag, so that an app whose status isn't populated
+// yet still keeps its image.
+func imagesToPrune(imageList []apiv1.Image, apps []apiv1.App, opts *ImagePruneOptions, now time.Time) (result []apiv1.Image) {
+	inUse := make(map[string]bool, len(apps))
+	for _, app := range apps {
+		if app.Status.AppImage.Digest != "" {
+			inUse[app.Status.AppImage.Digest] = true
+		}
+		if app.Spec.Image != "" {
+			if image, _, err := images.FindImageMatch(apiv1.ImageList{Items: imageList}, app.Spec.Image); err == nil {
+				inUse[image.Digest] = true
+			}
+		}
+	}
+
+	for _, image := range imageList {
+		if image.ZZ_Remote || inUse[image.Digest] {
+			continue
+		}
+		if (!opts.All || opts.DanglingOnly) && len(image.Tags) > 0 {
+			continue
+		}
+		if opts.Until > 0 && now.Sub(image.CreationTimestamp.Time) < opts.Until {
+			continue
+		}
+		if len(opts.Images) > 0 && !slices.Contains(opts.Images, image.Name) {
+			continue
+		}
+		result = append(result, image)
+	}
+	return result
+}
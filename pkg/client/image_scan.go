@@ -0,0 +1,173 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"sort"
+	"strings"
+
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/tags"
+	"github.com/google/go-containerregistry/pkg/name"
+	"golang.org/x/exp/slices"
+)
+
+const defaultImageScanner = "trivy"
+
+// ImageScanSeverities are the severities of scan findings, from lowest to highest.
+var ImageScanSeverities = []string{"UNKNOWN", "LOW", "MEDIUM", "HIGH", "CRITICAL"}
+
+// ImageScanSeverityRank returns the position of severity in ImageScanSeverities, unknown severities rank lowest.
+func ImageScanSeverityRank(severity string) int {
+	if i := slices.Index(ImageScanSeverities, strings.ToUpper(severity)); i >= 0 {
+		return i
+	}
+	return 0
+}
+
+// runScanner runs the scanner command and returns what it printed to stdout. It's a variable for testing.
+var runScanner = func(ctx context.Context, scanner string, args, env []string) ([]byte, error) {
+	if _, err := exec.LookPath(scanner); err != nil {
+		return nil, fmt.Errorf("image scanner %s not found, install it or set imageScanner in the CLI config: %w", scanner, err)
+	}
+
+	stderr := &bytes.Buffer{}
+	cmd := exec.CommandContext(ctx, scanner, args...)
+	cmd.Env = append(os.Environ(), env...)
+	cmd.Stderr = stderr
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("running image scanner %s: %w: %s", scanner, err, strings.TrimSpace(stderr.String()))
+	}
+	return out, nil
+}
+
+func (c *DefaultClient) ImageScan(ctx context.Context, imageName string, opts *ImageScanOptions) (*ImageScanResult, error) {
+	return scanImage(ctx, c, imageName, opts)
+}
+
+func scanImage(ctx context.Context, c Client, imageName string, opts *ImageScanOptions) (*ImageScanResult, error) {
+	if opts == nil {
+		opts = &ImageScanOptions{}
+	}
+	scanner := opts.Scanner
+	if scanner == "" {
+		scanner = defaultImageScanner
+	}
+
+	details, err := c.ImageDetails(ctx, imageName, &ImageDetailsOptions{
+		Auth: opts.Auth,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ref, err := remoteReference(ctx, c, imageName, details.AppImage, "scan it")
+	if err != nil {
+		return nil, err
+	}
+
+	var env []string
+	if opts.Auth != nil {
+		env = append(env, "TRIVY_USERNAME="+opts.Auth.Username, "TRIVY_PASSWORD="+opts.Auth.Password)
+	}
+
+	out, err := runScanner(ctx, scanner, []string{"image", "--quiet", "--format", "json", ref.String()}, env)
+	if err != nil {
+		return nil, err
+	}
+
+	findings, err := parseTrivyReport(out)
+	if err != nil {
+		return nil, fmt.Errorf("parsing report of image scanner %s: %w", scanner, err)
+	}
+
+	return &ImageScanResult{
+		Image:    imageName,
+		Digest:   details.AppImage.Digest,
+		Scanner:  scanner,
+		Findings: findings,
+	}, nil
+}
+
+// remoteReference returns the digest reference to pull the image from outside the cluster. The internal registry can't
+// be reached from there, so images referred to by ID or by a tag without registry are pulled through one of their
+// remote tags. action completes the error message if the image has none, like "scan it".
+func remoteReference(ctx context.Context, c Client, imageName string, appImage v1.AppImage, action string) (name.Digest, error) {
+	candidates := []string{imageName}
+	if tags.IsLocalReference(imageName) || tags.HasNoSpecifiedRegistry(imageName) {
+		id := appImage.ID
+		if id == "" {
+			id = imageName
+		}
+		image, err := c.ImageGet(ctx, id)
+		if err != nil {
+			return name.Digest{}, err
+		}
+		candidates = image.Tags
+	}
+
+	for _, candidate := range candidates {
+		if tags.IsLocalReference(candidate) || tags.HasNoSpecifiedRegistry(candidate) {
+			continue
+		}
+		ref, err := name.ParseReference(candidate)
+		if err != nil {
+			continue
+		}
+		return ref.Context().Digest(appImage.Digest), nil
+	}
+
+	return name.Digest{}, fmt.Errorf("image %s is only stored in the internal registry, push it to a registry to %s", imageName, action)
+}
+
+type trivyReport struct {
+	Results []struct {
+		Target          string `json:"Target"`
+		Vulnerabilities []struct {
+			VulnerabilityID  string `json:"VulnerabilityID"`
+			PkgName          string `json:"PkgName"`
+			InstalledVersion string `json:"InstalledVersion"`
+			FixedVersion     string `json:"FixedVersion"`
+			Severity         string `json:"Severity"`
+			Title            string `json:"Title"`
+			PrimaryURL       string `json:"PrimaryURL"`
+		} `json:"Vulnerabilities"`
+	} `json:"Results"`
+}
+
+// parseTrivyReport returns the vulnerabilities of a trivy JSON report, the most severe first.
+func parseTrivyReport(data []byte) ([]ImageScanFinding, error) {
+	report := trivyReport{}
+	if err := json.Unmarshal(data, &report); err != nil {
+		return nil, err
+	}
+
+	var findings []ImageScanFinding
+	for _, result := range report.Results {
+		for _, vuln := range result.Vulnerabilities {
+			findings = append(findings, ImageScanFinding{
+				ID:               vuln.VulnerabilityID,
+				Target:           result.Target,
+				Package:          vuln.PkgName,
+				InstalledVersion: vuln.InstalledVersion,
+				FixedVersion:     vuln.FixedVersion,
+				Severity:         strings.ToUpper(vuln.Severity),
+				Title:            vuln.Title,
+				URL:              vuln.PrimaryURL,
+			})
+		}
+	}
+
+	sort.SliceStable(findings, func(i, j int) bool {
+		if ri, rj := ImageScanSeverityRank(findings[i].Severity), ImageScanSeverityRank(findings[j].Severity); ri != rj {
+			return ri > rj
+		}
+		return findings[i].ID < findings[j].ID
+	})
+	return findings, nil
+}
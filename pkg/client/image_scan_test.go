@@ -0,0 +1,50 @@
+package client
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTrivyReport(t *testing.T) {
+	findings, err := parseTrivyReport([]byte(`{
+  "Results": [
+    {
+      "Target": "ghcr.io/acme/app (alpine 3.18)",
+      "Vulnerabilities": [
+        {"VulnerabilityID": "CVE-2023-2", "PkgName": "busybox", "InstalledVersion": "1.36.0", "Severity": "low"},
+        {"VulnerabilityID": "CVE-2023-1", "PkgName": "openssl", "InstalledVersion": "3.1.0", "FixedVersion": "3.1.1", "Severity": "HIGH", "Title": "overflow", "PrimaryURL": "https://example.com/CVE-2023-1"}
+      ]
+    },
+    {"Target": "app/go.sum"},
+    {
+      "Target": "app/go.sum",
+      "Vulnerabilities": [
+        {"VulnerabilityID": "GHSA-1", "PkgName": "golang.org/x/net", "InstalledVersion": "v0.1.0", "Severity": "HIGH"}
+      ]
+    }
+  ]
+}`))
+	require.NoError(t, err)
+
+	assert.Equal(t, []ImageScanFinding{
+		{ID: "CVE-2023-1", Target: "ghcr.io/acme/app (alpine 3.18)", Package: "openssl", InstalledVersion: "3.1.0", FixedVersion: "3.1.1", Severity: "HIGH", Title: "overflow", URL: "https://example.com/CVE-2023-1"},
+		{ID: "GHSA-1", Target: "app/go.sum", Package: "golang.org/x/net", InstalledVersion: "v0.1.0", Severity: "HIGH"},
+		{ID: "CVE-2023-2", Target: "ghcr.io/acme/app (alpine 3.18)", Package: "busybox", InstalledVersion: "1.36.0", Severity: "LOW"},
+	}, findings)
+
+	findings, err = parseTrivyReport([]byte(`{"Results": []}`))
+	require.NoError(t, err)
+	assert.Empty(t, findings)
+
+	_, err = parseTrivyReport([]byte(`not json`))
+	assert.Error(t, err)
+}
+
+func TestImageScanSeverityRank(t *testing.T) {
+	assert.Equal(t, 4, ImageScanSeverityRank("critical"))
+	assert.Equal(t, 1, ImageScanSeverityRank("LOW"))
+	assert.Equal(t, 0, ImageScanSeverityRank("UNKNOWN"))
+	assert.Equal(t, 0, ImageScanSeverityRank("severe"))
+}
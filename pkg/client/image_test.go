@@ -4,12 +4,15 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
 	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
 	"github.com/acorn-io/runtime/pkg/images"
 	"github.com/acorn-io/runtime/pkg/labels"
 	scheme2 "github.com/acorn-io/runtime/pkg/scheme"
+	"github.com/acorn-io/z"
+	"github.com/stretchr/testify/assert"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	testcontrollerclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 )
@@ -170,3 +173,45 @@ func TestFindImage(t *testing.T) {
 		})
 	}
 }
+
+func TestImagesToPrune(t *testing.T) {
+	now := time.Now()
+	image := func(name, digest string, age time.Duration, tags ...string) apiv1.Image {
+		return apiv1.Image{
+			ObjectMeta: metav1.ObjectMeta{Name: name, CreationTimestamp: metav1.NewTime(now.Add(-age))},
+			Digest:     digest,
+			Tags:       tags,
+		}
+	}
+	images := []apiv1.Image{
+		image("running", "sha256:running", time.Hour),
+		image("stopped", "sha256:stopped", time.Hour),
+		image("tagged", "sha256:tagged", time.Hour, "app:v1"),
+		image("dangling", "sha256:dangling", time.Hour),
+		image("new", "sha256:new", time.Minute),
+		image("by-id", "sha256:0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef", time.Hour),
+		image("by-digest", "sha256:fedcba9876543210fedcba9876543210fedcba9876543210fedcba9876543210", time.Hour, "app:v2"),
+		image("by-tag", "sha256:by-tag", time.Hour, "app:v3"),
+	}
+	apps := []apiv1.App{
+		{Status: v1.AppInstanceStatus{AppImage: v1.AppImage{Digest: "sha256:running"}}},
+		{Spec: v1.AppInstanceSpec{Stop: z.Pointer(true)}, Status: v1.AppInstanceStatus{AppImage: v1.AppImage{Digest: "sha256:stopped"}}},
+		// Apps whose status isn't populated yet
+		{Spec: v1.AppInstanceSpec{Image: "0123456789ab"}},
+		{Spec: v1.AppInstanceSpec{Image: "app@sha256:fedcba9876543210fedcba9876543210fedcba9876543210fedcba9876543210"}},
+		{Spec: v1.AppInstanceSpec{Image: "app:v3"}},
+	}
+
+	names := func(images []apiv1.Image) (result []string) {
+		for _, image := range images {
+			result = append(result, image.Name)
+		}
+		return result
+	}
+
+	assert.Equal(t, []string{"dangling", "new"}, names(imagesToPrune(images, apps, &ImagePruneOptions{}, now)))
+	assert.Equal(t, []string{"tagged", "dangling", "new"}, names(imagesToPrune(images, apps, &ImagePruneOptions{All: true}, now)))
+	assert.Equal(t, []string{"dangling", "new"}, names(imagesToPrune(images, apps, &ImagePruneOptions{All: true, DanglingOnly: true}, now)))
+	assert.Equal(t, []string{"dangling"}, names(imagesToPrune(images, apps, &ImagePruneOptions{Until: 30 * time.Minute}, now)))
+	assert.Equal(t, []string{"tagged"}, names(imagesToPrune(images, apps, &ImagePruneOptions{All: true, Images: []string{"tagged", "running"}}, now)))
+}
@@ -0,0 +1,162 @@
+package client
+
+import (
+	"context"
+	"time"
+
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/channels"
+	"github.com/sirupsen/logrus"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// logsReconnectMinDelay and logsReconnectMaxDelay bound the backoff between attempts to reconnect a log stream
+	logsReconnectMinDelay = time.Second
+	logsReconnectMaxDelay = 15 * time.Second
+)
+
+type appLogger interface {
+	AppLog(ctx context.Context, name string, opts *LogOptions) (<-chan apiv1.LogMessage, error)
+	ContainerReplicaGet(ctx context.Context, name string) (*apiv1.ContainerReplica, error)
+}
+
+// followAppLogs streams the logs like AppLog does, but when following, a stream that ends before ctx is closed is
+// established again from the time of the last line received. Lines already sent are skipped, and a message with
+// Reconnected set is sent every time the stream was established again. If the container replica the logs are
+// followed of is gone, the logs of the container of the replica are followed instead.
+func followAppLogs(ctx context.Context, c appLogger, name string, opts *LogOptions) (<-chan apiv1.LogMessage, error) {
+	if opts == nil {
+		opts = &LogOptions{}
+	}
+
+	// AppLog sets the container replica of the options it's passed, so every connection gets its own copy
+	connOpts := *opts
+	msgs, err := c.AppLog(ctx, name, &connOpts)
+	if err != nil || !opts.Follow {
+		return msgs, err
+	}
+
+	result := make(chan apiv1.LogMessage)
+	go func() {
+		defer close(result)
+
+		f := logFollower{name: name}
+		for {
+			for msg := range msgs {
+				if !f.isNew(msg) {
+					continue
+				}
+				if err := channels.Send(ctx, result, msg); err != nil {
+					return
+				}
+			}
+
+			if ctx.Err() != nil || untilReached(opts.Until) {
+				return
+			}
+
+			msgs, err = f.reconnect(ctx, c, *opts)
+			if err != nil {
+				if !channels.NilOrCanceled(err) {
+					_ = channels.Send(ctx, result, apiv1.LogMessage{Error: err.Error()})
+				}
+				return
+			}
+			if err := channels.Send(ctx, result, apiv1.LogMessage{
+				AppName:     f.appName,
+				Time:        metav1.Now(),
+				Reconnected: true,
+			}); err != nil {
+				return
+			}
+		}
+	}()
+
+	return result, nil
+}
+
+type logFollower struct {
+	name string
+	// appName and container are those of the last line received, used to follow the container when its replica is gone
+	appName   string
+	container string
+	// last is the time of the last line received. Times only have a precision of seconds, so sent and received count
+	// how often each line of that second was sent and received over the current stream.
+	last     metav1.Time
+	sent     map[apiv1.LogMessage]int
+	received map[apiv1.LogMessage]int
+}
+
+// isNew tells whether the message wasn't sent yet. A reconnected stream starts with the lines of the second the last
+// line was logged in, the ones already sent are skipped.
+func (f *logFollower) isNew(msg apiv1.LogMessage) bool {
+	if msg.Error != "" || msg.Time.IsZero() {
+		return true
+	}
+	if msg.Time.Before(&f.last) {
+		return false
+	}
+	if !msg.Time.Equal(&f.last) {
+		f.last = msg.Time
+		f.sent = map[apiv1.LogMessage]int{}
+		f.received = map[apiv1.LogMessage]int{}
+	}
+	f.received[msg]++
+	if f.received[msg] <= f.sent[msg] {
+		return false
+	}
+	f.sent[msg]++
+	f.appName, f.container = msg.AppName, msg.Container
+	return true
+}
+
+// reconnect establishes the stream again from the time of the last line received, retrying with a backoff until
+// ctx is closed or the app is gone.
+func (f *logFollower) reconnect(ctx context.Context, c appLogger, opts LogOptions) (<-chan apiv1.LogMessage, error) {
+	if !f.last.IsZero() {
+		opts.Since = f.last.UTC().Format(time.RFC3339)
+		opts.Tail = nil
+		f.received = map[apiv1.LogMessage]int{}
+	}
+
+	delay := logsReconnectMinDelay
+	for {
+		if f.name != f.appName && f.appName != "" && f.container != "" {
+			if _, err := c.ContainerReplicaGet(ctx, f.name); apierrors.IsNotFound(err) {
+				// The replica was replaced, follow the new replicas of its container instead
+				logrus.Debugf("Following logs of container %s of app %s, %s is gone", f.container, f.appName, f.name)
+				f.name = f.appName
+				opts.ContainerReplica = ""
+				opts.Container = f.container
+			}
+		}
+
+		connOpts := opts
+		msgs, err := c.AppLog(ctx, f.name, &connOpts)
+		if err == nil {
+			return msgs, nil
+		} else if apierrors.IsNotFound(err) {
+			return nil, err
+		}
+
+		logrus.Debugf("Failed to reconnect to the logs of %s, retrying in %s: %v", f.name, delay, err)
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(delay):
+		}
+		delay = min(2*delay, logsReconnectMaxDelay)
+	}
+}
+
+// untilReached tells whether the stream ended because it reached the end of the --until time window. Durations are
+// before now, so such a window always ended already.
+func untilReached(until string) bool {
+	if until == "" {
+		return false
+	}
+	t, err := time.Parse(time.RFC3339, until)
+	return err != nil || !time.Now().Before(t)
+}
@@ -0,0 +1,132 @@
+package client
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// fakeLogStreams returns one of its streams for every call of AppLog and records the names and options of the calls.
+type fakeLogStreams struct {
+	streams  [][]apiv1.LogMessage
+	replicas map[string]bool
+	names    []string
+	opts     []LogOptions
+}
+
+func (f *fakeLogStreams) AppLog(_ context.Context, name string, opts *LogOptions) (<-chan apiv1.LogMessage, error) {
+	f.names = append(f.names, name)
+	f.opts = append(f.opts, *opts)
+
+	result := make(chan apiv1.LogMessage, 10)
+	defer close(result)
+	if len(f.streams) == 0 {
+		return nil, apierrors.NewNotFound(schema.GroupResource{Resource: "apps"}, name)
+	}
+	for _, msg := range f.streams[0] {
+		result <- msg
+	}
+	f.streams = f.streams[1:]
+	return result, nil
+}
+
+func (f *fakeLogStreams) ContainerReplicaGet(_ context.Context, name string) (*apiv1.ContainerReplica, error) {
+	if !f.replicas[name] {
+		return nil, apierrors.NewNotFound(schema.GroupResource{Resource: "containerreplicas"}, name)
+	}
+	return &apiv1.ContainerReplica{}, nil
+}
+
+func logLine(sec int, replica, line string) apiv1.LogMessage {
+	return apiv1.LogMessage{
+		AppName:       "my-app",
+		ContainerName: replica,
+		Container:     "web",
+		Time:          metav1.NewTime(time.Date(2023, 1, 1, 0, 0, sec, 0, time.UTC)),
+		Line:          line,
+	}
+}
+
+func collectLines(t *testing.T, msgs <-chan apiv1.LogMessage) (result []string) {
+	t.Helper()
+	for msg := range msgs {
+		switch {
+		case msg.Reconnected:
+			result = append(result, "reconnected")
+		case msg.Error != "":
+			result = append(result, "error: "+msg.Error)
+		default:
+			result = append(result, msg.Line)
+		}
+	}
+	return result
+}
+
+func TestFollowAppLogsReconnects(t *testing.T) {
+	tail := int64(10)
+	streams := &fakeLogStreams{
+		streams: [][]apiv1.LogMessage{
+			{logLine(1, "my-app.web-1", "a"), logLine(2, "my-app.web-1", "ping"), logLine(2, "my-app.web-1", "ping")},
+			// The stream is established again from the second of the last line, so the lines of that second are sent again
+			{logLine(2, "my-app.web-1", "ping"), logLine(2, "my-app.web-1", "ping"), logLine(2, "my-app.web-1", "ping"), logLine(3, "my-app.web-1", "b")},
+		},
+	}
+
+	msgs, err := followAppLogs(context.Background(), streams, "my-app", &LogOptions{
+		LogOptions: apiv1.LogOptions{Follow: true, Tail: &tail},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"a", "ping", "ping", "reconnected", "ping", "b", "error: apps \"my-app\" not found"}, collectLines(t, msgs))
+	assert.Equal(t, []string{"my-app", "my-app", "my-app"}, streams.names)
+	assert.Equal(t, "2023-01-01T00:00:02Z", streams.opts[1].Since)
+	assert.Nil(t, streams.opts[1].Tail)
+}
+
+func TestFollowAppLogsReplicaReplaced(t *testing.T) {
+	streams := &fakeLogStreams{
+		streams: [][]apiv1.LogMessage{
+			{logLine(1, "my-app.web-1", "a")},
+			{logLine(2, "my-app.web-2", "b")},
+		},
+	}
+
+	msgs, err := followAppLogs(context.Background(), streams, "my-app.web-1", &LogOptions{
+		LogOptions: apiv1.LogOptions{Follow: true},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"a", "reconnected", "b", "error: apps \"my-app\" not found"}, collectLines(t, msgs))
+	assert.Equal(t, []string{"my-app.web-1", "my-app", "my-app"}, streams.names)
+	assert.Equal(t, "web", streams.opts[1].Container)
+	assert.Equal(t, "", streams.opts[1].ContainerReplica)
+}
+
+func TestFollowAppLogsNotFollowing(t *testing.T) {
+	streams := &fakeLogStreams{
+		streams: [][]apiv1.LogMessage{
+			{logLine(1, "my-app.web-1", "a")},
+			{logLine(2, "my-app.web-1", "b")},
+		},
+	}
+
+	msgs, err := followAppLogs(context.Background(), streams, "my-app", &LogOptions{})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"a"}, collectLines(t, msgs))
+	assert.Len(t, streams.names, 1)
+}
+
+func TestUntilReached(t *testing.T) {
+	assert.False(t, untilReached(""))
+	assert.True(t, untilReached("42m"))
+	assert.True(t, untilReached(time.Now().Add(-time.Minute).Format(time.RFC3339)))
+	assert.False(t, untilReached(time.Now().Add(time.Hour).Format(time.RFC3339)))
+}
@@ -3,6 +3,7 @@ package client
 import (
 	"context"
 	"fmt"
+	"io"
 	"reflect"
 	"sort"
 	"strings"
@@ -67,6 +68,11 @@ func aggregateOptionalNaming[T any, V ObjectPointer[T]](ctx context.Context, app
 	return result, nil
 }
 
+// trimProject returns name without the project prefix, for names of a second resource that is in the same project
+func trimProject(name string) string {
+	return name[strings.LastIndex(name, "/")+1:]
+}
+
 func isNil(obj kclient.Object) bool {
 	return obj == nil || reflect.ValueOf(obj).IsNil()
 }
@@ -149,6 +155,44 @@ func (m *MultiClient) AppStop(ctx context.Context, name string) error {
 	return err
 }
 
+func (m *MultiClient) AppPause(ctx context.Context, name string) error {
+	_, err := onOne(ctx, m.Factory, name, func(name string, c Client) (*apiv1.App, error) {
+		return &apiv1.App{}, c.AppPause(ctx, name)
+	})
+	return err
+}
+
+func (m *MultiClient) AppResume(ctx context.Context, name string) error {
+	_, err := onOne(ctx, m.Factory, name, func(name string, c Client) (*apiv1.App, error) {
+		return &apiv1.App{}, c.AppResume(ctx, name)
+	})
+	return err
+}
+
+func (m *MultiClient) AppRename(ctx context.Context, oldName, newName string) (*apiv1.App, error) {
+	return onOne(ctx, m.Factory, oldName, func(name string, c Client) (*apiv1.App, error) {
+		return c.AppRename(ctx, name, newName)
+	})
+}
+
+func (m *MultiClient) ServiceGrant(ctx context.Context, publisher, consumer string) (*apiv1.App, error) {
+	return onOne(ctx, m.Factory, publisher, func(name string, c Client) (*apiv1.App, error) {
+		return c.ServiceGrant(ctx, name, trimProject(consumer))
+	})
+}
+
+func (m *MultiClient) ServiceRevoke(ctx context.Context, publisher, consumer string) (*apiv1.App, error) {
+	return onOne(ctx, m.Factory, publisher, func(name string, c Client) (*apiv1.App, error) {
+		return c.ServiceRevoke(ctx, name, trimProject(consumer))
+	})
+}
+
+func (m *MultiClient) AppScale(ctx context.Context, name, container string, replicas int32) (*apiv1.App, error) {
+	return onOne(ctx, m.Factory, name, func(name string, c Client) (*apiv1.App, error) {
+		return c.AppScale(ctx, name, container, replicas)
+	})
+}
+
 func (m *MultiClient) AppInfo(ctx context.Context, name string) (string, error) {
 	var (
 		info = ""
@@ -163,6 +207,68 @@ func (m *MultiClient) AppInfo(ctx context.Context, name string) (string, error)
 	return info, err
 }
 
+func (m *MultiClient) AppMetrics(ctx context.Context, name string) (*apiv1.AppMetrics, error) {
+	return onOne(ctx, m.Factory, name, func(name string, c Client) (*apiv1.AppMetrics, error) {
+		return c.AppMetrics(ctx, name)
+	})
+}
+
+func (m *MultiClient) AppDiff(ctx context.Context, name string, newSpec *v1.AppInstanceSpec) (*AppDiff, error) {
+	var (
+		diff *AppDiff
+		err  error
+	)
+
+	_, err = onOne(ctx, m.Factory, name, func(name string, c Client) (*apiv1.App, error) {
+		diff, err = c.AppDiff(ctx, name, newSpec)
+		return &apiv1.App{}, err
+	})
+	if diff != nil {
+		diff.Name = name
+	}
+
+	return diff, err
+}
+
+func (m *MultiClient) AppExport(ctx context.Context, name string, opts *AppExportOptions) ([]byte, error) {
+	var (
+		bundle []byte
+		err    error
+	)
+
+	_, err = onOne(ctx, m.Factory, name, func(name string, c Client) (*apiv1.App, error) {
+		bundle, err = c.AppExport(ctx, name, opts)
+		return &apiv1.App{}, err
+	})
+
+	return bundle, err
+}
+
+func (m *MultiClient) AppContainerEnv(ctx context.Context, name, container string, opts *AppContainerEnvOptions) ([]AppContainerEnvVar, error) {
+	var (
+		env []AppContainerEnvVar
+		err error
+	)
+
+	_, err = onOne(ctx, m.Factory, name, func(name string, c Client) (*apiv1.App, error) {
+		env, err = c.AppContainerEnv(ctx, name, container, opts)
+		return &apiv1.App{}, err
+	})
+
+	return env, err
+}
+
+func (m *MultiClient) AppImport(ctx context.Context, bundle []byte, opts *AppImportOptions) (*apiv1.App, error) {
+	name := ""
+	if opts != nil {
+		name = opts.Name
+	}
+	return onOne(ctx, m.Factory, name, func(name string, c Client) (*apiv1.App, error) {
+		o := AppImportOptions{Name: name}
+		return c.AppImport(ctx, bundle, &o)
+	})
+}
+
 func (m *MultiClient) AppStart(ctx context.Context, name string) error {
 	_, err := onOne(ctx, m.Factory, name, func(name string, c Client) (*apiv1.App, error) {
 		return &apiv1.App{}, c.AppStart(ctx, name)
@@ -212,6 +318,10 @@ func (m *MultiClient) AppLog(ctx context.Context, name string, opts *LogOptions)
 	return result, nil
 }
 
+func (m *MultiClient) AppLogsFollow(ctx context.Context, name string, opts *LogOptions) (<-chan apiv1.LogMessage, error) {
+	return followAppLogs(ctx, m, name, opts)
+}
+
 func (m *MultiClient) AppConfirmUpgrade(ctx context.Context, name string) error {
 	_, err := onOne(ctx, m.Factory, name, func(name string, c Client) (*apiv1.App, error) {
 		return &apiv1.App{}, c.AppConfirmUpgrade(ctx, name)
@@ -233,6 +343,43 @@ func (m *MultiClient) AppIgnoreDeleteCleanup(ctx context.Context, name string) e
 	return err
 }
 
+func (m *MultiClient) AppRestart(ctx context.Context, name string, opts *AppRestartOptions) error {
+	_, err := onOne(ctx, m.Factory, name, func(name string, c Client) (*apiv1.App, error) {
+		return &apiv1.App{}, c.AppRestart(ctx, name, opts)
+	})
+	return err
+}
+
+func (m *MultiClient) AppStatusStream(ctx context.Context, name string) (<-chan apiv1.App, error) {
+	var (
+		apps    <-chan apiv1.App
+		project string
+		err     error
+	)
+	_, err = onOne(ctx, m.Factory, name, func(name string, c Client) (*apiv1.App, error) {
+		project = c.GetProject()
+		apps, err = c.AppStatusStream(ctx, name)
+		return &apiv1.App{}, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if project == m.Factory.DefaultProject() {
+		return apps, nil
+	}
+	result := make(chan apiv1.App)
+	go func() {
+		defer close(result)
+		for app := range apps {
+			app.Name = project + "/" + app.Name
+			if err := channels.Send(ctx, result, app); err != nil {
+				return
+			}
+		}
+	}()
+	return result, nil
+}
+
 func (m *MultiClient) DevSessionRenew(ctx context.Context, name string, client v1.DevSessionInstanceClient) error {
 	_, err := onOne(ctx, m.Factory, name, func(name string, c Client) (*apiv1.App, error) {
 		return &apiv1.App{}, c.DevSessionRenew(ctx, name, client)
@@ -313,6 +460,51 @@ func (m *MultiClient) SecretDelete(ctx context.Context, name string) (*apiv1.Sec
 	})
 }
 
+func (m *MultiClient) SecretRotate(ctx context.Context, name string) (*apiv1.SecretRotate, error) {
+	return onOne(ctx, m.Factory, name, func(name string, c Client) (*apiv1.SecretRotate, error) {
+		return c.SecretRotate(ctx, name)
+	})
+}
+
+func (m *MultiClient) SecretRevealAll(ctx context.Context) (*SecretRevealAllResult, error) {
+	c, err := m.Factory.ForProject(ctx, m.Factory.DefaultProject())
+	if err != nil {
+		return nil, err
+	}
+	return c.SecretRevealAll(ctx)
+}
+
+func (m *MultiClient) SecretCopy(ctx context.Context, name string, opts *SecretCopyOptions) (*apiv1.Secret, error) {
+	if opts == nil {
+		opts = &SecretCopyOptions{}
+	}
+
+	projectName := ""
+	if i := strings.LastIndex(name, "/"); i != -1 {
+		projectName, name = name[:i], name[i+1:]
+	}
+	src, err := m.Factory.ForProject(ctx, projectName)
+	if err != nil {
+		return nil, err
+	}
+
+	dst := src
+	if opts.TargetProject != "" {
+		if dst, err = m.Factory.ForProject(ctx, opts.TargetProject); err != nil {
+			return nil, err
+		}
+	}
+
+	result, err := copySecret(ctx, src, dst, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	if dst.GetProject() != m.Factory.DefaultProject() {
+		result.SetName(dst.GetProject() + "/" + result.GetName())
+	}
+	return result, nil
+}
+
 func (m *MultiClient) ContainerReplicaList(ctx context.Context, opts *ContainerReplicaListOptions) ([]apiv1.ContainerReplica, error) {
 	if opts != nil && opts.App != "" {
 		return onOneList(ctx, m.Factory, opts.App, func(name string, c Client) ([]apiv1.ContainerReplica, error) {
@@ -396,6 +588,42 @@ func (m *MultiClient) VolumeDelete(ctx context.Context, name string) (*apiv1.Vol
 	})
 }
 
+func (m *MultiClient) VolumeResize(ctx context.Context, name, newSize string) (*apiv1.Volume, error) {
+	return onOne(ctx, m.Factory, name, func(name string, c Client) (*apiv1.Volume, error) {
+		return c.VolumeResize(ctx, name, newSize)
+	})
+}
+
+func (m *MultiClient) VolumeSnapshotCreate(ctx context.Context, volumeName, name string) (*apiv1.VolumeSnapshot, error) {
+	return onOne(ctx, m.Factory, volumeName, func(volumeName string, c Client) (*apiv1.VolumeSnapshot, error) {
+		return c.VolumeSnapshotCreate(ctx, volumeName, trimProject(name))
+	})
+}
+
+func (m *MultiClient) VolumeSnapshotList(ctx context.Context) ([]apiv1.VolumeSnapshot, error) {
+	return aggregate(ctx, m.Factory, func(c Client) ([]apiv1.VolumeSnapshot, error) {
+		return c.VolumeSnapshotList(ctx)
+	})
+}
+
+func (m *MultiClient) VolumeSnapshotGet(ctx context.Context, name string) (*apiv1.VolumeSnapshot, error) {
+	return onOne(ctx, m.Factory, name, func(name string, c Client) (*apiv1.VolumeSnapshot, error) {
+		return c.VolumeSnapshotGet(ctx, name)
+	})
+}
+
+func (m *MultiClient) VolumeSnapshotDelete(ctx context.Context, name string) (*apiv1.VolumeSnapshot, error) {
+	return onOne(ctx, m.Factory, name, func(name string, c Client) (*apiv1.VolumeSnapshot, error) {
+		return c.VolumeSnapshotDelete(ctx, name)
+	})
+}
+
+func (m *MultiClient) VolumeSnapshotRestore(ctx context.Context, volumeName, snapshotName string) (*apiv1.Volume, error) {
+	return onOne(ctx, m.Factory, volumeName, func(volumeName string, c Client) (*apiv1.Volume, error) {
+		return c.VolumeSnapshotRestore(ctx, volumeName, trimProject(snapshotName))
+	})
+}
+
 func (m *MultiClient) ImageList(ctx context.Context) ([]apiv1.Image, error) {
 	c, err := m.Factory.ForProject(ctx, m.Factory.DefaultProject())
 	if err != nil {
@@ -404,6 +632,22 @@ func (m *MultiClient) ImageList(ctx context.Context) ([]apiv1.Image, error) {
 	return c.ImageList(ctx)
 }
 
+func (m *MultiClient) ImagePrune(ctx context.Context, opts *ImagePruneOptions) ([]apiv1.Image, error) {
+	c, err := m.Factory.ForProject(ctx, m.Factory.DefaultProject())
+	if err != nil {
+		return nil, err
+	}
+	return c.ImagePrune(ctx, opts)
+}
+
+func (m *MultiClient) ImageIndexCreate(ctx context.Context, tag string, entries []ImageIndexEntry, opts *ImageIndexCreateOptions) (string, error) {
+	c, err := m.Factory.ForProject(ctx, m.Factory.DefaultProject())
+	if err != nil {
+		return "", err
+	}
+	return c.ImageIndexCreate(ctx, tag, entries, opts)
+}
+
 func (m *MultiClient) ImageGet(ctx context.Context, name string) (*apiv1.Image, error) {
 	c, err := m.Factory.ForProject(ctx, m.Factory.DefaultProject())
 	if err != nil {
@@ -436,12 +680,12 @@ func (m *MultiClient) ImagePull(ctx context.Context, name string, opts *ImagePul
 	return c.ImagePull(ctx, name, opts)
 }
 
-func (m *MultiClient) ImageTag(ctx context.Context, image, tag string) error {
+func (m *MultiClient) ImageTag(ctx context.Context, image, tag string, opts *ImageTagOptions) error {
 	c, err := m.Factory.ForProject(ctx, m.Factory.DefaultProject())
 	if err != nil {
 		return err
 	}
-	return c.ImageTag(ctx, image, tag)
+	return c.ImageTag(ctx, image, tag, opts)
 }
 
 func (m *MultiClient) ImageDetails(ctx context.Context, imageName string, opts *ImageDetailsOptions) (result *ImageDetails, err error) {
@@ -452,6 +696,38 @@ func (m *MultiClient) ImageDetails(ctx context.Context, imageName string, opts *
 	return c.ImageDetails(ctx, imageName, opts)
 }
 
+func (m *MultiClient) CredentialValidate(ctx context.Context, serverAddress string, opts *CredentialValidateOptions) (*CredentialValidation, error) {
+	c, err := m.Factory.ForProject(ctx, m.Factory.DefaultProject())
+	if err != nil {
+		return nil, err
+	}
+	return c.CredentialValidate(ctx, serverAddress, opts)
+}
+
+func (m *MultiClient) ImageScan(ctx context.Context, imageName string, opts *ImageScanOptions) (*ImageScanResult, error) {
+	c, err := m.Factory.ForProject(ctx, m.Factory.DefaultProject())
+	if err != nil {
+		return nil, err
+	}
+	return c.ImageScan(ctx, imageName, opts)
+}
+
+func (m *MultiClient) ImageExtractFile(ctx context.Context, imageName, filePath string, out io.Writer, opts *ImageExtractOptions) error {
+	c, err := m.Factory.ForProject(ctx, m.Factory.DefaultProject())
+	if err != nil {
+		return err
+	}
+	return c.ImageExtractFile(ctx, imageName, filePath, out, opts)
+}
+
+func (m *MultiClient) ImageHistory(ctx context.Context, imageName string, opts *ImageHistoryOptions) (*ImageHistory, error) {
+	c, err := m.Factory.ForProject(ctx, m.Factory.DefaultProject())
+	if err != nil {
+		return nil, err
+	}
+	return c.ImageHistory(ctx, imageName, opts)
+}
+
 func (m *MultiClient) ImageSign(ctx context.Context, image string, payload []byte, signatureB64 string, opts *ImageSignOptions) (*apiv1.ImageSignature, error) {
 	c, err := m.Factory.ForProject(ctx, m.Factory.DefaultProject())
 	if err != nil {
@@ -468,6 +744,22 @@ func (m *MultiClient) ImageVerify(ctx context.Context, image string, opts *Image
 	return c.ImageVerify(ctx, image, opts)
 }
 
+func (m *MultiClient) ImageSignatures(ctx context.Context, image string, opts *ImageSignaturesOptions) ([]apiv1.ImageSignatureEntry, error) {
+	c, err := m.Factory.ForProject(ctx, m.Factory.DefaultProject())
+	if err != nil {
+		return nil, err
+	}
+	return c.ImageSignatures(ctx, image, opts)
+}
+
+func (m *MultiClient) ImageUnsign(ctx context.Context, image string, signatureDigest string, opts *ImageUnsignOptions) error {
+	c, err := m.Factory.ForProject(ctx, m.Factory.DefaultProject())
+	if err != nil {
+		return err
+	}
+	return c.ImageUnsign(ctx, image, signatureDigest, opts)
+}
+
 func (m *MultiClient) AcornImageBuildGet(ctx context.Context, name string) (*apiv1.AcornImageBuild, error) {
 	c, err := m.Factory.ForProject(ctx, m.Factory.DefaultProject())
 	if err != nil {
@@ -526,12 +818,12 @@ func (m *MultiClient) ProjectDelete(ctx context.Context, name string) (*apiv1.Pr
 	return c.ProjectDelete(ctx, name)
 }
 
-func (m *MultiClient) ProjectCreate(ctx context.Context, name, defaultRegion string, supportedRegions []string) (*apiv1.Project, error) {
+func (m *MultiClient) ProjectCreate(ctx context.Context, name string, opts *ProjectCreateOptions) (*apiv1.Project, error) {
 	c, err := m.Factory.ForProject(ctx, m.Factory.DefaultProject())
 	if err != nil {
 		return nil, err
 	}
-	return c.ProjectCreate(ctx, name, defaultRegion, supportedRegions)
+	return c.ProjectCreate(ctx, name, opts)
 }
 
 func (m *MultiClient) ProjectUpdate(ctx context.Context, project *apiv1.Project, defaultRegion string, supportedRegions []string) (*apiv1.Project, error) {
@@ -603,11 +895,13 @@ func (m *MultiClient) EventStream(ctx context.Context, opts *EventStreamOptions)
 			return nil, err
 		}
 
-		var (
-			list       apiv1.EventList
-			clientOpts = *opts
-			listOpts   = clientOpts.ListOptions()
-		)
+		clientOpts := *opts
+		listOpts, err := clientOpts.ListOptions()
+		if err != nil {
+			return nil, err
+		}
+
+		var list apiv1.EventList
 		listOpts.Namespace = client.GetNamespace()
 
 		if opts.ResourceVersion == "" {
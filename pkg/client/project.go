@@ -2,10 +2,12 @@ package client
 
 import (
 	"context"
+	"fmt"
 	"sort"
 
 	"github.com/acorn-io/baaah/pkg/router"
 	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/strings/slices"
@@ -29,16 +31,33 @@ func (c *DefaultClient) ProjectList(ctx context.Context) ([]apiv1.Project, error
 	return result.Items, nil
 }
 
-func (c *DefaultClient) ProjectCreate(ctx context.Context, name, defaultRegion string, supportedRegions []string) (*apiv1.Project, error) {
+func (c *DefaultClient) ProjectCreate(ctx context.Context, name string, opts *ProjectCreateOptions) (*apiv1.Project, error) {
+	if opts == nil {
+		opts = &ProjectCreateOptions{}
+	}
+
 	project := &apiv1.Project{
 		ObjectMeta: metav1.ObjectMeta{
 			Name: name,
 		},
+		Spec: v1.ProjectInstanceSpec{
+			DefaultComputeClass: opts.DefaultComputeClass,
+			DefaultVolumeClass:  opts.DefaultVolumeClass,
+		},
 	}
-	if defaultRegion != "" {
-		project.Spec.DefaultRegion = defaultRegion
-		if !slices.Contains(supportedRegions, defaultRegion) && !slices.Contains(supportedRegions, apiv1.AllRegions) {
-			supportedRegions = append([]string{defaultRegion}, supportedRegions...)
+
+	supportedRegions := opts.SupportedRegions
+	if opts.DefaultRegion != "" {
+		// The server accepts regions it doesn't know about, so make sure the region exists before using it as the default.
+		if _, err := c.RegionGet(ctx, opts.DefaultRegion); apierrors.IsNotFound(err) {
+			return nil, fmt.Errorf("region %s does not exist", opts.DefaultRegion)
+		} else if err != nil {
+			return nil, err
+		}
+
+		project.Spec.DefaultRegion = opts.DefaultRegion
+		if !slices.Contains(supportedRegions, opts.DefaultRegion) && !slices.Contains(supportedRegions, apiv1.AllRegions) {
+			supportedRegions = append([]string{opts.DefaultRegion}, supportedRegions...)
 		}
 	}
 	project.Spec.SupportedRegions = supportedRegions
@@ -0,0 +1,94 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/google/go-containerregistry/pkg/v1/remote/transport"
+	"github.com/sirupsen/logrus"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/util/retry"
+)
+
+// transientRegistryErrors are the parts of error messages which the api-server passes through from failed registry
+// requests that are worth retrying
+var transientRegistryErrors = []string{
+	"TOOMANYREQUESTS",
+	"429 Too Many Requests",
+	"502 Bad Gateway",
+	"503 Service Unavailable",
+	"504 Gateway Timeout",
+	"connection reset by peer",
+	"TLS handshake timeout",
+	"i/o timeout",
+	"unexpected EOF",
+}
+
+// IsRetryable returns true if err is likely to be transient, like registry rate limits, server errors or network
+// errors. Errors like failed authentication are not retryable.
+func IsRetryable(err error) bool {
+	if err == nil || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+
+	var terr *transport.Error
+	if errors.As(err, &terr) {
+		return terr.StatusCode == http.StatusTooManyRequests || terr.StatusCode >= http.StatusInternalServerError
+	}
+
+	var statusErr *apierrors.StatusError
+	if errors.As(err, &statusErr) {
+		switch {
+		case apierrors.IsTooManyRequests(err), apierrors.IsServerTimeout(err), apierrors.IsTimeout(err), apierrors.IsServiceUnavailable(err):
+			return true
+		case apierrors.IsInternalError(err):
+			// registry errors end up as internal errors of the api-server
+			return isTransientRegistryError(statusErr.Status().Message)
+		}
+		return statusErr.Status().Code == http.StatusBadGateway || statusErr.Status().Code == http.StatusGatewayTimeout
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) || errors.Is(err, syscall.ECONNRESET) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return true
+	}
+
+	return isTransientRegistryError(err.Error())
+}
+
+func isTransientRegistryError(msg string) bool {
+	for _, s := range transientRegistryErrors {
+		if strings.Contains(msg, s) {
+			return true
+		}
+	}
+	return false
+}
+
+// WithRetry calls fn and retries it up to retries times with exponential backoff starting at delay, as long as the
+// returned error is retryable (see IsRetryable).
+func WithRetry(ctx context.Context, retries int, delay time.Duration, fn func() error) error {
+	attempt := 0
+	return retry.OnError(wait.Backoff{
+		Steps:    retries + 1,
+		Duration: delay,
+		Factor:   2,
+		Jitter:   0.1,
+	}, func(err error) bool {
+		if ctx.Err() != nil || !IsRetryable(err) {
+			return false
+		}
+		logrus.Debugf("Attempt %d of %d failed with retryable error, retrying: %v", attempt, retries+1, err)
+		return true
+	}, func() error {
+		attempt++
+		return fn()
+	})
+}
@@ -0,0 +1,72 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-containerregistry/pkg/v1/remote/transport"
+	"github.com/stretchr/testify/assert"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func TestIsRetryable(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{name: "nil", err: nil, want: false},
+		{name: "context canceled", err: context.Canceled, want: false},
+		{name: "registry rate limit", err: &transport.Error{StatusCode: http.StatusTooManyRequests}, want: true},
+		{name: "registry server error", err: &transport.Error{StatusCode: http.StatusBadGateway}, want: true},
+		{name: "registry unauthorized", err: &transport.Error{StatusCode: http.StatusUnauthorized}, want: false},
+		{name: "api-server too many requests", err: apierrors.NewTooManyRequests("slow down", 1), want: true},
+		{name: "api-server unavailable", err: apierrors.NewServiceUnavailable("unavailable"), want: true},
+		{name: "internal error from registry rate limit", err: apierrors.NewInternalError(errors.New("GET https://index.docker.io/v2/: TOOMANYREQUESTS: rate limit exceeded")), want: true},
+		{name: "internal error from registry auth", err: apierrors.NewInternalError(errors.New("GET https://index.docker.io/v2/: UNAUTHORIZED: authentication required")), want: false},
+		{name: "not found", err: apierrors.NewNotFound(schema.GroupResource{Resource: "images"}, "foo"), want: false},
+		{name: "connection reset", err: fmt.Errorf("read tcp: %w", errors.New("connection reset by peer")), want: true},
+		{name: "other error", err: errors.New("invalid reference"), want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, IsRetryable(tt.err))
+		})
+	}
+}
+
+func TestWithRetry(t *testing.T) {
+	retryable := &transport.Error{StatusCode: http.StatusServiceUnavailable}
+
+	attempts := 0
+	err := WithRetry(context.Background(), 3, 0, func() error {
+		attempts++
+		if attempts < 3 {
+			return retryable
+		}
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 3, attempts)
+
+	attempts = 0
+	err = WithRetry(context.Background(), 2, 0, func() error {
+		attempts++
+		return retryable
+	})
+	assert.ErrorIs(t, err, retryable)
+	assert.Equal(t, 3, attempts)
+
+	attempts = 0
+	err = WithRetry(context.Background(), 5, 0, func() error {
+		attempts++
+		return apierrors.NewUnauthorized("unauthorized")
+	})
+	assert.True(t, apierrors.IsUnauthorized(err))
+	assert.Equal(t, 1, attempts, "non-retryable errors should fail fast")
+}
@@ -2,6 +2,7 @@ package client
 
 import (
 	"context"
+	"fmt"
 	"sort"
 	"strings"
 
@@ -46,6 +47,21 @@ func (c *DefaultClient) SecretReveal(ctx context.Context, name string) (*apiv1.S
 	return result, err
 }
 
+// SecretRotate regenerates the generated keys of a token or basic secret that an app generated and returns the
+// regenerated keys and the apps that use the secret. The containers of these apps roll out the new value, containers
+// that use the secret with onChange noAction are restarted for it.
+func (c *DefaultClient) SecretRotate(ctx context.Context, name string) (*apiv1.SecretRotate, error) {
+	result := &apiv1.SecretRotate{}
+	err := c.RESTClient.Post().
+		Namespace(c.Namespace).
+		Resource("secrets").
+		Name(name).
+		SubResource("rotate").
+		Body(&apiv1.SecretRotate{}).
+		Do(ctx).Into(result)
+	return result, err
+}
+
 func (c *DefaultClient) SecretUpdate(ctx context.Context, name string, data map[string][]byte) (*apiv1.Secret, error) {
 	secret := &apiv1.Secret{}
 	err := c.Client.Get(ctx, kclient.ObjectKey{
@@ -98,3 +114,104 @@ func (c *DefaultClient) SecretDelete(ctx context.Context, serverAddress string)
 	}
 	return secret, err
 }
+
+// SecretCopy copies the type and data of the secret to the target project and name of opts. The secret must be
+// revealable, secrets whose data never leaves the server can't be copied.
+func (c *DefaultClient) SecretCopy(ctx context.Context, name string, opts *SecretCopyOptions) (*apiv1.Secret, error) {
+	if opts == nil {
+		opts = &SecretCopyOptions{}
+	}
+
+	var dst Client = c
+	if opts.TargetProject != "" && opts.TargetProject != c.Project {
+		dst = &DefaultClient{
+			Project:    opts.TargetProject,
+			Namespace:  opts.TargetProject,
+			Client:     c.Client,
+			RESTConfig: c.RESTConfig,
+			RESTClient: c.RESTClient,
+			Dialer:     c.Dialer,
+		}
+	}
+
+	return copySecret(ctx, c, dst, name, opts)
+}
+
+// SecretRevealAll reveals every secret of the project. Secrets the caller isn't allowed to reveal and secrets whose data
+// never leaves the server are skipped rather than failing the whole reveal.
+func (c *DefaultClient) SecretRevealAll(ctx context.Context) (*SecretRevealAllResult, error) {
+	return revealAllSecrets(ctx, c)
+}
+
+func revealAllSecrets(ctx context.Context, c Client) (*SecretRevealAllResult, error) {
+	secrets, err := c.SecretList(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(secrets, func(i, j int) bool {
+		return secrets[i].Name < secrets[j].Name
+	})
+
+	result := &SecretRevealAllResult{}
+	for _, listed := range secrets {
+		secret, err := c.SecretReveal(ctx, listed.Name)
+		if apierrors.IsForbidden(err) {
+			result.Skipped = append(result.Skipped, SkippedSecret{Name: listed.Name, Reason: "not authorized to reveal its data"})
+			continue
+		} else if apierrors.IsNotFound(err) {
+			// deleted since it was listed
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+		if len(secret.Data) == 0 && len(secret.Keys) > 0 {
+			result.Skipped = append(result.Skipped, SkippedSecret{Name: listed.Name, Reason: "its data is only available on the server"})
+			continue
+		}
+		result.Secrets = append(result.Secrets, *secret)
+	}
+
+	return result, nil
+}
+
+func copySecret(ctx context.Context, src, dst Client, name string, opts *SecretCopyOptions) (*apiv1.Secret, error) {
+	targetName := opts.TargetName
+	if targetName == "" {
+		targetName = name
+	}
+	if targetName == name && src.GetProject() == dst.GetProject() {
+		return nil, fmt.Errorf("secret %s can not be copied onto itself, specify a new name or another project", name)
+	}
+
+	secret, err := src.SecretReveal(ctx, name)
+	if apierrors.IsForbidden(err) {
+		return nil, fmt.Errorf("secret %s can not be copied, its data can not be revealed: %w", name, err)
+	} else if err != nil {
+		return nil, err
+	}
+	if len(secret.Data) == 0 && len(secret.Keys) > 0 {
+		return nil, fmt.Errorf("secret %s can not be copied, its data is only available on the server", name)
+	}
+
+	copied, err := dst.SecretCreate(ctx, targetName, secret.Type, secret.Data)
+	if !apierrors.IsAlreadyExists(err) {
+		return copied, err
+	} else if !opts.Overwrite {
+		return nil, fmt.Errorf("secret %s already exists in project %s", targetName, dst.GetProject())
+	}
+
+	existing, err := dst.SecretGet(ctx, targetName)
+	if err != nil {
+		return nil, err
+	}
+	if existing.Type == secret.Type {
+		return dst.SecretUpdate(ctx, targetName, secret.Data)
+	}
+
+	// The type of a secret can't be changed, so replace the existing secret
+	if _, err := dst.SecretDelete(ctx, targetName); err != nil {
+		return nil, err
+	}
+	return dst.SecretCreate(ctx, targetName, secret.Type, secret.Data)
+}
@@ -0,0 +1,171 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// secretProject is a Client for a single project that only supports the secret operations used to copy secrets.
+type secretProject struct {
+	Client
+	project string
+	secrets map[string]apiv1.Secret
+}
+
+func (s *secretProject) GetProject() string {
+	return s.project
+}
+
+func (s *secretProject) SecretReveal(_ context.Context, name string) (*apiv1.Secret, error) {
+	secret, ok := s.secrets[name]
+	if !ok {
+		return nil, apierrors.NewNotFound(schema.GroupResource{Resource: "secrets"}, name)
+	}
+	return &secret, nil
+}
+
+func (s *secretProject) SecretGet(ctx context.Context, name string) (*apiv1.Secret, error) {
+	secret, err := s.SecretReveal(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	secret.Data = nil
+	return secret, nil
+}
+
+func (s *secretProject) SecretCreate(_ context.Context, name, secretType string, data map[string][]byte) (*apiv1.Secret, error) {
+	if _, ok := s.secrets[name]; ok {
+		return nil, apierrors.NewAlreadyExists(schema.GroupResource{Resource: "secrets"}, name)
+	}
+	secret := apiv1.Secret{ObjectMeta: metav1.ObjectMeta{Name: name}, Type: secretType, Data: data}
+	s.secrets[name] = secret
+	return &secret, nil
+}
+
+func (s *secretProject) SecretUpdate(_ context.Context, name string, data map[string][]byte) (*apiv1.Secret, error) {
+	secret := s.secrets[name]
+	secret.Data = data
+	s.secrets[name] = secret
+	return &secret, nil
+}
+
+func (s *secretProject) SecretDelete(_ context.Context, name string) (*apiv1.Secret, error) {
+	secret := s.secrets[name]
+	delete(s.secrets, name)
+	return &secret, nil
+}
+
+func (s *secretProject) SecretList(_ context.Context) (result []apiv1.Secret, _ error) {
+	for _, secret := range s.secrets {
+		secret.Data = nil
+		result = append(result, secret)
+	}
+	return result, nil
+}
+
+func TestCopySecret(t *testing.T) {
+	ctx := context.Background()
+	src := &secretProject{project: "src", secrets: map[string]apiv1.Secret{
+		"creds": {
+			ObjectMeta: metav1.ObjectMeta{Name: "creds"},
+			Type:       "generated",
+			Data:       map[string][]byte{"token": []byte("secret")},
+			Keys:       []string{"token"},
+		},
+		"sealed": {
+			ObjectMeta: metav1.ObjectMeta{Name: "sealed"},
+			Type:       "opaque",
+			Keys:       []string{"key"},
+		},
+	}}
+	dst := &secretProject{project: "dst", secrets: map[string]apiv1.Secret{
+		"existing": {
+			ObjectMeta: metav1.ObjectMeta{Name: "existing"},
+			Type:       "opaque",
+			Data:       map[string][]byte{"old": []byte("value")},
+		},
+	}}
+
+	copied, err := copySecret(ctx, src, dst, "creds", &SecretCopyOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, "creds", copied.Name)
+	assert.Equal(t, "generated", dst.secrets["creds"].Type)
+	assert.Equal(t, map[string][]byte{"token": []byte("secret")}, dst.secrets["creds"].Data)
+
+	_, err = copySecret(ctx, src, src, "creds", &SecretCopyOptions{})
+	assert.EqualError(t, err, "secret creds can not be copied onto itself, specify a new name or another project")
+
+	_, err = copySecret(ctx, src, dst, "sealed", &SecretCopyOptions{})
+	assert.EqualError(t, err, "secret sealed can not be copied, its data is only available on the server")
+
+	_, err = copySecret(ctx, src, dst, "creds", &SecretCopyOptions{TargetName: "existing"})
+	assert.EqualError(t, err, "secret existing already exists in project dst")
+
+	// Overwriting a secret of another type replaces it, the type of a secret can't be updated
+	_, err = copySecret(ctx, src, dst, "creds", &SecretCopyOptions{TargetName: "existing", Overwrite: true})
+	require.NoError(t, err)
+	assert.Equal(t, "generated", dst.secrets["existing"].Type)
+	assert.Equal(t, map[string][]byte{"token": []byte("secret")}, dst.secrets["existing"].Data)
+}
+
+// forbiddenSecrets is a secretProject that doesn't allow to reveal some of its secrets
+type forbiddenSecrets struct {
+	*secretProject
+	forbidden string
+}
+
+func (f *forbiddenSecrets) SecretReveal(ctx context.Context, name string) (*apiv1.Secret, error) {
+	if name == f.forbidden {
+		return nil, apierrors.NewForbidden(schema.GroupResource{Resource: "secrets"}, name, nil)
+	}
+	return f.secretProject.SecretReveal(ctx, name)
+}
+
+func TestRevealAllSecrets(t *testing.T) {
+	project := &forbiddenSecrets{
+		forbidden: "private",
+		secretProject: &secretProject{project: "src", secrets: map[string]apiv1.Secret{
+			"creds": {
+				ObjectMeta: metav1.ObjectMeta{Name: "creds"},
+				Type:       "generated",
+				Data:       map[string][]byte{"token": []byte("secret")},
+				Keys:       []string{"token"},
+			},
+			"empty": {
+				ObjectMeta: metav1.ObjectMeta{Name: "empty"},
+				Type:       "opaque",
+			},
+			"private": {
+				ObjectMeta: metav1.ObjectMeta{Name: "private"},
+				Type:       "opaque",
+				Data:       map[string][]byte{"key": []byte("value")},
+			},
+			"sealed": {
+				ObjectMeta: metav1.ObjectMeta{Name: "sealed"},
+				Type:       "opaque",
+				Keys:       []string{"key"},
+			},
+		}},
+	}
+
+	result, err := revealAllSecrets(context.Background(), project)
+	require.NoError(t, err)
+
+	names := make([]string, 0, len(result.Secrets))
+	for _, secret := range result.Secrets {
+		names = append(names, secret.Name)
+	}
+	assert.Equal(t, []string{"creds", "empty"}, names)
+	assert.Equal(t, map[string][]byte{"token": []byte("secret")}, result.Secrets[0].Data)
+	assert.Equal(t, []SkippedSecret{
+		{Name: "private", Reason: "not authorized to reveal its data"},
+		{Name: "sealed", Reason: "its data is only available on the server"},
+	}, result.Skipped)
+}
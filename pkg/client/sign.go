@@ -9,10 +9,15 @@ import (
 
 func (c *DefaultClient) ImageSign(ctx context.Context, image string, payload []byte, signatureB64 string, opts *ImageSignOptions) (*apiv1.ImageSignature, error) {
 	sigInput := &apiv1.ImageSignature{
-		Payload:      payload,
-		SignatureB64: signatureB64,
-		PublicKey:    opts.PublicKey,
-		Auth:         opts.Auth,
+		Payload:          payload,
+		SignatureB64:     signatureB64,
+		PublicKey:        opts.PublicKey,
+		Auth:             opts.Auth,
+		Certificate:      opts.Certificate,
+		CertificateChain: opts.CertificateChain,
+		RekorBundle:      opts.RekorBundle,
+		Force:            opts.Force,
+		Attestation:      opts.Attestation,
 	}
 
 	imageDetails, err := c.ImageDetails(ctx, image, &ImageDetailsOptions{Auth: opts.Auth})
@@ -0,0 +1,45 @@
+package client
+
+import (
+	"context"
+	"strings"
+
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+)
+
+func (c *DefaultClient) ImageSignatures(ctx context.Context, image string, opts *ImageSignaturesOptions) ([]apiv1.ImageSignatureEntry, error) {
+	sigsInput := &apiv1.ImageSignatures{}
+	if opts != nil {
+		sigsInput.PublicKey = opts.PublicKey
+		sigsInput.Auth = opts.Auth
+	}
+
+	sigsResult := &apiv1.ImageSignatures{}
+	err := c.RESTClient.Post().
+		Namespace(c.Namespace).
+		Resource("images").
+		Name(strings.ReplaceAll(image, "/", "+")).
+		SubResource("signatures").
+		Body(sigsInput).Do(ctx).Into(sigsResult)
+	if err != nil {
+		return nil, err
+	}
+
+	return sigsResult.Signatures, nil
+}
+
+func (c *DefaultClient) ImageUnsign(ctx context.Context, image string, signatureDigest string, opts *ImageUnsignOptions) error {
+	unsignInput := &apiv1.ImageUnsign{
+		SignatureDigest: signatureDigest,
+	}
+	if opts != nil {
+		unsignInput.Auth = opts.Auth
+	}
+
+	return c.RESTClient.Post().
+		Namespace(c.Namespace).
+		Resource("images").
+		Name(strings.ReplaceAll(image, "/", "+")).
+		SubResource("unsign").
+		Body(unsignInput).Do(ctx).Error()
+}
@@ -18,6 +18,11 @@ type ExecIO struct {
 	ExitCode <-chan ExitCode
 }
 
+// closeWriter is implemented by stdin streams that can signal EOF to the process without closing the connection
+type closeWriter interface {
+	CloseWrite() error
+}
+
 type Size struct {
 	Height uint16
 	Width  uint16
@@ -27,6 +32,18 @@ func IsTerminal(in io.Reader) bool {
 	return term.IsTerminal(in)
 }
 
+// GetSize returns the size of the terminal out is attached to, or nil if it can't be detected
+func GetSize(out io.Writer) *Size {
+	size := (&term.TTY{Out: out}).GetSize()
+	if size == nil {
+		return nil
+	}
+	return &Size{
+		Height: size.Height,
+		Width:  size.Width,
+	}
+}
+
 func Pipe(execIO *ExecIO, streams *streams.Streams) (int, error) {
 	if execIO.TTY {
 		t := &term.TTY{
@@ -63,13 +80,17 @@ func copyIO(cIO *ExecIO, streams *streams.Streams) <-chan struct{} {
 
 	go func() {
 		c, err := io.Copy(cIO.Stdin, streams.In)
-		if c == 0 && err == nil {
+		if cw, ok := cIO.Stdin.(closeWriter); ok && err == nil && cw.CloseWrite() == nil {
+			// The process reads EOF from its stdin now, so just wait until it exits
+			// and stdout/stderr are done
+			<-result
+		} else if c == 0 && err == nil {
 			// Very good chance the stdin was closed at start, so just wait
 			// until stdout/stderr are done
 			<-result
 		} else {
-			// This is an unfortunate hack. It does not seem possible to close the
-			// stdin side of an exec session to kubernetes over a WebSocket. This
+			// This is an unfortunate hack. Without the v5 protocol it is not possible
+			// to close the stdin side of an exec session to kubernetes over a WebSocket. This
 			// means that for a command like "echo hi | acorn exec container cat" we
 			// can not reliably run it. For a command like that you have to finish
 			// reading stdin, close it, and then fully read the response.  If you
@@ -6,23 +6,30 @@ import (
 	"strings"
 
 	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
-	internalv1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	signatureannotations "github.com/acorn-io/runtime/pkg/imageselector/signatures/annotations"
 )
 
 func (c *DefaultClient) ImageVerify(ctx context.Context, image string, opts *ImageVerifyOptions) (*apiv1.ImageSignature, error) {
 	sigInput := &apiv1.ImageSignature{
-		PublicKey:    opts.PublicKey,
-		Auth:         opts.Auth,
-		NoVerifyName: opts.NoVerifyName,
+		PublicKey:      opts.PublicKey,
+		CertIdentity:   opts.CertIdentity,
+		CertOidcIssuer: opts.CertOidcIssuer,
+		Auth:           opts.Auth,
+		NoVerifyName:   opts.NoVerifyName,
+		RequireTlog:    opts.RequireTlog,
+		PredicateType:  opts.PredicateType,
 	}
 
-	if opts.PublicKey == "" {
-		return nil, fmt.Errorf("public key required for verification")
+	keyless := opts.CertIdentity != "" || opts.CertOidcIssuer != ""
+	if opts.PublicKey == "" && !keyless {
+		return nil, fmt.Errorf("public key or certificate identity required for verification")
+	} else if opts.PublicKey != "" && keyless {
+		return nil, fmt.Errorf("public key and certificate identity cannot be used together for verification")
+	} else if keyless && (opts.CertIdentity == "" || opts.CertOidcIssuer == "") {
+		return nil, fmt.Errorf("both certificate identity and certificate OIDC issuer are required for verification")
 	}
 
-	sigInput.Annotations = internalv1.SignatureAnnotations{
-		Match: opts.Annotations,
-	}
+	sigInput.Annotations = signatureannotations.ParseMatch(opts.Annotations)
 
 	sigResult := &apiv1.ImageSignature{}
 	err := c.RESTClient.Post().
@@ -2,11 +2,15 @@ package client
 
 import (
 	"context"
+	"fmt"
 	"sort"
 
 	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
 	apierror "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
 	kclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -51,6 +55,55 @@ func (c *DefaultClient) VolumeDelete(ctx context.Context, name string) (*apiv1.V
 	})
 }
 
+// VolumeResize requests newSize for the volume by setting the size of its binding on the app that owns it. Volumes
+// can only grow, and the server rejects the request if the storage class of the volume does not allow expansion.
+// The returned volume still has the capacity from before the resize, until the storage provider has expanded it.
+func (c *DefaultClient) VolumeResize(ctx context.Context, name, newSize string) (*apiv1.Volume, error) {
+	size, err := v1.ParseQuantity(newSize)
+	if err != nil {
+		return nil, err
+	}
+	requested, err := resource.ParseQuantity(string(size))
+	if err != nil {
+		return nil, fmt.Errorf("invalid size %s: %w", newSize, err)
+	}
+
+	vol, err := c.VolumeGet(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if vol.Status.AppName == "" || vol.Status.VolumeName == "" {
+		return nil, fmt.Errorf("volume %s is not used by an app and can not be resized", name)
+	}
+	if vol.Spec.Capacity != nil && requested.Cmp(*vol.Spec.Capacity) < 0 {
+		return nil, fmt.Errorf("can not shrink volume %s from %s to %s", name, vol.Spec.Capacity, &requested)
+	}
+
+	return vol, retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		app, err := c.AppGet(ctx, vol.Status.AppName)
+		if err != nil {
+			return err
+		}
+
+		app.Spec.Volumes = setVolumeBindingSize(app.Spec.Volumes, vol.Status.VolumeName, size)
+		return c.Client.Update(ctx, app)
+	})
+}
+
+// setVolumeBindingSize sets the size of the binding for target, keeping the other settings of an existing binding.
+func setVolumeBindingSize(bindings []v1.VolumeBinding, target string, size v1.Quantity) []v1.VolumeBinding {
+	for i, binding := range bindings {
+		if binding.Target == target {
+			bindings[i].Size = size
+			return bindings
+		}
+	}
+	return append(bindings, v1.VolumeBinding{
+		Target: target,
+		Size:   size,
+	})
+}
+
 func (c *DefaultClient) VolumeClassList(ctx context.Context) ([]apiv1.VolumeClass, error) {
 	volumeClasses := new(apiv1.VolumeClassList)
 	err := c.Client.List(ctx, volumeClasses, &kclient.ListOptions{Namespace: c.Namespace})
@@ -0,0 +1,129 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	apierror "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// VolumeSnapshotCreate takes a snapshot of the volume. If name is empty, a name is generated. The snapshot is taken
+// asynchronously, it can be restored once it is ready.
+func (c *DefaultClient) VolumeSnapshotCreate(ctx context.Context, volumeName, name string) (*apiv1.VolumeSnapshot, error) {
+	snapshot := &apiv1.VolumeSnapshot{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: c.Namespace,
+		},
+		Spec: apiv1.VolumeSnapshotSpec{
+			Volume: volumeName,
+		},
+	}
+	if name == "" {
+		snapshot.GenerateName = "snapshot-"
+	}
+	return snapshot, c.Client.Create(ctx, snapshot)
+}
+
+func (c *DefaultClient) VolumeSnapshotList(ctx context.Context) ([]apiv1.VolumeSnapshot, error) {
+	snapshots := &apiv1.VolumeSnapshotList{}
+	err := c.Client.List(ctx, snapshots, &kclient.ListOptions{
+		Namespace: c.Namespace,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(snapshots.Items, func(i, j int) bool {
+		if snapshots.Items[i].CreationTimestamp.Time == snapshots.Items[j].CreationTimestamp.Time {
+			return snapshots.Items[i].Name < snapshots.Items[j].Name
+		}
+		return snapshots.Items[i].CreationTimestamp.After(snapshots.Items[j].CreationTimestamp.Time)
+	})
+
+	return snapshots.Items, nil
+}
+
+func (c *DefaultClient) VolumeSnapshotGet(ctx context.Context, name string) (*apiv1.VolumeSnapshot, error) {
+	snapshot := &apiv1.VolumeSnapshot{}
+	return snapshot, c.Client.Get(ctx, kclient.ObjectKey{
+		Name:      name,
+		Namespace: c.Namespace,
+	}, snapshot)
+}
+
+func (c *DefaultClient) VolumeSnapshotDelete(ctx context.Context, name string) (*apiv1.VolumeSnapshot, error) {
+	// get first to find the namespace the snapshot is stored in
+	snapshot, err := c.VolumeSnapshotGet(ctx, name)
+	if apierror.IsNotFound(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	return snapshot, c.Client.Delete(ctx, snapshot)
+}
+
+// VolumeSnapshotRestore replaces the volume with a new one that has the data of the snapshot, by setting the snapshot
+// on the binding of the volume on the app that owns it. The snapshot must be ready and taken of a volume of the same
+// app. The replaced volume is kept and can be found by the name of its PersistentVolume.
+func (c *DefaultClient) VolumeSnapshotRestore(ctx context.Context, volumeName, snapshotName string) (*apiv1.Volume, error) {
+	vol, err := c.VolumeGet(ctx, volumeName)
+	if err != nil {
+		return nil, err
+	}
+	if vol.Status.AppName == "" || vol.Status.VolumeName == "" {
+		return nil, fmt.Errorf("volume %s is not used by an app and can not be restored", volumeName)
+	}
+
+	snapshot, err := c.VolumeSnapshotGet(ctx, snapshotName)
+	if err != nil {
+		return nil, err
+	}
+	if snapshot.Status.AppName != vol.Status.AppName {
+		return nil, fmt.Errorf("snapshot %s was taken of a volume of app %s and can not be restored to volume %s of app %s",
+			snapshotName, snapshot.Status.AppName, volumeName, vol.Status.AppName)
+	}
+	if !snapshot.Status.Ready {
+		if snapshot.Status.Error != "" {
+			return nil, fmt.Errorf("snapshot %s failed: %s", snapshotName, snapshot.Status.Error)
+		}
+		return nil, fmt.Errorf("snapshot %s is not ready yet", snapshotName)
+	}
+
+	return vol, retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		app, err := c.AppGet(ctx, vol.Status.AppName)
+		if err != nil {
+			return err
+		}
+
+		app.Spec.Volumes, err = setVolumeBindingSnapshot(app.Spec.Volumes, vol.Status.VolumeName, snapshot.Name)
+		if err != nil {
+			return err
+		}
+		return c.Client.Update(ctx, app)
+	})
+}
+
+// setVolumeBindingSnapshot sets the snapshot to restore target from on its binding, keeping the other settings of an
+// existing binding. Volumes bound to an existing volume can't be restored, the bound volume has to be restored instead.
+func setVolumeBindingSnapshot(bindings []v1.VolumeBinding, target, snapshot string) ([]v1.VolumeBinding, error) {
+	for i, binding := range bindings {
+		if binding.Target == target {
+			if binding.Volume != "" {
+				return nil, fmt.Errorf("volume %s is bound to volume %s, restore that volume instead", target, binding.Volume)
+			}
+			bindings[i].Snapshot = snapshot
+			return bindings, nil
+		}
+	}
+	return append(bindings, v1.VolumeBinding{
+		Target:   target,
+		Snapshot: snapshot,
+	}), nil
+}
@@ -5,7 +5,6 @@ import (
 	"errors"
 	"fmt"
 	"math"
-	"sort"
 
 	"github.com/acorn-io/baaah/pkg/router"
 	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
@@ -20,6 +19,7 @@ const gi = float64(1 << 30) // 1 Gibibyte. Note: this is not equivalent to 1 Gig
 
 var (
 	ErrInvalidMemoryForClass = errors.New("memory is invalid")
+	ErrInvalidCPUForClass    = errors.New("cpu is invalid")
 	ErrInvalidClass          = errors.New("compute class is invalid")
 )
 
@@ -124,12 +124,43 @@ func Validate(cc apiv1.ComputeClass, memory resource.Quantity, memDefault *int64
 }
 
 func CalculateCPU(cc internaladminv1.ProjectComputeClassInstance, memory resource.Quantity) (resource.Quantity, error) {
+	return scaleCPU(cc.CPUScaler, memory), nil
+}
+
+func scaleCPU(cpuScaler float64, memory resource.Quantity) resource.Quantity {
 	// The CPU scaler calculates the CPUs per Gi of memory so get the memory in a ratio of Gi
 	memoryInGi := memory.AsApproximateFloat64() / gi
 	// Since we're putting this in to mili-cpu's, multiply memoryInGi by the scaler and by 1000
-	value := cc.CPUScaler * memoryInGi * 1000
+	value := cpuScaler * memoryInGi * 1000
+
+	return *resource.NewMilliQuantity(int64(math.Ceil(value)), resource.DecimalSI)
+}
+
+// ValidateCPU checks a CPU request set by the user against the bounds of the ComputeClass. A ComputeClass allocates
+// CPU in proportion to memory, so its bounds are its CPU scaler applied to its minimum and maximum memory. A
+// ComputeClass without a CPU scaler or without a maximum memory doesn't bound the CPU, or its maximum, respectively.
+func ValidateCPU(cc apiv1.ComputeClass, cpu resource.Quantity) error {
+	if cc.CPUScaler == 0 {
+		return nil
+	}
+
+	parsedMemory, err := ParseComputeClassMemory(cc.Memory)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidClass, err)
+	}
 
-	return *resource.NewMilliQuantity(int64(math.Ceil(value)), resource.DecimalSI), nil
+	if parsedMemory.Max.Value() != 0 {
+		if max := scaleCPU(cc.CPUScaler, *parsedMemory.Max); cpu.Cmp(max) > 0 {
+			return fmt.Errorf("%w: defined cpu %v exceeds the maximum cpu for the ComputeClass %v of %v (%v CPUs per Gi of memory, up to %v of memory)",
+				ErrInvalidCPUForClass, cpu.String(), cc.Name, max.String(), cc.CPUScaler, parsedMemory.Max.String())
+		}
+	}
+	if min := scaleCPU(cc.CPUScaler, *parsedMemory.Min); cpu.Cmp(min) < 0 {
+		return fmt.Errorf("%w: defined cpu %v is below the minimum cpu for the ComputeClass %v of %v (%v CPUs per Gi of memory, from %v of memory)",
+			ErrInvalidCPUForClass, cpu.String(), cc.Name, min.String(), cc.CPUScaler, parsedMemory.Min.String())
+	}
+
+	return nil
 }
 
 func GetComputeClassNameForWorkload(workload string, container internalv1.Container, computeClasses internalv1.ComputeClassMap) string {
@@ -190,71 +221,6 @@ func GetAsProjectComputeClassInstance(ctx context.Context, c client.Client, name
 	return &projectComputeClass, nil
 }
 
-func getCurrentClusterComputeClassDefault(ctx context.Context, c client.Client) (*internaladminv1.ClusterComputeClassInstance, error) {
-	clusterComputeClasses := internaladminv1.ClusterComputeClassInstanceList{}
-	if err := c.List(ctx, &clusterComputeClasses, &client.ListOptions{}); err != nil {
-		return nil, err
-	}
-
-	sort.Slice(clusterComputeClasses.Items, func(i, j int) bool {
-		return clusterComputeClasses.Items[i].Name < clusterComputeClasses.Items[j].Name
-	})
-
-	var defaultCCC *internaladminv1.ClusterComputeClassInstance
-	for _, clusterComputeClass := range clusterComputeClasses.Items {
-		if clusterComputeClass.Default {
-			if defaultCCC != nil {
-				return nil, fmt.Errorf(
-					"cannot establish defaults because two default computeclasses exist: %v and %v",
-					defaultCCC.Name, clusterComputeClass.Name)
-			}
-			t := clusterComputeClass // Create a new variable that isn't being iterated on to get a pointer
-			defaultCCC = &t
-		}
-	}
-
-	return defaultCCC, nil
-}
-
-func getCurrentProjectComputeClassDefault(ctx context.Context, c client.Client, namespace string) (*internaladminv1.ProjectComputeClassInstance, error) {
-	projectComputeClasses := internaladminv1.ProjectComputeClassInstanceList{}
-	if err := c.List(ctx, &projectComputeClasses, &client.ListOptions{Namespace: namespace}); err != nil {
-		return nil, err
-	}
-
-	sort.Slice(projectComputeClasses.Items, func(i, j int) bool {
-		return projectComputeClasses.Items[i].Name < projectComputeClasses.Items[j].Name
-	})
-
-	var defaultPCC *internaladminv1.ProjectComputeClassInstance
-	for _, projectComputeClass := range projectComputeClasses.Items {
-		if projectComputeClass.Default {
-			if defaultPCC != nil {
-				return nil, fmt.Errorf(
-					"cannot establish defaults because two default computeclasses exist: %v and %v",
-					defaultPCC.Name, projectComputeClass.Name)
-			}
-			t := projectComputeClass // Create a new variable that isn't being iterated on to get a pointer
-			defaultPCC = &t
-		}
-	}
-
-	return defaultPCC, nil
-}
-
 func GetDefaultComputeClass(ctx context.Context, c client.Client, namespace string) (string, error) {
-	pcc, err := getCurrentProjectComputeClassDefault(ctx, c, namespace)
-	if err != nil {
-		return "", err
-	} else if pcc != nil {
-		return pcc.Name, nil
-	}
-
-	ccc, err := getCurrentClusterComputeClassDefault(ctx, c)
-	if err != nil {
-		return "", err
-	} else if ccc != nil {
-		return ccc.Name, nil
-	}
-	return "", nil
+	return internaladminv1.GetDefaultComputeClass(ctx, c, namespace)
 }
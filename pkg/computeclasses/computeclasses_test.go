@@ -0,0 +1,77 @@
+package computeclasses
+
+import (
+	"testing"
+
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestValidateCPU(t *testing.T) {
+	cc := apiv1.ComputeClass{
+		ObjectMeta: metav1.ObjectMeta{Name: "sample"},
+		CPUScaler:  0.5,
+		Memory: apiv1.ComputeClassMemory{
+			Min: "1Gi",
+			Max: "4Gi",
+		},
+	}
+
+	tests := []struct {
+		name    string
+		cc      apiv1.ComputeClass
+		cpu     string
+		wantErr string
+	}{
+		{
+			name: "within bounds",
+			cc:   cc,
+			cpu:  "1",
+		},
+		{
+			name: "at the maximum",
+			cc:   cc,
+			cpu:  "2",
+		},
+		{
+			name:    "above the maximum",
+			cc:      cc,
+			cpu:     "2500m",
+			wantErr: "cpu is invalid: defined cpu 2500m exceeds the maximum cpu for the ComputeClass sample of 2 (0.5 CPUs per Gi of memory, up to 4Gi of memory)",
+		},
+		{
+			name:    "below the minimum",
+			cc:      cc,
+			cpu:     "100m",
+			wantErr: "cpu is invalid: defined cpu 100m is below the minimum cpu for the ComputeClass sample of 500m (0.5 CPUs per Gi of memory, from 1Gi of memory)",
+		},
+		{
+			name: "no cpu scaler",
+			cc: apiv1.ComputeClass{
+				ObjectMeta: metav1.ObjectMeta{Name: "sample"},
+				Memory:     cc.Memory,
+			},
+			cpu: "8",
+		},
+		{
+			name: "no maximum memory",
+			cc: apiv1.ComputeClass{
+				ObjectMeta: metav1.ObjectMeta{Name: "sample"},
+				CPUScaler:  0.5,
+			},
+			cpu: "8",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateCPU(tt.cc, resource.MustParse(tt.cpu))
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -59,6 +59,9 @@ type CLIConfig struct {
 	LastProject        string                `json:"lastProject,omitempty"`
 	AcornConfigFile    string                `json:"acornConfig,omitempty"`
 
+	// ImageScanner is the command acorn image scan runs, trivy if empty
+	ImageScanner string `json:"imageScanner,omitempty"`
+
 	// ProjectURLs is used for testing to return EndpointURLs for remote projects
 	ProjectURLs map[string]string `json:"projectURLs,omitempty"`
 
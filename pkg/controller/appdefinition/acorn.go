@@ -156,6 +156,26 @@ func trimPrefixMemory(app *v1.AppInstance, memory v1.MemoryMap, name string) (re
 	return
 }
 
+func trimPrefixCPU(app *v1.AppInstance, name string) (result v1.CPUMap) {
+	prefix := name + "."
+	result = map[string]*int64{}
+
+	// add default first to maintain idempotency
+	if cpu := app.Spec.CPU[""]; cpu != nil {
+		result[""] = cpu
+	}
+
+	for id, cpu := range app.Spec.CPU {
+		if strings.HasPrefix(id, prefix) {
+			result[strings.TrimPrefix(id, prefix)] = cpu
+		} else if id == name {
+			result[""] = cpu
+		}
+	}
+
+	return
+}
+
 func scopeLinks(app *v1.AppInstance, bindings v1.ServiceBindings) (result v1.ServiceBindings) {
 	for _, binding := range bindings {
 		binding.Service = publicname.Get(app) + "." + binding.Service
@@ -224,6 +244,7 @@ func toAcorn(appInstance *v1.AppInstance, tag name.Reference, pullSecrets *PullS
 			NotifyUpgrade:       acorn.NotifyUpgrade,
 			ComputeClasses:      trimPrefixComputeClass(appInstance, acorn.ComputeClasses, acornName),
 			Memory:              trimPrefixMemory(appInstance, acorn.Memory, acornName),
+			CPU:                 trimPrefixCPU(appInstance, acornName),
 		},
 	}
 
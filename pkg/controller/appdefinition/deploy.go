@@ -32,6 +32,7 @@ import (
 	"github.com/acorn-io/schemer/data/convert"
 	"github.com/acorn-io/z"
 	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/sirupsen/logrus"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	apierror "k8s.io/apimachinery/pkg/api/errors"
@@ -848,6 +849,38 @@ func toFunctionDeployment(req router.Request, appInstance *v1.AppInstance, tag n
 	return dep, nil
 }
 
+// pausedReplicas returns the replica count of each container recorded when the app was last paused.
+func pausedReplicas(appInstance *v1.AppInstance) map[string]int32 {
+	data := appInstance.Annotations[labels.AcornPausedReplicas]
+	if data == "" {
+		return nil
+	}
+
+	var replicas map[string]int32
+	if err := json.Unmarshal([]byte(data), &replicas); err != nil {
+		logrus.Errorf("failed to parse the paused replicas of app %s/%s: %v", appInstance.Namespace, appInstance.Name, err)
+		return nil
+	}
+	return replicas
+}
+
+// restoredReplicas returns the current replicas of the existing deployment if they were restored from the replicas
+// recorded when the app was paused. Once the recorded replicas are removed from the app, they are kept as they are
+// instead of being reset to the default, until the container is stopped.
+func restoredReplicas(req router.Request, dep *appsv1.Deployment) (*int32, error) {
+	existing := &appsv1.Deployment{}
+	if err := req.Client.Get(req.Ctx, router.Key(dep.Namespace, dep.Name), existing); apierror.IsNotFound(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	if existing.Annotations[labels.AcornPausedReplicas] != "true" || z.Dereference(existing.Spec.Replicas) == 0 {
+		return nil, nil
+	}
+	return existing.Spec.Replicas, nil
+}
+
 func toDeployment(req router.Request, appInstance *v1.AppInstance, tag name.Reference, name string, container v1.Container, pullSecrets *PullSecrets, interpolator *secrets.Interpolator) (*appsv1.Deployment, error) {
 	var (
 		stateful   = isStateful(appInstance, container)
@@ -921,6 +954,19 @@ func toDeployment(req router.Request, appInstance *v1.AppInstance, tag name.Refe
 		},
 	}
 
+	if container.Scale == nil {
+		if replicas, ok := pausedReplicas(appInstance)[name]; ok {
+			// Restore the replicas the container had when the app was paused, they are not defined by the app
+			dep.Spec.Replicas = &replicas
+			dep.Annotations[labels.AcornPausedReplicas] = "true"
+		} else if replicas, err := restoredReplicas(req, dep); err != nil {
+			return nil, err
+		} else if replicas != nil {
+			dep.Spec.Replicas = replicas
+			dep.Annotations[labels.AcornPausedReplicas] = "true"
+		}
+	}
+
 	if stateful {
 		dep.Spec.Replicas = z.Pointer[int32](1)
 		dep.Spec.Template.Spec.Hostname = dep.Name
@@ -6,6 +6,7 @@ import (
 	"github.com/acorn-io/runtime/pkg/appdefinition"
 	"github.com/acorn-io/runtime/pkg/condition"
 	"github.com/acorn-io/runtime/pkg/controller/permissions"
+	"github.com/acorn-io/z"
 )
 
 func ParseAppImage(req router.Request, resp router.Response) error {
@@ -38,6 +39,13 @@ func ParseAppImage(req router.Request, resp router.Response) error {
 		appInstance.Status.Staged.AppScopedPermissions = permissions.GetAppScopedPermissions(appInstance, appSpec)
 	}
 
+	for name, replicas := range appInstance.Spec.Scale {
+		if container, ok := appSpec.Containers[name]; ok {
+			container.Scale = z.Pointer(replicas)
+			appSpec.Containers[name] = container
+		}
+	}
+
 	appInstance.Status.AppSpec = *appSpec
 	status.Success()
 	return nil
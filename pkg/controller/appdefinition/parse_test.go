@@ -17,6 +17,10 @@ func TestParseAppImageDevMode(t *testing.T) {
 	tester.DefaultTest(t, scheme.Scheme, "testdata/parsedevmode", ParseAppImage)
 }
 
+func TestParseAppImageScale(t *testing.T) {
+	tester.DefaultTest(t, scheme.Scheme, "testdata/parsescale", ParseAppImage)
+}
+
 func TestParseAppImageBug(t *testing.T) {
 	appImage := &v1.AppImage{
 		ImageData: v1.ImagesData{
@@ -0,0 +1,38 @@
+package appdefinition
+
+import (
+	"github.com/acorn-io/baaah/pkg/router"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/labels"
+	"github.com/acorn-io/z"
+)
+
+// ClearPausedReplicas removes the replicas recorded when the app was paused once it was resumed and all of its
+// containers are ready with them again, so that they don't keep pinning the replicas of the containers.
+func ClearPausedReplicas(req router.Request, _ router.Response) error {
+	app := req.Object.(*v1.AppInstance)
+	if _, ok := app.Annotations[labels.AcornPausedReplicas]; !ok || app.Annotations[labels.AcornPaused] == "true" || z.Dereference(app.Spec.Stop) {
+		return nil
+	}
+
+	for name, replicas := range pausedReplicas(app) {
+		if app.Status.AppStatus.Containers[name].ReadyReplicaCount < replicas {
+			return nil
+		}
+	}
+
+	// The object of the request can have the spec of a dev session overlaid, so only the annotation is removed from
+	// the stored app
+	stored := &v1.AppInstance{}
+	if err := req.Client.Get(req.Ctx, router.Key(app.Namespace, app.Name), stored); err != nil {
+		return err
+	}
+	delete(stored.Annotations, labels.AcornPausedReplicas)
+	if err := req.Client.Update(req.Ctx, stored); err != nil {
+		return err
+	}
+
+	delete(app.Annotations, labels.AcornPausedReplicas)
+	app.ResourceVersion = stored.ResourceVersion
+	return nil
+}
@@ -0,0 +1,122 @@
+package appdefinition
+
+import (
+	"context"
+	"testing"
+
+	"github.com/acorn-io/baaah/pkg/router"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/labels"
+	"github.com/acorn-io/runtime/pkg/scheme"
+	"github.com/acorn-io/z"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestClearPausedReplicas(t *testing.T) {
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		stop        bool
+		ready       int32
+		wantCleared bool
+	}{
+		{
+			name:        "resumed and ready",
+			annotations: map[string]string{labels.AcornPausedReplicas: `{"web":3}`},
+			ready:       3,
+			wantCleared: true,
+		},
+		{
+			name:        "resumed and not ready",
+			annotations: map[string]string{labels.AcornPausedReplicas: `{"web":3}`},
+			ready:       1,
+		},
+		{
+			name:        "paused",
+			annotations: map[string]string{labels.AcornPausedReplicas: `{"web":3}`, labels.AcornPaused: "true"},
+			stop:        true,
+			ready:       3,
+		},
+		{
+			name:        "stopped",
+			annotations: map[string]string{labels.AcornPausedReplicas: `{"web":3}`},
+			stop:        true,
+			ready:       3,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			app := &v1.AppInstance{
+				ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "project", Annotations: tt.annotations},
+				Spec:       v1.AppInstanceSpec{Stop: z.Pointer(tt.stop)},
+				Status: v1.AppInstanceStatus{
+					AppStatus: v1.AppStatus{
+						Containers: map[string]v1.ContainerStatus{"web": {ReadyReplicaCount: tt.ready}},
+					},
+				},
+			}
+			c := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(app.DeepCopy()).Build()
+
+			req := router.Request{Client: c, Ctx: context.Background(), Object: app}
+			require.NoError(t, ClearPausedReplicas(req, nil))
+
+			stored := &v1.AppInstance{}
+			require.NoError(t, c.Get(context.Background(), router.Key("project", "app"), stored))
+			_, ok := stored.Annotations[labels.AcornPausedReplicas]
+			assert.Equal(t, !tt.wantCleared, ok)
+			_, ok = app.Annotations[labels.AcornPausedReplicas]
+			assert.Equal(t, !tt.wantCleared, ok)
+		})
+	}
+}
+
+func TestRestoredReplicas(t *testing.T) {
+	tests := []struct {
+		name     string
+		existing *appsv1.Deployment
+		want     *int32
+	}{
+		{
+			name: "no deployment",
+		},
+		{
+			name: "restored",
+			existing: &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "app-ns", Annotations: map[string]string{labels.AcornPausedReplicas: "true"}},
+				Spec:       appsv1.DeploymentSpec{Replicas: z.Pointer[int32](3)},
+			},
+			want: z.Pointer[int32](3),
+		},
+		{
+			name: "stopped",
+			existing: &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "app-ns", Annotations: map[string]string{labels.AcornPausedReplicas: "true"}},
+				Spec:       appsv1.DeploymentSpec{Replicas: z.Pointer[int32](0)},
+			},
+		},
+		{
+			name: "never paused",
+			existing: &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "app-ns"},
+				Spec:       appsv1.DeploymentSpec{Replicas: z.Pointer[int32](3)},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			builder := fake.NewClientBuilder().WithScheme(scheme.Scheme)
+			if tt.existing != nil {
+				builder = builder.WithObjects(tt.existing)
+			}
+
+			req := router.Request{Client: builder.Build(), Ctx: context.Background()}
+			replicas, err := restoredReplicas(req, &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "app-ns"}})
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, replicas)
+		})
+	}
+}
@@ -94,6 +94,7 @@ func pullAppImage(transport http.RoundTripper, client pullClient) router.Handler
 		targetImage.Name = target
 		appInstance.Status.AvailableAppImage = ""
 		appInstance.Status.ConfirmUpgradeAppImage = ""
+		appInstance.Status.ConfirmUpgradeDigest = ""
 		// Reset the whole object, reset all staged state
 		appInstance.Status.Staged = v1.AppStatusStaged{
 			AppImage: *targetImage,
@@ -17,8 +17,10 @@ import (
 	"github.com/acorn-io/runtime/pkg/secrets"
 	"github.com/acorn-io/runtime/pkg/volume"
 	"github.com/acorn-io/z"
+	snapshotv1 "github.com/kubernetes-csi/external-snapshotter/client/v6/apis/volumesnapshot/v1"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	klabels "k8s.io/apimachinery/pkg/labels"
@@ -46,42 +48,8 @@ func translateAccessModes(accessModes []v1.AccessMode) []corev1.PersistentVolume
 }
 
 func LookupExistingPV(req router.Request, appInstance *v1.AppInstance, volumeName string) (string, error) {
-	var pvc corev1.PersistentVolumeClaim
-	if err := req.Get(&pvc, appInstance.Status.Namespace, volumeName); err == nil {
-		if pvc.Spec.VolumeName == "" {
-			return "", nil
-		}
-		pv := corev1.PersistentVolume{}
-		if err := req.Get(&pv, "", pvc.Spec.VolumeName); err == nil {
-			if pv.DeletionTimestamp.IsZero() {
-				return pvc.Spec.VolumeName, nil
-			}
-		} else if !apierrors.IsNotFound(err) {
-			return "", err
-		}
-	} else if !apierrors.IsNotFound(err) {
-		return "", err
-	}
-
-	// same thing as above but uncached
-	if err := req.Get(uncached.Get(&pvc), appInstance.Status.Namespace, volumeName); err == nil {
-		if pvc.Spec.VolumeName == "" {
-			return "", nil
-		}
-		pv := corev1.PersistentVolume{}
-		if err := req.Get(uncached.Get(&pv), "", pvc.Spec.VolumeName); err == nil {
-			if pv.DeletionTimestamp.IsZero() {
-				return pvc.Spec.VolumeName, nil
-			}
-		} else if !apierrors.IsNotFound(err) {
-			return "", err
-		}
-		// at this point we have to delete the PVC so that we can reset the invalid pvc.Spec.VolumeName
-		if err := req.Client.Delete(req.Ctx, &pvc); err != nil {
-			return "", err
-		}
-	} else if !apierrors.IsNotFound(err) {
-		return "", err
+	if pvName, found, err := lookupClaimedPV(req, appInstance.Status.Namespace, volumeName); err != nil || found {
+		return pvName, err
 	}
 
 	var pv corev1.PersistentVolumeList
@@ -112,6 +80,50 @@ func LookupExistingPV(req router.Request, appInstance *v1.AppInstance, volumeNam
 	}
 }
 
+// lookupClaimedPV returns the name of the PersistentVolume claimed by the PersistentVolumeClaim pvcName. found is false
+// if the claim doesn't exist or claims a volume that is gone, in which case the claim is deleted.
+func lookupClaimedPV(req router.Request, namespace, pvcName string) (_ string, found bool, _ error) {
+	var pvc corev1.PersistentVolumeClaim
+	if err := req.Get(&pvc, namespace, pvcName); err == nil {
+		if pvc.Spec.VolumeName == "" {
+			return "", true, nil
+		}
+		pv := corev1.PersistentVolume{}
+		if err := req.Get(&pv, "", pvc.Spec.VolumeName); err == nil {
+			if pv.DeletionTimestamp.IsZero() {
+				return pvc.Spec.VolumeName, true, nil
+			}
+		} else if !apierrors.IsNotFound(err) {
+			return "", false, err
+		}
+	} else if !apierrors.IsNotFound(err) {
+		return "", false, err
+	}
+
+	// same thing as above but uncached
+	if err := req.Get(uncached.Get(&pvc), namespace, pvcName); err == nil {
+		if pvc.Spec.VolumeName == "" {
+			return "", true, nil
+		}
+		pv := corev1.PersistentVolume{}
+		if err := req.Get(uncached.Get(&pv), "", pvc.Spec.VolumeName); err == nil {
+			if pv.DeletionTimestamp.IsZero() {
+				return pvc.Spec.VolumeName, true, nil
+			}
+		} else if !apierrors.IsNotFound(err) {
+			return "", false, err
+		}
+		// at this point we have to delete the PVC so that we can reset the invalid pvc.Spec.VolumeName
+		if err := req.Client.Delete(req.Ctx, &pvc); err != nil {
+			return "", false, err
+		}
+	} else if !apierrors.IsNotFound(err) {
+		return "", false, err
+	}
+
+	return "", false, nil
+}
+
 func toPVCs(req router.Request, appInstance *v1.AppInstance) (result []kclient.Object, err error) {
 	volumeClasses, _, err := volume.GetVolumeClassInstances(req.Ctx, req.Client, appInstance.Namespace)
 	if err != nil {
@@ -210,6 +222,12 @@ func toPVCs(req router.Request, appInstance *v1.AppInstance) (result []kclient.O
 			} else {
 				pvc.Spec.Resources.Requests[corev1.ResourceStorage] = *v1.MustParseResourceQuantity(volumeRequest.Size)
 			}
+
+			if volumeBinding.Snapshot != "" {
+				if err := toRestorePVC(req, appInstance, &pvc, volumeBinding.Snapshot); err != nil {
+					return nil, err
+				}
+			}
 		}
 
 		// Ensure that no other PersistentVolume exists with the same public name
@@ -232,6 +250,59 @@ func toPVCs(req router.Request, appInstance *v1.AppInstance) (result []kclient.O
 	return
 }
 
+// toRestorePVC turns pvc into a claim for a new volume with the data of the CSI VolumeSnapshot snapshot. The claim gets
+// its own name, so the previous volume isn't reused, and the previous volumes of the claim give up its public name.
+func toRestorePVC(req router.Request, appInstance *v1.AppInstance, pvc *corev1.PersistentVolumeClaim, snapshot string) error {
+	var volumeSnapshot snapshotv1.VolumeSnapshot
+	if err := req.Get(&volumeSnapshot, appInstance.Status.Namespace, snapshot); apierrors.IsNotFound(err) || meta.IsNoMatchError(err) {
+		return fmt.Errorf("snapshot %s to restore volume %s from not found", snapshot, pvc.Name)
+	} else if err != nil {
+		return err
+	}
+
+	if volumeSnapshot.Status != nil && volumeSnapshot.Status.RestoreSize != nil &&
+		volumeSnapshot.Status.RestoreSize.Cmp(pvc.Spec.Resources.Requests[corev1.ResourceStorage]) > 0 {
+		pvc.Spec.Resources.Requests[corev1.ResourceStorage] = *volumeSnapshot.Status.RestoreSize
+	}
+
+	pvc.Name = restoreName(pvc.Name, snapshot)
+	pvc.Spec.DataSource = &corev1.TypedLocalObjectReference{
+		APIGroup: z.Pointer(snapshotv1.GroupName),
+		Kind:     "VolumeSnapshot",
+		Name:     snapshot,
+	}
+
+	pvName, _, err := lookupClaimedPV(req, appInstance.Status.Namespace, pvc.Name)
+	if err != nil {
+		return err
+	}
+	pvc.Spec.VolumeName = pvName
+
+	var pvList corev1.PersistentVolumeList
+	if err := req.List(uncached.List(&pvList), &kclient.ListOptions{
+		LabelSelector: klabels.SelectorFromSet(map[string]string{
+			labels.AcornManaged:      "true",
+			labels.AcornAppNamespace: appInstance.Namespace,
+			labels.AcornPublicName:   pvc.Labels[labels.AcornPublicName],
+		}),
+	}); err != nil {
+		return err
+	}
+
+	for _, pv := range pvList.Items {
+		if pv.Name == pvName {
+			continue
+		}
+		// Keep the data of the replaced volume available under the name of the PersistentVolume
+		pv.Labels[labels.AcornPublicName] = pv.Name
+		if err := req.Client.Update(req.Ctx, &pv); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func getPVForVolumeBinding(req router.Request, appInstance *v1.AppInstance, binding v1.VolumeBinding) (*corev1.PersistentVolume, error) {
 	// binding.Volume can either be the actual name of the PersistentVolume, or its public name in Acorn.
 	// Check for the actual name first.
@@ -322,9 +393,15 @@ func bindName(volume string) string {
 	return name2.SafeConcatName(volume, "bind")
 }
 
+func restoreName(volume, snapshot string) string {
+	return name2.SafeConcatName(volume, "restore", snapshot)
+}
+
 func toVolumeName(appInstance *v1.AppInstance, volume string) (string, bool) {
-	if _, bind := isBind(appInstance, volume); bind {
+	if binding, bind := isBind(appInstance, volume); bind {
 		return bindName(volume), true
+	} else if binding.Snapshot != "" {
+		return restoreName(volume, binding.Snapshot), false
 	}
 	return volume, false
 }
@@ -50,9 +50,16 @@ func message(app *v1.AppInstance) string {
 	if !app.DeletionTimestamp.IsZero() {
 		buf.WriteString("removing")
 	} else if app.GetStopped() && !app.Status.AppStatus.Stopped {
-		buf.WriteString("stopping")
+		if isPaused(app) {
+			buf.WriteString("pausing")
+		} else {
+			buf.WriteString("stopping")
+		}
 	} else if app.Status.ConfirmUpgradeAppImage != "" {
 		buf.WriteString("Upgrade available: " + app.Status.ConfirmUpgradeAppImage)
+		if app.Status.ConfirmUpgradeDigest != "" {
+			buf.WriteString(" (" + app.Status.ConfirmUpgradeDigest + ")")
+		}
 	}
 
 	for _, cond := range app.Status.Conditions {
@@ -107,8 +114,15 @@ func uptodate(app *v1.AppInstance) string {
 	return strconv.Itoa(int(uptodate))
 }
 
+func isPaused(app *v1.AppInstance) bool {
+	return app.Annotations[labels.AcornPaused] == "true"
+}
+
 func healthy(app *v1.AppInstance) string {
 	if app.Status.AppStatus.Stopped {
+		if isPaused(app) {
+			return "paused"
+		}
 		return "stopped"
 	}
 	if app.Status.Namespace == "" {
@@ -12,7 +12,8 @@ import (
 
 func MarkAndSave(req router.Request, resp router.Response) error {
 	pvc := req.Object.(*corev1.PersistentVolumeClaim)
-	if pvc.Spec.VolumeName == "" {
+	if pvc.Spec.VolumeName == "" || !pvc.DeletionTimestamp.IsZero() {
+		// A claim that is being deleted, like the one of a volume replaced by a restore, doesn't label its volume anymore
 		return nil
 	}
 
@@ -77,6 +77,10 @@ func TestComputeClassDefault(t *testing.T) {
 	tester.DefaultTest(t, scheme.Scheme, "testdata/computeclass/compute-class-default", Calculate)
 }
 
+func TestComputeClassProjectSpecDefault(t *testing.T) {
+	tester.DefaultTest(t, scheme.Scheme, "testdata/computeclass/compute-class-project-spec-default", Calculate)
+}
+
 func TestAcornfileOverrideComputeClass(t *testing.T) {
 	tester.DefaultTest(t, scheme.Scheme, "testdata/computeclass/acornfile-override-compute-class", Calculate)
 }
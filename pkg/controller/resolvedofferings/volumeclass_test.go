@@ -29,6 +29,10 @@ func TestClusterVolumeClassDefault(t *testing.T) {
 	tester.DefaultTest(t, scheme.Scheme, "testdata/volumeclass/volume-class-fill-cluster-default", Calculate)
 }
 
+func TestProjectSpecVolumeClassDefault(t *testing.T) {
+	tester.DefaultTest(t, scheme.Scheme, "testdata/volumeclass/volume-class-fill-project-spec-default", Calculate)
+}
+
 func TestClusterProjectWithSameName(t *testing.T) {
 	tester.DefaultTest(t, scheme.Scheme, "testdata/volumeclass/cluster-and-project-class-same-name", Calculate)
 }
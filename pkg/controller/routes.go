@@ -92,6 +92,7 @@ func routes(router *router.Router, cfg *rest.Config, registryTransport http.Roun
 	appMeetsPreconditions.HandlerFunc(networkpolicy.ForApp)
 	appMeetsPreconditions.HandlerFunc(appdefinition.AddAcornProjectLabel)
 	appMeetsPreconditions.HandlerFunc(appdefinition.UpdateObservedFields)
+	appMeetsPreconditions.HandlerFunc(appdefinition.ClearPausedReplicas)
 
 	appRouter.HandlerFunc(appstatus.GetStatus)
 	appRouter.HandlerFunc(appstatus.SetStatus)
@@ -13,6 +13,10 @@ func TestContainerComputeClass(t *testing.T) {
 	tester.DefaultTest(t, scheme.Scheme, "testdata/computeclass/container", Calculate)
 }
 
+func TestCPUOverrideComputeClass(t *testing.T) {
+	tester.DefaultTest(t, scheme.Scheme, "testdata/computeclass/cpu-override", Calculate)
+}
+
 func TestDifferentComputeClass(t *testing.T) {
 	tester.DefaultTest(t, scheme.Scheme, "testdata/computeclass/different-computeclass", Calculate)
 }
@@ -208,7 +208,10 @@ func ResourceRequirements(req router.Request, app *v1.AppInstance, containerName
 		requirements.Limits[corev1.ResourceMemory] = memoryLimit
 	}
 
-	if computeClass != nil {
+	// A CPU request set by the user takes precedence over the one calculated from the compute class
+	if cpu := app.Spec.CPU.ForWorkload(containerName); cpu != nil {
+		requirements.Requests[corev1.ResourceCPU] = *resource.NewMilliQuantity(*cpu, resource.DecimalSI)
+	} else if computeClass != nil {
 		cpuQuantity, err := computeclasses.CalculateCPU(*computeClass, memoryRequest)
 		if err != nil {
 			return nil, err
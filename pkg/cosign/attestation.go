@@ -0,0 +1,200 @@
+package cosign
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	ggcrv1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/sigstore/cosign/v2/pkg/cosign"
+	"github.com/sigstore/cosign/v2/pkg/cosign/attestation"
+	"github.com/sigstore/cosign/v2/pkg/oci"
+	ociremote "github.com/sigstore/cosign/v2/pkg/oci/remote"
+	"github.com/sigstore/cosign/v2/pkg/types"
+	"github.com/sigstore/sigstore/pkg/signature"
+	"github.com/sigstore/sigstore/pkg/signature/dsse"
+	signatureoptions "github.com/sigstore/sigstore/pkg/signature/options"
+	"github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// PredicateTypes maps the predicate types accepted by cosign attest --type to the URI of their predicate type
+var PredicateTypes = map[string]string{
+	"custom":         "https://cosign.sigstore.dev/attestation/v1",
+	"slsaprovenance": "https://slsa.dev/provenance/v0.2",
+	"spdx":           "https://spdx.dev/Document",
+	"spdxjson":       "https://spdx.dev/Document",
+	"cyclonedx":      "https://cyclonedx.org/bom",
+	"link":           "https://in-toto.io/Link/v1",
+	"vuln":           "https://cosign.sigstore.dev/attestation/vuln/v1",
+}
+
+// PredicateTypeURI returns the URI of the predicate type, which is either one of PredicateTypes or an URI itself
+func PredicateTypeURI(predicateType string) (string, error) {
+	if uri, ok := PredicateTypes[predicateType]; ok {
+		return uri, nil
+	}
+	if _, err := url.ParseRequestURI(predicateType); err != nil {
+		types := sets.StringKeySet(PredicateTypes).List()
+		return "", fmt.Errorf("invalid attestation type %s, must be one of %s or an URI", predicateType, strings.Join(types, "|"))
+	}
+	return predicateType, nil
+}
+
+// MatchAttestation verifies the attestations of the image with the public keys or the certificate identity of opts,
+// like MatchSignature does for signatures, and returns the JSON encoded predicate of the first verified attestation
+// of predicateType. Annotation rules only apply to signatures and are ignored.
+func MatchAttestation(ctx context.Context, opts VerifyOpts, predicateType string) ([]byte, error) {
+	predicateURI, err := PredicateTypeURI(predicateType)
+	if err != nil {
+		return nil, err
+	}
+
+	attTag, err := ociremote.AttestationTag(opts.ImageRef, ociremote.WithRemoteOptions(opts.RemoteOpts...))
+	if err != nil {
+		return nil, err
+	}
+
+	atts, err := ociremote.Signatures(attTag, ociremote.WithRemoteOptions(opts.RemoteOpts...))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get attestations: %w", err)
+	}
+
+	if sl, err := atts.Get(); err != nil {
+		return nil, fmt.Errorf("failed to get attestations: %w", err)
+	} else if len(sl) == 0 {
+		return nil, NewVerificationFailure(&ErrNoSignaturesFound{Err: fmt.Errorf("no attestations found for image %s", opts.ImageRef.String())})
+	}
+
+	imgDigestHash, err := ggcrv1.NewHash(opts.ImageRef.DigestStr())
+	if err != nil {
+		return nil, err
+	}
+
+	cosignOpts, err := newCheckOpts(ctx, opts, cosign.IntotoSubjectClaimVerifier)
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.Key != "" {
+		verifiers, err := VerifiersFromPublicKeyRef(ctx, opts.Key, opts.SignatureAlgorithm)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load key: %w", err)
+		}
+		opts.Verifiers = append(opts.Verifiers, verifiers...)
+	}
+
+	// Keyless verification doesn't need a verifier, so it is a single pass without one
+	verifiers := opts.Verifiers
+	if opts.CertIdentity != "" || opts.CertOidcIssuer != "" {
+		verifiers = []signature.Verifier{nil}
+	}
+
+	var (
+		errs       []error
+		foundTypes = sets.NewString()
+	)
+	for _, v := range verifiers {
+		cosignOpts.SigVerifier = v
+		verified, _, err := cosign.VerifyImageAttestation(ctx, atts, imgDigestHash, cosignOpts)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		for _, att := range verified {
+			predicate, attType, err := attestationPredicate(att)
+			if err != nil {
+				return nil, err
+			}
+			if attType == predicateURI {
+				return predicate, nil
+			}
+			foundTypes.Insert(attType)
+		}
+	}
+
+	if foundTypes.Len() > 0 {
+		return nil, &VerificationFailure{&ErrNoMatchingSignatures{fmt.Errorf("found attestations for %s matching given identity, but none of type %s (found %s)", opts.ImageRef.String(), predicateType, strings.Join(foundTypes.List(), ", "))}}
+	}
+
+	err = &VerificationFailure{&ErrNoMatchingSignatures{fmt.Errorf("failed to find valid attestation for %s matching given identity", opts.ImageRef.String())}}
+	logrus.Debugf("%s: %v", err, errors.Join(errs...))
+	return nil, err
+}
+
+// SignAttestation creates the in-toto statement about the image digest with predicate as predicate of predicateType,
+// one of the types accepted by cosign attest --type, and signs it with signer like cosign attest does. It returns the
+// JSON encoded DSSE envelope, which is attached to the image as attestation.
+func SignAttestation(ctx context.Context, signer signature.Signer, digest name.Digest, predicateType string, predicate []byte) ([]byte, error) {
+	h, err := ggcrv1.NewHash(digest.DigestStr())
+	if err != nil {
+		return nil, err
+	}
+
+	statement, err := attestation.GenerateStatement(attestation.GenerateOpts{
+		Predicate: bytes.NewReader(predicate),
+		Type:      predicateType,
+		Digest:    h.Hex,
+		Repo:      digest.Repository.String(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create the %s attestation: %w", predicateType, err)
+	}
+
+	payload, err := json.Marshal(statement)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope, err := dsse.WrapSigner(signer, types.IntotoPayloadType).SignMessage(bytes.NewReader(payload), signatureoptions.WithContext(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign the %s attestation: %w", predicateType, err)
+	}
+	return envelope, nil
+}
+
+// AttestationPredicateType returns the predicate type of the in-toto statement in the DSSE envelope of an attestation
+func AttestationPredicateType(envelope []byte) (string, error) {
+	_, predicateType, err := decodeAttestation(envelope)
+	return predicateType, err
+}
+
+// attestationPredicate returns the predicate and the predicate type of the in-toto statement of a verified attestation
+func attestationPredicate(att oci.Signature) ([]byte, string, error) {
+	pld, err := att.Payload()
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to get payload: %w", err)
+	}
+	return decodeAttestation(pld)
+}
+
+// decodeAttestation returns the predicate and the predicate type of the in-toto statement in a DSSE envelope
+func decodeAttestation(pld []byte) ([]byte, string, error) {
+	var envelope struct {
+		Payload string `json:"payload"`
+	}
+	if err := json.Unmarshal(pld, &envelope); err != nil {
+		return nil, "", fmt.Errorf("error decoding the attestation envelope: %w", err)
+	}
+
+	statementData, err := base64.StdEncoding.DecodeString(envelope.Payload)
+	if err != nil {
+		return nil, "", fmt.Errorf("error decoding the attestation payload: %w", err)
+	}
+
+	var statement struct {
+		PredicateType string          `json:"predicateType"`
+		Predicate     json.RawMessage `json:"predicate"`
+	}
+	if err := json.Unmarshal(statementData, &statement); err != nil {
+		return nil, "", fmt.Errorf("error decoding the in-toto statement: %w", err)
+	}
+
+	return statement.Predicate, statement.PredicateType, nil
+}
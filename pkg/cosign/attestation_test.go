@@ -0,0 +1,123 @@
+package cosign
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/sigstore/cosign/v2/pkg/oci/static"
+	"github.com/sigstore/cosign/v2/pkg/types"
+	"github.com/sigstore/sigstore/pkg/signature"
+	"github.com/sigstore/sigstore/pkg/signature/dsse"
+)
+
+func TestPredicateTypeURI(t *testing.T) {
+	for predicateType, expected := range map[string]string{
+		"slsaprovenance":                "https://slsa.dev/provenance/v0.2",
+		"spdxjson":                      "https://spdx.dev/Document",
+		"https://example.com/predicate": "https://example.com/predicate",
+	} {
+		uri, err := PredicateTypeURI(predicateType)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if uri != expected {
+			t.Errorf("expected %s for %s, got %s", expected, predicateType, uri)
+		}
+	}
+
+	if _, err := PredicateTypeURI("sbom"); err == nil {
+		t.Error("expected error for unknown predicate type sbom")
+	}
+}
+
+func TestAttestationPredicate(t *testing.T) {
+	statement := `{"_type":"https://in-toto.io/Statement/v0.1","predicateType":"https://slsa.dev/provenance/v0.2","subject":[],"predicate":{"builder":{"id":"acorn"}}}`
+	envelope := `{"payloadType":"application/vnd.in-toto+json","payload":"` + base64.StdEncoding.EncodeToString([]byte(statement)) + `","signatures":[]}`
+
+	att, err := static.NewAttestation([]byte(envelope))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	predicate, predicateType, err := attestationPredicate(att)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if predicateType != "https://slsa.dev/provenance/v0.2" {
+		t.Errorf("expected predicate type https://slsa.dev/provenance/v0.2, got %s", predicateType)
+	}
+	if string(predicate) != `{"builder":{"id":"acorn"}}` {
+		t.Errorf("expected predicate {\"builder\":{\"id\":\"acorn\"}}, got %s", predicate)
+	}
+}
+
+func TestSignAttestation(t *testing.T) {
+	priv, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sv, err := signature.LoadECDSASignerVerifier(priv, crypto.SHA256)
+	if err != nil {
+		t.Fatal(err)
+	}
+	digest, err := name.NewDigest("ghcr.io/acorn-io/test@sha256:0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	envelope, err := SignAttestation(context.Background(), sv, digest, "cyclonedx", []byte(`{"bomFormat":"CycloneDX"}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := dsse.WrapVerifier(sv).VerifySignature(bytes.NewReader(envelope), nil); err != nil {
+		t.Errorf("expected the envelope to be signed with the key: %v", err)
+	}
+
+	predicateType, err := AttestationPredicateType(envelope)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if predicateType != "https://cyclonedx.org/bom" {
+		t.Errorf("expected predicate type https://cyclonedx.org/bom, got %s", predicateType)
+	}
+
+	att, err := static.NewAttestation(envelope)
+	if err != nil {
+		t.Fatal(err)
+	}
+	predicate, _, err := attestationPredicate(att)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(predicate) != `{"bomFormat":"CycloneDX"}` {
+		t.Errorf("expected the SBOM as predicate, got %s", predicate)
+	}
+
+	var env struct {
+		PayloadType string `json:"payloadType"`
+		Payload     string `json:"payload"`
+	}
+	if err := json.Unmarshal(envelope, &env); err != nil {
+		t.Fatal(err)
+	}
+	if env.PayloadType != types.IntotoPayloadType {
+		t.Errorf("expected payload type %s, got %s", types.IntotoPayloadType, env.PayloadType)
+	}
+	statement, _ := base64.StdEncoding.DecodeString(env.Payload)
+	if !bytes.Contains(statement, []byte(`"sha256":"0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"`)) {
+		t.Errorf("expected the image digest as subject, got %s", statement)
+	}
+
+	if _, err := SignAttestation(context.Background(), sv, digest, "spdxjson", []byte("not json")); err == nil {
+		t.Error("expected an error for an spdxjson predicate that isn't JSON")
+	}
+}
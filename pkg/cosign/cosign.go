@@ -3,6 +3,7 @@ package cosign
 import (
 	"context"
 	"crypto"
+	"crypto/x509"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -22,6 +23,7 @@ import (
 	ociremote "github.com/sigstore/cosign/v2/pkg/oci/remote"
 	"github.com/sigstore/cosign/v2/pkg/oci/static"
 	cosignature "github.com/sigstore/cosign/v2/pkg/signature"
+	"github.com/sigstore/sigstore/pkg/fulcioroots"
 	"github.com/sigstore/sigstore/pkg/signature"
 	"github.com/sigstore/sigstore/pkg/signature/payload"
 	"github.com/sirupsen/logrus"
@@ -39,6 +41,23 @@ type VerifyOpts struct {
 	RemoteOpts         []remote.Option
 	NoCache            bool
 	Verifiers          []signature.Verifier
+	// CertIdentity and CertOidcIssuer are used to verify keyless signatures by the identity in their Fulcio certificate
+	// instead of by a public key
+	CertIdentity   string
+	CertOidcIssuer string
+	// RequireTlog only accepts signatures with a transparency log bundle that is valid for the signature
+	RequireTlog bool
+	// TrustRoots are used to verify keyless signatures and transparency log bundles. If nil, the trust roots of the
+	// public Sigstore instance are used.
+	TrustRoots *TrustRoots
+}
+
+// TrustRoots are the certificate authorities and transparency log keys of a Sigstore instance
+type TrustRoots struct {
+	RootCerts         *x509.CertPool
+	IntermediateCerts *x509.CertPool
+	RekorPubKeys      *cosign.TrustedTransparencyLogPubKeys
+	CTLogPubKeys      *cosign.TrustedTransparencyLogPubKeys
 }
 
 func GetSignatureCacheRepository(ctx context.Context, c client.Reader, namespace string) (name.Repository, error) {
@@ -168,57 +187,169 @@ func ensureSignatureArtifact(ctx context.Context, c client.Reader, namespace str
 // careful to not do too many GET requests that count against registry rate limits (e.g. for Docker Hub).
 // Crane uses HEAD (with GET as a fallback) wherever it can, so it's a good choice here e.g. for fetching digests.
 func VerifySignature(ctx context.Context, opts VerifyOpts) error {
+	_, err := MatchSignature(ctx, opts)
+	return err
+}
+
+// MatchSignature does the same as VerifySignature, but returns the signature that was verified
+func MatchSignature(ctx context.Context, opts VerifyOpts) (oci.Signature, error) {
 	sigs, err := ociremote.Signatures(opts.SignatureRef, ociremote.WithRemoteOptions(opts.RemoteOpts...)) // this runs against our internal registry, so it should not count against the rate limits
 	if err != nil {
-		return fmt.Errorf("failed to get signatures: %w", err)
+		return nil, fmt.Errorf("failed to get signatures: %w", err)
 	}
 
 	imgDigestHash, err := ggcrv1.NewHash(opts.ImageRef.DigestStr())
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	// --- cosign verifier options
 
-	cosignOpts := &cosign.CheckOpts{
-		Annotations:        map[string]interface{}{},
-		ClaimVerifier:      cosign.SimpleClaimVerifier,
-		RegistryClientOpts: []ociremote.Option{ociremote.WithRemoteOptions(opts.RemoteOpts...)},
-		IgnoreTlog:         true,
+	cosignOpts, err := newCheckOpts(ctx, opts, cosign.SimpleClaimVerifier)
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.CertIdentity != "" || opts.CertOidcIssuer != "" {
+		sig, verr := verifySignature(ctx, sigs, imgDigestHash, opts, cosignOpts)
+		if errors.Is(verr, ErrAnnotationsUnmatched) {
+			return nil, &VerificationFailure{&ErrNoMatchingSignatures{fmt.Errorf("found signatures for %s matching certificate identity %s (issuer %s), but none matching the annotation rules: %w", opts.ImageRef.String(), opts.CertIdentity, opts.CertOidcIssuer, ErrAnnotationsUnmatched)}}
+		} else if verr != nil {
+			err := &VerificationFailure{&ErrNoMatchingSignatures{fmt.Errorf("failed to find valid signature for %s matching certificate identity %s (issuer %s) and annotation rules", opts.ImageRef.String(), opts.CertIdentity, opts.CertOidcIssuer)}}
+			logrus.Debugf("%s: %v", err, verr)
+			return nil, err
+		}
+		return sig, nil
 	}
 
 	// --- parse key
 	if opts.Key != "" {
 		verifiers, err := VerifiersFromPublicKeyRef(ctx, opts.Key, opts.SignatureAlgorithm)
 		if err != nil {
-			return fmt.Errorf("failed to load key: %w", err)
+			return nil, fmt.Errorf("failed to load key: %w", err)
 		}
 		opts.Verifiers = append(opts.Verifiers, verifiers...)
 	}
 
-	var errs []error
+	var (
+		errs                 []error
+		annotationsUnmatched bool
+	)
 	for _, v := range opts.Verifiers {
 		cosignOpts.SigVerifier = v
-		err := verifySignature(ctx, sigs, imgDigestHash, opts, cosignOpts)
+		sig, err := verifySignature(ctx, sigs, imgDigestHash, opts, cosignOpts)
 		if err == nil {
-			return nil
+			return sig, nil
 		}
+		annotationsUnmatched = annotationsUnmatched || errors.Is(err, ErrAnnotationsUnmatched)
 		errs = append(errs, err)
 	}
 
+	// Let callers tell apart signatures by someone else from signatures with the wrong annotations
+	if annotationsUnmatched {
+		return nil, &VerificationFailure{&ErrNoMatchingSignatures{fmt.Errorf("found signatures for %s matching given identity, but none matching the annotation rules: %w", opts.ImageRef.String(), ErrAnnotationsUnmatched)}}
+	}
+
 	err = &VerificationFailure{&ErrNoMatchingSignatures{fmt.Errorf("failed to find valid signature for %s matching given identity and annotation rules using %d loaded verifiers/keys", opts.ImageRef.String(), len(opts.Verifiers))}}
 	logrus.Debugf("%s: %v", err, errors.Join(errs...))
-	return err
+	return nil, err
 }
 
-func verifySignature(ctx context.Context, sigs oci.Signatures, imgDigestHash ggcrv1.Hash, opts VerifyOpts, cosignOpts *cosign.CheckOpts) error {
+// newCheckOpts returns the cosign check options for verifying signatures or attestations with claimVerifier. If opts
+// has a certificate identity, they are configured for keyless verification, otherwise the caller has to set the
+// SigVerifier.
+func newCheckOpts(ctx context.Context, opts VerifyOpts, claimVerifier func(oci.Signature, ggcrv1.Hash, map[string]interface{}) error) (*cosign.CheckOpts, error) {
+	cosignOpts := &cosign.CheckOpts{
+		Annotations:        map[string]interface{}{},
+		ClaimVerifier:      claimVerifier,
+		RegistryClientOpts: []ociremote.Option{ociremote.WithRemoteOptions(opts.RemoteOpts...)},
+		IgnoreTlog:         true,
+	}
+
+	if opts.RequireTlog {
+		if err := withTlog(ctx, cosignOpts, opts.TrustRoots); err != nil {
+			return nil, err
+		}
+	}
+
+	if opts.CertIdentity != "" || opts.CertOidcIssuer != "" {
+		if opts.Key != "" || len(opts.Verifiers) > 0 {
+			return nil, fmt.Errorf("cannot verify using a public key and a certificate identity at the same time")
+		}
+		if err := withCertIdentity(ctx, cosignOpts, opts.CertIdentity, opts.CertOidcIssuer, opts.TrustRoots); err != nil {
+			return nil, err
+		}
+	}
+
+	return cosignOpts, nil
+}
+
+// withCertIdentity configures the check options to verify signatures by their Fulcio certificate chain, the
+// transparency log bundle and the identity the certificate was issued to.
+func withCertIdentity(ctx context.Context, cosignOpts *cosign.CheckOpts, identity, issuer string, trustRoots *TrustRoots) error {
+	if identity == "" || issuer == "" {
+		return fmt.Errorf("both certificate identity and certificate OIDC issuer are required for keyless verification")
+	}
+
+	if trustRoots != nil {
+		cosignOpts.RootCerts = trustRoots.RootCerts
+		cosignOpts.IntermediateCerts = trustRoots.IntermediateCerts
+		cosignOpts.CTLogPubKeys = trustRoots.CTLogPubKeys
+	} else {
+		roots, err := fulcioroots.Get()
+		if err != nil {
+			return fmt.Errorf("failed to get fulcio root certificates: %w", err)
+		}
+		intermediates, err := fulcioroots.GetIntermediates()
+		if err != nil {
+			return fmt.Errorf("failed to get fulcio intermediate certificates: %w", err)
+		}
+		ctLogPubKeys, err := cosign.GetCTLogPubs(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get CT log public keys: %w", err)
+		}
+
+		cosignOpts.RootCerts = roots
+		cosignOpts.IntermediateCerts = intermediates
+		cosignOpts.CTLogPubKeys = ctLogPubKeys
+	}
+	cosignOpts.Identities = []cosign.Identity{{Subject: identity, Issuer: issuer}}
+
+	// The short-lived certificate has usually expired by the time we verify, so we rely on the
+	// Rekor bundle to prove that the signature was created while the certificate was valid.
+	return withTlog(ctx, cosignOpts, trustRoots)
+}
+
+// withTlog configures the check options to require a transparency log bundle attached to the signature. The bundle
+// is verified offline against the Rekor public keys, so no requests to Rekor are made.
+func withTlog(ctx context.Context, cosignOpts *cosign.CheckOpts, trustRoots *TrustRoots) error {
+	if cosignOpts.RekorPubKeys != nil {
+		return nil
+	}
+
+	if trustRoots != nil {
+		cosignOpts.RekorPubKeys = trustRoots.RekorPubKeys
+	} else {
+		rekorPubKeys, err := cosign.GetRekorPubs(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get rekor public keys: %w", err)
+		}
+		cosignOpts.RekorPubKeys = rekorPubKeys
+	}
+	cosignOpts.IgnoreTlog = false
+	cosignOpts.Offline = true
+
+	return nil
+}
+
+func verifySignature(ctx context.Context, sigs oci.Signatures, imgDigestHash ggcrv1.Hash, opts VerifyOpts, cosignOpts *cosign.CheckOpts) (oci.Signature, error) {
 	// --- get and verify signatures
 	signatures, bundlesVerified, err := verifySignatures(ctx, sigs, imgDigestHash, cosignOpts)
 	if err != nil {
 		if _, ok := err.(*cosign.VerificationError); ok {
-			return err
+			return nil, err
 		}
-		return fmt.Errorf("failed to verify image signatures: %w", err)
+		return nil, fmt.Errorf("failed to verify image signatures: %w", err)
 	}
 
 	logrus.Debugf("image %s: %d signatures verified (bundle verified: %v)", opts.ImageRef.Name(), len(signatures), bundlesVerified)
@@ -226,19 +357,26 @@ func verifySignature(ctx context.Context, sigs oci.Signatures, imgDigestHash ggc
 	// --- extract payloads for subsequent checks
 	payloads, err := extractPayload(signatures)
 	if err != nil {
-		return fmt.Errorf("failed to extract payload: %w", err)
+		return nil, fmt.Errorf("failed to extract payload: %w", err)
 	}
 
 	// --- check annotations
 	if err := checkAnnotations(payloads, opts.AnnotationRules); err != nil {
 		if _, ok := err.(*cosign.VerificationError); ok {
-			return err
+			return nil, err
 		}
-		return fmt.Errorf("failed to check annotations: %w", err)
+		return nil, fmt.Errorf("failed to check annotations: %w", err)
 	}
 	logrus.Debugf("image %s: Annotations (%+v) verified", opts.ImageRef.Name(), opts.AnnotationRules)
 
-	return nil
+	// prefer a signature that matches the annotation rules on its own
+	for i := range payloads {
+		if checkAnnotations(payloads[i:i+1], opts.AnnotationRules) == nil {
+			return signatures[i], nil
+		}
+	}
+
+	return signatures[0], nil
 }
 
 func DecodePEM(raw []byte, signatureAlgorithm crypto.Hash) (signature.Verifier, error) {
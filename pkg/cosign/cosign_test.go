@@ -2,24 +2,53 @@ package cosign
 
 import (
 	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
 	_ "embed"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
 	"fmt"
 	"io"
 	"log"
+	"math/big"
 	"net/http/httptest"
 	"net/url"
 	"testing"
+	"time"
 
 	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
 	signatureannotations "github.com/acorn-io/runtime/pkg/imageselector/signatures/annotations"
+	ct "github.com/google/certificate-transparency-go"
+	cttls "github.com/google/certificate-transparency-go/tls"
+	ctx509 "github.com/google/certificate-transparency-go/x509"
+	ctx509util "github.com/google/certificate-transparency-go/x509util"
 	"github.com/google/go-containerregistry/pkg/crane"
 	"github.com/google/go-containerregistry/pkg/name"
 	"github.com/google/go-containerregistry/pkg/registry"
 	regv1 "github.com/google/go-containerregistry/pkg/v1"
 	"github.com/google/go-containerregistry/pkg/v1/layout"
 	"github.com/google/go-containerregistry/pkg/v1/partial"
+	"github.com/google/go-containerregistry/pkg/v1/random"
 	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/sigstore/cosign/v2/pkg/cosign"
+	cbundle "github.com/sigstore/cosign/v2/pkg/cosign/bundle"
+	"github.com/sigstore/cosign/v2/pkg/oci"
+	"github.com/sigstore/cosign/v2/pkg/oci/mutate"
+	ociremote "github.com/sigstore/cosign/v2/pkg/oci/remote"
+	"github.com/sigstore/cosign/v2/pkg/oci/static"
+	"github.com/sigstore/sigstore/pkg/cryptoutils"
+	"github.com/sigstore/sigstore/pkg/signature"
+	"github.com/sigstore/sigstore/pkg/tuf"
 	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/labels"
 
 	_ "embed"
 )
@@ -268,3 +297,418 @@ func TestLoadVerifiers(t *testing.T) {
 	_, err = VerifiersFromPublicKeyRef(ctx, privkeyCosign, "sha256")
 	require.Error(t, err, "Should not be able to import Cosign Private Key as Public Key")
 }
+
+// testSigstore is a certificate authority, CT log and transparency log for issuing and logging keyless signatures
+type testSigstore struct {
+	caCert   *x509.Certificate
+	caKey    *ecdsa.PrivateKey
+	ctKey    *ecdsa.PrivateKey
+	rekorKey *ecdsa.PrivateKey
+}
+
+func newTestSigstore(t *testing.T) *testSigstore {
+	t.Helper()
+
+	s := &testSigstore{}
+	for _, key := range []**ecdsa.PrivateKey{&s.caKey, &s.ctKey, &s.rekorKey} {
+		var err error
+		*key, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		require.NoError(t, err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test-fulcio"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, s.caKey.Public(), s.caKey)
+	require.NoError(t, err)
+	s.caCert, err = x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	return s
+}
+
+func (s *testSigstore) trustRoots(t *testing.T) *TrustRoots {
+	t.Helper()
+
+	roots := x509.NewCertPool()
+	roots.AddCert(s.caCert)
+
+	logKeys := func(key *ecdsa.PrivateKey) *cosign.TrustedTransparencyLogPubKeys {
+		pemBytes, err := cryptoutils.MarshalPublicKeyToPEM(key.Public())
+		require.NoError(t, err)
+		keys := cosign.NewTrustedTransparencyLogPubKeys()
+		require.NoError(t, keys.AddTransparencyLogPubKey(pemBytes, tuf.Active))
+		return &keys
+	}
+
+	return &TrustRoots{
+		RootCerts:         roots,
+		IntermediateCerts: x509.NewCertPool(),
+		RekorPubKeys:      logKeys(s.rekorKey),
+		CTLogPubKeys:      logKeys(s.ctKey),
+	}
+}
+
+// issueCert returns a signing certificate for pub, issued to identity as confirmed by issuer, with an embedded SCT
+func (s *testSigstore) issueCert(t *testing.T, pub crypto.PublicKey, identity, issuer string) []byte {
+	t.Helper()
+
+	template := &x509.Certificate{
+		SerialNumber:   big.NewInt(2),
+		NotBefore:      time.Now().Add(-time.Minute),
+		NotAfter:       time.Now().Add(10 * time.Minute),
+		EmailAddresses: []string{identity},
+		KeyUsage:       x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:    []x509.ExtKeyUsage{x509.ExtKeyUsageCodeSigning},
+		ExtraExtensions: []pkix.Extension{{
+			// Fulcio's OIDC issuer extension
+			Id:    asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 57264, 1, 1},
+			Value: []byte(issuer),
+		}},
+	}
+
+	// The SCT signs the certificate without the SCT, so issue it without first
+	precertDER, err := x509.CreateCertificate(rand.Reader, template, s.caCert, pub, s.caKey)
+	require.NoError(t, err)
+	precert, err := x509.ParseCertificate(precertDER)
+	require.NoError(t, err)
+
+	timestamp := uint64(time.Now().UnixMilli())
+	leaf := ct.MerkleTreeLeaf{
+		Version:  ct.V1,
+		LeafType: ct.TimestampedEntryLeafType,
+		TimestampedEntry: &ct.TimestampedEntry{
+			Timestamp: timestamp,
+			EntryType: ct.PrecertLogEntryType,
+			PrecertEntry: &ct.PreCert{
+				IssuerKeyHash:  sha256.Sum256(s.caCert.RawSubjectPublicKeyInfo),
+				TBSCertificate: precert.RawTBSCertificate,
+			},
+		},
+	}
+	ctPubDER, err := x509.MarshalPKIXPublicKey(s.ctKey.Public())
+	require.NoError(t, err)
+	sct := &ct.SignedCertificateTimestamp{
+		SCTVersion: ct.V1,
+		LogID:      ct.LogID{KeyID: sha256.Sum256(ctPubDER)},
+		Timestamp:  timestamp,
+	}
+	input, err := ct.SerializeSCTSignatureInput(*sct, ct.LogEntry{Leaf: leaf})
+	require.NoError(t, err)
+	digest := sha256.Sum256(input)
+	sctSig, err := ecdsa.SignASN1(rand.Reader, s.ctKey, digest[:])
+	require.NoError(t, err)
+	sct.Signature = ct.DigitallySigned{
+		Algorithm: cttls.SignatureAndHashAlgorithm{Hash: cttls.SHA256, Signature: cttls.ECDSA},
+		Signature: sctSig,
+	}
+
+	sctList, err := ctx509util.MarshalSCTsIntoSCTList([]*ct.SignedCertificateTimestamp{sct})
+	require.NoError(t, err)
+	sctListBytes, err := cttls.Marshal(*sctList)
+	require.NoError(t, err)
+	sctExt, err := asn1.Marshal(sctListBytes)
+	require.NoError(t, err)
+	template.ExtraExtensions = append(template.ExtraExtensions, pkix.Extension{Id: asn1.ObjectIdentifier(ctx509.OIDExtensionCTSCT), Value: sctExt})
+
+	der, err := x509.CreateCertificate(rand.Reader, template, s.caCert, pub, s.caKey)
+	require.NoError(t, err)
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+}
+
+// sign returns a keyless signature of the image by identity, optionally with a transparency log bundle
+func (s *testSigstore) sign(t *testing.T, imageRef name.Digest, identity, issuer string, logged bool) oci.Signature {
+	t.Helper()
+
+	priv, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	signer, err := signature.LoadECDSASignerVerifier(priv, crypto.SHA256)
+	require.NoError(t, err)
+
+	certPEM := s.issueCert(t, priv.Public(), identity, issuer)
+	chainPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: s.caCert.Raw})
+
+	payload, sig, err := signature.SignImage(signer, imageRef, nil)
+	require.NoError(t, err)
+	sigB64 := base64.StdEncoding.EncodeToString(sig)
+
+	opts := []static.Option{static.WithCertChain(certPEM, chainPEM)}
+	if logged {
+		opts = append(opts, static.WithBundle(s.logEntry(t, payload, sigB64, certPEM)))
+	}
+
+	ociSig, err := static.NewSignature(payload, sigB64, opts...)
+	require.NoError(t, err)
+	return ociSig
+}
+
+// logEntry returns the transparency log bundle of the signature over payload, created with the key or certificate
+// in pemBytes
+func (s *testSigstore) logEntry(t *testing.T, payload []byte, sigB64 string, pemBytes []byte) *cbundle.RekorBundle {
+	t.Helper()
+
+	payloadHash := sha256.Sum256(payload)
+	body, err := json.Marshal(map[string]any{
+		"apiVersion": "0.0.1",
+		"kind":       "hashedrekord",
+		"spec": map[string]any{
+			"data": map[string]any{
+				"hash": map[string]any{"algorithm": "sha256", "value": hex.EncodeToString(payloadHash[:])},
+			},
+			"signature": map[string]any{
+				"content":   sigB64,
+				"publicKey": map[string]any{"content": base64.StdEncoding.EncodeToString(pemBytes)},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	rekorPubDER, err := x509.MarshalPKIXPublicKey(s.rekorKey.Public())
+	require.NoError(t, err)
+	logID := sha256.Sum256(rekorPubDER)
+	rekorPayload := cbundle.RekorPayload{
+		Body:           base64.StdEncoding.EncodeToString(body),
+		IntegratedTime: time.Now().Unix(),
+		LogIndex:       1,
+		LogID:          hex.EncodeToString(logID[:]),
+	}
+
+	// Keys of maps are sorted, so this is the canonical JSON encoding the SET is verified against
+	canonical, err := json.Marshal(map[string]any{
+		"body":           rekorPayload.Body,
+		"integratedTime": rekorPayload.IntegratedTime,
+		"logIndex":       rekorPayload.LogIndex,
+		"logID":          rekorPayload.LogID,
+	})
+	require.NoError(t, err)
+	digest := sha256.Sum256(canonical)
+	set, err := ecdsa.SignASN1(rand.Reader, s.rekorKey, digest[:])
+	require.NoError(t, err)
+
+	return &cbundle.RekorBundle{SignedEntryTimestamp: set, Payload: rekorPayload}
+}
+
+// pushSignedImage pushes a random image to repo in the registry at host and attaches the signature created by sign
+func pushSignedImage(t *testing.T, host, repo string, sign func(name.Digest) oci.Signature) (name.Digest, name.Tag) {
+	t.Helper()
+
+	img, err := random.Image(64, 1)
+	require.NoError(t, err)
+	repoRef, err := name.NewRepository(fmt.Sprintf("%s/%s", host, repo))
+	require.NoError(t, err)
+	digest, err := img.Digest()
+	require.NoError(t, err)
+	imgRef := repoRef.Digest(digest.String())
+	require.NoError(t, remote.Write(imgRef, img))
+
+	entity, err := ociremote.SignedEntity(imgRef)
+	require.NoError(t, err)
+	entity, err = mutate.AttachSignatureToEntity(entity, sign(imgRef))
+	require.NoError(t, err)
+	require.NoError(t, ociremote.WriteSignatures(repoRef, entity))
+
+	sigTag, err := ociremote.SignatureTag(imgRef)
+	require.NoError(t, err)
+	return imgRef, sigTag
+}
+
+func TestMatchSignatureCertIdentity(t *testing.T) {
+	const (
+		identity = "me@example.com"
+		issuer   = "https://issuer.example.com"
+	)
+
+	s := httptest.NewServer(registry.New(registry.Logger(log.New(io.Discard, "", 0))))
+	defer s.Close()
+	u, err := url.Parse(s.URL)
+	require.NoError(t, err)
+
+	sigstore := newTestSigstore(t)
+
+	signKeyless := func(logged bool) func(name.Digest) oci.Signature {
+		return func(imgRef name.Digest) oci.Signature {
+			return sigstore.sign(t, imgRef, identity, issuer, logged)
+		}
+	}
+
+	signedImg, signedSig := pushSignedImage(t, u.Host, "signed", signKeyless(true))
+	unloggedImg, unloggedSig := pushSignedImage(t, u.Host, "unlogged", signKeyless(false))
+
+	tests := []struct {
+		name       string
+		imageRef   name.Digest
+		sigRef     name.Reference
+		identity   string
+		issuer     string
+		trustRoots *TrustRoots
+		wantErr    bool
+	}{
+		{
+			name:       "identity and issuer match",
+			imageRef:   signedImg,
+			sigRef:     signedSig,
+			identity:   identity,
+			issuer:     issuer,
+			trustRoots: sigstore.trustRoots(t),
+		},
+		{
+			name:       "other identity",
+			imageRef:   signedImg,
+			sigRef:     signedSig,
+			identity:   "someone-else@example.com",
+			issuer:     issuer,
+			trustRoots: sigstore.trustRoots(t),
+			wantErr:    true,
+		},
+		{
+			name:       "other issuer",
+			imageRef:   signedImg,
+			sigRef:     signedSig,
+			identity:   identity,
+			issuer:     "https://accounts.example.com",
+			trustRoots: sigstore.trustRoots(t),
+			wantErr:    true,
+		},
+		{
+			name:       "certificate issued by an untrusted authority",
+			imageRef:   signedImg,
+			sigRef:     signedSig,
+			identity:   identity,
+			issuer:     issuer,
+			trustRoots: newTestSigstore(t).trustRoots(t),
+			wantErr:    true,
+		},
+		{
+			name:       "signature not recorded in the transparency log",
+			imageRef:   unloggedImg,
+			sigRef:     unloggedSig,
+			identity:   identity,
+			issuer:     issuer,
+			trustRoots: sigstore.trustRoots(t),
+			wantErr:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sig, err := MatchSignature(context.Background(), VerifyOpts{
+				ImageRef:           tt.imageRef,
+				SignatureRef:       tt.sigRef,
+				AnnotationRules:    labels.Everything(),
+				SignatureAlgorithm: "sha256",
+				NoCache:            true,
+				CertIdentity:       tt.identity,
+				CertOidcIssuer:     tt.issuer,
+				TrustRoots:         tt.trustRoots,
+			})
+			if tt.wantErr {
+				require.Error(t, err)
+				var verificationFailure *VerificationFailure
+				require.ErrorAs(t, err, &verificationFailure)
+				return
+			}
+			require.NoError(t, err)
+
+			cert, err := sig.Cert()
+			require.NoError(t, err)
+			require.Equal(t, []string{identity}, cert.EmailAddresses)
+		})
+	}
+}
+
+func TestMatchSignatureRequireTlog(t *testing.T) {
+	s := httptest.NewServer(registry.New(registry.Logger(log.New(io.Discard, "", 0))))
+	defer s.Close()
+	u, err := url.Parse(s.URL)
+	require.NoError(t, err)
+
+	sigstore := newTestSigstore(t)
+
+	priv, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	signer, err := signature.LoadECDSASignerVerifier(priv, crypto.SHA256)
+	require.NoError(t, err)
+	pubPEM, err := cryptoutils.MarshalPublicKeyToPEM(priv.Public())
+	require.NoError(t, err)
+
+	signWithKey := func(tlog *testSigstore) func(name.Digest) oci.Signature {
+		return func(imgRef name.Digest) oci.Signature {
+			payload, sig, err := signature.SignImage(signer, imgRef, nil)
+			require.NoError(t, err)
+			sigB64 := base64.StdEncoding.EncodeToString(sig)
+
+			var opts []static.Option
+			if tlog != nil {
+				opts = append(opts, static.WithBundle(tlog.logEntry(t, payload, sigB64, pubPEM)))
+			}
+			ociSig, err := static.NewSignature(payload, sigB64, opts...)
+			require.NoError(t, err)
+			return ociSig
+		}
+	}
+
+	loggedImg, loggedSig := pushSignedImage(t, u.Host, "logged", signWithKey(sigstore))
+	unloggedImg, unloggedSig := pushSignedImage(t, u.Host, "unlogged", signWithKey(nil))
+	untrustedImg, untrustedSig := pushSignedImage(t, u.Host, "untrusted", signWithKey(newTestSigstore(t)))
+
+	tests := []struct {
+		name        string
+		imageRef    name.Digest
+		sigRef      name.Reference
+		requireTlog bool
+		wantErr     bool
+	}{
+		{
+			name:        "recorded in the transparency log",
+			imageRef:    loggedImg,
+			sigRef:      loggedSig,
+			requireTlog: true,
+		},
+		{
+			name:        "not recorded in the transparency log",
+			imageRef:    unloggedImg,
+			sigRef:      unloggedSig,
+			requireTlog: true,
+			wantErr:     true,
+		},
+		{
+			name:        "recorded in an untrusted transparency log",
+			imageRef:    untrustedImg,
+			sigRef:      untrustedSig,
+			requireTlog: true,
+			wantErr:     true,
+		},
+		{
+			name:     "not recorded and not required",
+			imageRef: unloggedImg,
+			sigRef:   unloggedSig,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := MatchSignature(context.Background(), VerifyOpts{
+				ImageRef:           tt.imageRef,
+				SignatureRef:       tt.sigRef,
+				AnnotationRules:    labels.Everything(),
+				SignatureAlgorithm: "sha256",
+				NoCache:            true,
+				Verifiers:          []signature.Verifier{signer},
+				RequireTlog:        tt.requireTlog,
+				TrustRoots:         sigstore.trustRoots(t),
+			})
+			if tt.wantErr {
+				var verificationFailure *VerificationFailure
+				require.ErrorAs(t, err, &verificationFailure)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,42 @@
+package cosign
+
+import (
+	"crypto"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// SignatureIdentity returns a deterministic identity of a signature of the image digest, created with the key of
+// publicKey and carrying annotations. Two signatures with the same identity are interchangeable for verification,
+// even if their payloads differ in formatting or were signed at different times, so signing an image again with the
+// same key and annotations doesn't need to add another signature.
+func SignatureIdentity(digest string, publicKey crypto.PublicKey, annotations map[string]any) (string, error) {
+	fingerprint, err := DERFingerprint(publicKey)
+	if err != nil {
+		return "", err
+	}
+
+	keys := make([]string, 0, len(annotations))
+	for k := range annotations {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "digest=%s\nkey=%s\n", digest, fingerprint)
+	for _, k := range keys {
+		// Values are JSON encoded, so that annotations of different types with the same string form don't match
+		value, err := json.Marshal(annotations[k])
+		if err != nil {
+			return "", fmt.Errorf("encoding annotation %s: %w", k, err)
+		}
+		fmt.Fprintf(&b, "annotation=%q:%s\n", k, value)
+	}
+
+	hash := sha256.Sum256([]byte(b.String()))
+	return "sha256:" + hex.EncodeToString(hash[:]), nil
+}
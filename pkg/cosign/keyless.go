@@ -0,0 +1,156 @@
+package cosign
+
+import (
+	"context"
+	"crypto"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/sigstore/cosign/v2/pkg/cosign"
+	cbundle "github.com/sigstore/cosign/v2/pkg/cosign/bundle"
+	fulcioapi "github.com/sigstore/fulcio/pkg/api"
+	rekorclient "github.com/sigstore/rekor/pkg/client"
+	"github.com/sigstore/sigstore/pkg/cryptoutils"
+	"github.com/sigstore/sigstore/pkg/oauthflow"
+	"github.com/sigstore/sigstore/pkg/signature"
+	"github.com/sigstore/sigstore/pkg/signature/options"
+)
+
+const (
+	DefaultFulcioURL  = "https://fulcio.sigstore.dev"
+	DefaultOIDCIssuer = "https://oauth2.sigstore.dev/auth"
+	DefaultRekorURL   = "https://rekor.sigstore.dev"
+
+	oidcClientID = "sigstore"
+)
+
+type KeylessOpts struct {
+	FulcioURL     string
+	OIDCIssuer    string
+	IdentityToken string // if empty, the interactive OIDC flow is used to obtain a token
+}
+
+// KeylessSigner is an ephemeral signer whose public key is bound to an OIDC identity by a short-lived Fulcio certificate
+type KeylessSigner struct {
+	signature.SignerVerifier
+	Cert  []byte
+	Chain []byte
+}
+
+// NewKeylessSigner generates an ephemeral key pair and requests a signing certificate for it from Fulcio,
+// proving possession of the key by signing the subject of the OIDC identity token.
+// Adapted from Cosign's fulcio.NewSigner
+func NewKeylessSigner(ctx context.Context, opts KeylessOpts) (*KeylessSigner, error) {
+	if opts.FulcioURL == "" {
+		opts.FulcioURL = DefaultFulcioURL
+	}
+	if opts.OIDCIssuer == "" {
+		opts.OIDCIssuer = DefaultOIDCIssuer
+	}
+
+	fulcioURL, err := url.Parse(opts.FulcioURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid fulcio url %s: %w", opts.FulcioURL, err)
+	}
+
+	priv, err := cosign.GeneratePrivateKey()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate ephemeral key: %w", err)
+	}
+
+	sv, err := signature.LoadECDSASignerVerifier(priv, crypto.SHA256)
+	if err != nil {
+		return nil, err
+	}
+
+	var tg oauthflow.TokenGetter = oauthflow.DefaultIDTokenGetter
+	if opts.IdentityToken != "" {
+		tg = &oauthflow.StaticTokenGetter{RawToken: opts.IdentityToken}
+	}
+
+	tok, err := oauthflow.OIDConnect(opts.OIDCIssuer, oidcClientID, "", "", tg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get OIDC identity token from %s: %w", opts.OIDCIssuer, err)
+	}
+
+	pubBytes, err := cryptoutils.MarshalPublicKeyToPEM(priv.Public())
+	if err != nil {
+		return nil, err
+	}
+
+	proof, err := sv.SignMessage(strings.NewReader(tok.Subject), options.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := fulcioapi.NewClient(fulcioURL).SigningCert(fulcioapi.CertificateRequest{
+		PublicKey: fulcioapi.Key{
+			Content: pubBytes,
+		},
+		SignedEmailAddress: proof,
+	}, tok.RawString)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve signing certificate from %s: %w", opts.FulcioURL, err)
+	}
+
+	return &KeylessSigner{
+		SignerVerifier: sv,
+		Cert:           resp.CertPEM,
+		Chain:          resp.ChainPEM,
+	}, nil
+}
+
+// TlogEntry is the transparency log entry recorded for a signature
+type TlogEntry struct {
+	UUID           string
+	IntegratedTime int64
+	// Bundle is JSON encoded, so it can be attached to the signature as-is
+	Bundle []byte
+}
+
+// UploadToTransparencyLog records the signature over payload in the Rekor transparency log at rekorURL.
+// pemBytes is either the PEM encoded public key or signing certificate.
+func UploadToTransparencyLog(ctx context.Context, rekorURL string, payload, sig, pemBytes []byte) (*TlogEntry, error) {
+	if rekorURL == "" {
+		rekorURL = DefaultRekorURL
+	}
+
+	rClient, err := rekorclient.GetRekorClient(rekorURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create rekor client for %s: %w", rekorURL, err)
+	}
+
+	checksum := sha256.New()
+	if _, err := checksum.Write(payload); err != nil {
+		return nil, err
+	}
+
+	entry, err := cosign.TLogUpload(ctx, rClient, sig, checksum, pemBytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to upload signature to transparency log %s: %w", rekorURL, err)
+	}
+
+	leafHash, err := cosign.ComputeLeafHash(entry)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compute transparency log entry UUID: %w", err)
+	}
+
+	bundle, err := json.Marshal(cbundle.EntryToBundle(entry))
+	if err != nil {
+		return nil, err
+	}
+
+	result := &TlogEntry{
+		UUID:   hex.EncodeToString(leafHash),
+		Bundle: bundle,
+	}
+	if entry.IntegratedTime != nil {
+		result.IntegratedTime = *entry.IntegratedTime
+	}
+
+	return result, nil
+}
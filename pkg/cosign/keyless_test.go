@@ -0,0 +1,335 @@
+package cosign
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	cbundle "github.com/sigstore/cosign/v2/pkg/cosign/bundle"
+	fulcioapi "github.com/sigstore/fulcio/pkg/api"
+	"github.com/sigstore/sigstore/pkg/cryptoutils"
+	"github.com/sigstore/sigstore/pkg/signature"
+)
+
+// testIdentityToken returns an OIDC identity token with the given claims. Its signature is never verified on the
+// client side, so it doesn't have to be valid.
+func testIdentityToken(claims map[string]any) string {
+	header, _ := json.Marshal(map[string]string{"alg": "ES256", "typ": "JWT"})
+	payload, _ := json.Marshal(claims)
+	return strings.Join([]string{
+		base64.RawURLEncoding.EncodeToString(header),
+		base64.RawURLEncoding.EncodeToString(payload),
+		base64.RawURLEncoding.EncodeToString([]byte("signature")),
+	}, ".")
+}
+
+// newTestFulcio starts a fake Fulcio that issues certificates for the public key of a request if the request is made
+// with token and proves possession of the key by signing subject.
+func newTestFulcio(t *testing.T, token, subject string) *httptest.Server {
+	t.Helper()
+
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	caTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test-fulcio"},
+		NotBefore:             time.Now().Add(-time.Minute),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	caDER, err := x509.CreateCertificate(rand.Reader, caTemplate, caTemplate, caKey.Public(), caKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	caCert, err := x509.ParseCertificate(caDER)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/api/v1/signingCert" {
+			http.NotFound(w, r)
+			return
+		}
+		if r.Header.Get("Authorization") != "Bearer "+token {
+			http.Error(w, "invalid identity token", http.StatusUnauthorized)
+			return
+		}
+
+		var cr fulcioapi.CertificateRequest
+		if err := json.NewDecoder(r.Body).Decode(&cr); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		pub, err := cryptoutils.UnmarshalPEMToPublicKey(cr.PublicKey.Content)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		verifier, err := signature.LoadVerifier(pub, crypto.SHA256)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if err := verifier.VerifySignature(bytes.NewReader(cr.SignedEmailAddress), strings.NewReader(subject)); err != nil {
+			http.Error(w, "invalid proof of possession", http.StatusBadRequest)
+			return
+		}
+
+		template := &x509.Certificate{
+			SerialNumber:   big.NewInt(2),
+			NotBefore:      time.Now().Add(-time.Minute),
+			NotAfter:       time.Now().Add(10 * time.Minute),
+			EmailAddresses: []string{subject},
+			KeyUsage:       x509.KeyUsageDigitalSignature,
+			ExtKeyUsage:    []x509.ExtKeyUsage{x509.ExtKeyUsageCodeSigning},
+		}
+		der, err := x509.CreateCertificate(rand.Reader, template, caCert, pub, caKey)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("SCT", "")
+		w.WriteHeader(http.StatusCreated)
+		_ = pem.Encode(w, &pem.Block{Type: "CERTIFICATE", Bytes: der})
+		_ = pem.Encode(w, &pem.Block{Type: "CERTIFICATE", Bytes: caDER})
+	}))
+	t.Cleanup(srv.Close)
+
+	return srv
+}
+
+func TestNewKeylessSigner(t *testing.T) {
+	const subject = "me@example.com"
+	token := testIdentityToken(map[string]any{"email": subject, "email_verified": true})
+	fulcio := newTestFulcio(t, token, subject)
+
+	tests := []struct {
+		name    string
+		token   string
+		wantErr string
+	}{
+		{
+			name:  "certificate issued for the identity of the token",
+			token: token,
+		},
+		{
+			name:    "token rejected by fulcio",
+			token:   testIdentityToken(map[string]any{"email": subject, "email_verified": true, "aud": "other"}),
+			wantErr: "failed to retrieve signing certificate",
+		},
+		{
+			name:    "unverified email",
+			token:   testIdentityToken(map[string]any{"email": subject}),
+			wantErr: "failed to get OIDC identity token",
+		},
+		{
+			name:    "malformed token",
+			token:   "not-a-token",
+			wantErr: "failed to get OIDC identity token",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			signer, err := NewKeylessSigner(context.Background(), KeylessOpts{
+				FulcioURL:     fulcio.URL,
+				IdentityToken: tt.token,
+			})
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("expected error containing %q, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			certs, err := cryptoutils.UnmarshalCertificatesFromPEM(signer.Cert)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(certs) != 1 {
+				t.Fatalf("expected exactly one signing certificate, got %d", len(certs))
+			}
+			if len(certs[0].EmailAddresses) != 1 || certs[0].EmailAddresses[0] != subject {
+				t.Errorf("expected certificate to be issued to %s, got %v", subject, certs[0].EmailAddresses)
+			}
+
+			chain, err := cryptoutils.UnmarshalCertificatesFromPEM(signer.Chain)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(chain) != 1 {
+				t.Fatalf("expected the chain to contain the CA certificate, got %d certificates", len(chain))
+			}
+			if err := certs[0].CheckSignatureFrom(chain[0]); err != nil {
+				t.Errorf("signing certificate is not issued by the chain: %v", err)
+			}
+
+			pub, err := signer.PublicKey()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := cryptoutils.EqualKeys(pub, certs[0].PublicKey); err != nil {
+				t.Errorf("signing certificate is not issued for the key of the signer: %v", err)
+			}
+		})
+	}
+}
+
+// newTestRekor starts a fake Rekor that accepts hashedrekord entries for payload, sig and pemBytes and rejects any
+// other entry.
+func newTestRekor(t *testing.T, payload, sig, pemBytes []byte) *httptest.Server {
+	t.Helper()
+
+	sum := sha256.Sum256(payload)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/api/v1/log/entries" {
+			http.NotFound(w, r)
+			return
+		}
+
+		body := &bytes.Buffer{}
+		var entry struct {
+			Kind string `json:"kind"`
+			Spec struct {
+				Data struct {
+					Hash struct {
+						Algorithm string `json:"algorithm"`
+						Value     string `json:"value"`
+					} `json:"hash"`
+				} `json:"data"`
+				Signature struct {
+					Content   []byte `json:"content"`
+					PublicKey struct {
+						Content []byte `json:"content"`
+					} `json:"publicKey"`
+				} `json:"signature"`
+			} `json:"spec"`
+		}
+		if err := json.NewDecoder(io.TeeReader(r.Body, body)).Decode(&entry); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if entry.Kind != "hashedrekord" ||
+			entry.Spec.Data.Hash.Algorithm != "sha256" ||
+			entry.Spec.Data.Hash.Value != hex.EncodeToString(sum[:]) ||
+			!bytes.Equal(entry.Spec.Signature.Content, sig) ||
+			!bytes.Equal(entry.Spec.Signature.PublicKey.Content, pemBytes) {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"code":400,"message":"invalid entry"}`))
+			return
+		}
+
+		w.WriteHeader(http.StatusCreated)
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"test-uuid": map[string]any{
+				"body":           base64.StdEncoding.EncodeToString(bytes.TrimSpace(body.Bytes())),
+				"integratedTime": 1700000000,
+				"logID":          "test-log",
+				"logIndex":       42,
+				"verification": map[string]any{
+					"signedEntryTimestamp": base64.StdEncoding.EncodeToString([]byte("set")),
+				},
+			},
+		})
+	}))
+	t.Cleanup(srv.Close)
+
+	return srv
+}
+
+func TestUploadToTransparencyLog(t *testing.T) {
+	sv, _, err := signature.NewDefaultECDSASignerVerifier()
+	if err != nil {
+		t.Fatal(err)
+	}
+	pub, err := sv.PublicKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	pemBytes, err := cryptoutils.MarshalPublicKeyToPEM(pub)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	payload := []byte(`{"critical":{"image":{"docker-manifest-digest":"sha256:abc"}}}`)
+	sig, err := sv.SignMessage(bytes.NewReader(payload))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rekor := newTestRekor(t, payload, sig, pemBytes)
+
+	tests := []struct {
+		name    string
+		payload []byte
+		wantErr string
+	}{
+		{
+			name:    "entry for the signed payload",
+			payload: payload,
+		},
+		{
+			name:    "entry rejected by rekor",
+			payload: []byte("other payload"),
+			wantErr: "failed to upload signature to transparency log",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			entry, err := UploadToTransparencyLog(context.Background(), rekor.URL, tt.payload, sig, pemBytes)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("expected error containing %q, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if entry.IntegratedTime != 1700000000 {
+				t.Errorf("expected integrated time 1700000000, got %d", entry.IntegratedTime)
+			}
+			if len(entry.UUID) != sha256.Size*2 {
+				t.Errorf("expected the UUID to be the hex encoded leaf hash, got %q", entry.UUID)
+			}
+
+			var bundle cbundle.RekorBundle
+			if err := json.Unmarshal(entry.Bundle, &bundle); err != nil {
+				t.Fatal(err)
+			}
+			if bundle.Payload.LogIndex != 42 || bundle.Payload.LogID != "test-log" || bundle.Payload.IntegratedTime != 1700000000 {
+				t.Errorf("bundle does not match the log entry: %+v", bundle.Payload)
+			}
+		})
+	}
+}
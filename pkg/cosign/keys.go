@@ -14,6 +14,7 @@ import (
 	"os"
 	"path/filepath"
 	"regexp"
+	"strings"
 
 	"github.com/secure-systems-lab/go-securesystemslib/encrypted"
 	"github.com/sigstore/cosign/v2/pkg/cosign"
@@ -21,6 +22,9 @@ import (
 	"golang.org/x/crypto/ssh"
 )
 
+// ed25519PrivateKeyPemType is the PEM type some tools use for PKCS #8 encoded Ed25519 private keys
+const ed25519PrivateKeyPemType = "ED25519 PRIVATE KEY"
+
 var (
 	supportedSSHKeyAlgos = map[string]struct{}{
 		ssh.KeyAlgoRSA:      {},
@@ -31,7 +35,7 @@ var (
 	}
 
 	PublicKeyPattern  = regexp.MustCompile(`^-----BEGIN (RSA |ED25519 |ECDSA )?PUBLIC KEY-----\n(.*\n)+-----END (RSA |ED25519 |ECDSA )?PUBLIC KEY-----\s*$`)
-	PrivateKeyPattern = regexp.MustCompile(`^-----BEGIN (ENCRYPTED )?(RSA |ED25519 |ECDSA )?PRIVATE KEY-----\n(.*\n)+-----END (ENCRYPTED )?(RSA |ED25519 |ECDSA )?PRIVATE KEY-----\s*$`)
+	PrivateKeyPattern = regexp.MustCompile(`^-----BEGIN (ENCRYPTED )?(RSA |ED25519 |ECDSA |EC |OPENSSH )?PRIVATE KEY-----\n(.*\n)+-----END (ENCRYPTED )?(RSA |ED25519 |ECDSA |EC |OPENSSH )?PRIVATE KEY-----\s*$`)
 )
 
 func PemEncodeCryptoPublicKey(pubKey crypto.PublicKey) ([]byte, string, error) {
@@ -46,6 +50,42 @@ func PemEncodeCryptoPublicKey(pubKey crypto.PublicKey) ([]byte, string, error) {
 	return encoded, fingerprint, nil
 }
 
+// DERFingerprint returns the hex encoded SHA-256 hash of the DER encoded public key
+func DERFingerprint(pubKey crypto.PublicKey) (string, error) {
+	der, err := cryptoutils.MarshalPublicKeyToDER(pubKey)
+	if err != nil {
+		return "", err
+	}
+
+	hash := sha256.Sum256(der)
+	return hex.EncodeToString(hash[:]), nil
+}
+
+// MatchesFingerprint checks whether fingerprint is either the DER fingerprint of the public key, as computed by
+// openssl, or the PEM fingerprint listed by acorn image signatures
+func MatchesFingerprint(pubKey crypto.PublicKey, fingerprint string) (bool, error) {
+	fingerprint = NormalizeFingerprint(fingerprint)
+
+	derFingerprint, err := DERFingerprint(pubKey)
+	if err != nil {
+		return false, err
+	}
+	_, pemFingerprint, err := PemEncodeCryptoPublicKey(pubKey)
+	if err != nil {
+		return false, err
+	}
+
+	return fingerprint == derFingerprint || fingerprint == pemFingerprint, nil
+}
+
+// NormalizeFingerprint lowercases a hex fingerprint and strips an optional "sha256:" prefix and ":" separators,
+// so that it can be compared to the output of DERFingerprint
+func NormalizeFingerprint(fingerprint string) string {
+	fingerprint = strings.ToLower(strings.TrimSpace(fingerprint))
+	fingerprint = strings.TrimPrefix(fingerprint, "sha256:")
+	return strings.ReplaceAll(fingerprint, ":", "")
+}
+
 func PemEncodeSSHPublicKey(key ssh.PublicKey) ([]byte, error) {
 	pubKey := key.(ssh.CryptoPublicKey).CryptoPublicKey()
 	pem, _, err := PemEncodeCryptoPublicKey(pubKey)
@@ -130,16 +170,24 @@ func (k *KeysBytes) Password() []byte {
 	return k.password
 }
 
+// isRawKeyData tells whether a key reference holds the key data itself rather than a path to a key file
+func isRawKeyData(keyRef string) bool {
+	// filename too long or contains newlines
+	return len(keyRef) > 255 || strings.Contains(strings.Trim(keyRef, "\n"), "\n")
+}
+
 func ImportKeyPair(keyRef string, pass []byte) (*KeysBytes, error) {
 	pemBytes := []byte(keyRef)
 
-	finfo, err := os.Stat(keyRef)
-	if (err != nil && !os.IsNotExist(err)) || (err == nil && finfo.IsDir()) {
-		return nil, fmt.Errorf("invalid key file")
-	} else if err == nil {
-		pemBytes, err = os.ReadFile(filepath.Clean(keyRef))
-		if err != nil {
-			return nil, err
+	if !isRawKeyData(keyRef) {
+		finfo, err := os.Stat(keyRef)
+		if (err != nil && !os.IsNotExist(err)) || (err == nil && finfo.IsDir()) {
+			return nil, fmt.Errorf("invalid key file")
+		} else if err == nil {
+			pemBytes, err = os.ReadFile(filepath.Clean(keyRef))
+			if err != nil {
+				return nil, err
+			}
 		}
 	}
 
@@ -163,16 +211,17 @@ func ImportKeyPair(keyRef string, pass []byte) (*KeysBytes, error) {
 	case cosign.ECPrivateKeyPemType:
 		ecdsaPk, err := x509.ParseECPrivateKey(pemBlock.Bytes)
 		if err != nil {
-			return nil, fmt.Errorf("error parsing ecdsa private key")
+			return nil, fmt.Errorf("error parsing ecdsa private key: %w", err)
 		}
 		if err = cryptoutils.ValidatePubKey(ecdsaPk.Public()); err != nil {
 			return nil, fmt.Errorf("error validating ecdsa key: %w", err)
 		}
 		signer = ecdsaPk
-	case cosign.PrivateKeyPemType:
+	case cosign.PrivateKeyPemType, ed25519PrivateKeyPemType:
+		// Some tools label PKCS #8 encoded Ed25519 keys with their own PEM type
 		pkcs8Pk, err := x509.ParsePKCS8PrivateKey(pemBlock.Bytes)
 		if err != nil {
-			return nil, fmt.Errorf("error parsing pkcs #8 private key")
+			return nil, fmt.Errorf("error parsing pkcs #8 private key: %w", err)
 		}
 		signer, err = getSigner(pkcs8Pk)
 		if err != nil {
@@ -0,0 +1,37 @@
+package cosign
+
+import (
+	"context"
+	"crypto"
+	"fmt"
+	"strings"
+
+	"github.com/sigstore/sigstore/pkg/signature"
+	"github.com/sigstore/sigstore/pkg/signature/kms"
+
+	// Register the KMS providers that can be referenced as signing keys
+	_ "github.com/sigstore/sigstore/pkg/signature/kms/aws"
+	_ "github.com/sigstore/sigstore/pkg/signature/kms/azure"
+	_ "github.com/sigstore/sigstore/pkg/signature/kms/gcp"
+	_ "github.com/sigstore/sigstore/pkg/signature/kms/hashivault"
+)
+
+// IsKMSKeyRef returns true if keyRef uses the URI scheme of one of the registered KMS providers,
+// e.g. awskms://, gcpkms://, azurekms:// or hashivault://
+func IsKMSKeyRef(keyRef string) bool {
+	for _, scheme := range kms.SupportedProviders() {
+		if strings.HasPrefix(keyRef, scheme) {
+			return true
+		}
+	}
+	return false
+}
+
+// SignerVerifierFromKMSKeyRef loads a signer-verifier backed by a key that never leaves the KMS
+func SignerVerifierFromKMSKeyRef(ctx context.Context, keyRef string) (signature.SignerVerifier, error) {
+	sv, err := kms.Get(ctx, keyRef, crypto.SHA256)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load key %s from KMS: %w", keyRef, err)
+	}
+	return sv, nil
+}
@@ -0,0 +1,156 @@
+package cosign
+
+import (
+	"fmt"
+	"strings"
+
+	ggcrv1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/layout"
+	"github.com/google/go-containerregistry/pkg/v1/match"
+	ggcrmutate "github.com/google/go-containerregistry/pkg/v1/mutate"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/sigstore/cosign/v2/pkg/oci"
+	"github.com/sigstore/cosign/v2/pkg/oci/empty"
+)
+
+// LayoutImage is an image stored in a local OCI layout
+type LayoutImage struct {
+	Digest ggcrv1.Hash
+	// RefName is the value of the org.opencontainers.image.ref.name annotation, if any
+	RefName string
+}
+
+// FindLayoutImage finds the image to sign in the OCI layout at path. If refName is empty, the layout must contain
+// exactly one image (not counting signature and attestation tags), otherwise the image annotated with refName is used.
+func FindLayoutImage(path, refName string) (*LayoutImage, error) {
+	lp, err := layout.FromPath(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read OCI layout %s: %w", path, err)
+	}
+
+	index, err := lp.ImageIndex()
+	if err != nil {
+		return nil, err
+	}
+
+	manifest, err := index.IndexManifest()
+	if err != nil {
+		return nil, err
+	}
+
+	var candidates []LayoutImage
+	for _, desc := range manifest.Manifests {
+		ref := desc.Annotations[ocispec.AnnotationRefName]
+		if strings.HasSuffix(ref, ".sig") || strings.HasSuffix(ref, ".att") {
+			continue
+		}
+		if refName != "" && ref != refName {
+			continue
+		}
+		candidates = append(candidates, LayoutImage{Digest: desc.Digest, RefName: ref})
+	}
+
+	switch {
+	case len(candidates) == 0 && refName != "":
+		return nil, fmt.Errorf("no image with reference %s found in OCI layout %s", refName, path)
+	case len(candidates) == 0:
+		return nil, fmt.Errorf("no image found in OCI layout %s", path)
+	case len(candidates) > 1:
+		return nil, fmt.Errorf("OCI layout %s contains %d images, select one by its reference", path, len(candidates))
+	}
+
+	return &candidates[0], nil
+}
+
+// WriteLayoutSignature adds the signature to the signatures of the image with the given digest in the OCI layout at
+// path. Like in a registry, the signatures are stored as image tagged sha256-<hex>.sig, so that they can be pushed
+// together with the image. Returns the digest of the updated signatures image.
+func WriteLayoutSignature(path string, imgDigest ggcrv1.Hash, sig oci.Signature) (ggcrv1.Hash, error) {
+	lp, sigTag, sigImage, err := layoutSignaturesImage(path, imgDigest, sig)
+	if err != nil {
+		return ggcrv1.Hash{}, err
+	}
+
+	if err := lp.ReplaceImage(sigImage, match.Name(sigTag), layout.WithAnnotations(map[string]string{
+		ocispec.AnnotationRefName: sigTag,
+	})); err != nil {
+		return ggcrv1.Hash{}, fmt.Errorf("failed to write signature to OCI layout %s: %w", path, err)
+	}
+
+	return sigImage.Digest()
+}
+
+// LayoutSignatureDigest returns the digest the signatures image would have after WriteLayoutSignature, without
+// changing the OCI layout at path
+func LayoutSignatureDigest(path string, imgDigest ggcrv1.Hash, sig oci.Signature) (ggcrv1.Hash, error) {
+	_, _, sigImage, err := layoutSignaturesImage(path, imgDigest, sig)
+	if err != nil {
+		return ggcrv1.Hash{}, err
+	}
+	return sigImage.Digest()
+}
+
+// layoutSignaturesImage returns the signatures image of the image with the given digest in the OCI layout at path with
+// the signature added, along with the layout and the tag the signatures image is stored as
+func layoutSignaturesImage(path string, imgDigest ggcrv1.Hash, sig oci.Signature) (layout.Path, string, ggcrv1.Image, error) {
+	lp, err := layout.FromPath(path)
+	if err != nil {
+		return "", "", nil, fmt.Errorf("failed to read OCI layout %s: %w", path, err)
+	}
+
+	// same as the tag cosign uses in registries (see ociremote.SignatureTag)
+	sigTag := fmt.Sprintf("%s-%s.sig", imgDigest.Algorithm, imgDigest.Hex)
+
+	var base ggcrv1.Image = empty.Signatures()
+	existing, err := lp.ImageIndex()
+	if err != nil {
+		return "", "", nil, err
+	}
+	images, err := layoutImages(existing, match.Name(sigTag))
+	if err != nil {
+		return "", "", nil, err
+	}
+	if len(images) > 0 {
+		base = images[0]
+	}
+
+	annotations, err := sig.Annotations()
+	if err != nil {
+		return "", "", nil, err
+	}
+	mediaType, err := sig.MediaType()
+	if err != nil {
+		return "", "", nil, err
+	}
+
+	sigImage, err := ggcrmutate.Append(base, ggcrmutate.Addendum{
+		Layer:       sig,
+		Annotations: annotations,
+		MediaType:   mediaType,
+	})
+	if err != nil {
+		return "", "", nil, err
+	}
+
+	return lp, sigTag, sigImage, nil
+}
+
+func layoutImages(index ggcrv1.ImageIndex, matcher match.Matcher) ([]ggcrv1.Image, error) {
+	manifest, err := index.IndexManifest()
+	if err != nil {
+		return nil, err
+	}
+
+	var result []ggcrv1.Image
+	for _, desc := range manifest.Manifests {
+		if !matcher(desc) {
+			continue
+		}
+		img, err := index.Image(desc.Digest)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, img)
+	}
+	return result, nil
+}
@@ -0,0 +1,128 @@
+package cosign
+
+import (
+	"testing"
+
+	ggcrv1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/layout"
+	"github.com/google/go-containerregistry/pkg/v1/match"
+	"github.com/google/go-containerregistry/pkg/v1/random"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/sigstore/cosign/v2/pkg/oci/static"
+)
+
+func writeTestLayout(t *testing.T, refNames ...string) (string, []ggcrv1.Hash) {
+	t.Helper()
+
+	path := t.TempDir()
+	lp, err := layout.Write(path, empty.Index)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var digests []ggcrv1.Hash
+	for _, refName := range refNames {
+		img, err := random.Image(64, 1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := lp.AppendImage(img, layout.WithAnnotations(map[string]string{ocispec.AnnotationRefName: refName})); err != nil {
+			t.Fatal(err)
+		}
+		digest, err := img.Digest()
+		if err != nil {
+			t.Fatal(err)
+		}
+		digests = append(digests, digest)
+	}
+
+	return path, digests
+}
+
+func TestFindLayoutImage(t *testing.T) {
+	single, singleDigests := writeTestLayout(t, "ghcr.io/acme/app:v1")
+	multi, multiDigests := writeTestLayout(t, "v1", "v2")
+
+	img, err := FindLayoutImage(single, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if img.Digest != singleDigests[0] || img.RefName != "ghcr.io/acme/app:v1" {
+		t.Errorf("unexpected image %v", img)
+	}
+
+	if _, err := FindLayoutImage(multi, ""); err == nil {
+		t.Errorf("expected an error for a layout with multiple images and no reference")
+	}
+
+	img, err = FindLayoutImage(multi, "v2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if img.Digest != multiDigests[1] {
+		t.Errorf("expected digest %s, got %s", multiDigests[1], img.Digest)
+	}
+
+	if _, err := FindLayoutImage(multi, "v3"); err == nil {
+		t.Errorf("expected an error for an unknown reference")
+	}
+}
+
+func TestWriteLayoutSignature(t *testing.T) {
+	path, digests := writeTestLayout(t, "ghcr.io/acme/app:v1")
+
+	for _, payload := range []string{"first", "second"} {
+		sig, err := static.NewSignature([]byte(payload), "c2lnbmF0dXJl")
+		if err != nil {
+			t.Fatal(err)
+		}
+		// A dry run must report the digest the signatures image gets once written
+		expected, err := LayoutSignatureDigest(path, digests[0], sig)
+		if err != nil {
+			t.Fatal(err)
+		}
+		actual, err := WriteLayoutSignature(path, digests[0], sig)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if actual != expected {
+			t.Errorf("expected signatures digest %s, got %s", expected, actual)
+		}
+	}
+
+	// The signature tag must not be picked as image to sign
+	img, err := FindLayoutImage(path, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if img.Digest != digests[0] {
+		t.Errorf("expected digest %s, got %s", digests[0], img.Digest)
+	}
+
+	lp, err := layout.FromPath(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	index, err := lp.ImageIndex()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sigTag := digests[0].Algorithm + "-" + digests[0].Hex + ".sig"
+	images, err := layoutImages(index, match.Name(sigTag))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(images) != 1 {
+		t.Fatalf("expected a single signature image tagged %s, got %d", sigTag, len(images))
+	}
+
+	layers, err := images[0].Layers()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(layers) != 2 {
+		t.Errorf("expected 2 signatures, got %d", len(layers))
+	}
+}
@@ -0,0 +1,66 @@
+package cosign
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/sigstore/cosign/v2/pkg/cosign"
+	cosignature "github.com/sigstore/cosign/v2/pkg/signature"
+	"github.com/sigstore/sigstore/pkg/signature"
+	"github.com/sirupsen/logrus"
+)
+
+// SignerFromKey gets a signer-verifier from a private key, given either as a path to a key file or as raw key data.
+// Keys that Cosign doesn't support natively (e.g. OpenSSH keys) are imported first.
+func SignerFromKey(ctx context.Context, keyRef string, pass []byte) (signature.SignerVerifier, error) {
+	pf := func(_ bool) ([]byte, error) {
+		return pass, nil
+	}
+
+	var (
+		sigSigner signature.SignerVerifier
+		err       error
+	)
+
+	if isRawKeyData(keyRef) {
+		// Not a file - load from raw key data
+		sigSigner, err = cosign.LoadPrivateKey([]byte(keyRef), pass)
+	} else {
+		var finfo os.FileInfo
+		finfo, err = os.Stat(keyRef)
+		if err != nil {
+			if os.IsNotExist(err) || strings.Contains(keyRef, "\n") {
+				// Not a file - load from raw key data
+				sigSigner, err = cosign.LoadPrivateKey([]byte(keyRef), pass)
+			} else {
+				return nil, fmt.Errorf("failed to stat key file: %w", err)
+			}
+		} else {
+			if finfo.IsDir() {
+				return nil, fmt.Errorf("invalid key file: is directory")
+			}
+			// Load from file
+			sigSigner, err = cosignature.SignerVerifierFromKeyRef(ctx, keyRef, pf)
+		}
+	}
+
+	if err != nil {
+		if !strings.Contains(err.Error(), "unsupported pem type") {
+			return nil, fmt.Errorf("failed to create signer from private key: %w", err)
+		}
+		// don't log the key reference, it may be raw key data
+		logrus.Debugln("Key is not a supported PEM key, importing...")
+		keyBytes, err := ImportKeyPair(keyRef, pass)
+		if err != nil {
+			return nil, fmt.Errorf("failed to import private key: %w", err)
+		}
+		sigSigner, err = cosign.LoadPrivateKey(keyBytes.PrivateBytes, keyBytes.Password())
+		if err != nil {
+			return nil, fmt.Errorf("failed to create signer from imported private key: %w", err)
+		}
+	}
+
+	return sigSigner, nil
+}
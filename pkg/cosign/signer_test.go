@@ -0,0 +1,196 @@
+package cosign
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"golang.org/x/crypto/ssh"
+)
+
+func TestSignerFromKey(t *testing.T) {
+	rawKey, err := os.ReadFile("testdata/keys/openssh-rsa-nopw.key")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// A path below a regular file makes stat fail with ENOTDIR rather than ENOENT
+	notADir := filepath.Join(t.TempDir(), "file")
+	if err := os.WriteFile(notADir, nil, 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	testcases := []struct {
+		name    string
+		key     string
+		wantErr string
+	}{
+		{
+			name: "key file",
+			key:  "testdata/keys/openssh-rsa-nopw.key",
+		},
+		{
+			name: "raw PEM with newlines",
+			key:  string(rawKey),
+		},
+		{
+			name: "raw PEM with surrounding newlines",
+			key:  "\n" + string(rawKey) + "\n",
+		},
+		{
+			name:    "directory",
+			key:     "testdata/keys",
+			wantErr: "is directory",
+		},
+		{
+			name:    "nonexistent file",
+			key:     filepath.Join(t.TempDir(), "does-not-exist.key"),
+			wantErr: "failed to create signer from private key",
+		},
+		{
+			name:    "stat error on key with newline falls back to raw key data",
+			key:     filepath.Join(notADir, "key") + "\n",
+			wantErr: "failed to create signer from private key",
+		},
+		{
+			name:    "stat error on key file",
+			key:     filepath.Join(notADir, "key"),
+			wantErr: "failed to stat key file",
+		},
+		{
+			name:    "filename too long",
+			key:     strings.Repeat("a", 256) + ".key",
+			wantErr: "failed to create signer from private key",
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			sv, err := SignerFromKey(context.Background(), tc.key, nil)
+			if tc.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+					t.Errorf("SignerFromKey() error = %v, want error containing %q", err, tc.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("SignerFromKey() errored where it should not: %v", err)
+			}
+			if _, err := sv.PublicKey(); err != nil {
+				t.Errorf("failed to get public key from signer: %v", err)
+			}
+		})
+	}
+}
+
+func TestSignerFromKeyTypes(t *testing.T) {
+	_, ed25519Key, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ecdsaKeys := map[string]*ecdsa.PrivateKey{}
+	for name, curve := range map[string]elliptic.Curve{"P-256": elliptic.P256(), "P-384": elliptic.P384(), "P-521": elliptic.P521()} {
+		if ecdsaKeys[name], err = ecdsa.GenerateKey(curve, rand.Reader); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	pemEncode := func(pemType string, der []byte, err error) []byte {
+		if err != nil {
+			t.Fatal(err)
+		}
+		return pem.EncodeToMemory(&pem.Block{Type: pemType, Bytes: der})
+	}
+	openSSH := func(key crypto.PrivateKey) []byte {
+		block, err := ssh.MarshalPrivateKey(key, "")
+		if err != nil {
+			t.Fatal(err)
+		}
+		return pem.EncodeToMemory(block)
+	}
+
+	type testcase struct {
+		name   string
+		key    []byte
+		public crypto.PublicKey
+	}
+
+	rsaPKCS8, err := x509.MarshalPKCS8PrivateKey(rsaKey)
+	ed25519PKCS8, err2 := x509.MarshalPKCS8PrivateKey(ed25519Key)
+	testcases := []testcase{
+		{name: "RSA PKCS #1", key: pemEncode("RSA PRIVATE KEY", x509.MarshalPKCS1PrivateKey(rsaKey), nil), public: rsaKey.Public()},
+		{name: "RSA PKCS #8", key: pemEncode("PRIVATE KEY", rsaPKCS8, err), public: rsaKey.Public()},
+		{name: "Ed25519 PKCS #8", key: pemEncode("PRIVATE KEY", ed25519PKCS8, err2), public: ed25519Key.Public()},
+		{name: "Ed25519 PKCS #8 with ED25519 PEM type", key: pemEncode("ED25519 PRIVATE KEY", ed25519PKCS8, err2), public: ed25519Key.Public()},
+		{name: "Ed25519 OpenSSH", key: openSSH(ed25519Key), public: ed25519Key.Public()},
+	}
+	for _, curve := range []string{"P-256", "P-384", "P-521"} {
+		key := ecdsaKeys[curve]
+		sec1, err := x509.MarshalECPrivateKey(key)
+		pkcs8, err2 := x509.MarshalPKCS8PrivateKey(key)
+		testcases = append(testcases,
+			testcase{name: "ECDSA " + curve + " SEC 1", key: pemEncode("EC PRIVATE KEY", sec1, err), public: key.Public()},
+			testcase{name: "ECDSA " + curve + " PKCS #8", key: pemEncode("PRIVATE KEY", pkcs8, err2), public: key.Public()},
+			testcase{name: "ECDSA " + curve + " OpenSSH", key: openSSH(key), public: key.Public()},
+		)
+	}
+
+	digest := []byte("dummy digest")
+
+	for _, tc := range testcases {
+		keyFile := filepath.Join(t.TempDir(), "key")
+		if err := os.WriteFile(keyFile, tc.key, 0600); err != nil {
+			t.Fatal(err)
+		}
+
+		for keyRefType, keyRef := range map[string]string{"raw": string(tc.key), "file": keyFile} {
+			t.Run(tc.name+" "+keyRefType, func(t *testing.T) {
+				sv, err := SignerFromKey(context.Background(), keyRef, nil)
+				if err != nil {
+					t.Fatalf("SignerFromKey() errored where it should not: %v", err)
+				}
+
+				sig, err := sv.SignMessage(bytes.NewReader(digest))
+				if err != nil {
+					t.Fatalf("failed to sign: %v", err)
+				}
+
+				pubKey, err := sv.PublicKey()
+				if err != nil {
+					t.Fatalf("failed to get public key from signer: %v", err)
+				}
+				if !tc.public.(interface{ Equal(crypto.PublicKey) bool }).Equal(pubKey) {
+					t.Errorf("public key of signer does not match the private key")
+				}
+
+				// The exported public key has to verify the signature
+				pubPEM, _, err := PemEncodeCryptoPublicKey(pubKey)
+				if err != nil {
+					t.Fatalf("failed to PEM encode public key: %v", err)
+				}
+				verifier, err := DecodePEM(pubPEM, crypto.SHA256)
+				if err != nil {
+					t.Fatalf("failed to load exported public key: %v", err)
+				}
+				if err := verifier.VerifySignature(bytes.NewReader(sig), bytes.NewReader(digest)); err != nil {
+					t.Errorf("exported public key does not verify the signature: %v", err)
+				}
+			})
+		}
+	}
+}
@@ -2,6 +2,9 @@ package cosign
 
 const (
 	SignatureAnnotationSignedName = "acorn.io/signed-name" // If an image was signed by `acorn image sign foo/bar:v1`, this annotation should be set to `foo/bar:v1` (the payload usually only includes the image digest)
+	SignatureAnnotationGitCommit  = "acorn.io/git-commit"  // Set by `acorn image sign --annotation-from-git` to the commit the image was built from
+	SignatureAnnotationGitBranch  = "acorn.io/git-branch"  // Set by `acorn image sign --annotation-from-git` to the branch the image was built from
+	SignatureAnnotationBuildURL   = "acorn.io/build-url"   // Set by `acorn image sign --annotation-from-git` to the URL of the CI build that built the image
 )
 
 func GetDefaultSignatureAnnotations(imageName string) map[string]interface{} {
@@ -2,6 +2,7 @@ package crds
 
 import (
 	"context"
+	"sort"
 
 	"github.com/acorn-io/baaah/pkg/apply"
 	"github.com/acorn-io/baaah/pkg/restconfig"
@@ -14,33 +15,54 @@ import (
 	kclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
-func Create(ctx context.Context, scheme *runtime.Scheme, gvs ...schema.GroupVersion) error {
-	var schemerCRDs []crd.CRD
-
+// Kinds returns the kinds of the group versions a CRD is created for, which are the kinds of all objects but lists
+func Kinds(scheme *runtime.Scheme, gvs ...schema.GroupVersion) (result []schema.GroupVersionKind, _ error) {
 	for _, gv := range gvs {
 		for kind := range scheme.KnownTypes(gv) {
 			gvk := gv.WithKind(kind)
 			obj, err := scheme.New(gvk)
 			if err != nil {
-				return err
+				return nil, err
 			}
 			_, isObj := obj.(kclient.Object)
 			_, isListObj := obj.(kclient.ObjectList)
 
 			if isObj && !isListObj {
-				var nonNamespaced bool
-				if o, ok := obj.(strategy.NamespaceScoper); ok {
-					nonNamespaced = !o.NamespaceScoped()
-				}
-				schemerCRDs = append(schemerCRDs, crd.CRD{
-					GVK:          gvk,
-					SchemaObject: obj,
-					Status:       true,
-					NonNamespace: nonNamespaced,
-				}.WithColumnsFromStruct(obj))
+				result = append(result, gvk)
 			}
 		}
 	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].String() < result[j].String()
+	})
+	return result, nil
+}
+
+func Create(ctx context.Context, scheme *runtime.Scheme, gvs ...schema.GroupVersion) error {
+	var schemerCRDs []crd.CRD
+
+	gvks, err := Kinds(scheme, gvs...)
+	if err != nil {
+		return err
+	}
+
+	for _, gvk := range gvks {
+		obj, err := scheme.New(gvk)
+		if err != nil {
+			return err
+		}
+
+		var nonNamespaced bool
+		if o, ok := obj.(strategy.NamespaceScoper); ok {
+			nonNamespaced = !o.NamespaceScoped()
+		}
+		schemerCRDs = append(schemerCRDs, crd.CRD{
+			GVK:          gvk,
+			SchemaObject: obj,
+			Status:       true,
+			NonNamespace: nonNamespaced,
+		}.WithColumnsFromStruct(obj))
+	}
 
 	restConfig, err := restconfig.New(scheme)
 	if err != nil {
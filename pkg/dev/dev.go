@@ -51,6 +51,9 @@ type Options struct {
 	Replace           bool
 	Dangerous         bool
 	BidirectionalSync bool
+	Exclude           []string
+	NoRebuild         bool
+	RestartCmd        string
 	TimeoutSeconds    int32
 	ReleaseOnExit     *bool
 	Logger            Logger
@@ -87,6 +90,8 @@ func (o *Options) complete(ctx context.Context, c client.Client) *Options {
 type watcher struct {
 	c               client.Client
 	imageAndArgs    imagesource.ImageSource
+	exclude         []string
+	noRebuild       bool
 	trigger         chan struct{}
 	dynamicWatching []string
 	watching        []string
@@ -120,7 +125,24 @@ func (w *watcher) readFiles(ctx context.Context) ([]string, error) {
 	if err != nil {
 		return nil, err
 	}
-	return append(files, w.dynamicWatching...), nil
+
+	cwd, buildFile, err := w.imageAndArgs.ResolveImageAndFile()
+	if err != nil {
+		return nil, err
+	}
+	if w.noRebuild {
+		buildContexts, err := w.imageAndArgs.BuildContexts(ctx, w.c)
+		if err != nil {
+			return nil, err
+		}
+		files = rebuildFiles(buildFile, files, buildContexts)
+	}
+	files = append(files, w.dynamicWatching...)
+	exclude, err := readExcludes(cwd, w.exclude)
+	if err != nil {
+		return nil, err
+	}
+	return dropExcluded(cwd, exclude, files)
 }
 
 func (w *watcher) foundChanges() bool {
@@ -231,6 +253,8 @@ func buildLoop(ctx context.Context, c client.Client, hash clientHash, opts *Opti
 			trigger:      make(chan struct{}, 1),
 			watchingTS:   make([]time.Time, 1),
 			imageAndArgs: opts.ImageSource,
+			exclude:      opts.Exclude,
+			noRebuild:    opts.NoRebuild,
 			logger:       opts.Logger,
 		}
 		startLock sync.Mutex
@@ -529,25 +553,22 @@ func PrintAppStatus(app *apiv1.App, logger AppStatusLogger) {
 }
 
 func AppStatusLoop(ctx context.Context, c client.Client, logger AppStatusLogger, appName string) error {
-	wc, err := c.GetClient()
+	apps, err := c.AppStatusStream(ctx, appName)
 	if err != nil {
 		return err
 	}
-	w := objwatcher.New[*apiv1.App](wc)
 	msg, ready := "", false
-	_, err = w.ByName(ctx, c.GetNamespace(), appName, func(app *apiv1.App) (bool, error) {
-		newMsg, newReady := appStatusMessage(app)
+	// The stream ends when the context is canceled or the app is deleted
+	for app := range apps {
+		newMsg, newReady := appStatusMessage(&app)
 		logrus.Debugf("app status loop %s/%s rev=%s, generation=%d, observed=%d: newMsg=%s, newReady=%v", app.Namespace, app.Name,
 			app.ResourceVersion, app.Generation, app.Status.ObservedGeneration, newMsg, newReady)
 		if newMsg != msg || newReady != ready {
-			PrintAppStatus(app, logger)
+			PrintAppStatus(&app, logger)
 		}
 		msg, ready = newMsg, newReady
-
-		// Return false because the context will be canceled when this check should stop.
-		return false, nil
-	})
-	return err
+	}
+	return ctx.Err()
 }
 
 func LogLoop(ctx context.Context, c client.Client, appName string, opts *client.LogOptions) error {
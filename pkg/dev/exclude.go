@@ -0,0 +1,91 @@
+package dev
+
+import (
+	"bufio"
+	"errors"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/loft-sh/devspace/helper/server/ignoreparser"
+	logpkg "github.com/loft-sh/devspace/pkg/util/log"
+)
+
+// acornIgnoreFile lists patterns in gitignore syntax of the files in the build directory that are neither watched
+// nor synced in dev mode
+const acornIgnoreFile = ".acornignore"
+
+// readExcludes returns the patterns of the .acornignore file in cwd, if there is one, followed by the ones passed
+// with --exclude. The patterns are relative to cwd.
+func readExcludes(cwd string, exclude []string) ([]string, error) {
+	f, err := os.Open(filepath.Join(cwd, acornIgnoreFile))
+	if errors.Is(err, fs.ErrNotExist) {
+		return exclude, nil
+	} else if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var result []string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		result = append(result, line)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return append(result, exclude...), nil
+}
+
+// dropExcluded returns the files that aren't matched by the exclude patterns. Files outside of cwd are never excluded.
+func dropExcluded(cwd string, patterns, files []string) ([]string, error) {
+	matcher, err := ignoreparser.CompilePaths(patterns, logpkg.Discard)
+	if err != nil || matcher == nil {
+		return files, err
+	}
+
+	var result []string
+	for _, file := range files {
+		rel, err := filepath.Rel(cwd, file)
+		if err == nil && rel != "." && !strings.HasPrefix(rel, "..") {
+			s, err := os.Stat(file)
+			if matcher.Matches(filepath.ToSlash(rel), err == nil && s.IsDir()) {
+				continue
+			}
+		}
+		result = append(result, file)
+	}
+	return result, nil
+}
+
+// excludesForDir rebases the exclude patterns, which are relative to cwd, onto dir, a directory in cwd that's synced
+// on its own. Patterns that match at any depth are kept as they are, patterns anchored to a path outside of dir are
+// dropped.
+func excludesForDir(patterns []string, dir string) (result []string) {
+	dir = path.Clean(filepath.ToSlash(dir))
+	for _, pattern := range patterns {
+		negate := strings.HasPrefix(pattern, "!")
+		p := strings.TrimPrefix(pattern, "!")
+
+		// A pattern is anchored if it contains a slash anywhere but at its end
+		if dir != "." && strings.Contains(strings.TrimSuffix(p, "/"), "/") && !strings.HasPrefix(p, "**/") {
+			rel, ok := strings.CutPrefix(strings.TrimPrefix(p, "/"), dir+"/")
+			if !ok {
+				continue
+			}
+			p = "/" + rel
+		}
+
+		if negate {
+			p = "!" + p
+		}
+		result = append(result, p)
+	}
+	return
+}
@@ -0,0 +1,59 @@
+package dev
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadExcludes(t *testing.T) {
+	cwd := t.TempDir()
+
+	exclude, err := readExcludes(cwd, []string{"dist"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"dist"}, exclude)
+
+	require.NoError(t, os.WriteFile(filepath.Join(cwd, acornIgnoreFile), []byte("# dependencies\nnode_modules\n\n.git/\n"), 0644))
+	exclude, err = readExcludes(cwd, []string{"dist"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"node_modules", ".git/", "dist"}, exclude)
+}
+
+func TestDropExcluded(t *testing.T) {
+	cwd := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(cwd, "web", "node_modules", "lib"), 0755))
+
+	files := []string{
+		filepath.Join(cwd, "Acornfile"),
+		filepath.Join(cwd, "web", "node_modules", "lib", "index.js"),
+		filepath.Join(cwd, "web", "dist", "app.js"),
+		filepath.Join(cwd, "dist", "app.js"),
+		filepath.Join(cwd, "web", "keep.log"),
+		filepath.Join(cwd, "web", "server.log"),
+		filepath.Join(filepath.Dir(cwd), "node_modules", "outside.js"),
+	}
+
+	result, err := dropExcluded(cwd, []string{"node_modules", "/dist", "*.log", "!keep.log"}, files)
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		filepath.Join(cwd, "Acornfile"),
+		filepath.Join(cwd, "web", "dist", "app.js"),
+		filepath.Join(cwd, "web", "keep.log"),
+		filepath.Join(filepath.Dir(cwd), "node_modules", "outside.js"),
+	}, result)
+
+	result, err = dropExcluded(cwd, nil, files)
+	require.NoError(t, err)
+	assert.Equal(t, files, result)
+}
+
+func TestExcludesForDir(t *testing.T) {
+	patterns := []string{"node_modules", "build/", "/dist", "web/tmp/", "!web/tmp/keep", "api/cache", "**/*.log"}
+
+	assert.Equal(t, patterns, excludesForDir(patterns, "."))
+	assert.Equal(t, []string{"node_modules", "build/", "/tmp/", "!/tmp/keep", "**/*.log"}, excludesForDir(patterns, "web"))
+	assert.Equal(t, []string{"node_modules", "build/", "/tmp/", "!/tmp/keep", "**/*.log"}, excludesForDir(patterns, "./web/"))
+}
@@ -0,0 +1,44 @@
+package dev
+
+import (
+	"path/filepath"
+)
+
+// dependencyManifests are the files declaring the dependencies of interpreted apps. The dependencies are installed
+// when the image is built, so with --no-rebuild a change to them still rebuilds the image.
+var dependencyManifests = []string{
+	"package.json",
+	"package-lock.json",
+	"yarn.lock",
+	"pnpm-lock.yaml",
+	"requirements.txt",
+	"Pipfile",
+	"Pipfile.lock",
+	"pyproject.toml",
+	"poetry.lock",
+	"Gemfile",
+	"Gemfile.lock",
+	"composer.json",
+	"composer.lock",
+}
+
+// rebuildFiles returns the files that rebuild the image with --no-rebuild when they change: the Acornfiles of the app
+// and the dependency manifests in the build contexts. Changes to any other file, like a Dockerfile, are only synced.
+func rebuildFiles(buildFile string, watchFiles, buildContexts []string) (result []string) {
+	for _, file := range watchFiles {
+		if file == buildFile || filepath.Dir(file) == buildFile || isAcornfile(file) {
+			result = append(result, file)
+		}
+	}
+	for _, dir := range buildContexts {
+		for _, manifest := range dependencyManifests {
+			result = append(result, filepath.Join(dir, manifest))
+		}
+	}
+	return
+}
+
+func isAcornfile(file string) bool {
+	base := filepath.Base(file)
+	return base == "Acornfile" || filepath.Ext(base) == ".acorn"
+}
@@ -0,0 +1,32 @@
+package dev
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRebuildFiles(t *testing.T) {
+	files := rebuildFiles(filepath.Join("app", "Acornfile"), []string{
+		filepath.Join("app", ".dockerignore"),
+		filepath.Join("app", "Acornfile"),
+		filepath.Join("app", "Dockerfile"),
+		filepath.Join("app", "nested", "Acornfile"),
+		filepath.Join("app", "fn", "build.acorn"),
+	}, []string{filepath.Join("app", "web")})
+
+	assert.Equal(t, filepath.Join("app", "Acornfile"), files[0])
+	assert.Equal(t, filepath.Join("app", "nested", "Acornfile"), files[1])
+	assert.Equal(t, filepath.Join("app", "fn", "build.acorn"), files[2])
+	assert.Contains(t, files, filepath.Join("app", "web", "package.json"))
+	assert.Contains(t, files, filepath.Join("app", "web", "requirements.txt"))
+	assert.NotContains(t, files, filepath.Join("app", "Dockerfile"))
+	assert.Len(t, files, 3+len(dependencyManifests))
+
+	files = rebuildFiles(filepath.Join("app", "Acorndir"), []string{
+		filepath.Join("app", "Acorndir"),
+		filepath.Join("app", "Acorndir", "containers.cue"),
+	}, nil)
+	assert.Equal(t, []string{filepath.Join("app", "Acorndir"), filepath.Join("app", "Acorndir", "containers.cue")}, files)
+}
@@ -52,6 +52,11 @@ func containerSync(ctx context.Context, client client.Client, logger Logger, app
 		return nil
 	}
 
+	exclude, err := readExcludes(cwd, opts.Exclude)
+	if err != nil {
+		return err
+	}
+
 	syncLock := sync2.Mutex{}
 	syncing := map[string]bool{}
 	wc, err := client.GetClient()
@@ -76,7 +81,7 @@ func containerSync(ctx context.Context, client client.Client, logger Logger, app
 					mount     = mount
 				)
 				go func() {
-					startSyncForPath(ctx, client, logger, con, cwd, mount.ContextDir, remoteDir, opts.BidirectionalSync)
+					startSyncForPath(ctx, client, logger, con, cwd, mount.ContextDir, remoteDir, excludesForDir(exclude, mount.ContextDir), opts)
 					syncLock.Lock()
 					delete(syncing, con.Name)
 					syncLock.Unlock()
@@ -114,7 +119,7 @@ func findDockerIgnore(path string) (string, error) {
 	}
 }
 
-func invokeStartSyncForPath(ctx context.Context, client client.Client, logger Logger, con *apiv1.ContainerReplica, cwd, localDir, remoteDir string, bidirectional bool) (chan struct{}, chan error, error) {
+func invokeStartSyncForPath(ctx context.Context, client client.Client, logger Logger, con *apiv1.ContainerReplica, cwd, localDir, remoteDir string, excludePaths []string, opts *Options) (chan struct{}, chan error, error) {
 	source := filepath.Join(cwd, localDir)
 	if s, err := os.Stat(source); err == nil && !s.IsDir() {
 		return nil, nil, nil
@@ -143,15 +148,22 @@ func invokeStartSyncForPath(ctx context.Context, client client.Client, logger Lo
 		logrus.Warnf("failed to open %s for syncing: %v", filepath.Join(cwd, ".dockerignore"), err)
 		exclude = nil
 	}
-	s, err := sync.NewSync(ctx, source, sync.Options{
-		DownstreamDisabled: !bidirectional,
+	syncOpts := sync.Options{
+		DownstreamDisabled: !opts.BidirectionalSync,
 		Polling:            true,
 		Verbose:            true,
 		UploadExcludePaths: exclude,
+		ExcludePaths:       excludePaths,
 		InitialSync:        latest.InitialSyncStrategyPreferLocal,
 		Log: newLogger(logger, con).
 			WithPrefix("(sync): "),
-	})
+	}
+	if opts.RestartCmd != "" {
+		// The command is run in the container after every batch of changes was uploaded
+		syncOpts.UploadBatchCmd = "sh"
+		syncOpts.UploadBatchArgs = []string{"-c", opts.RestartCmd}
+	}
+	s, err := sync.NewSync(ctx, source, syncOpts)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -219,7 +231,7 @@ func (i *ignore) Write(p []byte) (n int, err error) {
 	return i.Out.Write(p)
 }
 
-func startSyncForPath(ctx context.Context, client client.Client, logger Logger, con *apiv1.ContainerReplica, cwd, localDir, remoteDir string, bidirectional bool) {
+func startSyncForPath(ctx context.Context, client client.Client, logger Logger, con *apiv1.ContainerReplica, cwd, localDir, remoteDir string, excludePaths []string, opts *Options) {
 	for {
 		var (
 			wait    <-chan struct{}
@@ -231,7 +243,7 @@ func startSyncForPath(ctx context.Context, client client.Client, logger Logger,
 			return
 		}
 		if err == nil {
-			wait, waiterr, err = invokeStartSyncForPath(ctx, client, logger, con, cwd, localDir, remoteDir, bidirectional)
+			wait, waiterr, err = invokeStartSyncForPath(ctx, client, logger, con, cwd, localDir, remoteDir, excludePaths, opts)
 		}
 
 		if err == nil {
@@ -0,0 +1,41 @@
+package nacl
+
+import (
+	"crypto/ecdh"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+)
+
+// X25519PublicKey returns the base64 encoded public key of a project as the X25519 key it is, so it can be PEM
+// encoded in the format PublicKeyFromPEM reads.
+func X25519PublicKey(publicKey string) (*ecdh.PublicKey, error) {
+	keyBytes, err := base64.RawURLEncoding.DecodeString(publicKey)
+	if err != nil {
+		return nil, err
+	}
+	return ecdh.X25519().NewPublicKey(keyBytes)
+}
+
+// PublicKeyFromPEM returns the PEM encoded X25519 public key as the base64 encoded key Encrypt takes. NaCl boxes are
+// sealed with X25519 keys, so keys of other types, like signing keys, are rejected.
+func PublicKeyFromPEM(pemBytes []byte) (string, error) {
+	block, _ := pem.Decode(pemBytes)
+	if block == nil || block.Type != "PUBLIC KEY" {
+		return "", fmt.Errorf("invalid public key: no PEM encoded PUBLIC KEY block found")
+	}
+
+	pub, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return "", fmt.Errorf("invalid public key: %w", err)
+	}
+	key, ok := pub.(*ecdh.PublicKey)
+	if !ok || key.Curve() != ecdh.X25519() {
+		return "", fmt.Errorf("invalid public key: expected an X25519 key, got %T", pub)
+	}
+
+	keyBytes := &[32]byte{}
+	copy(keyBytes[:], key.Bytes())
+	return KeyBytesToB64String(keyBytes), nil
+}
@@ -0,0 +1,53 @@
+package nacl
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/nacl/box"
+)
+
+func TestPublicKeyPEMRoundTrip(t *testing.T) {
+	publicKey, privateKey, err := box.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	key, err := X25519PublicKey(KeyBytesToB64String(publicKey))
+	require.NoError(t, err)
+	encoded, err := x509.MarshalPKIXPublicKey(key)
+	require.NoError(t, err)
+	encoded = pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: encoded})
+	assert.Contains(t, string(encoded), "-----BEGIN PUBLIC KEY-----")
+
+	b64Key, err := PublicKeyFromPEM(encoded)
+	require.NoError(t, err)
+	assert.Equal(t, KeyBytesToB64String(publicKey), b64Key)
+
+	encData, err := Encrypt("secret value", b64Key)
+	require.NoError(t, err)
+	data, err := encData.Marshal()
+	require.NoError(t, err)
+
+	decrypted, err := (&NaclKey{PublicKey: publicKey, privateKey: privateKey}).Decrypt([]byte(data))
+	require.NoError(t, err)
+	assert.Equal(t, "secret value", string(decrypted))
+}
+
+func TestPublicKeyFromPEMRejectsSigningKeys(t *testing.T) {
+	signingKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	encoded, err := x509.MarshalPKIXPublicKey(signingKey.Public())
+	require.NoError(t, err)
+	encoded = pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: encoded})
+
+	_, err = PublicKeyFromPEM(encoded)
+	assert.EqualError(t, err, "invalid public key: expected an X25519 key, got *ecdsa.PublicKey")
+
+	_, err = PublicKeyFromPEM([]byte("not a key"))
+	assert.EqualError(t, err, "invalid public key: no PEM encoded PUBLIC KEY block found")
+}
@@ -49,7 +49,11 @@ type GetImageDetailsOptions struct {
 	Nested        string
 	NoDefaultReg  bool
 	IncludeNested bool
-	RemoteOpts    []remote.Option
+	// ListImages lists the images contained in the app image, and in its nested images if IncludeNested is set
+	ListImages bool
+	// Local only resolves the image, and its nested images, from the local image store, never from a registry
+	Local      bool
+	RemoteOpts []remote.Option
 }
 
 func GetImageDetails(ctx context.Context, c kclient.Client, namespace, imageName string, opts GetImageDetailsOptions) (*apiv1.ImageDetails, error) {
@@ -92,6 +96,8 @@ func getImageDetails(ctx context.Context, c kclient.Client, namespace, imageName
 	err := c.Get(ctx, router.Key(namespace, name), image)
 	if err != nil && !apierror.IsNotFound(err) {
 		return nil, err
+	} else if err != nil && opts.Local {
+		return nil, fmt.Errorf("image %s was not found in the local image store", imageName)
 	} else if err != nil && apierror.IsNotFound(err) && (tags.IsLocalReference(name) || (opts.NoDefaultReg && tags.HasNoSpecifiedRegistry(imageName))) {
 		return nil, err
 	} else if err == nil {
@@ -132,12 +138,20 @@ func getImageDetails(ctx context.Context, c kclient.Client, namespace, imageName
 
 	permissions := getPermissions(details.AppSpec)
 
-	var nestedImages []apiv1.NestedImage
+	var (
+		nestedImages    []apiv1.NestedImage
+		containedImages []apiv1.ContainedImage
+	)
+	if opts.ListImages {
+		containedImages = listImages(ctx, c, namespace, imageName, appImageWithData.AppImage.ImageData, remoteOpts)
+	}
 	if opts.IncludeNested {
-		nestedImages, err = getNested(ctx, c, namespace, imageName, details.AppSpec, appImageWithData.AppImage.ImageData, remoteOpts)
+		var nestedContained []apiv1.ContainedImage
+		nestedImages, nestedContained, err = getNested(ctx, c, namespace, imageName, details.AppSpec, appImageWithData.AppImage.ImageData, remoteOpts, opts.ListImages, opts.Local)
 		if err != nil {
 			return nil, err
 		}
+		containedImages = append(containedImages, nestedContained...)
 	}
 
 	return &apiv1.ImageDetails{
@@ -155,21 +169,24 @@ func getImageDetails(ctx context.Context, c kclient.Client, namespace, imageName
 		Readme:          string(appImageWithData.Readme),
 		Permissions:     permissions,
 		NestedImages:    nestedImages,
+		Images:          containedImages,
 	}, nil
 }
 
-func getNested(ctx context.Context, c kclient.Client, namespace, image string, appSpec *v1.AppSpec, imageData v1.ImagesData, remoteOpts []remote.Option) (result []apiv1.NestedImage, _ error) {
-	nested, err := getNestedAcorns(ctx, c, namespace, image, appSpec, imageData, remoteOpts)
+func getNested(ctx context.Context, c kclient.Client, namespace, image string, appSpec *v1.AppSpec, imageData v1.ImagesData, remoteOpts []remote.Option, listImages, local bool) (result []apiv1.NestedImage, contained []apiv1.ContainedImage, _ error) {
+	nested, nestedContained, err := getNestedAcorns(ctx, c, namespace, image, appSpec, imageData, remoteOpts, listImages, local)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	result = append(result, nested...)
+	contained = append(contained, nestedContained...)
 
-	nested, err = getNestedServices(ctx, c, namespace, image, appSpec, imageData, remoteOpts)
+	nested, nestedContained, err = getNestedServices(ctx, c, namespace, image, appSpec, imageData, remoteOpts, listImages, local)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	result = append(result, nested...)
+	contained = append(contained, nestedContained...)
 
 	return
 }
@@ -217,14 +234,14 @@ func toNestedImage(serviceName string, details *apiv1.ImageDetails, imageName st
 	return
 }
 
-func getNestedAcorns(ctx context.Context, c kclient.Client, namespace, image string, app *v1.AppSpec, imageData v1.ImagesData, remoteOpts []remote.Option) (result []apiv1.NestedImage, err error) {
+func getNestedAcorns(ctx context.Context, c kclient.Client, namespace, image string, app *v1.AppSpec, imageData v1.ImagesData, remoteOpts []remote.Option, listImages, local bool) (result []apiv1.NestedImage, contained []apiv1.ContainedImage, err error) {
 	for _, acornName := range typed.SortedKeys(app.Acorns) {
 		acorn := app.Acorns[acornName]
 
 		var nestedImage string
 		acornImage, ok := appdefinition.GetImageReferenceForServiceName(acornName, app, imageData)
 		if !ok {
-			return nil, fmt.Errorf("failed to find image information for nested acorn [%s]", acornName)
+			return nil, nil, fmt.Errorf("failed to find image information for nested acorn [%s]", acornName)
 		}
 
 		if tags.IsImageDigest(acornImage) {
@@ -238,18 +255,23 @@ func getNestedAcorns(ctx context.Context, c kclient.Client, namespace, image str
 			Nested:        nestedImage,
 			RemoteOpts:    remoteOpts,
 			IncludeNested: true,
+			ListImages:    listImages,
+			Local:         local,
 		})
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 
 		result = append(result, toNestedImage(acornName, details, acorn.Image)...)
+		if listImages {
+			contained = append(contained, nestedContainedImages(ctx, c, namespace, image, acornName, "acorn", nestedImageRef(acornImage, nestedImage), details, remoteOpts)...)
+		}
 	}
 
 	return
 }
 
-func getNestedServices(ctx context.Context, c kclient.Client, namespace, image string, app *v1.AppSpec, imageData v1.ImagesData, remoteOpts []remote.Option) (result []apiv1.NestedImage, err error) {
+func getNestedServices(ctx context.Context, c kclient.Client, namespace, image string, app *v1.AppSpec, imageData v1.ImagesData, remoteOpts []remote.Option, listImages, local bool) (result []apiv1.NestedImage, contained []apiv1.ContainedImage, err error) {
 	for _, serviceName := range typed.SortedKeys(app.Services) {
 		service := app.Services[serviceName]
 
@@ -272,12 +294,17 @@ func getNestedServices(ctx context.Context, c kclient.Client, namespace, image s
 			Nested:        nestedImage,
 			RemoteOpts:    remoteOpts,
 			IncludeNested: true,
+			ListImages:    listImages,
+			Local:         local,
 		})
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 
 		result = append(result, toNestedImage(serviceName, details, service.Image)...)
+		if listImages {
+			contained = append(contained, nestedContainedImages(ctx, c, namespace, image, serviceName, "service", nestedImageRef(serviceImage, nestedImage), details, remoteOpts)...)
+		}
 	}
 
 	return
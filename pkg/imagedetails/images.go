@@ -0,0 +1,155 @@
+package imagedetails
+
+import (
+	"context"
+
+	"github.com/acorn-io/baaah/pkg/typed"
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/images"
+	"github.com/acorn-io/runtime/pkg/tags"
+	imagename "github.com/google/go-containerregistry/pkg/name"
+	ggcrv1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/sirupsen/logrus"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// listImages returns the images of the containers, sidecars, functions, jobs and images of the app image. Nested
+// acorns are listed by getNestedAcorns and getNestedServices.
+func listImages(ctx context.Context, c kclient.Client, namespace, appImage string, imageData v1.ImagesData, remoteOpts []remote.Option) (result []apiv1.ContainedImage) {
+	addContainers := func(kind string, containers map[string]v1.ContainerData) {
+		for _, entry := range typed.Sorted(containers) {
+			result = append(result, containedImage(ctx, c, namespace, appImage, entry.Key, kind, entry.Value.Image, "", remoteOpts))
+			for _, sidecar := range typed.Sorted(entry.Value.Sidecars) {
+				result = append(result, containedImage(ctx, c, namespace, appImage, entry.Key+"."+sidecar.Key, "sidecar", sidecar.Value.Image, "", remoteOpts))
+			}
+		}
+	}
+
+	addContainers("container", imageData.Containers)
+	addContainers("function", imageData.Functions)
+	addContainers("job", imageData.Jobs)
+	for _, entry := range typed.Sorted(imageData.Images) {
+		result = append(result, containedImage(ctx, c, namespace, appImage, entry.Key, "image", entry.Value.Image, "", remoteOpts))
+	}
+	return
+}
+
+// nestedContainedImages returns the nested acorn itself followed by the images it contains, named after the acorn
+func nestedContainedImages(ctx context.Context, c kclient.Client, namespace, appImage, name, kind, image string, details *apiv1.ImageDetails, remoteOpts []remote.Option) []apiv1.ContainedImage {
+	result := []apiv1.ContainedImage{
+		containedImage(ctx, c, namespace, appImage, name, kind, image, details.AppImage.Digest, remoteOpts),
+	}
+	for _, nested := range details.Images {
+		nested.Name = name + "." + nested.Name
+		result = append(result, nested)
+	}
+	return result
+}
+
+// containedImage resolves the repository and digest of an image referenced by the app image. Images only referenced
+// by digest are stored in the repository of the app image. If the image can't be resolved or its size not be
+// determined, the fields are left empty rather than failing the whole request.
+func containedImage(ctx context.Context, c kclient.Client, namespace, appImage, name, kind, image, digest string, remoteOpts []remote.Option) apiv1.ContainedImage {
+	result := apiv1.ContainedImage{
+		Name:   name,
+		Type:   kind,
+		Digest: digest,
+	}
+
+	var repo imagename.Repository
+	if tags.IsImageDigest(image) {
+		ref, err := images.GetImageReference(ctx, c, namespace, appImage)
+		if err != nil {
+			logrus.Debugf("failed to resolve repository of image %s of %s: %v", image, appImage, err)
+			return result
+		}
+		repo = ref.Context()
+		if result.Digest == "" {
+			result.Digest = image
+		}
+	} else {
+		ref, err := imagename.ParseReference(image)
+		if err != nil {
+			logrus.Debugf("failed to parse image %s of %s: %v", image, appImage, err)
+			return result
+		}
+		repo = ref.Context()
+		if d, ok := ref.(imagename.Digest); ok && result.Digest == "" {
+			result.Digest = d.DigestStr()
+		}
+	}
+	result.Repo = repo.Name()
+
+	if result.Digest != "" {
+		size, err := imageSize(repo.Digest(result.Digest), remoteOpts)
+		if err != nil {
+			logrus.Debugf("failed to determine size of image %s@%s: %v", result.Repo, result.Digest, err)
+		}
+		result.Size = size
+	}
+	return result
+}
+
+// imageSize sums up the sizes of the configs and layers of the image, or of all images of an index
+func imageSize(ref imagename.Reference, remoteOpts []remote.Option) (int64, error) {
+	desc, err := remote.Get(ref, remoteOpts...)
+	if err != nil {
+		return 0, err
+	}
+
+	if !desc.MediaType.IsIndex() {
+		img, err := desc.Image()
+		if err != nil {
+			return 0, err
+		}
+		return manifestSize(img)
+	}
+
+	index, err := desc.ImageIndex()
+	if err != nil {
+		return 0, err
+	}
+	indexManifest, err := index.IndexManifest()
+	if err != nil {
+		return 0, err
+	}
+
+	var total int64
+	for _, m := range indexManifest.Manifests {
+		if !m.MediaType.IsImage() {
+			continue
+		}
+		img, err := index.Image(m.Digest)
+		if err != nil {
+			return 0, err
+		}
+		size, err := manifestSize(img)
+		if err != nil {
+			return 0, err
+		}
+		total += size
+	}
+	return total, nil
+}
+
+func manifestSize(img ggcrv1.Image) (int64, error) {
+	manifest, err := img.Manifest()
+	if err != nil {
+		return 0, err
+	}
+	size := manifest.Config.Size
+	for _, layer := range manifest.Layers {
+		size += layer.Size
+	}
+	return size, nil
+}
+
+// nestedImageRef returns the reference of a nested acorn, which is a digest if it's stored with the app image
+func nestedImageRef(image, nestedDigest string) string {
+	if nestedDigest != "" {
+		return nestedDigest
+	}
+	return image
+}
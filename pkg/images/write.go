@@ -1,11 +1,14 @@
 package images
 
 import (
+	"errors"
 	"fmt"
+	"net/http"
 
 	"github.com/google/go-containerregistry/pkg/name"
 	ggcrv1 "github.com/google/go-containerregistry/pkg/v1"
 	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/remote/transport"
 )
 
 type ImageProgress struct {
@@ -66,6 +69,66 @@ func RemoteWrite(progress chan<- SimpleUpdate, destRef name.Reference, source an
 	}
 }
 
+// RemoteCopy writes source to destRef like RemoteWrite and then verifies that destRef has the digest of source. Any
+// error is reported on progress and also returned, so that callers can stop copying after a failure.
+func RemoteCopy(progress chan<- SimpleUpdate, destRef name.Reference, source any, description string, opts ...remote.Option) error {
+	writeProgress := make(chan ggcrv1.Update)
+	writeOpts := append([]remote.Option{remote.WithProgress(writeProgress)}, opts...)
+
+	var (
+		digest ggcrv1.Hash
+		write  func() error
+		err    error
+	)
+	switch s := source.(type) {
+	case ggcrv1.ImageIndex:
+		digest, err = s.Digest()
+		write = func() error { return remote.WriteIndex(destRef, s, writeOpts...) }
+	case ggcrv1.Image:
+		digest, err = s.Digest()
+		write = func() error { return remote.Write(destRef, s, writeOpts...) }
+	default:
+		err = fmt.Errorf("unsupported source type: %T", source)
+	}
+	if err != nil {
+		ReportError(progress, description, err)
+		return err
+	}
+
+	progress <- SimpleUpdate{
+		updateChan:  writeProgress,
+		description: description,
+	}
+	if err := write(); err != nil {
+		handleRemoteWriteError(err, writeProgress)
+		return err
+	}
+
+	desc, err := remote.Head(destRef, opts...)
+	if err != nil {
+		err = fmt.Errorf("verifying %s: %w", destRef, err)
+	} else if desc.Digest != digest {
+		err = fmt.Errorf("verifying %s: digest %s does not match the digest %s of the source", destRef, desc.Digest, digest)
+	}
+	if err != nil {
+		ReportError(progress, description, err)
+	}
+	return err
+}
+
+// ReportError reports err as an update of the task with the description on progress.
+func ReportError(progress chan<- SimpleUpdate, description string, err error) {
+	updates := make(chan ggcrv1.Update, 1)
+	updates <- ggcrv1.Update{
+		Error: err,
+	}
+	close(updates)
+	progress <- SimpleUpdate{
+		updateChan:  updates,
+		description: description,
+	}
+}
+
 func handleRemoteWriteError(err error, progress chan ggcrv1.Update) {
 	if err == nil {
 		return
@@ -86,3 +149,30 @@ func handleRemoteWriteError(err error, progress chan ggcrv1.Update) {
 		close(progress)
 	}
 }
+
+// ErrIfImageExistsAndIsDifferent returns an error if destRef exists already with another digest than sourceIndex.
+func ErrIfImageExistsAndIsDifferent(sourceIndex ggcrv1.ImageIndex, destRef name.Reference, destOpts []remote.Option) error {
+	// Make sure that we are not about to destroy the remote index
+	destIndex, err := remote.Index(destRef, destOpts...)
+	var terr *transport.Error
+	if ok := errors.As(err, &terr); ok && terr.StatusCode == http.StatusNotFound {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	destDigest, err := destIndex.Digest()
+	if err != nil {
+		return err
+	}
+
+	sourceDigest, err := sourceIndex.Digest()
+	if err != nil {
+		return err
+	}
+
+	if destDigest.String() != sourceDigest.String() {
+		return fmt.Errorf("not copying image to %s since it already exists (use --force to override)", destRef.String())
+	}
+	return nil
+}
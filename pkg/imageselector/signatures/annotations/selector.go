@@ -3,6 +3,8 @@ package annotations
 import (
 	"errors"
 	"fmt"
+	"path"
+	"sort"
 	"strings"
 
 	internalv1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
@@ -41,6 +43,7 @@ func labelSelectorAsSelector(ps *metav1.LabelSelector, opts LabelSelectorOpts) (
 		return labels.Everything(), nil
 	}
 	requirements := make([]labels.Requirement, 0, len(ps.MatchLabels)+len(ps.MatchExpressions))
+	var globs []globRequirement
 	for k, v := range ps.MatchLabels {
 		r, err := labels.NewRequirement(k, selection.Equals, []string{v})
 		if utilerrors.FilterOut(err, opts.LabelRequirementErrorFilters...) != nil {
@@ -49,6 +52,14 @@ func labelSelectorAsSelector(ps *metav1.LabelSelector, opts LabelSelectorOpts) (
 		requirements = append(requirements, *r)
 	}
 	for _, expr := range ps.MatchExpressions {
+		if expr.Operator == internalv1.LabelSelectorOpGlob {
+			g, err := newGlobRequirement(expr.Key, expr.Values, opts)
+			if err != nil {
+				return nil, err
+			}
+			globs = append(globs, g)
+			continue
+		}
 		var op selection.Operator
 		switch expr.Operator {
 		case metav1.LabelSelectorOpIn:
@@ -70,9 +81,121 @@ func labelSelectorAsSelector(ps *metav1.LabelSelector, opts LabelSelectorOpts) (
 	}
 	selector := labels.NewSelector()
 	selector = selector.Add(requirements...)
+	if len(globs) > 0 {
+		return &globSelector{Selector: selector, globs: globs}, nil
+	}
 	return selector, nil
 }
 
+// ParseMatch turns key/value pairs into annotation rules. Values containing glob metacharacters (*, ? or [) are
+// matched as glob patterns instead of exact values.
+func ParseMatch(match map[string]string) internalv1.SignatureAnnotations {
+	var r internalv1.SignatureAnnotations
+	for k, v := range match {
+		if strings.ContainsAny(v, "*?[") {
+			r.Expressions = append(r.Expressions, metav1.LabelSelectorRequirement{
+				Key:      k,
+				Operator: internalv1.LabelSelectorOpGlob,
+				Values:   []string{v},
+			})
+			continue
+		}
+		if r.Match == nil {
+			r.Match = make(map[string]string, len(match))
+		}
+		r.Match[k] = v
+	}
+	// map iteration order is random, so keep the expressions stable
+	sort.Slice(r.Expressions, func(i, j int) bool {
+		return r.Expressions[i].Key < r.Expressions[j].Key
+	})
+	return r
+}
+
+// globRequirement requires the value of an annotation to match at least one of the glob patterns
+type globRequirement struct {
+	key      string
+	patterns []string
+}
+
+func newGlobRequirement(key string, patterns []string, opts LabelSelectorOpts) (globRequirement, error) {
+	// validate the key the same way as for the other operators
+	if _, err := labels.NewRequirement(key, selection.Exists, nil); utilerrors.FilterOut(err, opts.LabelRequirementErrorFilters...) != nil {
+		return globRequirement{}, err
+	}
+	if len(patterns) == 0 {
+		return globRequirement{}, fmt.Errorf("values must be non-empty for operator %q on key %q", internalv1.LabelSelectorOpGlob, key)
+	}
+	for _, p := range patterns {
+		if _, err := path.Match(p, ""); err != nil {
+			return globRequirement{}, fmt.Errorf("invalid glob pattern %q for key %q: %w", p, key, err)
+		}
+	}
+	return globRequirement{key: key, patterns: append([]string(nil), patterns...)}, nil
+}
+
+func (g globRequirement) matches(ls labels.Labels) bool {
+	if !ls.Has(g.key) {
+		return false
+	}
+	v := ls.Get(g.key)
+	for _, p := range g.patterns {
+		if ok, _ := path.Match(p, v); ok {
+			return true
+		}
+	}
+	return false
+}
+
+func (g globRequirement) String() string {
+	return fmt.Sprintf("%s glob (%s)", g.key, strings.Join(g.patterns, ","))
+}
+
+// globSelector extends a label selector with glob requirements, which label selectors can't express
+type globSelector struct {
+	labels.Selector
+	globs []globRequirement
+}
+
+func (s *globSelector) Matches(ls labels.Labels) bool {
+	if !s.Selector.Matches(ls) {
+		return false
+	}
+	for _, g := range s.globs {
+		if !g.matches(ls) {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *globSelector) Empty() bool {
+	return s.Selector.Empty() && len(s.globs) == 0
+}
+
+func (s *globSelector) String() string {
+	reqs := make([]string, 0, len(s.globs)+1)
+	if !s.Selector.Empty() {
+		reqs = append(reqs, s.Selector.String())
+	}
+	for _, g := range s.globs {
+		reqs = append(reqs, g.String())
+	}
+	return strings.Join(reqs, ",")
+}
+
+func (s *globSelector) Add(r ...labels.Requirement) labels.Selector {
+	return &globSelector{Selector: s.Selector.Add(r...), globs: s.globs}
+}
+
+func (s *globSelector) DeepCopySelector() labels.Selector {
+	globs := make([]globRequirement, len(s.globs))
+	for i, g := range s.globs {
+		globs[i] = globRequirement{key: g.key, patterns: append([]string(nil), g.patterns...)}
+	}
+	return &globSelector{Selector: s.Selector.DeepCopySelector(), globs: globs}
+}
+
 var LabelValueMaxLengthErrMsg string = validation.MaxLenError(validation.LabelValueMaxLength)
 
 const LabelValueRegexpErrMsg string = "a valid label must be an empty string or consist of alphanumeric characters, '-', '_' or '.', and must start and end with an alphanumeric character"
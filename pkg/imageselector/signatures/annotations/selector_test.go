@@ -0,0 +1,58 @@
+package annotations
+
+import (
+	"testing"
+
+	internalv1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+func TestGenerateSelectorGlob(t *testing.T) {
+	sel, err := GenerateSelector(internalv1.SignatureAnnotations{
+		Match: map[string]string{"qa": "approved"},
+		Expressions: []metav1.LabelSelectorRequirement{
+			{Key: "release.version", Operator: internalv1.LabelSelectorOpGlob, Values: []string{"1.2.*", "2.*"}},
+		},
+	}, DefaultAnnotationOpts)
+	require.NoError(t, err)
+	assert.False(t, sel.Empty())
+
+	assert.True(t, sel.Matches(labels.Set{"qa": "approved", "release.version": "1.2.3"}))
+	assert.True(t, sel.Matches(labels.Set{"qa": "approved", "release.version": "2.0.0"}))
+	assert.False(t, sel.Matches(labels.Set{"qa": "approved", "release.version": "1.3.0"}))
+	assert.False(t, sel.Matches(labels.Set{"qa": "approved"}))
+	assert.False(t, sel.Matches(labels.Set{"qa": "rejected", "release.version": "1.2.3"}))
+
+	assert.True(t, sel.DeepCopySelector().Matches(labels.Set{"qa": "approved", "release.version": "1.2.3"}))
+}
+
+func TestGenerateSelectorGlobInvalid(t *testing.T) {
+	_, err := GenerateSelector(internalv1.SignatureAnnotations{
+		Expressions: []metav1.LabelSelectorRequirement{
+			{Key: "release.version", Operator: internalv1.LabelSelectorOpGlob},
+		},
+	}, DefaultAnnotationOpts)
+	assert.Error(t, err)
+
+	_, err = GenerateSelector(internalv1.SignatureAnnotations{
+		Expressions: []metav1.LabelSelectorRequirement{
+			{Key: "release.version", Operator: internalv1.LabelSelectorOpGlob, Values: []string{"1.[2"}},
+		},
+	}, DefaultAnnotationOpts)
+	assert.Error(t, err)
+}
+
+func TestParseMatch(t *testing.T) {
+	r := ParseMatch(map[string]string{
+		"qa":              "approved",
+		"release.version": "1.2.*",
+	})
+
+	assert.Equal(t, map[string]string{"qa": "approved"}, r.Match)
+	assert.Equal(t, []metav1.LabelSelectorRequirement{
+		{Key: "release.version", Operator: internalv1.LabelSelectorOpGlob, Values: []string{"1.2.*"}},
+	}, r.Expressions)
+}
@@ -2,6 +2,7 @@ package signatures
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/acorn-io/baaah/pkg/merr"
@@ -17,6 +18,21 @@ type MatchImageSignatureOpts struct {
 	NoCache bool
 }
 
+// PolicyViolation is returned if an image does not satisfy a signature policy
+type PolicyViolation struct {
+	Image  string
+	Reason string
+	Err    error
+}
+
+func (e *PolicyViolation) Error() string {
+	return fmt.Sprintf("image [%s]: %s", e.Image, e.Reason)
+}
+
+func (e *PolicyViolation) Unwrap() error {
+	return e.Err
+}
+
 func VerifySignatureRule(ctx context.Context, c client.Reader, namespace string, image string, rule internalv1.SignatureRules, opts MatchImageSignatureOpts, remoteOpts ...remote.Option) error {
 	// TODO(@iwilltry42): Move this out of here again or only leave default here and merge incoming?
 	// ... alternatively, re-do the function signature to avoid unnecessary external calls in EnsureReferences
@@ -81,3 +97,62 @@ func VerifySignatureRule(ctx context.Context, c client.Reader, namespace string,
 	}
 	return nil
 }
+
+// ValidateSignaturePolicy checks that the policy names exactly one signer, either a key or a certificate identity
+func ValidateSignaturePolicy(policy internalv1.SignaturePolicy) error {
+	keyless := policy.CertIdentity != "" || policy.CertOidcIssuer != ""
+	if policy.Key == "" && !keyless {
+		return fmt.Errorf("signature policy requires a key or a certificate identity")
+	} else if policy.Key != "" && keyless {
+		return fmt.Errorf("signature policy cannot require a key and a certificate identity at the same time")
+	} else if keyless && (policy.CertIdentity == "" || policy.CertOidcIssuer == "") {
+		return fmt.Errorf("signature policy requires both a certificate identity and a certificate OIDC issuer")
+	}
+
+	if _, err := annotations.GenerateSelector(policy.Annotations, annotations.DefaultAnnotationOpts); err != nil {
+		return fmt.Errorf("failed to parse annotation rules of signature policy: %w", err)
+	}
+	return nil
+}
+
+// VerifySignaturePolicy checks that the image carries a signature by the key or certificate identity of the policy,
+// which matches the annotation rules of the policy. If it doesn't, a *PolicyViolation is returned that tells why.
+func VerifySignaturePolicy(ctx context.Context, c client.Reader, namespace string, image string, policy internalv1.SignaturePolicy, opts MatchImageSignatureOpts, remoteOpts ...remote.Option) error {
+	verifyOpts := acornsign.VerifyOpts{
+		Namespace:          namespace,
+		Key:                policy.Key,
+		CertIdentity:       policy.CertIdentity,
+		CertOidcIssuer:     policy.CertOidcIssuer,
+		SignatureAlgorithm: "sha256",
+		RemoteOpts:         remoteOpts,
+		NoCache:            opts.NoCache,
+	}
+
+	if err := acornsign.EnsureReferences(ctx, c, image, namespace, &verifyOpts); err != nil {
+		if noSigErr := (*acornsign.ErrNoSignaturesFound)(nil); errors.As(err, &noSigErr) {
+			return &PolicyViolation{Image: image, Reason: "missing signature", Err: err}
+		}
+		return fmt.Errorf("failed to look up signatures of image %s: %w", image, err)
+	}
+
+	sel, err := annotations.GenerateSelector(policy.Annotations, annotations.DefaultAnnotationOpts)
+	if err != nil {
+		return fmt.Errorf("failed to parse annotation rules of signature policy: %w", err)
+	}
+	verifyOpts.AnnotationRules = sel
+
+	_, err = acornsign.MatchSignature(ctx, verifyOpts)
+	if noMatchErr := (*acornsign.ErrNoMatchingSignatures)(nil); errors.Is(err, acornsign.ErrAnnotationsUnmatched) {
+		return &PolicyViolation{Image: image, Reason: "signature annotations do not match the signature policy", Err: err}
+	} else if errors.As(err, &noMatchErr) {
+		return &PolicyViolation{Image: image, Reason: fmt.Sprintf("not signed by %s", policySigner(policy)), Err: err}
+	}
+	return err
+}
+
+func policySigner(policy internalv1.SignaturePolicy) string {
+	if policy.CertIdentity != "" || policy.CertOidcIssuer != "" {
+		return fmt.Sprintf("certificate identity %s (issuer %s)", policy.CertIdentity, policy.CertOidcIssuer)
+	}
+	return "the key of the signature policy"
+}
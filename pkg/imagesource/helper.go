@@ -5,8 +5,10 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
 
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
 	"github.com/acorn-io/runtime/pkg/appdefinition"
 	"github.com/acorn-io/runtime/pkg/autoupgrade"
 	"github.com/acorn-io/runtime/pkg/build"
@@ -23,10 +25,17 @@ type ImageSource struct {
 	Args      []string
 	ArgsFile  string
 	Platforms []string
+	// Profiles are activated in addition to the ones passed with --profile in Args
+	Profiles []string
 	// NoDefaultRegistry - if true, indicates that no container registry should be assumed for the Image.
 	// This is used if the ImageSource is for an app with auto-upgrade enabled.
 	NoDefaultRegistry bool
 	Streams           *streams.Output
+	// BuildSecrets provides the secrets mounted into the image builds, their values are never part of the images
+	BuildSecrets client.BuildSecretLookup
+	// CacheFrom and CacheTo are the remote caches the image builds import layers from and export layers to
+	CacheFrom []v1.RemoteCache
+	CacheTo   []v1.RemoteCache
 
 	// acornConfig is the path to the acorn config file.
 	acornConfig string
@@ -105,6 +114,11 @@ func (i ImageSource) GetAppDefinition(ctx context.Context, c client.Client) (*ap
 		return nil, nil, nil, err
 	}
 
+	profiles, err = app.ResolveProfiles(append(slices.Clone(i.Profiles), profiles...))
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
 	app = app.WithArgs(deployArgs, profiles)
 	return app, deployArgs, profiles, nil
 }
@@ -141,6 +155,20 @@ func (i ImageSource) WatchFiles(ctx context.Context, c client.Client) ([]string,
 	return append([]string{file}, files...), nil
 }
 
+// BuildContexts returns the build contexts of the images built from Dockerfiles, or nothing if the app isn't built
+func (i ImageSource) BuildContexts(ctx context.Context, c client.Client) ([]string, error) {
+	cwd, file, err := i.ResolveImageAndFile()
+	if err != nil || file == "" {
+		return nil, err
+	}
+
+	app, _, _, err := i.GetAppDefinition(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+	return app.BuildContexts(cwd)
+}
+
 func (i ImageSource) ResolveImageAndFile() (string, string, error) {
 	if !i.IsImageSet() {
 		i.Image = "."
@@ -205,10 +233,13 @@ func (i ImageSource) GetImageAndDeployArgs(ctx context.Context, c client.Client)
 
 		image, err := c.AcornImageBuild(ctx, i.File, &client.AcornImageBuildOptions{
 			Credentials: creds,
+			Secrets:     i.BuildSecrets,
 			Cwd:         i.Image,
 			Args:        params,
 			Profiles:    profiles,
 			Platforms:   platforms,
+			CacheFrom:   i.CacheFrom,
+			CacheTo:     i.CacheTo,
 			Streams:     i.Streams,
 		})
 		if err != nil {
@@ -5,13 +5,17 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"strings"
 	"time"
 
 	"github.com/acorn-io/baaah/pkg/name"
 	"github.com/acorn-io/baaah/pkg/randomtoken"
 	"github.com/acorn-io/baaah/pkg/restconfig"
 	"github.com/acorn-io/baaah/pkg/watcher"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	adminv1 "github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1"
 	"github.com/acorn-io/runtime/pkg/client/term"
+	"github.com/acorn-io/runtime/pkg/crds"
 	"github.com/acorn-io/runtime/pkg/k8schannel"
 	"github.com/acorn-io/runtime/pkg/k8sclient"
 	"github.com/acorn-io/runtime/pkg/publish"
@@ -25,6 +29,7 @@ import (
 	networkingv1 "k8s.io/api/networking/v1"
 	storagev1 "k8s.io/api/storage/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/client-go/rest"
@@ -34,11 +39,23 @@ import (
 	kclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
+// CheckStatus is the outcome of a check. A warning doesn't keep Acorn from running apps, but some features won't work.
+type CheckStatus string
+
+const (
+	CheckStatusPass = CheckStatus("pass")
+	CheckStatusWarn = CheckStatus("warn")
+	CheckStatusFail = CheckStatus("fail")
+)
+
 // CheckResult describes the results of a check, making it human-readable
 type CheckResult struct {
-	Message string `json:"message"`
-	Passed  bool   `json:"passed"`
-	Name    string `json:"name"`
+	Message string      `json:"message"`
+	Passed  bool        `json:"passed"`
+	Name    string      `json:"name"`
+	Status  CheckStatus `json:"status"`
+	// Hint tells how to fix the cause of a warning or failure
+	Hint string `json:"hint,omitempty"`
 }
 
 // CheckOptions defines some extra settings for the tests
@@ -122,11 +139,20 @@ func RunChecks(ctx context.Context, opts CheckOptions, checks ...func(ctx contex
 	if err := opts.setDefaults(ctx); err != nil {
 		return append(results, CheckResult{
 			Passed:  false,
+			Status:  CheckStatusFail,
 			Message: fmt.Sprintf("Error setting default check options: %v", err),
 		})
 	}
 	for _, check := range checks {
-		results = append(results, check(ctx, opts))
+		result := check(ctx, opts)
+		// Checks that can't warn only set whether they passed
+		if result.Status == "" {
+			result.Status = CheckStatusFail
+			if result.Passed {
+				result.Status = CheckStatusPass
+			}
+		}
+		results = append(results, result)
 	}
 	return results
 }
@@ -265,6 +291,7 @@ func CheckExec(ctx context.Context, opts CheckOptions) CheckResult {
 	if exitCode != 0 {
 		result.Passed = false
 		result.Message = fmt.Sprintf("Container replica exec exited with code %d", exitCode)
+		result.Hint = "Check that the Kubernetes API server can connect to the kubelets, which is required by acorn exec"
 		return result
 	}
 
@@ -396,6 +423,7 @@ func CheckIngressCapability(ctx context.Context, opts CheckOptions) CheckResult
 	if errors.Is(err, context.DeadlineExceeded) {
 		result.Passed = false
 		result.Message = "Ingress not ready (test timed out after 1 minute)"
+		result.Hint = "Check that the ingress controller is running and assigns addresses to ingresses, or select another one with --ingress-class-name"
 		return result
 	} else if err != nil {
 		result.Passed = false
@@ -443,6 +471,7 @@ func CheckNodesReady(ctx context.Context, opts CheckOptions) CheckResult {
 	if nrdy > 0 {
 		result.Passed = false
 		result.Message = fmt.Sprintf("%d nodes are not ready", nrdy)
+		result.Hint = "Check the conditions of the nodes with kubectl describe nodes"
 	} else {
 		result.Passed = true
 		result.Message = "All nodes are ready"
@@ -483,6 +512,7 @@ func CheckRBAC(ctx context.Context, opts CheckOptions) CheckResult {
 			result.Message = "User can create namespaces"
 		} else {
 			result.Message = "User cannot create namespaces"
+			result.Hint = "Acorn has to be installed by a user that can create namespaces, like a cluster admin"
 		}
 	}
 
@@ -525,10 +555,14 @@ func CheckDefaultStorageClass(ctx context.Context, opts CheckOptions) CheckResul
 
 	if len(scs.Items) == 0 {
 		result.Passed = false
+		result.Status = CheckStatusWarn
 		result.Message = "No storage classes found"
+		result.Hint = "Volumes can't be created without a storage class, install a storage provisioner"
 	} else if defaultSc == "" {
 		result.Passed = false
+		result.Status = CheckStatusWarn
 		result.Message = fmt.Sprintf("Found %d storage classes, but none are marked as default", len(scs.Items))
+		result.Hint = fmt.Sprintf("Volumes without a class need a default storage class, mark one with the %s=true annotation", storage.IsDefaultStorageClassAnnotation)
 	} else {
 		result.Passed = true
 		result.Message = fmt.Sprintf("Found default storage class %s", defaultSc)
@@ -536,3 +570,133 @@ func CheckDefaultStorageClass(ctx context.Context, opts CheckOptions) CheckResul
 
 	return result
 }
+
+/*
+ * CheckCRDs checks if the CRDs of the internal Acorn APIs are installed.
+ * -> Before Acorn is installed, none are and the check only warns.
+ */
+func CheckCRDs(ctx context.Context, opts CheckOptions) CheckResult {
+	result := CheckResult{
+		Name: "CRDs",
+	}
+
+	silenceKlog()
+
+	gvks, err := crds.Kinds(scheme.Scheme, v1.SchemeGroupVersion, adminv1.SchemeGroupVersion)
+	if err != nil {
+		result.Passed = false
+		result.Message = fmt.Sprintf("Error listing required CRDs: %v", err)
+		return result
+	}
+
+	var missing []string
+	for _, gvk := range gvks {
+		if _, err := opts.Client.RESTMapper().RESTMapping(gvk.GroupKind(), gvk.Version); meta.IsNoMatchError(err) {
+			missing = append(missing, gvk.GroupKind().String())
+		} else if err != nil {
+			result.Passed = false
+			result.Message = fmt.Sprintf("Error looking up CRD of %s: %v", gvk.GroupKind(), err)
+			return result
+		}
+	}
+
+	switch {
+	case len(missing) == 0:
+		result.Passed = true
+		result.Message = fmt.Sprintf("All %d CRDs are installed", len(gvks))
+	case len(missing) == len(gvks):
+		result.Passed = false
+		result.Status = CheckStatusWarn
+		result.Message = "No CRDs are installed"
+		result.Hint = "The CRDs are created when Acorn is installed with acorn install"
+	default:
+		result.Passed = false
+		result.Message = fmt.Sprintf("%d of %d CRDs are missing: %s", len(missing), len(gvks), strings.Join(missing, ", "))
+		result.Hint = "Run acorn install again to create the missing CRDs"
+	}
+
+	return result
+}
+
+/*
+ * CheckIngressClass checks if there is an ingress class apps can be published with.
+ * -> This is a non-critical check, Acorn installs Traefik on Docker Desktop if there is none.
+ */
+func CheckIngressClass(ctx context.Context, opts CheckOptions) CheckResult {
+	result := CheckResult{
+		Name: "IngressClass",
+	}
+
+	silenceKlog()
+
+	var ics networkingv1.IngressClassList
+	if err := opts.Client.List(ctx, &ics); err != nil {
+		result.Passed = false
+		result.Message = fmt.Sprintf("Error listing ingress classes: %v", err)
+		return result
+	}
+
+	if opts.IngressClassName != nil {
+		for _, ic := range ics.Items {
+			if ic.Name == *opts.IngressClassName {
+				result.Passed = true
+				result.Message = fmt.Sprintf("Found ingress class %s", ic.Name)
+				return result
+			}
+		}
+		result.Passed = false
+		result.Message = fmt.Sprintf("Ingress class %s not found", *opts.IngressClassName)
+		result.Hint = "Pass the name of an existing ingress class with --ingress-class-name"
+		return result
+	}
+
+	if len(ics.Items) == 0 {
+		result.Passed = false
+		result.Status = CheckStatusWarn
+		result.Message = "No ingress classes found"
+		result.Hint = "HTTP ports of apps can't be published without an ingress controller, install one like Traefik or ingress-nginx"
+		return result
+	}
+
+	result.Passed = true
+	result.Message = fmt.Sprintf("Found %d ingress classes", len(ics.Items))
+	return result
+}
+
+/*
+ * CheckClusterDNS checks if the cluster DNS service has ready endpoints.
+ * -> Apps reach the services of each other through the cluster DNS.
+ */
+func CheckClusterDNS(ctx context.Context, opts CheckOptions) CheckResult {
+	result := CheckResult{
+		Name: "ClusterDNS",
+	}
+
+	silenceKlog()
+
+	var eps corev1.Endpoints
+	if err := opts.Client.Get(ctx, kclient.ObjectKey{Namespace: "kube-system", Name: "kube-dns"}, &eps); apierrors.IsNotFound(err) {
+		result.Passed = false
+		result.Status = CheckStatusWarn
+		result.Message = "No kube-dns service found in kube-system"
+		result.Hint = "Apps resolve the services of each other through the cluster DNS, make sure CoreDNS or another cluster DNS is installed"
+		return result
+	} else if err != nil {
+		result.Passed = false
+		result.Message = fmt.Sprintf("Error getting the endpoints of the cluster DNS: %v", err)
+		return result
+	}
+
+	for _, subset := range eps.Subsets {
+		if len(subset.Addresses) > 0 {
+			result.Passed = true
+			result.Message = "Cluster DNS is ready"
+			return result
+		}
+	}
+
+	result.Passed = false
+	result.Message = "Cluster DNS has no ready endpoints"
+	result.Hint = "Check the pods of the kube-dns service with kubectl get pods -n kube-system -l k8s-app=kube-dns"
+	return result
+}
@@ -0,0 +1,83 @@
+package install
+
+import (
+	"context"
+	"testing"
+
+	adminv1 "github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/crds"
+	"github.com/acorn-io/runtime/pkg/scheme"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func runCheck(t *testing.T, check func(context.Context, CheckOptions) CheckResult, mapper meta.RESTMapper, objs ...kclient.Object) CheckResult {
+	t.Helper()
+	builder := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(objs...)
+	if mapper != nil {
+		builder = builder.WithRESTMapper(mapper)
+	}
+	results := RunChecks(context.Background(), CheckOptions{Client: builder.Build(), RuntimeImage: "acorn"}, check)
+	if len(results) != 1 {
+		t.Fatalf("expected one result, got %v", results)
+	}
+	return results[0]
+}
+
+func TestCheckCRDs(t *testing.T) {
+	gvks, err := crds.Kinds(scheme.Scheme, adminv1.SchemeGroupVersion)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	mapper := meta.NewDefaultRESTMapper(nil)
+	result := runCheck(t, CheckCRDs, mapper)
+	assert.Equal(t, CheckStatusWarn, result.Status)
+	assert.False(t, result.Passed)
+	assert.Equal(t, "No CRDs are installed", result.Message)
+
+	for _, gvk := range gvks {
+		mapper.Add(gvk, meta.RESTScopeNamespace)
+	}
+	result = runCheck(t, CheckCRDs, mapper)
+	assert.Equal(t, CheckStatusFail, result.Status)
+	assert.Contains(t, result.Message, "CRDs are missing: ")
+	assert.NotEmpty(t, result.Hint)
+}
+
+func TestCheckIngressClass(t *testing.T) {
+	result := runCheck(t, CheckIngressClass, nil)
+	assert.Equal(t, CheckStatusWarn, result.Status)
+	assert.Equal(t, "No ingress classes found", result.Message)
+
+	result = runCheck(t, CheckIngressClass, nil, &networkingv1.IngressClass{ObjectMeta: metav1.ObjectMeta{Name: "traefik"}})
+	assert.Equal(t, CheckStatusPass, result.Status)
+	assert.True(t, result.Passed)
+	assert.Equal(t, "Found ingress class traefik", result.Message)
+}
+
+func TestCheckClusterDNS(t *testing.T) {
+	result := runCheck(t, CheckClusterDNS, nil)
+	assert.Equal(t, CheckStatusWarn, result.Status)
+
+	endpoints := &corev1.Endpoints{ObjectMeta: metav1.ObjectMeta{Namespace: "kube-system", Name: "kube-dns"}}
+	result = runCheck(t, CheckClusterDNS, nil, endpoints)
+	assert.Equal(t, CheckStatusFail, result.Status)
+	assert.Equal(t, "Cluster DNS has no ready endpoints", result.Message)
+
+	endpoints.Subsets = []corev1.EndpointSubset{{Addresses: []corev1.EndpointAddress{{IP: "10.43.0.10"}}}}
+	result = runCheck(t, CheckClusterDNS, nil, endpoints)
+	assert.Equal(t, CheckStatusPass, result.Status)
+}
+
+func TestCheckDefaultStorageClassWarns(t *testing.T) {
+	result := runCheck(t, CheckDefaultStorageClass, nil)
+	assert.Equal(t, CheckStatusWarn, result.Status)
+	assert.False(t, result.Passed)
+	assert.Equal(t, "No storage classes found", result.Message)
+}
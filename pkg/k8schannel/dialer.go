@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/gorilla/websocket"
+	"k8s.io/apimachinery/pkg/util/remotecommand"
 	"k8s.io/client-go/rest"
 )
 
@@ -98,7 +99,8 @@ func NewDialer(cfg *rest.Config, needsInit bool) (*Dialer, error) {
 	return &Dialer{
 		needsInit: needsInit,
 		dialer: &websocket.Dialer{
-			Subprotocols:     []string{"v4.channel.k8s.io"},
+			// Prefer v5, which allows closing stdin, servers not supporting it yet negotiate v4
+			Subprotocols:     []string{remotecommand.StreamProtocolV5Name, remotecommand.StreamProtocolV4Name},
 			Proxy:            http.ProxyFromEnvironment,
 			HandshakeTimeout: 45 * time.Second,
 			TLSClientConfig:  tlsConfig,
@@ -2,6 +2,7 @@ package k8schannel
 
 import (
 	"encoding/json"
+	"errors"
 	"io"
 	"net"
 	"net/http"
@@ -11,8 +12,12 @@ import (
 	"github.com/acorn-io/baaah/pkg/merr"
 	"github.com/acorn-io/runtime/pkg/client/term"
 	"github.com/gorilla/websocket"
+	"k8s.io/apimachinery/pkg/util/remotecommand"
 )
 
+// ErrCloseStreamUnsupported is returned when closing a single stream, if the negotiated protocol doesn't support it
+var ErrCloseStreamUnsupported = errors.New("closing a single stream requires the " + remotecommand.StreamProtocolV5Name + " protocol")
+
 var Upgrader = &websocket.Upgrader{CheckOrigin: func(req *http.Request) bool {
 	return true
 }, HandshakeTimeout: 15 * time.Second}
@@ -182,6 +187,16 @@ func (c *Connection) Write(streamNum uint8, b []byte) (n int, err error) {
 	return n, m.Close()
 }
 
+// CloseStream signals the other side that nothing more will be written to the stream, e.g. to make a process read EOF
+// from stdin, while keeping the connection and all other streams open. This is only supported by the v5 protocol.
+func (c *Connection) CloseStream(streamNum uint8) error {
+	if c.conn.Subprotocol() != remotecommand.StreamProtocolV5Name {
+		return ErrCloseStreamUnsupported
+	}
+	_, err := c.Write(remotecommand.StreamClose, []byte{streamNum})
+	return err
+}
+
 func (c *Connection) Close() (err error) {
 	defer func() {
 		c.streamsLock.Lock()
@@ -218,6 +233,11 @@ func (c *netConn) Close() error {
 	return c.conn.Close()
 }
 
+// CloseWrite closes only the writing side of the stream, see Connection.CloseStream
+func (c *netConn) CloseWrite() error {
+	return c.conn.CloseStream(c.streamNum)
+}
+
 func (c *netConn) LocalAddr() net.Addr {
 	return c.conn.conn.LocalAddr()
 }
@@ -63,6 +63,10 @@ const (
 	AcornPermissions                       = Prefix + "permissions"
 	AcornConfigHashAnnotation              = Prefix + "config-hash"
 	AcornContainerResolvedOfferings        = Prefix + "container-resolved-offerings"
+	AcornPaused                            = Prefix + "paused"
+	AcornPausedReplicas                    = Prefix + "paused-replicas"
+	AcornRestartedAt                       = Prefix + "restarted-at"
+	AcornSnapshotVolume                    = Prefix + "snapshot-volume"
 
 	IdentityPrefix                = "identity." + Prefix
 	AcornIdentityAccountServerURL = IdentityPrefix + "account-server-url"
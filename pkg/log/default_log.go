@@ -3,6 +3,7 @@ package log
 import (
 	"context"
 	"sync"
+	"time"
 
 	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
 	"github.com/acorn-io/runtime/pkg/client"
@@ -58,6 +59,12 @@ func (d *DefaultLoggerImpl) AppStatus(ready bool, msg string, app *apiv1.App) {
 	}
 }
 
+func (d *DefaultLoggerImpl) Reconnected(timeStamp metav1.Time) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	pterm.Println(pterm.FgGray.Sprintf("--- reconnected at %s ---", timeStamp.Format(time.TimeOnly)))
+}
+
 func (d *DefaultLoggerImpl) Container(timeStamp metav1.Time, containerName, line string) {
 	d.lock.Lock()
 	defer d.lock.Unlock()
@@ -0,0 +1,58 @@
+package log
+
+import (
+	"encoding/json"
+	"io"
+	"sync"
+	"time"
+
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	"github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// JSONLine is a log line as written by the JSONLogger
+type JSONLine struct {
+	Time             time.Time `json:"time"`
+	App              string    `json:"app,omitempty"`
+	ContainerReplica string    `json:"containerReplica,omitempty"`
+	Container        string    `json:"container,omitempty"`
+	Init             bool      `json:"init,omitempty"`
+	Line             string    `json:"line"`
+}
+
+// JSONLogger writes every log line as a JSON object on a line of its own, so that the output can be processed with
+// tools like jq
+type JSONLogger struct {
+	lock    sync.Mutex
+	encoder *json.Encoder
+}
+
+func NewJSONLogger(out io.Writer) *JSONLogger {
+	return &JSONLogger{
+		encoder: json.NewEncoder(out),
+	}
+}
+
+func (j *JSONLogger) Container(timeStamp metav1.Time, containerName, line string) {
+	j.Message(apiv1.LogMessage{
+		Time:          timeStamp,
+		ContainerName: containerName,
+		Line:          line,
+	})
+}
+
+func (j *JSONLogger) Message(msg apiv1.LogMessage) {
+	j.lock.Lock()
+	defer j.lock.Unlock()
+	if err := j.encoder.Encode(JSONLine{
+		Time:             msg.Time.Time,
+		App:              msg.AppName,
+		ContainerReplica: msg.ContainerName,
+		Container:        msg.Container,
+		Init:             msg.Init,
+		Line:             msg.Line,
+	}); err != nil {
+		logrus.Errorf("failed to write log line: %v", err)
+	}
+}
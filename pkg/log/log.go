@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"regexp"
 	"strings"
 	"sync"
 	"time"
@@ -69,6 +70,7 @@ type Message struct {
 	Line          string
 	Pod           *corev1.Pod
 	ContainerName string
+	Init          bool
 	Time          time.Time
 
 	Err error
@@ -82,6 +84,45 @@ type Options struct {
 	Follow           bool
 	ContainerReplica string
 	Container        string
+	// Init includes all init containers of the selected pods, not only the init sidecars of the acorn containers
+	Init bool
+	// Since and Until limit the logs to the lines logged in this time window, the tail is taken from within the window
+	Since *metav1.Time
+	Until *metav1.Time
+}
+
+// ParseTime parses a point in time given either as a duration before now (e.g. 42m) or as an RFC3339 timestamp.
+// An empty value returns nil.
+func ParseTime(value string, now time.Time) (*metav1.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+	if d, err := time.ParseDuration(value); err == nil {
+		return &metav1.Time{Time: now.Add(-d)}, nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid time %q, must be a duration (e.g. 42m) or an RFC3339 timestamp (e.g. 2006-01-02T15:04:05Z)", value)
+	}
+	return &metav1.Time{Time: t}, nil
+}
+
+// ParseGrep compiles the regular expression lines are filtered by. An empty pattern returns nil.
+func ParseGrep(pattern string) (*regexp.Regexp, error) {
+	if pattern == "" {
+		return nil, nil
+	}
+	grep, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, fmt.Errorf("invalid grep pattern %q: %w", pattern, err)
+	}
+	return grep, nil
+}
+
+// MatchesGrep tells whether a line passes the grep filter, i.e. whether it matches grep or, if invert is set, doesn't.
+// All lines pass if grep is nil.
+func MatchesGrep(line string, grep *regexp.Regexp, invert bool) bool {
+	return grep == nil || grep.MatchString(line) != invert
 }
 
 func (o *Options) restConfig() (*rest.Config, error) {
@@ -128,10 +169,15 @@ func (o *Options) Complete() (*Options, error) {
 	return o, nil
 }
 
-func pipe(input io.ReadCloser, output chan<- Message, pod *corev1.Pod, name string, after *metav1.Time) (*metav1.Time, error) {
+// pipe sends the lines logged after after and not after until to output. If keepLast is set, only that many of the
+// last lines are sent, once input is done.
+func pipe(input io.ReadCloser, output chan<- Message, pod *corev1.Pod, name string, after, until *metav1.Time, keepLast *int64) (*metav1.Time, error) {
 	defer input.Close()
 
-	var lastTS *metav1.Time
+	var (
+		lastTS *metav1.Time
+		last   []Message
+	)
 
 	scanner := bufio.NewScanner(input)
 	scanner.Buffer(nil, 2_000_000)
@@ -151,13 +197,29 @@ func pipe(input io.ReadCloser, output chan<- Message, pod *corev1.Pod, name stri
 		if after != nil && !lastTS.After(after.Time) {
 			continue
 		}
+		if until != nil && lastTS.After(until.Time) {
+			continue
+		}
 
-		output <- Message{
+		msg := Message{
 			Line:          newLine,
 			Pod:           pod,
 			ContainerName: name,
+			Init:          isInitContainer(pod, name),
 			Time:          lastTS.Time,
 		}
+		if keepLast == nil {
+			output <- msg
+			continue
+		}
+		last = append(last, msg)
+		if int64(len(last)) > *keepLast {
+			last = last[1:]
+		}
+	}
+
+	for _, msg := range last {
+		output <- msg
 	}
 
 	return lastTS, scanner.Err()
@@ -170,11 +232,17 @@ func Container(ctx context.Context, pod *corev1.Pod, name string, output chan<-
 	}
 
 	var (
-		first = true
-		since *metav1.Time
-		tail  = options.Tail
+		first    = true
+		since    *metav1.Time
+		tail     = options.Tail
+		keepLast *int64
 	)
 
+	if options.Until != nil && !options.Follow && tail != nil {
+		// The kubelet can only tail the latest lines, the tail of the time window is taken while reading all of it
+		keepLast, tail = tail, nil
+	}
+
 	for {
 		select {
 		case <-ctx.Done():
@@ -196,10 +264,15 @@ func Container(ctx context.Context, pod *corev1.Pod, name string, output chan<-
 			}
 		}
 
+		sinceTime := since
+		if sinceTime == nil {
+			sinceTime = options.Since
+		}
+
 		req := options.PodClient.Pods(pod.Namespace).GetLogs(pod.Name, &corev1.PodLogOptions{
 			Container:  name,
 			Follow:     options.Follow,
-			SinceTime:  since,
+			SinceTime:  sinceTime,
 			Timestamps: true,
 			TailLines:  tail,
 		})
@@ -218,7 +291,7 @@ func Container(ctx context.Context, pod *corev1.Pod, name string, output chan<-
 			continue
 		}
 		// pipe will close the readCloser
-		lastTS, err := pipe(readCloser, output, pod, name, since)
+		lastTS, err := pipe(readCloser, output, pod, name, since, options.Until, keepLast)
 		if err != nil && !errors.Is(err, context.Canceled) {
 			output <- Message{
 				Time:          time.Now(),
@@ -261,6 +334,18 @@ func isContainerLoggable(pod *corev1.Pod, containerName string) bool {
 	return false
 }
 
+func isInitContainer(pod *corev1.Pod, containerName string) bool {
+	if pod == nil {
+		return false
+	}
+	for _, container := range pod.Spec.InitContainers {
+		if container.Name == containerName {
+			return true
+		}
+	}
+	return false
+}
+
 func Pod(ctx context.Context, pod *corev1.Pod, output chan<- Message, options *Options) error {
 	options, err := options.Complete()
 	if err != nil {
@@ -280,7 +365,7 @@ func Pod(ctx context.Context, pod *corev1.Pod, output chan<- Message, options *O
 			}
 		}
 		for _, container := range pod.Spec.InitContainers {
-			if !matchesContainer(pod, container, options) {
+			if !matchesInitContainer(pod, container, options) {
 				continue
 			}
 			if err := Container(ctx, pod, container.Name, output, options); err != nil {
@@ -327,7 +412,7 @@ func Pod(ctx context.Context, pod *corev1.Pod, output chan<- Message, options *O
 			}
 			for _, container := range pod.Spec.InitContainers {
 				container := container
-				if !matchesContainer(pod, container, options) {
+				if !matchesInitContainer(pod, container, options) {
 					continue
 				}
 				if !isContainerLoggable(pod, container.Name) {
@@ -468,12 +553,51 @@ func matchesContainer(pod *corev1.Pod, container corev1.Container, options *Opti
 	return slices.Contains(validContainerNames, container.Name)
 }
 
+// matchesInitContainer is matchesContainer for the init containers of a pod. With the Init option all init containers
+// of the selected pods match, including the ones acorn adds to prepare the acorn containers.
+func matchesInitContainer(pod *corev1.Pod, container corev1.Container, options *Options) bool {
+	if matchesContainer(pod, container, options) {
+		return true
+	}
+	if options == nil || !options.Init {
+		return false
+	}
+
+	if options.ContainerReplica != "" {
+		podName, containerName := publicname.SplitPodContainerName(options.ContainerReplica)
+		return pod.Name == podName && (containerName == "" || container.Name == containerName)
+	}
+
+	if options.Container != "" {
+		return pod.Labels[applabels.AcornContainerName] == options.Container ||
+			pod.Labels[applabels.AcornFunctionName] == options.Container ||
+			pod.Labels[applabels.AcornJobName] == options.Container
+	}
+
+	return true
+}
+
 func App(ctx context.Context, app *apiv1.App, output chan<- Message, options *Options) error {
 	options, err := options.Complete()
 	if err != nil {
 		return err
 	}
 
+	if options.Until != nil && options.Follow {
+		if untilIn := time.Until(options.Until.Time); untilIn <= 0 {
+			// Nothing new can be in the time window, so stop after the lines logged so far
+			noFollow := *options
+			noFollow.Follow = false
+			options = &noFollow
+		} else {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithCancel(ctx)
+			defer cancel()
+			stop := time.AfterFunc(untilIn, cancel)
+			defer stop.Stop()
+		}
+	}
+
 	if !options.Follow {
 		return appNoFollow(ctx, app, output, options)
 	}
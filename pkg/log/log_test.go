@@ -1,10 +1,17 @@
 package log
 
 import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"strings"
 	"testing"
+	"time"
 
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
 	"github.com/acorn-io/runtime/pkg/labels"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -198,3 +205,162 @@ func TestMatchesContainer(t *testing.T) {
 		})
 	}
 }
+
+func TestParseTime(t *testing.T) {
+	now := time.Date(2023, 12, 24, 18, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		value    string
+		expected *metav1.Time
+		err      bool
+	}{
+		{value: "", expected: nil},
+		{value: "42m", expected: &metav1.Time{Time: now.Add(-42 * time.Minute)}},
+		{value: "2023-12-24T12:00:00Z", expected: &metav1.Time{Time: time.Date(2023, 12, 24, 12, 0, 0, 0, time.UTC)}},
+		{value: "yesterday", err: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.value, func(t *testing.T) {
+			result, err := ParseTime(test.value, now)
+			if test.err {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, result)
+		})
+	}
+}
+
+func TestMatchesGrep(t *testing.T) {
+	grep, err := ParseGrep("error|warn")
+	require.NoError(t, err)
+
+	assert.True(t, MatchesGrep("level=error msg=failed", grep, false))
+	assert.False(t, MatchesGrep("level=info msg=started", grep, false))
+	assert.False(t, MatchesGrep("level=error msg=failed", grep, true))
+	assert.True(t, MatchesGrep("level=info msg=started", grep, true))
+
+	none, err := ParseGrep("")
+	require.NoError(t, err)
+	assert.True(t, MatchesGrep("anything", none, false))
+	assert.True(t, MatchesGrep("anything", none, true))
+
+	_, err = ParseGrep("error(")
+	assert.EqualError(t, err, "invalid grep pattern \"error(\": error parsing regexp: missing closing ): `error(`")
+}
+
+func TestPipeTimeWindow(t *testing.T) {
+	input := strings.Join([]string{
+		"2023-12-24T10:00:00Z one",
+		"2023-12-24T11:00:00Z two",
+		"2023-12-24T12:00:00Z three",
+		"2023-12-24T13:00:00Z four",
+		"2023-12-24T14:00:00Z five",
+	}, "\n")
+	after := &metav1.Time{Time: time.Date(2023, 12, 24, 10, 0, 0, 0, time.UTC)}
+	until := &metav1.Time{Time: time.Date(2023, 12, 24, 13, 0, 0, 0, time.UTC)}
+
+	read := func(keepLast *int64) (lines []string) {
+		output := make(chan Message, 5)
+		_, err := pipe(io.NopCloser(strings.NewReader(input)), output, nil, "test", after, until, keepLast)
+		require.NoError(t, err)
+		close(output)
+		for msg := range output {
+			lines = append(lines, msg.Line)
+		}
+		return lines
+	}
+
+	assert.Equal(t, []string{"two", "three", "four"}, read(nil))
+
+	// The tail is taken from within the window
+	keepLast := int64(2)
+	assert.Equal(t, []string{"three", "four"}, read(&keepLast))
+}
+
+func TestJSONLogger(t *testing.T) {
+	buf := &bytes.Buffer{}
+	logger := NewJSONLogger(buf)
+
+	ts := time.Date(2023, 12, 24, 18, 0, 0, 0, time.UTC)
+	logger.Message(apiv1.LogMessage{
+		Line:          `hello "world"`,
+		AppName:       "app",
+		ContainerName: "web-7f8d9c-x2k4l.sidecar",
+		Container:     "sidecar",
+		Time:          metav1.NewTime(ts),
+	})
+	logger.Container(metav1.NewTime(ts), "web-7f8d9c-x2k4l", "second line")
+
+	// one object per line
+	assert.Equal(t, 2, strings.Count(buf.String(), "\n"))
+
+	var lines []JSONLine
+	decoder := json.NewDecoder(buf)
+	for decoder.More() {
+		var line JSONLine
+		require.NoError(t, decoder.Decode(&line))
+		lines = append(lines, line)
+	}
+
+	assert.Equal(t, []JSONLine{
+		{Time: ts, App: "app", ContainerReplica: "web-7f8d9c-x2k4l.sidecar", Container: "sidecar", Line: `hello "world"`},
+		{Time: ts, ContainerReplica: "web-7f8d9c-x2k4l", Line: "second line"},
+	}, lines)
+}
+
+func TestMatchesInitContainer(t *testing.T) {
+	pod := appWithLinkerdProxy.DeepCopy()
+	pod.Spec.InitContainers = []corev1.Container{
+		{Name: "acorn-helper"},
+		{Name: "sidecar"},
+	}
+
+	tests := []struct {
+		name      string
+		container string
+		options   *Options
+		expected  bool
+	}{
+		{name: "init-sidecar-without-init", container: "sidecar", options: &Options{}, expected: true},
+		{name: "helper-without-init", container: "acorn-helper", options: &Options{}, expected: false},
+		{name: "helper-with-init", container: "acorn-helper", options: &Options{Init: true}, expected: true},
+		{name: "helper-with-init-container-match", container: "acorn-helper", options: &Options{Init: true, Container: "nginx"}, expected: true},
+		{name: "helper-with-init-container-no-match", container: "acorn-helper", options: &Options{Init: true, Container: "other"}, expected: false},
+		{name: "helper-with-init-replica", container: "acorn-helper", options: &Options{Init: true, ContainerReplica: "app-name.app-with-linkerd-pod"}, expected: true},
+		{name: "helper-with-init-replica-container", container: "acorn-helper", options: &Options{Init: true, ContainerReplica: "app-name.app-with-linkerd-pod:acorn-helper"}, expected: true},
+		{name: "helper-with-init-other-replica", container: "acorn-helper", options: &Options{Init: true, ContainerReplica: "app-name.other-pod"}, expected: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, matchesInitContainer(pod, corev1.Container{Name: test.container}, test.options))
+		})
+	}
+}
+
+func TestPipeMarksInitContainers(t *testing.T) {
+	pod := &corev1.Pod{
+		Spec: corev1.PodSpec{
+			InitContainers: []corev1.Container{{Name: "acorn-helper"}},
+			Containers:     []corev1.Container{{Name: "web"}},
+		},
+	}
+
+	read := func(name string) Message {
+		output := make(chan Message, 1)
+		_, err := pipe(io.NopCloser(strings.NewReader("2023-12-24T10:00:00Z hello")), output, pod, name, nil, nil, nil)
+		require.NoError(t, err)
+		return <-output
+	}
+
+	assert.True(t, read("acorn-helper").Init)
+	assert.False(t, read("web").Init)
+}
+
+func TestContainerNamePrefixesInit(t *testing.T) {
+	assert.Equal(t, "[init] web-7f8d9c-x2k4l.acorn-helper", containerName(apiv1.LogMessage{ContainerName: "web-7f8d9c-x2k4l.acorn-helper", Init: true}))
+	assert.Equal(t, "web-7f8d9c-x2k4l", containerName(apiv1.LogMessage{ContainerName: "web-7f8d9c-x2k4l"}))
+}
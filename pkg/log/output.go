@@ -9,6 +9,7 @@ import (
 	"github.com/acorn-io/runtime/pkg/client"
 	"github.com/pterm/pterm"
 	"github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 var (
@@ -23,12 +24,25 @@ var (
 	index = 0
 )
 
+// initPrefix is put in front of the container name of lines logged by init containers
+const initPrefix = "[init] "
+
 func nextColor() pterm.Color {
 	c := colors[index%len(colors)]
 	index++
 	return c
 }
 
+// messageWriter is implemented by loggers which write more of the log message than the container name and line
+type messageWriter interface {
+	Message(msg v1.LogMessage)
+}
+
+// reconnectWriter is implemented by loggers which mark where a followed log stream was established again
+type reconnectWriter interface {
+	Reconnected(timeStamp metav1.Time)
+}
+
 func getLogger(opts *client.LogOptions) client.ContainerLogsWriter {
 	if opts.Logger == nil {
 		return &DefaultLoggerImpl{
@@ -39,7 +53,26 @@ func getLogger(opts *client.LogOptions) client.ContainerLogsWriter {
 }
 
 func Output(ctx context.Context, c client.Client, name string, opts *client.LogOptions) error {
-	msgs, err := c.AppLog(ctx, name, opts)
+	// The server filters by time and grep pattern as well, this is only in case it doesn't support it yet
+	now := time.Now()
+	since, err := ParseTime(opts.Since, now)
+	if err != nil {
+		return err
+	}
+	until, err := ParseTime(opts.Until, now)
+	if err != nil {
+		return err
+	}
+	grep, err := ParseGrep(opts.Grep)
+	if err != nil {
+		return err
+	}
+
+	appLog := c.AppLog
+	if opts.Follow {
+		appLog = c.AppLogsFollow
+	}
+	msgs, err := appLog(ctx, name, opts)
 	if err != nil {
 		return err
 	}
@@ -47,29 +80,48 @@ func Output(ctx context.Context, c client.Client, name string, opts *client.LogO
 	logger := getLogger(opts)
 
 	for msg := range msgs {
-		result, err := SinceLogCheck(opts.Since, msg)
-		if err != nil {
-			return err
+		if msg.Reconnected {
+			if w, ok := logger.(reconnectWriter); ok {
+				w.Reconnected(msg.Time)
+			} else {
+				logrus.Debugf("Reconnected to the logs of %s", name)
+			}
+			continue
+		}
+		if !inTimeWindow(msg, since, until) {
+			continue
 		}
-		if result {
-			if msg.Error == "" {
-				logger.Container(msg.Time, msg.ContainerName, msg.Line)
-			} else if !strings.Contains(msg.Error, "context canceled") {
-				logrus.Error(msg.Error)
+		if msg.Error == "" && !MatchesGrep(msg.Line, grep, opts.GrepInvert) {
+			continue
+		}
+		if msg.Error == "" {
+			if w, ok := logger.(messageWriter); ok {
+				w.Message(msg)
+			} else {
+				logger.Container(msg.Time, containerName(msg), msg.Line)
 			}
+		} else if !strings.Contains(msg.Error, "context canceled") {
+			logrus.Error(msg.Error)
 		}
 	}
 
 	return nil
 }
 
-func SinceLogCheck(since string, msg v1.LogMessage) (bool, error) {
-	if since == "" {
-		return true, nil
+func containerName(msg v1.LogMessage) string {
+	if msg.Init {
+		return initPrefix + msg.ContainerName
 	}
-	beforeTime, err := time.ParseDuration(since)
-	if err != nil {
-		return false, err
+	return msg.ContainerName
+}
+
+// inTimeWindow tells whether the message was logged after since and not after until, errors are always in the window
+func inTimeWindow(msg v1.LogMessage, since, until *metav1.Time) bool {
+	if msg.Error != "" {
+		return true
+	}
+	if since != nil && !msg.Time.After(since.Time) {
+		return false
 	}
-	return msg.Time.After(time.Now().Add(-beforeTime)), nil
+	return until == nil || !msg.Time.After(until.Time)
 }
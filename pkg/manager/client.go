@@ -52,7 +52,7 @@ type accountStatus struct {
 }
 
 func httpDelete(ctx context.Context, url, token string) {
-	logrus.Debugf("Delete %s", url)
+	logrus.Debugf("Delete %s", redactURL(url))
 	req, err := newRequest(url, http.MethodDelete, token)
 	if err != nil {
 		return
@@ -66,7 +66,7 @@ func httpDelete(ctx context.Context, url, token string) {
 }
 
 func httpGet(ctx context.Context, url, token string, into interface{}) error {
-	logrus.Debugf("Looking up %s", url)
+	logrus.Debugf("Looking up %s", redactURL(url))
 	req, err := newRequest(url, http.MethodGet, token)
 	if err != nil {
 		return err
@@ -94,7 +94,12 @@ func httpGet(ctx context.Context, url, token string, into interface{}) error {
 		return fmt.Errorf("can't read response %w", err)
 	}
 
-	logrus.Debugf("Response code: %v. Response body: %s", resp.StatusCode, body)
+	if redactURL(url) != url {
+		// The body of a token request has the token
+		logrus.Debugf("Response code: %v", resp.StatusCode)
+	} else {
+		logrus.Debugf("Response code: %v. Response body: %s", resp.StatusCode, body)
+	}
 
 	return json.Unmarshal(body, into)
 }
@@ -21,6 +21,15 @@ func toAccountURL(address, account string) string {
 	return fmt.Sprintf("%s://%s/apis/manager.acorn.io/v1/accounts/%s", scheme(address), address, account)
 }
 
+// redactURL replaces the token in a token request URL, so that it isn't written to debug logs. Other URLs are returned
+// as is.
+func redactURL(url string) string {
+	if prefix, _, ok := strings.Cut(url, "/tokenrequests/"); ok {
+		return prefix + "/tokenrequests/REDACTED"
+	}
+	return url
+}
+
 func scheme(address string) string {
 	if isLocal(address) {
 		return "http"
@@ -6,6 +6,7 @@ package mocks
 
 import (
 	context "context"
+	io "io"
 	reflect "reflect"
 
 	v1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
@@ -113,6 +114,21 @@ func (mr *MockClientMockRecorder) AppConfirmUpgrade(arg0, arg1 interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AppConfirmUpgrade", reflect.TypeOf((*MockClient)(nil).AppConfirmUpgrade), arg0, arg1)
 }
 
+// AppContainerEnv mocks base method.
+func (m *MockClient) AppContainerEnv(arg0 context.Context, arg1, arg2 string, arg3 *client.AppContainerEnvOptions) ([]client.AppContainerEnvVar, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AppContainerEnv", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]client.AppContainerEnvVar)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AppContainerEnv indicates an expected call of AppContainerEnv.
+func (mr *MockClientMockRecorder) AppContainerEnv(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AppContainerEnv", reflect.TypeOf((*MockClient)(nil).AppContainerEnv), arg0, arg1, arg2, arg3)
+}
+
 // AppDelete mocks base method.
 func (m *MockClient) AppDelete(arg0 context.Context, arg1 string) (*v1.App, error) {
 	m.ctrl.T.Helper()
@@ -128,6 +144,36 @@ func (mr *MockClientMockRecorder) AppDelete(arg0, arg1 interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AppDelete", reflect.TypeOf((*MockClient)(nil).AppDelete), arg0, arg1)
 }
 
+// AppDiff mocks base method.
+func (m *MockClient) AppDiff(arg0 context.Context, arg1 string, arg2 *v10.AppInstanceSpec) (*client.AppDiff, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AppDiff", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*client.AppDiff)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AppDiff indicates an expected call of AppDiff.
+func (mr *MockClientMockRecorder) AppDiff(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AppDiff", reflect.TypeOf((*MockClient)(nil).AppDiff), arg0, arg1, arg2)
+}
+
+// AppExport mocks base method.
+func (m *MockClient) AppExport(arg0 context.Context, arg1 string, arg2 *client.AppExportOptions) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AppExport", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AppExport indicates an expected call of AppExport.
+func (mr *MockClientMockRecorder) AppExport(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AppExport", reflect.TypeOf((*MockClient)(nil).AppExport), arg0, arg1, arg2)
+}
+
 // AppGet mocks base method.
 func (m *MockClient) AppGet(arg0 context.Context, arg1 string) (*v1.App, error) {
 	m.ctrl.T.Helper()
@@ -157,6 +203,21 @@ func (mr *MockClientMockRecorder) AppIgnoreDeleteCleanup(arg0, arg1 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AppIgnoreDeleteCleanup", reflect.TypeOf((*MockClient)(nil).AppIgnoreDeleteCleanup), arg0, arg1)
 }
 
+// AppImport mocks base method.
+func (m *MockClient) AppImport(arg0 context.Context, arg1 []byte, arg2 *client.AppImportOptions) (*v1.App, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AppImport", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*v1.App)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AppImport indicates an expected call of AppImport.
+func (mr *MockClientMockRecorder) AppImport(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AppImport", reflect.TypeOf((*MockClient)(nil).AppImport), arg0, arg1, arg2)
+}
+
 // AppInfo mocks base method.
 func (m *MockClient) AppInfo(arg0 context.Context, arg1 string) (string, error) {
 	m.ctrl.T.Helper()
@@ -202,6 +263,50 @@ func (mr *MockClientMockRecorder) AppLog(arg0, arg1, arg2 interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AppLog", reflect.TypeOf((*MockClient)(nil).AppLog), arg0, arg1, arg2)
 }
 
+// AppLogsFollow mocks base method.
+func (m *MockClient) AppLogsFollow(arg0 context.Context, arg1 string, arg2 *client.LogOptions) (<-chan v1.LogMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AppLogsFollow", arg0, arg1, arg2)
+	ret0, _ := ret[0].(<-chan v1.LogMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AppLogsFollow indicates an expected call of AppLogsFollow.
+func (mr *MockClientMockRecorder) AppLogsFollow(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AppLogsFollow", reflect.TypeOf((*MockClient)(nil).AppLogsFollow), arg0, arg1, arg2)
+}
+
+// AppMetrics mocks base method.
+func (m *MockClient) AppMetrics(arg0 context.Context, arg1 string) (*v1.AppMetrics, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AppMetrics", arg0, arg1)
+	ret0, _ := ret[0].(*v1.AppMetrics)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AppMetrics indicates an expected call of AppMetrics.
+func (mr *MockClientMockRecorder) AppMetrics(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AppMetrics", reflect.TypeOf((*MockClient)(nil).AppMetrics), arg0, arg1)
+}
+
+// AppPause mocks base method.
+func (m *MockClient) AppPause(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AppPause", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AppPause indicates an expected call of AppPause.
+func (mr *MockClientMockRecorder) AppPause(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AppPause", reflect.TypeOf((*MockClient)(nil).AppPause), arg0, arg1)
+}
+
 // AppPullImage mocks base method.
 func (m *MockClient) AppPullImage(arg0 context.Context, arg1 string) error {
 	m.ctrl.T.Helper()
@@ -216,6 +321,49 @@ func (mr *MockClientMockRecorder) AppPullImage(arg0, arg1 interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AppPullImage", reflect.TypeOf((*MockClient)(nil).AppPullImage), arg0, arg1)
 }
 
+// AppRename mocks base method.
+func (m *MockClient) AppRename(arg0 context.Context, arg1, arg2 string) (*v1.App, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AppRename", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*v1.App)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AppRename indicates an expected call of AppRename.
+func (mr *MockClientMockRecorder) AppRename(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AppRename", reflect.TypeOf((*MockClient)(nil).AppRename), arg0, arg1, arg2)
+}
+
+// AppRestart mocks base method.
+func (m *MockClient) AppRestart(arg0 context.Context, arg1 string, arg2 *client.AppRestartOptions) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AppRestart", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AppRestart indicates an expected call of AppRestart.
+func (mr *MockClientMockRecorder) AppRestart(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AppRestart", reflect.TypeOf((*MockClient)(nil).AppRestart), arg0, arg1, arg2)
+}
+
+// AppResume mocks base method.
+func (m *MockClient) AppResume(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AppResume", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AppResume indicates an expected call of AppResume.
+func (mr *MockClientMockRecorder) AppResume(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AppResume", reflect.TypeOf((*MockClient)(nil).AppResume), arg0, arg1)
+}
+
 // AppRun mocks base method.
 func (m *MockClient) AppRun(arg0 context.Context, arg1 string, arg2 *client.AppRunOptions) (*v1.App, error) {
 	m.ctrl.T.Helper()
@@ -231,6 +379,21 @@ func (mr *MockClientMockRecorder) AppRun(arg0, arg1, arg2 interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AppRun", reflect.TypeOf((*MockClient)(nil).AppRun), arg0, arg1, arg2)
 }
 
+// AppScale mocks base method.
+func (m *MockClient) AppScale(arg0 context.Context, arg1, arg2 string, arg3 int32) (*v1.App, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AppScale", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*v1.App)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AppScale indicates an expected call of AppScale.
+func (mr *MockClientMockRecorder) AppScale(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AppScale", reflect.TypeOf((*MockClient)(nil).AppScale), arg0, arg1, arg2, arg3)
+}
+
 // AppStart mocks base method.
 func (m *MockClient) AppStart(arg0 context.Context, arg1 string) error {
 	m.ctrl.T.Helper()
@@ -245,6 +408,21 @@ func (mr *MockClientMockRecorder) AppStart(arg0, arg1 interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AppStart", reflect.TypeOf((*MockClient)(nil).AppStart), arg0, arg1)
 }
 
+// AppStatusStream mocks base method.
+func (m *MockClient) AppStatusStream(arg0 context.Context, arg1 string) (<-chan v1.App, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AppStatusStream", arg0, arg1)
+	ret0, _ := ret[0].(<-chan v1.App)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AppStatusStream indicates an expected call of AppStatusStream.
+func (mr *MockClientMockRecorder) AppStatusStream(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AppStatusStream", reflect.TypeOf((*MockClient)(nil).AppStatusStream), arg0, arg1)
+}
+
 // AppStop mocks base method.
 func (m *MockClient) AppStop(arg0 context.Context, arg1 string) error {
 	m.ctrl.T.Helper()
@@ -454,6 +632,21 @@ func (mr *MockClientMockRecorder) CredentialUpdate(arg0, arg1, arg2, arg3, arg4
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CredentialUpdate", reflect.TypeOf((*MockClient)(nil).CredentialUpdate), arg0, arg1, arg2, arg3, arg4)
 }
 
+// CredentialValidate mocks base method.
+func (m *MockClient) CredentialValidate(arg0 context.Context, arg1 string, arg2 *client.CredentialValidateOptions) (*client.CredentialValidation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CredentialValidate", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*client.CredentialValidation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CredentialValidate indicates an expected call of CredentialValidate.
+func (mr *MockClientMockRecorder) CredentialValidate(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CredentialValidate", reflect.TypeOf((*MockClient)(nil).CredentialValidate), arg0, arg1, arg2)
+}
+
 // DevSessionRelease mocks base method.
 func (m *MockClient) DevSessionRelease(arg0 context.Context, arg1 string) error {
 	m.ctrl.T.Helper()
@@ -571,6 +764,20 @@ func (mr *MockClientMockRecorder) ImageDetails(arg0, arg1, arg2 interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImageDetails", reflect.TypeOf((*MockClient)(nil).ImageDetails), arg0, arg1, arg2)
 }
 
+// ImageExtractFile mocks base method.
+func (m *MockClient) ImageExtractFile(arg0 context.Context, arg1, arg2 string, arg3 io.Writer, arg4 *client.ImageExtractOptions) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImageExtractFile", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ImageExtractFile indicates an expected call of ImageExtractFile.
+func (mr *MockClientMockRecorder) ImageExtractFile(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImageExtractFile", reflect.TypeOf((*MockClient)(nil).ImageExtractFile), arg0, arg1, arg2, arg3, arg4)
+}
+
 // ImageGet mocks base method.
 func (m *MockClient) ImageGet(arg0 context.Context, arg1 string) (*v1.Image, error) {
 	m.ctrl.T.Helper()
@@ -586,6 +793,36 @@ func (mr *MockClientMockRecorder) ImageGet(arg0, arg1 interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImageGet", reflect.TypeOf((*MockClient)(nil).ImageGet), arg0, arg1)
 }
 
+// ImageHistory mocks base method.
+func (m *MockClient) ImageHistory(arg0 context.Context, arg1 string, arg2 *client.ImageHistoryOptions) (*client.ImageHistory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImageHistory", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*client.ImageHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImageHistory indicates an expected call of ImageHistory.
+func (mr *MockClientMockRecorder) ImageHistory(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImageHistory", reflect.TypeOf((*MockClient)(nil).ImageHistory), arg0, arg1, arg2)
+}
+
+// ImageIndexCreate mocks base method.
+func (m *MockClient) ImageIndexCreate(arg0 context.Context, arg1 string, arg2 []client.ImageIndexEntry, arg3 *client.ImageIndexCreateOptions) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImageIndexCreate", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImageIndexCreate indicates an expected call of ImageIndexCreate.
+func (mr *MockClientMockRecorder) ImageIndexCreate(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImageIndexCreate", reflect.TypeOf((*MockClient)(nil).ImageIndexCreate), arg0, arg1, arg2, arg3)
+}
+
 // ImageList mocks base method.
 func (m *MockClient) ImageList(arg0 context.Context) ([]v1.Image, error) {
 	m.ctrl.T.Helper()
@@ -601,6 +838,21 @@ func (mr *MockClientMockRecorder) ImageList(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImageList", reflect.TypeOf((*MockClient)(nil).ImageList), arg0)
 }
 
+// ImagePrune mocks base method.
+func (m *MockClient) ImagePrune(arg0 context.Context, arg1 *client.ImagePruneOptions) ([]v1.Image, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImagePrune", arg0, arg1)
+	ret0, _ := ret[0].([]v1.Image)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImagePrune indicates an expected call of ImagePrune.
+func (mr *MockClientMockRecorder) ImagePrune(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImagePrune", reflect.TypeOf((*MockClient)(nil).ImagePrune), arg0, arg1)
+}
+
 // ImagePull mocks base method.
 func (m *MockClient) ImagePull(arg0 context.Context, arg1 string, arg2 *client.ImagePullOptions) (<-chan client.ImageProgress, error) {
 	m.ctrl.T.Helper()
@@ -631,6 +883,21 @@ func (mr *MockClientMockRecorder) ImagePush(arg0, arg1, arg2 interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImagePush", reflect.TypeOf((*MockClient)(nil).ImagePush), arg0, arg1, arg2)
 }
 
+// ImageScan mocks base method.
+func (m *MockClient) ImageScan(arg0 context.Context, arg1 string, arg2 *client.ImageScanOptions) (*client.ImageScanResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImageScan", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*client.ImageScanResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImageScan indicates an expected call of ImageScan.
+func (mr *MockClientMockRecorder) ImageScan(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImageScan", reflect.TypeOf((*MockClient)(nil).ImageScan), arg0, arg1, arg2)
+}
+
 // ImageSign mocks base method.
 func (m *MockClient) ImageSign(arg0 context.Context, arg1 string, arg2 []byte, arg3 string, arg4 *client.ImageSignOptions) (*v1.ImageSignature, error) {
 	m.ctrl.T.Helper()
@@ -646,18 +913,47 @@ func (mr *MockClientMockRecorder) ImageSign(arg0, arg1, arg2, arg3, arg4 interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImageSign", reflect.TypeOf((*MockClient)(nil).ImageSign), arg0, arg1, arg2, arg3, arg4)
 }
 
+// ImageSignatures mocks base method.
+func (m *MockClient) ImageSignatures(arg0 context.Context, arg1 string, arg2 *client.ImageSignaturesOptions) ([]v1.ImageSignatureEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImageSignatures", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]v1.ImageSignatureEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImageSignatures indicates an expected call of ImageSignatures.
+func (mr *MockClientMockRecorder) ImageSignatures(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImageSignatures", reflect.TypeOf((*MockClient)(nil).ImageSignatures), arg0, arg1, arg2)
+}
+
 // ImageTag mocks base method.
-func (m *MockClient) ImageTag(arg0 context.Context, arg1, arg2 string) error {
+func (m *MockClient) ImageTag(arg0 context.Context, arg1, arg2 string, arg3 *client.ImageTagOptions) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ImageTag", arg0, arg1, arg2)
+	ret := m.ctrl.Call(m, "ImageTag", arg0, arg1, arg2, arg3)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // ImageTag indicates an expected call of ImageTag.
-func (mr *MockClientMockRecorder) ImageTag(arg0, arg1, arg2 interface{}) *gomock.Call {
+func (mr *MockClientMockRecorder) ImageTag(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImageTag", reflect.TypeOf((*MockClient)(nil).ImageTag), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImageTag", reflect.TypeOf((*MockClient)(nil).ImageTag), arg0, arg1, arg2, arg3)
+}
+
+// ImageUnsign mocks base method.
+func (m *MockClient) ImageUnsign(arg0 context.Context, arg1, arg2 string, arg3 *client.ImageUnsignOptions) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImageUnsign", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ImageUnsign indicates an expected call of ImageUnsign.
+func (mr *MockClientMockRecorder) ImageUnsign(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImageUnsign", reflect.TypeOf((*MockClient)(nil).ImageUnsign), arg0, arg1, arg2, arg3)
 }
 
 // ImageVerify mocks base method.
@@ -765,18 +1061,18 @@ func (mr *MockClientMockRecorder) KubeProxyAddress(arg0, arg1 interface{}) *gomo
 }
 
 // ProjectCreate mocks base method.
-func (m *MockClient) ProjectCreate(arg0 context.Context, arg1, arg2 string, arg3 []string) (*v1.Project, error) {
+func (m *MockClient) ProjectCreate(arg0 context.Context, arg1 string, arg2 *client.ProjectCreateOptions) (*v1.Project, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ProjectCreate", arg0, arg1, arg2, arg3)
+	ret := m.ctrl.Call(m, "ProjectCreate", arg0, arg1, arg2)
 	ret0, _ := ret[0].(*v1.Project)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ProjectCreate indicates an expected call of ProjectCreate.
-func (mr *MockClientMockRecorder) ProjectCreate(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+func (mr *MockClientMockRecorder) ProjectCreate(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProjectCreate", reflect.TypeOf((*MockClient)(nil).ProjectCreate), arg0, arg1, arg2, arg3)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProjectCreate", reflect.TypeOf((*MockClient)(nil).ProjectCreate), arg0, arg1, arg2)
 }
 
 // ProjectDelete mocks base method.
@@ -869,6 +1165,21 @@ func (mr *MockClientMockRecorder) RegionList(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegionList", reflect.TypeOf((*MockClient)(nil).RegionList), arg0)
 }
 
+// SecretCopy mocks base method.
+func (m *MockClient) SecretCopy(arg0 context.Context, arg1 string, arg2 *client.SecretCopyOptions) (*v1.Secret, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SecretCopy", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*v1.Secret)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SecretCopy indicates an expected call of SecretCopy.
+func (mr *MockClientMockRecorder) SecretCopy(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SecretCopy", reflect.TypeOf((*MockClient)(nil).SecretCopy), arg0, arg1, arg2)
+}
+
 // SecretCreate mocks base method.
 func (m *MockClient) SecretCreate(arg0 context.Context, arg1, arg2 string, arg3 map[string][]byte) (*v1.Secret, error) {
 	m.ctrl.T.Helper()
@@ -944,6 +1255,36 @@ func (mr *MockClientMockRecorder) SecretReveal(arg0, arg1 interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SecretReveal", reflect.TypeOf((*MockClient)(nil).SecretReveal), arg0, arg1)
 }
 
+// SecretRevealAll mocks base method.
+func (m *MockClient) SecretRevealAll(arg0 context.Context) (*client.SecretRevealAllResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SecretRevealAll", arg0)
+	ret0, _ := ret[0].(*client.SecretRevealAllResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SecretRevealAll indicates an expected call of SecretRevealAll.
+func (mr *MockClientMockRecorder) SecretRevealAll(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SecretRevealAll", reflect.TypeOf((*MockClient)(nil).SecretRevealAll), arg0)
+}
+
+// SecretRotate mocks base method.
+func (m *MockClient) SecretRotate(arg0 context.Context, arg1 string) (*v1.SecretRotate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SecretRotate", arg0, arg1)
+	ret0, _ := ret[0].(*v1.SecretRotate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SecretRotate indicates an expected call of SecretRotate.
+func (mr *MockClientMockRecorder) SecretRotate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SecretRotate", reflect.TypeOf((*MockClient)(nil).SecretRotate), arg0, arg1)
+}
+
 // SecretUpdate mocks base method.
 func (m *MockClient) SecretUpdate(arg0 context.Context, arg1 string, arg2 map[string][]byte) (*v1.Secret, error) {
 	m.ctrl.T.Helper()
@@ -959,6 +1300,36 @@ func (mr *MockClientMockRecorder) SecretUpdate(arg0, arg1, arg2 interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SecretUpdate", reflect.TypeOf((*MockClient)(nil).SecretUpdate), arg0, arg1, arg2)
 }
 
+// ServiceGrant mocks base method.
+func (m *MockClient) ServiceGrant(arg0 context.Context, arg1, arg2 string) (*v1.App, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ServiceGrant", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*v1.App)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ServiceGrant indicates an expected call of ServiceGrant.
+func (mr *MockClientMockRecorder) ServiceGrant(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ServiceGrant", reflect.TypeOf((*MockClient)(nil).ServiceGrant), arg0, arg1, arg2)
+}
+
+// ServiceRevoke mocks base method.
+func (m *MockClient) ServiceRevoke(arg0 context.Context, arg1, arg2 string) (*v1.App, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ServiceRevoke", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*v1.App)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ServiceRevoke indicates an expected call of ServiceRevoke.
+func (mr *MockClientMockRecorder) ServiceRevoke(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ServiceRevoke", reflect.TypeOf((*MockClient)(nil).ServiceRevoke), arg0, arg1, arg2)
+}
+
 // VolumeClassGet mocks base method.
 func (m *MockClient) VolumeClassGet(arg0 context.Context, arg1 string) (*v1.VolumeClass, error) {
 	m.ctrl.T.Helper()
@@ -1034,6 +1405,96 @@ func (mr *MockClientMockRecorder) VolumeList(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VolumeList", reflect.TypeOf((*MockClient)(nil).VolumeList), arg0)
 }
 
+// VolumeResize mocks base method.
+func (m *MockClient) VolumeResize(arg0 context.Context, arg1, arg2 string) (*v1.Volume, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VolumeResize", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*v1.Volume)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VolumeResize indicates an expected call of VolumeResize.
+func (mr *MockClientMockRecorder) VolumeResize(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VolumeResize", reflect.TypeOf((*MockClient)(nil).VolumeResize), arg0, arg1, arg2)
+}
+
+// VolumeSnapshotCreate mocks base method.
+func (m *MockClient) VolumeSnapshotCreate(arg0 context.Context, arg1, arg2 string) (*v1.VolumeSnapshot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VolumeSnapshotCreate", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*v1.VolumeSnapshot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VolumeSnapshotCreate indicates an expected call of VolumeSnapshotCreate.
+func (mr *MockClientMockRecorder) VolumeSnapshotCreate(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VolumeSnapshotCreate", reflect.TypeOf((*MockClient)(nil).VolumeSnapshotCreate), arg0, arg1, arg2)
+}
+
+// VolumeSnapshotDelete mocks base method.
+func (m *MockClient) VolumeSnapshotDelete(arg0 context.Context, arg1 string) (*v1.VolumeSnapshot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VolumeSnapshotDelete", arg0, arg1)
+	ret0, _ := ret[0].(*v1.VolumeSnapshot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VolumeSnapshotDelete indicates an expected call of VolumeSnapshotDelete.
+func (mr *MockClientMockRecorder) VolumeSnapshotDelete(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VolumeSnapshotDelete", reflect.TypeOf((*MockClient)(nil).VolumeSnapshotDelete), arg0, arg1)
+}
+
+// VolumeSnapshotGet mocks base method.
+func (m *MockClient) VolumeSnapshotGet(arg0 context.Context, arg1 string) (*v1.VolumeSnapshot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VolumeSnapshotGet", arg0, arg1)
+	ret0, _ := ret[0].(*v1.VolumeSnapshot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VolumeSnapshotGet indicates an expected call of VolumeSnapshotGet.
+func (mr *MockClientMockRecorder) VolumeSnapshotGet(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VolumeSnapshotGet", reflect.TypeOf((*MockClient)(nil).VolumeSnapshotGet), arg0, arg1)
+}
+
+// VolumeSnapshotList mocks base method.
+func (m *MockClient) VolumeSnapshotList(arg0 context.Context) ([]v1.VolumeSnapshot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VolumeSnapshotList", arg0)
+	ret0, _ := ret[0].([]v1.VolumeSnapshot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VolumeSnapshotList indicates an expected call of VolumeSnapshotList.
+func (mr *MockClientMockRecorder) VolumeSnapshotList(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VolumeSnapshotList", reflect.TypeOf((*MockClient)(nil).VolumeSnapshotList), arg0)
+}
+
+// VolumeSnapshotRestore mocks base method.
+func (m *MockClient) VolumeSnapshotRestore(arg0 context.Context, arg1, arg2 string) (*v1.Volume, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VolumeSnapshotRestore", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*v1.Volume)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VolumeSnapshotRestore indicates an expected call of VolumeSnapshotRestore.
+func (mr *MockClientMockRecorder) VolumeSnapshotRestore(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VolumeSnapshotRestore", reflect.TypeOf((*MockClient)(nil).VolumeSnapshotRestore), arg0, arg1, arg2)
+}
+
 // MockProjectClientFactory is a mock of ProjectClientFactory interface.
 type MockProjectClientFactory struct {
 	ctrl     *gomock.Controller
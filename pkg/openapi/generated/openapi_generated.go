@@ -40,7 +40,9 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.App":                                                  schema_pkg_apis_apiacornio_v1_App(ref),
 		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.AppInfo":                                              schema_pkg_apis_apiacornio_v1_AppInfo(ref),
 		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.AppList":                                              schema_pkg_apis_apiacornio_v1_AppList(ref),
+		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.AppMetrics":                                           schema_pkg_apis_apiacornio_v1_AppMetrics(ref),
 		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.AppPullImage":                                         schema_pkg_apis_apiacornio_v1_AppPullImage(ref),
+		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.AppRestart":                                           schema_pkg_apis_apiacornio_v1_AppRestart(ref),
 		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.Builder":                                              schema_pkg_apis_apiacornio_v1_Builder(ref),
 		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.BuilderList":                                          schema_pkg_apis_apiacornio_v1_BuilderList(ref),
 		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.BuilderPortOptions":                                   schema_pkg_apis_apiacornio_v1_BuilderPortOptions(ref),
@@ -49,10 +51,12 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.ComputeClassMemory":                                   schema_pkg_apis_apiacornio_v1_ComputeClassMemory(ref),
 		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.Config":                                               schema_pkg_apis_apiacornio_v1_Config(ref),
 		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.ConfirmUpgrade":                                       schema_pkg_apis_apiacornio_v1_ConfirmUpgrade(ref),
+		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.ContainedImage":                                       schema_pkg_apis_apiacornio_v1_ContainedImage(ref),
 		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.ContainerReplica":                                     schema_pkg_apis_apiacornio_v1_ContainerReplica(ref),
 		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.ContainerReplicaColumns":                              schema_pkg_apis_apiacornio_v1_ContainerReplicaColumns(ref),
 		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.ContainerReplicaExecOptions":                          schema_pkg_apis_apiacornio_v1_ContainerReplicaExecOptions(ref),
 		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.ContainerReplicaList":                                 schema_pkg_apis_apiacornio_v1_ContainerReplicaList(ref),
+		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.ContainerReplicaMetrics":                              schema_pkg_apis_apiacornio_v1_ContainerReplicaMetrics(ref),
 		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.ContainerReplicaPortForwardOptions":                   schema_pkg_apis_apiacornio_v1_ContainerReplicaPortForwardOptions(ref),
 		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.ContainerReplicaSpec":                                 schema_pkg_apis_apiacornio_v1_ContainerReplicaSpec(ref),
 		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.ContainerReplicaStatus":                               schema_pkg_apis_apiacornio_v1_ContainerReplicaStatus(ref),
@@ -74,7 +78,10 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.ImagePull":                                            schema_pkg_apis_apiacornio_v1_ImagePull(ref),
 		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.ImagePush":                                            schema_pkg_apis_apiacornio_v1_ImagePush(ref),
 		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.ImageSignature":                                       schema_pkg_apis_apiacornio_v1_ImageSignature(ref),
+		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.ImageSignatureEntry":                                  schema_pkg_apis_apiacornio_v1_ImageSignatureEntry(ref),
+		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.ImageSignatures":                                      schema_pkg_apis_apiacornio_v1_ImageSignatures(ref),
 		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.ImageTag":                                             schema_pkg_apis_apiacornio_v1_ImageTag(ref),
+		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.ImageUnsign":                                          schema_pkg_apis_apiacornio_v1_ImageUnsign(ref),
 		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.Info":                                                 schema_pkg_apis_apiacornio_v1_Info(ref),
 		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.InfoList":                                             schema_pkg_apis_apiacornio_v1_InfoList(ref),
 		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.InfoSpec":                                             schema_pkg_apis_apiacornio_v1_InfoSpec(ref),
@@ -96,6 +103,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.RegistryAuth":                                         schema_pkg_apis_apiacornio_v1_RegistryAuth(ref),
 		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.Secret":                                               schema_pkg_apis_apiacornio_v1_Secret(ref),
 		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.SecretList":                                           schema_pkg_apis_apiacornio_v1_SecretList(ref),
+		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.SecretRotate":                                         schema_pkg_apis_apiacornio_v1_SecretRotate(ref),
 		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.Service":                                              schema_pkg_apis_apiacornio_v1_Service(ref),
 		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.ServiceList":                                          schema_pkg_apis_apiacornio_v1_ServiceList(ref),
 		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.Volume":                                               schema_pkg_apis_apiacornio_v1_Volume(ref),
@@ -104,6 +112,10 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.VolumeColumns":                                        schema_pkg_apis_apiacornio_v1_VolumeColumns(ref),
 		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.VolumeCreateOptions":                                  schema_pkg_apis_apiacornio_v1_VolumeCreateOptions(ref),
 		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.VolumeList":                                           schema_pkg_apis_apiacornio_v1_VolumeList(ref),
+		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.VolumeSnapshot":                                       schema_pkg_apis_apiacornio_v1_VolumeSnapshot(ref),
+		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.VolumeSnapshotList":                                   schema_pkg_apis_apiacornio_v1_VolumeSnapshotList(ref),
+		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.VolumeSnapshotSpec":                                   schema_pkg_apis_apiacornio_v1_VolumeSnapshotSpec(ref),
+		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.VolumeSnapshotStatus":                                 schema_pkg_apis_apiacornio_v1_VolumeSnapshotStatus(ref),
 		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.VolumeSpec":                                           schema_pkg_apis_apiacornio_v1_VolumeSpec(ref),
 		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.VolumeStatus":                                         schema_pkg_apis_apiacornio_v1_VolumeStatus(ref),
 		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Acorn":                                           schema_pkg_apis_internalacornio_v1_Acorn(ref),
@@ -196,6 +208,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.ProjectInstanceList":                             schema_pkg_apis_internalacornio_v1_ProjectInstanceList(ref),
 		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.ProjectInstanceSpec":                             schema_pkg_apis_internalacornio_v1_ProjectInstanceSpec(ref),
 		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.ProjectInstanceStatus":                           schema_pkg_apis_internalacornio_v1_ProjectInstanceStatus(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.RemoteCache":                                     schema_pkg_apis_internalacornio_v1_RemoteCache(ref),
 		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.ReplicasSummary":                                 schema_pkg_apis_internalacornio_v1_ReplicasSummary(ref),
 		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.ResolvedOfferings":                               schema_pkg_apis_internalacornio_v1_ResolvedOfferings(ref),
 		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Route":                                           schema_pkg_apis_internalacornio_v1_Route(ref),
@@ -210,12 +223,14 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Service":                                         schema_pkg_apis_internalacornio_v1_Service(ref),
 		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.ServiceBinding":                                  schema_pkg_apis_internalacornio_v1_ServiceBinding(ref),
 		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.ServiceConsumer":                                 schema_pkg_apis_internalacornio_v1_ServiceConsumer(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.ServiceGrants":                                   schema_pkg_apis_internalacornio_v1_ServiceGrants(ref),
 		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.ServiceInstance":                                 schema_pkg_apis_internalacornio_v1_ServiceInstance(ref),
 		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.ServiceInstanceList":                             schema_pkg_apis_internalacornio_v1_ServiceInstanceList(ref),
 		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.ServiceInstanceSpec":                             schema_pkg_apis_internalacornio_v1_ServiceInstanceSpec(ref),
 		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.ServiceInstanceStatus":                           schema_pkg_apis_internalacornio_v1_ServiceInstanceStatus(ref),
 		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.ServiceStatus":                                   schema_pkg_apis_internalacornio_v1_ServiceStatus(ref),
 		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.SignatureAnnotations":                            schema_pkg_apis_internalacornio_v1_SignatureAnnotations(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.SignaturePolicy":                                 schema_pkg_apis_internalacornio_v1_SignaturePolicy(ref),
 		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.SignatureRules":                                  schema_pkg_apis_internalacornio_v1_SignatureRules(ref),
 		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.SignedBy":                                        schema_pkg_apis_internalacornio_v1_SignedBy(ref),
 		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.TCPProbe":                                        schema_pkg_apis_internalacornio_v1_TCPProbe(ref),
@@ -1996,6 +2011,53 @@ func schema_pkg_apis_apiacornio_v1_AppList(ref common.ReferenceCallback) common.
 	}
 }
 
+func schema_pkg_apis_apiacornio_v1_AppMetrics(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"containers": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.ContainerReplicaMetrics"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.ContainerReplicaMetrics", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
 func schema_pkg_apis_apiacornio_v1_AppPullImage(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -2030,6 +2092,47 @@ func schema_pkg_apis_apiacornio_v1_AppPullImage(ref common.ReferenceCallback) co
 	}
 }
 
+func schema_pkg_apis_apiacornio_v1_AppRestart(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"container": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Container restricts the restart to one container, otherwise all containers of the app are restarted",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
 func schema_pkg_apis_apiacornio_v1_Builder(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -2208,12 +2311,37 @@ func schema_pkg_apis_apiacornio_v1_ComputeClass(ref common.ReferenceCallback) co
 							},
 						},
 					},
+					"cpuScaler": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CPUScaler is the number of CPUs a workload gets per Gi of memory",
+							Type:        []string{"number"},
+							Format:      "double",
+						},
+					},
+					"affinity": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/api/core/v1.Affinity"),
+						},
+					},
+					"tolerations": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/api/core/v1.Toleration"),
+									},
+								},
+							},
+						},
+					},
 				},
 				Required: []string{"default"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.ComputeClassMemory", "k8s.io/api/core/v1.ResourceRequirements", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.ComputeClassMemory", "k8s.io/api/core/v1.Affinity", "k8s.io/api/core/v1.ResourceRequirements", "k8s.io/api/core/v1.Toleration", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
@@ -2722,6 +2850,51 @@ func schema_pkg_apis_apiacornio_v1_ConfirmUpgrade(ref common.ReferenceCallback)
 	}
 }
 
+func schema_pkg_apis_apiacornio_v1_ContainedImage(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ContainedImage is an image referenced by an app image, like the image of a container or a nested acorn",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the path of the image in the app image, like web.proxy for a sidecar or db.server for a nested acorn",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"repo": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"digest": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"size": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Size is the compressed size of the config and layers of the image, summed up over all of its platforms",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_apiacornio_v1_ContainerReplica(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -2814,7 +2987,8 @@ func schema_pkg_apis_apiacornio_v1_ContainerReplicaExecOptions(ref common.Refere
 					},
 					"command": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
+							Description: "Command to run instead of the default shell. It has no omitempty, so that empty arguments are passed on.",
+							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
@@ -2832,12 +3006,55 @@ func schema_pkg_apis_apiacornio_v1_ContainerReplicaExecOptions(ref common.Refere
 							Format: "",
 						},
 					},
+					"stdin": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Stdin attaches the stdin of the command, it defaults to true if not set. It has no omitempty, so that false is passed on as well.",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
 					"debugImage": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
+					"rows": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int32",
+						},
+					},
+					"cols": {
+						SchemaProps: spec.SchemaProps{
+							Description: "initial terminal height, only used with TTY",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"env": {
+						SchemaProps: spec.SchemaProps{
+							Description: "initial terminal width, only used with TTY",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"workingDir": {
+						SchemaProps: spec.SchemaProps{
+							Description: "additional environment variables in KEY=VALUE format",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
@@ -2892,114 +3109,190 @@ func schema_pkg_apis_apiacornio_v1_ContainerReplicaList(ref common.ReferenceCall
 	}
 }
 
-func schema_pkg_apis_apiacornio_v1_ContainerReplicaPortForwardOptions(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_apiacornio_v1_ContainerReplicaMetrics(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Description: "Name is the name of the container replica, with the name of the sidecar appended for sidecars",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"apiVersion": {
+					"containerName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Description: "ContainerName is the name of the container or job in the app that the replica belongs to",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"port": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"integer"},
-							Format: "int32",
-						},
-					},
-				},
-			},
-		},
-	}
-}
-
-func schema_pkg_apis_apiacornio_v1_ContainerReplicaSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
-				Properties: map[string]spec.Schema{
-					"labels": {
+					"sidecarName": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"annotations": {
+					"usage": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"object"},
+							Description: "Usage is the current CPU and memory usage reported by the metrics API, empty if it has no measurement of the replica yet",
+							Type:        []string{"object"},
 							AdditionalProperties: &spec.SchemaOrBool{
 								Allows: true,
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
+										Ref: ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
 									},
 								},
 							},
 						},
 					},
-					"name": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
-						},
-					},
-					"description": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
-						},
-					},
-					"dirs": {
+					"requests": {
 						SchemaProps: spec.SchemaProps{
 							Type: []string{"object"},
 							AdditionalProperties: &spec.SchemaOrBool{
 								Allows: true,
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.VolumeMount"),
+										Ref: ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
 									},
 								},
 							},
 						},
 					},
-					"files": {
+					"limits": {
 						SchemaProps: spec.SchemaProps{
 							Type: []string{"object"},
 							AdditionalProperties: &spec.SchemaOrBool{
 								Allows: true,
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.File"),
+										Ref: ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
 									},
 								},
 							},
 						},
 					},
-					"image": {
-						SchemaProps: spec.SchemaProps{
+				},
+				Required: []string{"name"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/api/resource.Quantity"},
+	}
+}
+
+func schema_pkg_apis_apiacornio_v1_ContainerReplicaPortForwardOptions(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"port": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int32",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_apiacornio_v1_ContainerReplicaSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"labels": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"annotations": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"description": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"dirs": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.VolumeMount"),
+									},
+								},
+							},
+						},
+					},
+					"files": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.File"),
+									},
+								},
+							},
+						},
+					},
+					"image": {
+						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
@@ -4282,6 +4575,13 @@ func schema_pkg_apis_apiacornio_v1_ImageDetails(ref common.ReferenceCallback) co
 							Format: "",
 						},
 					},
+					"listImages": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ListImages - if true, Images lists the images contained in the app image and its nested images",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
 					"noDefaultRegistry": {
 						SchemaProps: spec.SchemaProps{
 							Description: "NoDefaultRegistry - if true, do not assume a default registry on the image if none is specified",
@@ -4289,6 +4589,13 @@ func schema_pkg_apis_apiacornio_v1_ImageDetails(ref common.ReferenceCallback) co
 							Format:      "",
 						},
 					},
+					"local": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Local - if true, the image is only resolved from the local image store and never from a registry",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
 					"appImage": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Output Params",
@@ -4344,6 +4651,19 @@ func schema_pkg_apis_apiacornio_v1_ImageDetails(ref common.ReferenceCallback) co
 							},
 						},
 					},
+					"images": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.ContainedImage"),
+									},
+								},
+							},
+						},
+					},
 					"parseError": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
@@ -4354,7 +4674,7 @@ func schema_pkg_apis_apiacornio_v1_ImageDetails(ref common.ReferenceCallback) co
 			},
 		},
 		Dependencies: []string{
-			"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.NestedImage", "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.RegistryAuth", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AppImage", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AppSpec", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.GenericMap", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.ParamSpec", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Permissions", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.ContainedImage", "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.NestedImage", "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.RegistryAuth", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AppImage", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AppSpec", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.GenericMap", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.ParamSpec", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Permissions", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
@@ -4524,6 +4844,32 @@ func schema_pkg_apis_apiacornio_v1_ImageSignature(ref common.ReferenceCallback)
 							Format: "",
 						},
 					},
+					"certIdentity": {
+						SchemaProps: spec.SchemaProps{
+							Description: "keyless signatures are verified by the identity (SAN) and OIDC issuer of their signing certificate instead of a public key",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"certOidcIssuer": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"requireTlog": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"boolean"},
+							Format: "",
+						},
+					},
+					"predicateType": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PredicateType verifies an attestation of this predicate type (e.g. slsaprovenance or an URI) instead of a signature",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 					"payload": {
 						SchemaProps: spec.SchemaProps{
 							Description: "- Signing",
@@ -4537,104 +4883,118 @@ func schema_pkg_apis_apiacornio_v1_ImageSignature(ref common.ReferenceCallback)
 							Format: "",
 						},
 					},
-					"signatureDigest": {
+					"certificate": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Output",
+							Type:   []string{"string"},
+							Format: "byte",
+						},
+					},
+					"certificateChain": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PEM encoded signing certificate (keyless signing)",
 							Type:        []string{"string"},
-							Format:      "",
+							Format:      "byte",
 						},
 					},
-				},
-			},
-		},
-		Dependencies: []string{
-			"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.RegistryAuth", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.SignatureAnnotations", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
-	}
-}
-
-func schema_pkg_apis_apiacornio_v1_ImageTag(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
-				Properties: map[string]spec.Schema{
-					"kind": {
+					"rekorBundle": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Description: "PEM encoded certificate chain of the signing certificate",
 							Type:        []string{"string"},
+							Format:      "byte",
+						},
+					},
+					"force": {
+						SchemaProps: spec.SchemaProps{
+							Description: "JSON encoded transparency log bundle",
+							Type:        []string{"boolean"},
 							Format:      "",
 						},
 					},
-					"apiVersion": {
+					"attestation": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Description: "Attestation is the JSON encoded DSSE envelope of an in-toto attestation to attach in addition to the signature",
 							Type:        []string{"string"},
-							Format:      "",
+							Format:      "byte",
 						},
 					},
-					"metadata": {
+					"signatureDigest": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+							Description: "Output",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"tag": {
+					"duplicate": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
+							Type:   []string{"boolean"},
 							Format: "",
 						},
 					},
+					"verifiedSignature": {
+						SchemaProps: spec.SchemaProps{
+							Description: "an identical signature existed already, it was replaced if Force was set",
+							Ref:         ref("github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.ImageSignatureEntry"),
+						},
+					},
+					"attestationPredicate": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AttestationPredicate is the JSON encoded predicate of the attestation that matched during verification of PredicateType",
+							Type:        []string{"string"},
+							Format:      "byte",
+						},
+					},
+					"attestationDigest": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AttestationDigest is the digest of the attestations artifact the Attestation was written to",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.ImageSignatureEntry", "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.RegistryAuth", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.SignatureAnnotations", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_pkg_apis_apiacornio_v1_Info(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_apiacornio_v1_ImageSignatureEntry(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"digest": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"apiVersion": {
+					"publicKey": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Description: "digest of the signature layer in the signature artifact",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"metadata": {
+					"fingerprint": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+							Description: "PEM encoded public key, empty if the signer could not be identified",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"regions": {
+					"certificate": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.InfoSpec"),
-									},
-								},
-							},
+							Description: "SHA256 fingerprint of the PEM encoded public key",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"extraData": {
+					"annotations": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"object"},
+							Description: "PEM encoded signing certificate (keyless signing)",
+							Type:        []string{"object"},
 							AdditionalProperties: &spec.SchemaOrBool{
 								Allows: true,
 								Schema: &spec.Schema{
@@ -4650,12 +5010,10 @@ func schema_pkg_apis_apiacornio_v1_Info(ref common.ReferenceCallback) common.Ope
 				},
 			},
 		},
-		Dependencies: []string{
-			"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.InfoSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_pkg_apis_apiacornio_v1_InfoList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_apiacornio_v1_ImageSignatures(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -4678,7 +5036,223 @@ func schema_pkg_apis_apiacornio_v1_InfoList(ref common.ReferenceCallback) common
 					"metadata": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"auth": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Input Params",
+							Ref:         ref("github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.RegistryAuth"),
+						},
+					},
+					"publicKey": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"signatures": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Output",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.ImageSignatureEntry"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.ImageSignatureEntry", "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.RegistryAuth", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_pkg_apis_apiacornio_v1_ImageTag(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"tag": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"copySignatures": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CopySignatures also copies the cosign signatures of the image to the repository of the tag",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_pkg_apis_apiacornio_v1_ImageUnsign(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"auth": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Input Params",
+							Ref:         ref("github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.RegistryAuth"),
+						},
+					},
+					"signatureDigest": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.RegistryAuth", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_pkg_apis_apiacornio_v1_Info(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"regions": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.InfoSpec"),
+									},
+								},
+							},
+						},
+					},
+					"extraData": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.InfoSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_pkg_apis_apiacornio_v1_InfoList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
 						},
 					},
 					"items": {
@@ -5003,9 +5577,23 @@ func schema_pkg_apis_apiacornio_v1_LogMessage(ref common.ReferenceCallback) comm
 							Format: "",
 						},
 					},
+					"container": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"init": {
+						SchemaProps: spec.SchemaProps{
+							Description: "name of the container, sidecar or job within the app",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
 					"time": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Description: "the line was logged by an init container",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
 					"error": {
@@ -5014,6 +5602,12 @@ func schema_pkg_apis_apiacornio_v1_LogMessage(ref common.ReferenceCallback) comm
 							Format: "",
 						},
 					},
+					"reconnected": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"boolean"},
+							Format: "",
+						},
+					},
 				},
 			},
 		},
@@ -5072,6 +5666,34 @@ func schema_pkg_apis_apiacornio_v1_LogOptions(ref common.ReferenceCallback) comm
 							Format: "",
 						},
 					},
+					"until": {
+						SchemaProps: spec.SchemaProps{
+							Description: "duration before now (e.g. 42m) or RFC3339 timestamp",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"init": {
+						SchemaProps: spec.SchemaProps{
+							Description: "duration before now (e.g. 42m) or RFC3339 timestamp",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"grep": {
+						SchemaProps: spec.SchemaProps{
+							Description: "also log the init containers acorn adds to pods, not only init sidecars",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"grepInvert": {
+						SchemaProps: spec.SchemaProps{
+							Description: "only stream lines matching this regular expression",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
@@ -5563,6 +6185,70 @@ func schema_pkg_apis_apiacornio_v1_SecretList(ref common.ReferenceCallback) comm
 	}
 }
 
+func schema_pkg_apis_apiacornio_v1_SecretRotate(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"keys": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Keys are the keys of the secret that were regenerated",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"apps": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Apps are the apps that use the secret and roll out its new value",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
 func schema_pkg_apis_apiacornio_v1_Service(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -5864,30 +6550,191 @@ func schema_pkg_apis_apiacornio_v1_VolumeColumns(ref common.ReferenceCallback) c
 	}
 }
 
-func schema_pkg_apis_apiacornio_v1_VolumeCreateOptions(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_apiacornio_v1_VolumeCreateOptions(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"accessModes": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"class": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_apiacornio_v1_VolumeList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.Volume"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.Volume", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_pkg_apis_apiacornio_v1_VolumeSnapshot(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.VolumeSnapshotSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.VolumeSnapshotStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.VolumeSnapshotSpec", "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.VolumeSnapshotStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_pkg_apis_apiacornio_v1_VolumeSnapshotList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.VolumeSnapshot"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.VolumeSnapshot", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_pkg_apis_apiacornio_v1_VolumeSnapshotSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"accessModes": {
-						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
-						},
-					},
-					"class": {
+					"volume": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "Volume is the name or public name of the volume the snapshot is taken of",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
@@ -5896,51 +6743,62 @@ func schema_pkg_apis_apiacornio_v1_VolumeCreateOptions(ref common.ReferenceCallb
 	}
 }
 
-func schema_pkg_apis_apiacornio_v1_VolumeList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_apiacornio_v1_VolumeSnapshotStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"appName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"volumeName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VolumeName is the name of the volume in the app",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"apiVersion": {
+					"snapshotNamespace": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Description: "SnapshotNamespace is the namespace of the app, where the CSI VolumeSnapshot is stored",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"metadata": {
+					"snapshotClass": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"items": {
+					"ready": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.Volume"),
-									},
-								},
-							},
+							Description: "Ready is true once the snapshot is taken and can be restored",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"size": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Size is the minimum size of a volume restored from the snapshot",
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+						},
+					},
+					"error": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
 				},
-				Required: []string{"items"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.Volume", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+			"k8s.io/apimachinery/pkg/api/resource.Quantity"},
 	}
 }
 
@@ -6025,10 +6883,17 @@ func schema_pkg_apis_apiacornio_v1_VolumeStatus(ref common.ReferenceCallback) co
 							Format: "",
 						},
 					},
+					"reclaimPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
 					"columns": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.VolumeColumns"),
+							Description: "Reclaim policy of the persistent volume, \"delete\" frees the storage when the volume is deleted, \"retain\" keeps it",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.VolumeColumns"),
 						},
 					},
 				},
@@ -6471,11 +7336,39 @@ func schema_pkg_apis_internalacornio_v1_AcornImageBuildInstanceSpec(ref common.R
 							Ref:     ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.VCS"),
 						},
 					},
+					"cacheFrom": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CacheFrom are the remote caches the image builds import layers from",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.RemoteCache"),
+									},
+								},
+							},
+						},
+					},
+					"cacheTo": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CacheTo are the remote caches the image builds export layers to",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.RemoteCache"),
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.GenericMap", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Platform", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.VCS"},
+			"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.GenericMap", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Platform", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.RemoteCache", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.VCS"},
 	}
 }
 
@@ -7112,11 +8005,52 @@ func schema_pkg_apis_internalacornio_v1_AppInstanceSpec(ref common.ReferenceCall
 							},
 						},
 					},
+					"cpu": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"integer"},
+										Format: "int64",
+									},
+								},
+							},
+						},
+					},
+					"scale": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: 0,
+										Type:    []string{"integer"},
+										Format:  "int32",
+									},
+								},
+							},
+						},
+					},
+					"signaturePolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Overrides the number of replicas of the containers by name",
+							Ref:         ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.SignaturePolicy"),
+						},
+					},
+					"serviceGrants": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Enforced in addition to the default signature policy of the project",
+							Ref:         ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.ServiceGrants"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.GenericMap", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.NameValue", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Permissions", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.PortBinding", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.ScopedLabel", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.SecretBinding", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.ServiceBinding", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.VolumeBinding"},
+			"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.GenericMap", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.NameValue", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Permissions", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.PortBinding", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.ScopedLabel", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.SecretBinding", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.ServiceBinding", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.ServiceGrants", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.SignaturePolicy", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.VolumeBinding"},
 	}
 }
 
@@ -7191,9 +8125,16 @@ func schema_pkg_apis_internalacornio_v1_AppInstanceStatus(ref common.ReferenceCa
 							Format: "",
 						},
 					},
+					"confirmUpgradeDigest": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
 					"appSpec": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AppSpec"),
+							Description: "Digest of ConfirmUpgradeAppImage when the upgrade became available",
+							Ref:         ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AppSpec"),
 						},
 					},
 					"appStatus": {
@@ -11373,9 +12314,31 @@ func schema_pkg_apis_internalacornio_v1_ProjectInstanceSpec(ref common.Reference
 							},
 						},
 					},
+					"defaultSignaturePolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DefaultSignaturePolicy is enforced for all apps in the project, in addition to their own signature policy",
+							Ref:         ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.SignaturePolicy"),
+						},
+					},
+					"defaultComputeClass": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DefaultComputeClass is used for all workloads in the project that don't specify a compute class. It takes precedence over project and cluster compute classes marked as default.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"defaultVolumeClass": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DefaultVolumeClass is used for all volumes in the project that don't specify a volume class. It takes precedence over project and cluster volume classes marked as default.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
+		Dependencies: []string{
+			"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.SignaturePolicy"},
 	}
 }
 
@@ -11418,6 +12381,40 @@ func schema_pkg_apis_internalacornio_v1_ProjectInstanceStatus(ref common.Referen
 	}
 }
 
+func schema_pkg_apis_internalacornio_v1_RemoteCache(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RemoteCache is a BuildKit cache stored outside the builder, like type=registry,ref=ghcr.io/org/app:buildcache",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"attrs": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_internalacornio_v1_ReplicasSummary(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -12391,6 +13388,12 @@ func schema_pkg_apis_internalacornio_v1_ServiceBinding(ref common.ReferenceCallb
 							Format: "",
 						},
 					},
+					"address": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
 				},
 			},
 		},
@@ -12441,6 +13444,34 @@ func schema_pkg_apis_internalacornio_v1_ServiceConsumer(ref common.ReferenceCall
 	}
 }
 
+func schema_pkg_apis_internalacornio_v1_ServiceGrants(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ServiceGrants is the allow-list of the apps in the project that can link to the services of an app. An empty list allows no app.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"consumers": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"consumers"},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_internalacornio_v1_ServiceInstance(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -12990,6 +14021,45 @@ func schema_pkg_apis_internalacornio_v1_SignatureAnnotations(ref common.Referenc
 	}
 }
 
+func schema_pkg_apis_internalacornio_v1_SignaturePolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SignaturePolicy requires the image of an app and all of its nested images to carry a signature matching either the public key or the certificate identity, as well as the annotation rules",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"key": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"certIdentity": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"certOidcIssuer": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"annotations": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.SignatureAnnotations"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.SignatureAnnotations"},
+	}
+}
+
 func schema_pkg_apis_internalacornio_v1_SignatureRules(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -13209,6 +14279,13 @@ func schema_pkg_apis_internalacornio_v1_VolumeBinding(ref common.ReferenceCallba
 							Format: "",
 						},
 					},
+					"snapshot": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Snapshot is the name of a CSI VolumeSnapshot in the namespace of the app to restore the volume from. The volume is replaced by a new one with the data of the snapshot, the previous one is kept under its own name.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
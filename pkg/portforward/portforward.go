@@ -11,23 +11,79 @@ import (
 	"inet.af/tcpproxy"
 )
 
+// Forward is a local port forwarded to a port of a container replica. PortDef is in the format [local:]remote, if
+// the local port is left out or the same as the remote one, the next free port is used if it's taken, unless ExactPort
+// is set.
+type Forward struct {
+	ContainerName string
+	PortDef       string
+	ExactPort     bool
+}
+
 func PortForward(ctx context.Context, c client.Client, containerName string, address string, portDef string) error {
+	return PortForwards(ctx, c, address, []Forward{{ContainerName: containerName, PortDef: portDef}})
+}
+
+// PortForwards forwards all ports until ctx is closed. Either all ports are forwarded, or none is.
+func PortForwards(ctx context.Context, c client.Client, address string, forwards []Forward) error {
+	var (
+		p         = tcpproxy.Proxy{}
+		listeners = map[string]net.Listener{}
+		messages  = map[string]string{}
+	)
+
+	for _, forward := range forwards {
+		listener, listenAddress, port, err := listen(address, forward.PortDef, forward.ExactPort)
+		if err != nil {
+			return err
+		}
+		defer listener.Close()
+
+		dialer, err := c.ContainerReplicaPortForward(ctx, forward.ContainerName, port)
+		if err != nil {
+			return err
+		}
+
+		listeners[listenAddress] = listener
+		messages[listenAddress] = fmt.Sprintf("Forwarding %s => %d for container [%s]", listener.Addr().String(), port, forward.ContainerName)
+		p.AddRoute(listenAddress, &tcpproxy.DialProxy{
+			DialContext: func(ctx context.Context, network, address string) (net.Conn, error) {
+				return dialer(ctx)
+			},
+		})
+	}
+
+	p.ListenFunc = func(_, laddr string) (net.Listener, error) {
+		fmt.Println(messages[laddr])
+		return listeners[laddr], nil
+	}
+	go func() {
+		<-ctx.Done()
+		_ = p.Close()
+	}()
+	if err := p.Start(); err != nil {
+		return err
+	}
+	return p.Wait()
+}
+
+// listen listens on the local port of portDef and returns the listener, the address it listens on and the remote port
+func listen(address, portDef string, exactPort bool) (net.Listener, string, int, error) {
 	var anyPort bool
 	src, dest, ok := strings.Cut(portDef, ":")
 	if !ok {
 		dest = src
-		anyPort = true
+		anyPort = !exactPort
 	} else if src == dest {
-		anyPort = true
+		anyPort = !exactPort
 	}
 
 	port, err := strconv.Atoi(dest)
 	if err != nil {
-		return err
+		return nil, "", 0, err
 	}
 
 	var (
-		listener      net.Listener
 		listenAddress = address + ":" + src
 		// this is only used when anyPort is true which assumes dest == src
 		currentSrcPort = port
@@ -39,35 +95,11 @@ func PortForward(ctx context.Context, c client.Client, containerName string, add
 			currentSrcPort++
 			listenAddress = fmt.Sprintf("%s:%d", address, currentSrcPort)
 			continue
+		} else if err != nil && exactPort && strings.Contains(err.Error(), "address already in use") {
+			return nil, "", 0, fmt.Errorf("local port %s is already in use: %w", src, err)
 		} else if err != nil {
-			return err
+			return nil, "", 0, err
 		}
-		listener = l
-		defer listener.Close()
-		break
-	}
-
-	dialer, err := c.ContainerReplicaPortForward(ctx, containerName, port)
-	if err != nil {
-		return err
+		return l, listenAddress, port, nil
 	}
-
-	p := tcpproxy.Proxy{}
-	p.AddRoute(listenAddress, &tcpproxy.DialProxy{
-		DialContext: func(ctx context.Context, network, address string) (net.Conn, error) {
-			return dialer(ctx)
-		},
-	})
-	p.ListenFunc = func(_, laddr string) (net.Listener, error) {
-		fmt.Printf("Forwarding %s => %d for container [%s]\n", listener.Addr().String(), port, containerName)
-		return listener, err
-	}
-	go func() {
-		<-ctx.Done()
-		_ = p.Close()
-	}()
-	if err := p.Start(); err != nil {
-		return err
-	}
-	return p.Wait()
 }
@@ -0,0 +1,30 @@
+package portforward
+
+import (
+	"net"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListenPortInUse(t *testing.T) {
+	taken, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer taken.Close()
+	port := strconv.Itoa(taken.Addr().(*net.TCPAddr).Port)
+
+	// The next free port is used if any port will do
+	l, listenAddress, remote, err := listen("127.0.0.1", port+":"+port, false)
+	require.NoError(t, err)
+	defer l.Close()
+	assert.NotEqual(t, "127.0.0.1:"+port, listenAddress)
+	assert.Equal(t, port, strconv.Itoa(remote))
+
+	// An exact port is never swapped for another one
+	_, _, _, err = listen("127.0.0.1", port+":"+port, true)
+	assert.ErrorContains(t, err, "local port "+port+" is already in use")
+	_, _, _, err = listen("127.0.0.1", port, true)
+	assert.ErrorContains(t, err, "local port "+port+" is already in use")
+}
@@ -142,3 +142,70 @@ func TestCollectPorts(t *testing.T) {
 		})
 	}
 }
+
+func TestCheckHostPortConflicts(t *testing.T) {
+	testCases := []struct {
+		name     string
+		bindings []v1.PortBinding
+		err      string
+	}{
+		{
+			name:     "distinct host ports",
+			bindings: []v1.PortBinding{{Port: 8080, TargetPort: 80}, {Port: 8081, TargetPort: 81}},
+		},
+		{
+			name:     "same binding twice",
+			bindings: []v1.PortBinding{{Port: 8080, TargetPort: 80}, {Port: 8080, TargetPort: 80}},
+		},
+		{
+			name:     "different protocols",
+			bindings: []v1.PortBinding{{Port: 53, TargetPort: 53, Protocol: v1.ProtocolTCP}, {Port: 53, TargetPort: 5353, Protocol: v1.ProtocolUDP}},
+		},
+		{
+			name:     "hostnames are not host ports",
+			bindings: []v1.PortBinding{{Hostname: "a.example.com", TargetPort: 80}, {Hostname: "a.example.com", TargetPort: 81}},
+		},
+		{
+			name:     "same host port for different ports",
+			bindings: []v1.PortBinding{{Port: 8080, TargetPort: 80}, {Port: 8080, TargetPort: 81}},
+			err:      "host port [8080] is published more than once, by [8080:80] and [8080:81]",
+		},
+		{
+			name:     "same host port for different services",
+			bindings: []v1.PortBinding{{Port: 8080, TargetServiceName: "web", TargetPort: 80}, {Port: 8080, TargetServiceName: "api", TargetPort: 80, Protocol: v1.ProtocolTCP}},
+			err:      "host port [8080] is published more than once, by [8080:web:80] and [8080:api:80/tcp]",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := CheckHostPortConflicts(tc.bindings)
+			if tc.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tc.err)
+			}
+		})
+	}
+}
+
+func TestHostPortInUse(t *testing.T) {
+	endpoints := []v1.Endpoint{
+		{Target: "web", TargetPort: 80, Address: "web.example.com", Protocol: v1.ProtocolHTTP},
+		{Target: "db", TargetPort: 5432, Address: "10.0.0.1:5432", Protocol: v1.ProtocolTCP},
+		{Target: "dns", TargetPort: 53, Address: "<Pending Ingress>:53", Protocol: v1.ProtocolUDP},
+	}
+
+	ep, inUse := HostPortInUse(v1.PortBinding{Port: 5432, TargetPort: 5432}, endpoints)
+	assert.True(t, inUse)
+	assert.Equal(t, "db", ep.Target)
+
+	_, inUse = HostPortInUse(v1.PortBinding{Port: 53, TargetPort: 53, Protocol: v1.ProtocolUDP}, endpoints)
+	assert.True(t, inUse)
+
+	_, inUse = HostPortInUse(v1.PortBinding{Port: 53, TargetPort: 53, Protocol: v1.ProtocolTCP}, endpoints)
+	assert.False(t, inUse)
+
+	_, inUse = HostPortInUse(v1.PortBinding{TargetPort: 5432}, endpoints)
+	assert.False(t, inUse)
+}
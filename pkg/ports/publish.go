@@ -3,6 +3,8 @@ package ports
 import (
 	"fmt"
 	"sort"
+	"strconv"
+	"strings"
 
 	"github.com/acorn-io/baaah/pkg/typed"
 	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
@@ -20,6 +22,9 @@ func LinkService(app *v1.AppInstance, name string) string {
 
 	for _, binding := range app.Spec.Links {
 		if binding.Target == name {
+			if binding.Address != "" {
+				return binding.Address
+			}
 			return binding.Service
 		}
 	}
@@ -173,6 +178,70 @@ func ApplyBindings(mode v1.PublishMode, bindings []v1.PortPublish, ports []v1.Po
 	return
 }
 
+// isHostPortBinding returns true if the binding publishes on an explicit host port, like 81:80 does
+func isHostPortBinding(binding v1.PortBinding) bool {
+	binding = binding.Complete()
+	return binding.Port != 0 && binding.Protocol != v1.ProtocolHTTP && binding.Protocol != v1.ProtocolHTTP2
+}
+
+func protocolsOverlap(a, b v1.Protocol) bool {
+	return a == "" || b == "" || a == b
+}
+
+// CheckHostPortConflicts returns an error if the bindings publish different targets on the same host port.
+func CheckHostPortConflicts(bindings []v1.PortBinding) error {
+	for i, binding := range bindings {
+		if !isHostPortBinding(binding) {
+			continue
+		}
+		for _, other := range bindings[:i] {
+			if !isHostPortBinding(other) || other.Port != binding.Port || !protocolsOverlap(other.Protocol, binding.Protocol) {
+				continue
+			}
+			if other.TargetPort != binding.TargetPort || other.TargetServiceName != binding.TargetServiceName {
+				return fmt.Errorf("host port [%d] is published more than once, by [%s] and [%s]", binding.Port,
+					formatBinding(other), formatBinding(binding))
+			}
+		}
+	}
+	return nil
+}
+
+// HostPortInUse returns the endpoint that already listens on the host port of the binding, if any.
+func HostPortInUse(binding v1.PortBinding, endpoints []v1.Endpoint) (v1.Endpoint, bool) {
+	if !isHostPortBinding(binding) {
+		return v1.Endpoint{}, false
+	}
+	for _, ep := range endpoints {
+		if ep.Protocol != v1.ProtocolTCP && ep.Protocol != v1.ProtocolUDP {
+			continue
+		}
+		i := strings.LastIndex(ep.Address, ":")
+		if i < 0 {
+			continue
+		}
+		if n, err := strconv.ParseInt(ep.Address[i+1:], 10, 32); err == nil && int32(n) == binding.Port && protocolsOverlap(binding.Protocol, ep.Protocol) {
+			return ep, true
+		}
+	}
+	return v1.Endpoint{}, false
+}
+
+func formatBinding(binding v1.PortBinding) string {
+	target := binding.TargetServiceName
+	if binding.TargetPort != 0 {
+		if target != "" {
+			target += ":"
+		}
+		target += strconv.Itoa(int(binding.TargetPort))
+	}
+	result := fmt.Sprintf("%d:%s", binding.Port, target)
+	if binding.Protocol != "" {
+		result += "/" + string(binding.Protocol)
+	}
+	return result
+}
+
 func portMatches(binding v1.PortPublish, port v1.PortDef) bool {
 	return binding.TargetPort == 0 || binding.TargetPort == port.Port
 }
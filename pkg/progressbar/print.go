@@ -3,6 +3,7 @@ package progressbar
 import (
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/acorn-io/baaah/pkg/typed"
@@ -10,10 +11,14 @@ import (
 	"github.com/pterm/pterm"
 )
 
+// Print renders the progress of an image transfer until progress is closed and returns the last error reported.
+// Each task, like pushing one image of an app, gets a progress bar with the bytes transferred, the transfer rate and
+// the percentage done. Without a terminal the same information is printed as a line every second.
 func Print(progress <-chan client.ImageProgress) error {
 	var (
-		err error
-		bar *pterm.ProgressbarPrinter
+		err      error
+		bar      *pterm.ProgressbarPrinter
+		transfer transferRate
 	)
 
 	if pterm.RawOutput {
@@ -26,14 +31,16 @@ func Print(progress <-chan client.ImageProgress) error {
 			if update.Total == 0 {
 				continue
 			}
+			transfer.update(update)
 			if update == last {
 				continue
 			}
-			fmt.Printf("[%d/%d]\n", update.Complete, update.Total)
+			fmt.Println(describe(update.CurrentTask, update, transfer.rate(), true))
 			last = update
 		}
 		if last.Total != 0 && last.Total != last.Complete {
-			fmt.Printf("[%d/%d]\n", last.Total, last.Total)
+			last.Complete = last.Total
+			fmt.Println(describe(last.CurrentTask, last, transfer.rate(), true))
 		}
 	} else {
 		var currentTask string
@@ -57,14 +64,16 @@ func Print(progress <-chan client.ImageProgress) error {
 				currentTask = update.CurrentTask
 			}
 
+			transfer.update(update)
 			if bar == nil {
-				bar = pterm.DefaultProgressbar.
+				bar, _ = pterm.DefaultProgressbar.
 					WithTotal(int(update.Total)).
-					WithCurrent(int(update.Complete))
-				if currentTask != "" {
-					bar = bar.WithTitle(currentTask)
-				}
-				bar, _ = bar.Start()
+					WithCurrent(int(update.Complete)).
+					WithShowCount(false).
+					WithTitle(describe(currentTask, update, transfer.rate(), false)).
+					Start()
+			} else {
+				bar.UpdateTitle(describe(currentTask, update, transfer.rate(), false))
 			}
 
 			if int(update.Complete) > bar.Current {
@@ -82,3 +91,68 @@ func Print(progress <-chan client.ImageProgress) error {
 
 	return err
 }
+
+// Wait consumes progress without printing anything and returns the last error reported.
+func Wait(progress <-chan client.ImageProgress) error {
+	var err error
+	for update := range progress {
+		if update.Error != "" {
+			err = errors.New(update.Error)
+		}
+	}
+	return err
+}
+
+// transferRate tracks the average rate of the current task.
+type transferRate struct {
+	task     string
+	start    time.Time
+	complete int64
+	elapsed  time.Duration
+}
+
+func (t *transferRate) update(update client.ImageProgress) {
+	if t.start.IsZero() || update.CurrentTask != t.task {
+		t.task, t.start = update.CurrentTask, time.Now()
+	}
+	t.complete, t.elapsed = update.Complete, time.Since(t.start)
+}
+
+// rate returns the bytes transferred per second, or 0 until enough time passed to tell.
+func (t *transferRate) rate() int64 {
+	if t.elapsed < time.Second {
+		return 0
+	}
+	return int64(float64(t.complete) / t.elapsed.Seconds())
+}
+
+// describe returns the task, the bytes transferred and the transfer rate of update. The percentage done is included
+// when it isn't shown otherwise, like by a progress bar.
+func describe(task string, update client.ImageProgress, rate int64, percentage bool) string {
+	parts := make([]string, 0, 4)
+	if task != "" {
+		parts = append(parts, task+":")
+	}
+	parts = append(parts, formatBytes(update.Complete)+"/"+formatBytes(update.Total))
+	if percentage {
+		parts = append(parts, fmt.Sprintf("(%d%%)", 100*update.Complete/update.Total))
+	}
+	if rate > 0 {
+		parts = append(parts, formatBytes(rate)+"/s")
+	}
+	return strings.Join(parts, " ")
+}
+
+// formatBytes formats n with decimal units, like the sizes of image layers are usually shown.
+func formatBytes(n int64) string {
+	const unit = 1000
+	if n < unit {
+		return fmt.Sprintf("%dB", n)
+	}
+	div, exp := int64(unit), 0
+	for m := n / unit; m >= unit; m /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f%cB", float64(n)/float64(div), "kMGTPE"[exp])
+}
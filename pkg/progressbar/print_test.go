@@ -0,0 +1,36 @@
+package progressbar
+
+import (
+	"testing"
+
+	"github.com/acorn-io/runtime/pkg/client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatBytes(t *testing.T) {
+	assert.Equal(t, "999B", formatBytes(999))
+	assert.Equal(t, "1.0kB", formatBytes(1000))
+	assert.Equal(t, "12.3MB", formatBytes(12_345_678))
+	assert.Equal(t, "4.5GB", formatBytes(4_500_000_000))
+}
+
+func TestDescribe(t *testing.T) {
+	update := client.ImageProgress{
+		Total:       40_000_000,
+		Complete:    10_000_000,
+		CurrentTask: "Pushing app",
+	}
+
+	assert.Equal(t, "Pushing app: 10.0MB/40.0MB (25%) 2.5MB/s", describe(update.CurrentTask, update, 2_500_000, true))
+	assert.Equal(t, "10.0MB/40.0MB", describe("", update, 0, false))
+}
+
+func TestWait(t *testing.T) {
+	progress := make(chan client.ImageProgress, 3)
+	progress <- client.ImageProgress{Total: 10, Complete: 5}
+	progress <- client.ImageProgress{Error: "layer upload failed"}
+	progress <- client.ImageProgress{Total: 10, Complete: 10}
+	close(progress)
+
+	assert.EqualError(t, Wait(progress), "layer upload failed")
+}
@@ -45,11 +45,13 @@ var (
 					"apps",
 					"apps/info",
 					"apps/icon",
+					"apps/metrics",
 					"acornimagebuilds",
 					"builders",
 					"devsessions",
 					"images",
 					"volumes",
+					"volumesnapshots",
 					"containerreplicas",
 					"credentials",
 					"secrets",
@@ -72,6 +74,7 @@ var (
 				Verbs: []string{"get", "create"},
 				Resources: []string{
 					"images/details",
+					"images/signatures",
 				},
 			},
 			{
@@ -99,6 +102,12 @@ var (
 					"secrets",
 				},
 			},
+			{
+				Verbs: []string{"create", "delete"},
+				Resources: []string{
+					"volumesnapshots",
+				},
+			},
 			{
 				Verbs: []string{"update", "delete", "patch"},
 				Resources: []string{
@@ -109,9 +118,12 @@ var (
 				Verbs: []string{"create"},
 				Resources: []string{
 					"images/tag",
+					"images/unsign",
 					"apps/confirmupgrade",
 					"apps/pullimage",
 					"apps/ignorecleanup",
+					"apps/restart",
+					"secrets/rotate",
 					"events",
 					"jobs/restart",
 				},
@@ -6,6 +6,7 @@ import (
 	acornapiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
 	acornv1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
 	acornadminv1 "github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1"
+	snapshotv1 "github.com/kubernetes-csi/external-snapshotter/client/v6/apis/volumesnapshot/v1"
 	appsv1 "k8s.io/api/apps/v1"
 	authv1 "k8s.io/api/authorization/v1"
 	batchv1 "k8s.io/api/batch/v1"
@@ -54,6 +55,7 @@ func AddToScheme(scheme *runtime.Scheme) error {
 	errs = append(errs, discoveryv1.AddToScheme(scheme))
 	errs = append(errs, schedulingv1.AddToScheme(scheme))
 	errs = append(errs, coordinationv1.AddToScheme(scheme))
+	errs = append(errs, snapshotv1.AddToScheme(scheme))
 	return merr.NewErrors(errs...)
 }
 
@@ -0,0 +1,49 @@
+package secrets
+
+import (
+	"fmt"
+
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// rotatableKeys are the keys that are generated for the secret types that can be rotated
+var rotatableKeys = map[string][]string{
+	"token": {"token"},
+	"basic": {corev1.BasicAuthUsernameKey, corev1.BasicAuthPasswordKey},
+}
+
+// Rotate returns a copy of data, the data of the secret generated for secretRef, with new values for the generated
+// keys and the keys that were regenerated. Keys whose value is set in secretRef keep their value, so the result can
+// be empty if the app sets all of them. Only token and basic secrets have generated keys.
+func Rotate(secretRef v1.Secret, data map[string][]byte) (map[string][]byte, []string, error) {
+	keys, ok := rotatableKeys[secretRef.Type]
+	if !ok {
+		return nil, nil, fmt.Errorf("secrets of type %s are not generated, rotation only applies to generated secrets of type token or basic", secretRef.Type)
+	}
+
+	result := make(map[string][]byte, len(data))
+	for k, v := range data {
+		result[k] = v
+	}
+
+	var rotated []string
+	for _, key := range keys {
+		if len(secretRef.Data[key]) > 0 {
+			continue
+		}
+		delete(result, key)
+		rotated = append(rotated, key)
+	}
+	if len(rotated) == 0 {
+		return result, nil, nil
+	}
+
+	var err error
+	if secretRef.Type == "token" {
+		err = generateTokenData(secretRef, result)
+	} else {
+		err = generateBasicData(secretRef, result)
+	}
+	return result, rotated, err
+}
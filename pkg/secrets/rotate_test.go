@@ -0,0 +1,42 @@
+package secrets
+
+import (
+	"testing"
+
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRotate(t *testing.T) {
+	t.Run("token", func(t *testing.T) {
+		data := map[string][]byte{"token": []byte("old")}
+		result, keys, err := Rotate(v1.Secret{Type: "token", Params: v1.NewGenericMap(map[string]any{"length": 8})}, data)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"token"}, keys)
+		assert.Len(t, result["token"], 8)
+		assert.NotEqual(t, "old", string(result["token"]))
+		assert.Equal(t, "old", string(data["token"]), "the data of the secret must not be modified")
+	})
+
+	t.Run("basic keeps the username set by the app", func(t *testing.T) {
+		data := map[string][]byte{"username": []byte("admin"), "password": []byte("old")}
+		result, keys, err := Rotate(v1.Secret{Type: "basic", Data: map[string]string{"username": "admin"}}, data)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"password"}, keys)
+		assert.Equal(t, "admin", string(result["username"]))
+		assert.NotEmpty(t, result["password"])
+		assert.NotEqual(t, "old", string(result["password"]))
+	})
+
+	t.Run("nothing to rotate if the app sets all keys", func(t *testing.T) {
+		_, keys, err := Rotate(v1.Secret{Type: "token", Data: map[string]string{"token": "static"}}, map[string][]byte{"token": []byte("static")})
+		require.NoError(t, err)
+		assert.Empty(t, keys)
+	})
+
+	t.Run("opaque can not be rotated", func(t *testing.T) {
+		_, _, err := Rotate(v1.Secret{Type: "opaque"}, nil)
+		assert.EqualError(t, err, "secrets of type opaque are not generated, rotation only applies to generated secrets of type token or basic")
+	})
+}
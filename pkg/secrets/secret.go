@@ -204,22 +204,32 @@ func generateToken(req router.Request, appInstance *v1.AppInstance, secretName s
 		Type: v1.SecretTypeToken,
 	}
 
-	if len(secret.Data["token"]) == 0 {
-		length, err := convert.ToNumber(secretRef.Params.GetData()["length"])
-		if err != nil {
-			return nil, err
-		}
-		characters := convert.ToString(secretRef.Params.GetData()["characters"])
-		v, err := GenerateRandomSecret(int(length), characters)
-		if err != nil {
-			return nil, err
-		}
-		secret.Data["token"] = []byte(v)
+	if err := generateTokenData(secretRef, secret.Data); err != nil {
+		return nil, err
 	}
 
 	return updateOrCreate(req, existing, secret)
 }
 
+// generateTokenData generates the token of a token secret unless data already has one
+func generateTokenData(secretRef v1.Secret, data map[string][]byte) error {
+	if len(data["token"]) > 0 {
+		return nil
+	}
+
+	length, err := convert.ToNumber(secretRef.Params.GetData()["length"])
+	if err != nil {
+		return err
+	}
+	characters := convert.ToString(secretRef.Params.GetData()["characters"])
+	v, err := GenerateRandomSecret(int(length), characters)
+	if err != nil {
+		return err
+	}
+	data["token"] = []byte(v)
+	return nil
+}
+
 func generateOpaque(req router.Request, appInstance *v1.AppInstance, secretName string, secretRef v1.Secret, existing *corev1.Secret) (*corev1.Secret, error) {
 	secret := &corev1.Secret{
 		ObjectMeta: metav1.ObjectMeta{
@@ -247,6 +257,15 @@ func generateBasic(req router.Request, appInstance *v1.AppInstance, secretName s
 		Type: v1.SecretTypeBasic,
 	}
 
+	if err := generateBasicData(secretRef, secret.Data); err != nil {
+		return nil, err
+	}
+
+	return updateOrCreate(req, existing, secret)
+}
+
+// generateBasicData generates the username and password of a basic secret unless data already has them
+func generateBasicData(secretRef v1.Secret, data map[string][]byte) error {
 	for _, keys := range []struct {
 		dataKey, lengthKey, charactersKey string
 	}{
@@ -261,7 +280,7 @@ func generateBasic(req router.Request, appInstance *v1.AppInstance, secretName s
 			charactersKey: "passwordCharacters",
 		},
 	} {
-		if len(secret.Data[keys.dataKey]) > 0 {
+		if len(data[keys.dataKey]) > 0 {
 			// Explicitly set by user, don't generate
 			continue
 		}
@@ -270,20 +289,19 @@ func generateBasic(req router.Request, appInstance *v1.AppInstance, secretName s
 		if lengthParam, ok := secretRef.Params.GetData()[keys.lengthKey]; ok {
 			var err error
 			if length, err = convert.ToNumber(lengthParam); err != nil {
-				return nil, err
+				return err
 			}
 		}
 		characters := convert.ToString(secretRef.Params.GetData()[keys.charactersKey])
 
 		v, err := GenerateRandomSecret(int(length), characters)
 		if err != nil {
-			return nil, err
+			return err
 		}
 
-		secret.Data[keys.dataKey] = []byte(v)
+		data[keys.dataKey] = []byte(v)
 	}
-
-	return updateOrCreate(req, existing, secret)
+	return nil
 }
 
 func updateOrCreate(req router.Request, existing, secret *corev1.Secret) (result *corev1.Secret, err error) {
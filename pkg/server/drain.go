@@ -0,0 +1,49 @@
+package server
+
+import (
+	"net/http"
+	"sync/atomic"
+	"time"
+)
+
+// drainer tracks in-flight requests, so that the server can wait for them to finish before shutting down
+type drainer struct {
+	inflight atomic.Int64
+	draining atomic.Bool
+}
+
+func (d *drainer) middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if d.draining.Load() {
+			w.Header().Set("Connection", "close")
+			http.Error(w, "server is shutting down", http.StatusServiceUnavailable)
+			return
+		}
+
+		d.inflight.Add(1)
+		defer d.inflight.Add(-1)
+		next.ServeHTTP(w, req)
+	})
+}
+
+// drain stops accepting new requests and waits for the in-flight requests to finish, up to the given grace period.
+// It returns the number of requests still in-flight afterward.
+func (d *drainer) drain(gracePeriod time.Duration) int64 {
+	d.draining.Store(true)
+
+	deadline := time.NewTimer(gracePeriod)
+	defer deadline.Stop()
+	ticker := time.NewTicker(100 * time.Millisecond)
+	defer ticker.Stop()
+
+	for {
+		if remaining := d.inflight.Load(); remaining == 0 {
+			return 0
+		}
+		select {
+		case <-deadline.C:
+			return d.inflight.Load()
+		case <-ticker.C:
+		}
+	}
+}
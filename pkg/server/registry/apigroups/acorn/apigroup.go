@@ -24,6 +24,7 @@ import (
 	"github.com/acorn-io/runtime/pkg/server/registry/apigroups/acorn/secrets"
 	"github.com/acorn-io/runtime/pkg/server/registry/apigroups/acorn/volumes"
 	"github.com/acorn-io/runtime/pkg/server/registry/apigroups/acorn/volumes/class"
+	"github.com/acorn-io/runtime/pkg/server/registry/apigroups/acorn/volumes/snapshots"
 	"github.com/acorn-io/runtime/pkg/server/registry/apigroups/admin/computeclass"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -84,29 +85,35 @@ func Stores(c kclient.WithWatch, cfg, localCfg *clientgo.Config) (map[string]res
 		"apps":                          appsStorage,
 		"apps/log":                      logsStorage,
 		"apps/info":                     apps.NewInfo(c),
+		"apps/metrics":                  apps.NewMetrics(c),
 		"apps/icon":                     apps.NewIcon(c, transport),
 		"apps/confirmupgrade":           apps.NewConfirmUpgrade(c),
 		"apps/pullimage":                apps.NewPullAppImage(c),
 		"apps/ignorecleanup":            apps.NewIgnoreCleanup(c),
+		"apps/restart":                  apps.NewRestart(c),
 		"devsessions":                   devsessions.NewStorage(c, clientFactory),
 		"builders":                      buildersStorage,
 		"builders/port":                 buildersPort,
 		"images":                        imagesStorage,
-		"images/tag":                    images.NewTagStorage(c),
+		"images/tag":                    images.NewTagStorage(c, transport),
 		"images/push":                   images.NewImagePush(c, transport),
 		"images/pull":                   images.NewImagePull(c, clientFactory, transport),
 		"images/details":                images.NewImageDetails(c, transport),
 		"images/sign":                   images.NewImageSign(c, transport),
 		"images/verify":                 images.NewImageVerify(c, transport),
+		"images/signatures":             images.NewImageSignatures(c, transport),
+		"images/unsign":                 images.NewImageUnsign(c, transport),
 		"projects":                      projectStorage,
 		"volumes":                       volumesStorage,
 		"volumeclasses":                 class.NewClassStorage(c),
+		"volumesnapshots":               snapshots.NewStorage(c),
 		"containerreplicas":             containersStorage,
 		"containerreplicas/exec":        containerExec,
 		"containerreplicas/portforward": portForward,
 		"credentials":                   credentials.NewStore(c),
 		"secrets":                       secrets.NewStorage(c),
 		"secrets/reveal":                secrets.NewReveal(c),
+		"secrets/rotate":                secrets.NewRotate(c),
 		"infos":                         info.NewStorage(c),
 		"computeclasses":                computeclass.NewAggregateStorage(c),
 		"regions":                       regions.NewStorage(c),
@@ -7,10 +7,7 @@ import (
 	"github.com/acorn-io/mink/pkg/stores"
 	"github.com/acorn-io/mink/pkg/types"
 	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
-	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
 	"github.com/acorn-io/runtime/pkg/controller/jobs"
-	kclient "github.com/acorn-io/runtime/pkg/k8sclient"
-	"github.com/acorn-io/runtime/pkg/labels"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apiserver/pkg/endpoints/request"
 	"k8s.io/apiserver/pkg/registry/rest"
@@ -40,21 +37,8 @@ func (s *ignoreCleanupStrategy) Create(ctx context.Context, obj types.Object) (t
 	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
 		// Use app instance here because in Manager this request is forwarded to the workload cluster.
 		// The app validation logic should not run there.
-		app := &v1.AppInstance{}
-		err := s.client.Get(ctx, kclient.ObjectKey{Namespace: ri.Namespace, Name: ri.Name}, app)
-		if apierrors.IsNotFound(err) {
-			// See if this is a public name
-			appList := &v1.AppInstanceList{}
-			listErr := s.client.List(ctx, appList, client.MatchingLabels{labels.AcornPublicName: ri.Name}, client.InNamespace(ri.Namespace))
-			if listErr != nil {
-				return listErr
-			}
-			if len(appList.Items) != 1 {
-				// return the NotFound error we got originally
-				return err
-			}
-			app = &appList.Items[0]
-		} else if err != nil {
+		app, err := getAppInstance(ctx, s.client, ri.Namespace, ri.Name)
+		if err != nil {
 			return err
 		}
 
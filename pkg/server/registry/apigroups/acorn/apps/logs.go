@@ -3,7 +3,9 @@ package apps
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/acorn-io/mink/pkg/strategy"
 	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
@@ -13,6 +15,7 @@ import (
 	"github.com/acorn-io/runtime/pkg/log"
 	"github.com/gorilla/websocket"
 	"github.com/sirupsen/logrus"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apiserver/pkg/endpoints/request"
@@ -61,8 +64,25 @@ func (i *Logs) Connect(ctx context.Context, id string, options runtime.Object, r
 
 	var (
 		opts = options.(*apiv1.LogOptions)
+		now  = time.Now()
 	)
 
+	since, err := log.ParseTime(opts.Since, now)
+	if err != nil {
+		return nil, apierrors.NewBadRequest(fmt.Sprintf("invalid since: %v", err))
+	}
+	until, err := log.ParseTime(opts.Until, now)
+	if err != nil {
+		return nil, apierrors.NewBadRequest(fmt.Sprintf("invalid until: %v", err))
+	}
+	if since != nil && until != nil && until.Before(since) {
+		return nil, apierrors.NewBadRequest("until must not be before since")
+	}
+	grep, err := log.ParseGrep(opts.Grep)
+	if err != nil {
+		return nil, apierrors.NewBadRequest(err.Error())
+	}
+
 	output := make(chan log.Message)
 	go func() {
 		defer close(output)
@@ -73,6 +93,9 @@ func (i *Logs) Connect(ctx context.Context, id string, options runtime.Object, r
 			Follow:           opts.Follow,
 			ContainerReplica: opts.ContainerReplica,
 			Container:        opts.Container,
+			Init:             opts.Init,
+			Since:            since,
+			Until:            until,
 		})
 		if err != nil {
 			output <- log.Message{
@@ -92,14 +115,21 @@ func (i *Logs) Connect(ctx context.Context, id string, options runtime.Object, r
 		k8schannel.AddCloseHandler(conn)
 
 		for message := range output {
+			// Filter before sending, so that lines that are not wanted don't take up bandwidth
+			if message.Err == nil && !log.MatchesGrep(message.Line, grep, opts.GrepInvert) {
+				continue
+			}
+
 			lm := apiv1.LogMessage{
 				Line:          message.Line,
 				ContainerName: message.ContainerName,
+				Init:          message.Init,
 				Time:          metav1.NewTime(message.Time),
 			}
 
 			if message.Pod != nil {
 				lm.AppName = message.Pod.Labels[labels.AcornAppName]
+				lm.Container = message.ContainerName
 				lm.ContainerName = message.Pod.Name
 				if message.ContainerName != message.Pod.Labels[labels.AcornContainerName] {
 					lm.ContainerName += "." + message.ContainerName
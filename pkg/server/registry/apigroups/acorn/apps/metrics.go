@@ -0,0 +1,149 @@
+package apps
+
+import (
+	"context"
+
+	"github.com/acorn-io/mink/pkg/stores"
+	"github.com/acorn-io/mink/pkg/types"
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/labels"
+	"github.com/acorn-io/runtime/pkg/namespace"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/apiserver/pkg/registry/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// podMetricsListGVK is the kind of the pod metrics served by the metrics API. The metrics API types aren't a dependency
+// of acorn, so they are read as unstructured objects.
+var podMetricsListGVK = schema.GroupVersionKind{
+	Group:   "metrics.k8s.io",
+	Version: "v1beta1",
+	Kind:    "PodMetricsList",
+}
+
+// podMetrics is the part of a metrics.k8s.io PodMetrics that is needed for the usage of the containers of an app
+type podMetrics struct {
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Containers        []struct {
+		Name  string              `json:"name"`
+		Usage corev1.ResourceList `json:"usage"`
+	} `json:"containers"`
+}
+
+func NewMetrics(c client.WithWatch) rest.Storage {
+	return stores.NewBuilder(c.Scheme(), &apiv1.AppMetrics{}).
+		WithGet(&MetricsStrategy{
+			client: c,
+		}).
+		Build()
+}
+
+type MetricsStrategy struct {
+	client client.WithWatch
+}
+
+func (s *MetricsStrategy) Get(ctx context.Context, namespace, name string) (types.Object, error) {
+	ri, _ := request.RequestInfoFrom(ctx)
+
+	appInstance, err := GetAppInstanceFromPublicName(ctx, s.client, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &apiv1.AppMetrics{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      ri.Name,
+			Namespace: ri.Namespace,
+		},
+	}
+	if appInstance.Status.Namespace == "" {
+		return resp, nil
+	}
+
+	selector := client.MatchingLabels{
+		labels.AcornManaged: "true",
+		labels.AcornAppName: appInstance.Name,
+	}
+
+	usage, err := s.podUsage(ctx, appInstance.Status.Namespace, selector)
+	if err != nil {
+		return nil, err
+	}
+
+	pods := &corev1.PodList{}
+	if err := s.client.List(ctx, pods, client.InNamespace(appInstance.Status.Namespace), selector); err != nil {
+		return nil, err
+	}
+
+	for _, pod := range pods.Items {
+		if pod.Status.Phase != corev1.PodRunning {
+			continue
+		}
+		resp.Containers = append(resp.Containers, podContainerMetrics(&pod, usage[pod.Name])...)
+	}
+	return resp, nil
+}
+
+// podUsage returns the usage of the containers of the pods matching selector from the metrics API, by pod name and
+// container name. It fails if the metrics API isn't available, so that missing metrics are never reported as no usage.
+func (s *MetricsStrategy) podUsage(ctx context.Context, namespace string, selector client.MatchingLabels) (map[string]map[string]corev1.ResourceList, error) {
+	list := &unstructured.UnstructuredList{}
+	list.SetGroupVersionKind(podMetricsListGVK)
+	if err := s.client.List(ctx, list, client.InNamespace(namespace), selector); err != nil {
+		if meta.IsNoMatchError(err) || apierrors.IsNotFound(err) || apierrors.IsServiceUnavailable(err) {
+			return nil, apierrors.NewServiceUnavailable("the metrics API (metrics.k8s.io) is not available in the cluster, metrics-server needs to be installed to show the resource usage of apps")
+		}
+		return nil, err
+	}
+
+	result := make(map[string]map[string]corev1.ResourceList, len(list.Items))
+	for _, item := range list.Items {
+		var metrics podMetrics
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(item.Object, &metrics); err != nil {
+			return nil, err
+		}
+
+		containers := make(map[string]corev1.ResourceList, len(metrics.Containers))
+		for _, container := range metrics.Containers {
+			containers[container.Name] = container.Usage
+		}
+		result[metrics.Name] = containers
+	}
+	return result, nil
+}
+
+// podContainerMetrics returns the metrics of the containers of pod, with the usage from usage, by container name
+func podContainerMetrics(pod *corev1.Pod, usage map[string]corev1.ResourceList) (result []apiv1.ContainerReplicaMetrics) {
+	containerName := pod.Labels[labels.AcornContainerName]
+	if containerName == "" {
+		containerName = pod.Labels[labels.AcornJobName]
+	}
+	_, replicaName := namespace.NormalizedName(pod.ObjectMeta)
+
+	for _, container := range pod.Spec.Containers {
+		metrics := apiv1.ContainerReplicaMetrics{
+			Name:          replicaName,
+			ContainerName: containerName,
+			Usage:         usage[container.Name],
+			Requests:      container.Resources.Requests,
+			Limits:        container.Resources.Limits,
+		}
+		if container.Name != containerName {
+			metrics.Name += ":" + container.Name
+			metrics.SidecarName = container.Name
+		}
+		result = append(result, metrics)
+	}
+	return
+}
+
+func (s *MetricsStrategy) New() types.Object {
+	return &apiv1.AppMetrics{}
+}
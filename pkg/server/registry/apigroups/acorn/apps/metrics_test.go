@@ -0,0 +1,114 @@
+package apps
+
+import (
+	"context"
+	"testing"
+
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/labels"
+	"github.com/acorn-io/runtime/pkg/scheme"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apiserver/pkg/endpoints/request"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+)
+
+func TestMetricsStrategy(t *testing.T) {
+	podLabels := map[string]string{
+		labels.AcornManaged:       "true",
+		labels.AcornAppName:       "my-app",
+		labels.AcornAppNamespace:  "my-project",
+		labels.AcornAppPublicName: "my-app",
+		labels.AcornContainerName: "web",
+	}
+	objs := []client.Object{
+		&v1.AppInstance{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-app", Namespace: "my-project"},
+			Status:     v1.AppInstanceStatus{Namespace: "my-app-ns"},
+		},
+		&corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "web-abc", Namespace: "my-app-ns", Labels: podLabels},
+			Spec: corev1.PodSpec{
+				Containers: []corev1.Container{
+					{
+						Name: "web",
+						Resources: corev1.ResourceRequirements{
+							Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("100m")},
+							Limits:   corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("256Mi")},
+						},
+					},
+					{Name: "proxy"},
+				},
+			},
+			Status: corev1.PodStatus{Phase: corev1.PodRunning},
+		},
+		&corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "web-pending", Namespace: "my-app-ns", Labels: podLabels},
+			Spec:       corev1.PodSpec{Containers: []corev1.Container{{Name: "web"}}},
+			Status:     corev1.PodStatus{Phase: corev1.PodPending},
+		},
+	}
+
+	podMetricsGVK := schema.GroupVersionKind{Group: "metrics.k8s.io", Version: "v1beta1", Kind: "PodMetrics"}
+	podMetrics := &unstructured.Unstructured{Object: map[string]any{
+		"metadata": map[string]any{"name": "web-abc", "namespace": "my-app-ns", "labels": map[string]any{
+			labels.AcornManaged: "true",
+			labels.AcornAppName: "my-app",
+		}},
+		"containers": []any{
+			map[string]any{"name": "web", "usage": map[string]any{"cpu": "25m", "memory": "64Mi"}},
+		},
+	}}
+	podMetrics.SetGroupVersionKind(podMetricsGVK)
+
+	ctx := request.WithRequestInfo(context.Background(), &request.RequestInfo{Name: "my-app", Namespace: "my-project"})
+
+	t.Run("usage of the running containers", func(t *testing.T) {
+		c := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(objs...).WithObjects(podMetrics).Build()
+
+		obj, err := (&MetricsStrategy{client: c}).Get(ctx, "my-project", "my-app")
+		require.NoError(t, err)
+		metrics := obj.(*apiv1.AppMetrics)
+		require.Len(t, metrics.Containers, 2)
+
+		web := metrics.Containers[0]
+		assert.Equal(t, "my-app.web-abc", web.Name)
+		assert.Equal(t, "web", web.ContainerName)
+		assert.Empty(t, web.SidecarName)
+		assert.Equal(t, "25m", web.Usage.Cpu().String())
+		assert.Equal(t, "64Mi", web.Usage.Memory().String())
+		assert.Equal(t, "100m", web.Requests.Cpu().String())
+		assert.Equal(t, "256Mi", web.Limits.Memory().String())
+
+		proxy := metrics.Containers[1]
+		assert.Equal(t, "my-app.web-abc:proxy", proxy.Name)
+		assert.Equal(t, "proxy", proxy.SidecarName)
+		assert.Empty(t, proxy.Usage, "containers without a measurement must not be reported as using nothing")
+	})
+
+	t.Run("metrics API not available", func(t *testing.T) {
+		// The fake client serves any kind, so return the error of a cluster without metrics-server
+		c := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(objs...).WithInterceptorFuncs(interceptor.Funcs{
+			List: func(ctx context.Context, c client.WithWatch, list client.ObjectList, opts ...client.ListOption) error {
+				if list.GetObjectKind().GroupVersionKind().Group == podMetricsGVK.Group {
+					return &meta.NoKindMatchError{GroupKind: podMetricsGVK.GroupKind(), SearchedVersions: []string{podMetricsGVK.Version}}
+				}
+				return c.List(ctx, list, opts...)
+			},
+		}).Build()
+
+		_, err := (&MetricsStrategy{client: c}).Get(ctx, "my-project", "my-app")
+		assert.True(t, apierrors.IsServiceUnavailable(err), "expected a service unavailable error, got %v", err)
+		assert.ErrorContains(t, err, "metrics-server needs to be installed")
+	})
+}
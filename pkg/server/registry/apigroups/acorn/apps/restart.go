@@ -0,0 +1,110 @@
+package apps
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/acorn-io/baaah/pkg/router"
+	"github.com/acorn-io/baaah/pkg/typed"
+	"github.com/acorn-io/mink/pkg/stores"
+	"github.com/acorn-io/mink/pkg/types"
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	kclient "github.com/acorn-io/runtime/pkg/k8sclient"
+	"github.com/acorn-io/runtime/pkg/labels"
+	appsv1 "k8s.io/api/apps/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/apiserver/pkg/registry/rest"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func NewRestart(c client.WithWatch) rest.Storage {
+	return stores.NewBuilder(c.Scheme(), &apiv1.AppRestart{}).
+		WithCreate(&restartStrategy{
+			client: c,
+		}).WithValidateName(nestedValidator{}).Build()
+}
+
+type restartStrategy struct {
+	client client.WithWatch
+}
+
+// Create restarts the containers of an app by setting the restart annotation on the pod templates of their
+// deployments, like kubectl rollout restart does. The deployments then replace their pods one by one.
+func (s *restartStrategy) Create(ctx context.Context, obj types.Object) (types.Object, error) {
+	ri, _ := request.RequestInfoFrom(ctx)
+
+	if ri.Name == "" || ri.Namespace == "" {
+		return obj, nil
+	}
+
+	// Use app instance here because in Manager this request is forwarded to the workload cluster.
+	app, err := getAppInstance(ctx, s.client, ri.Namespace, ri.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	containers := typed.SortedKeys(app.Status.AppSpec.Containers)
+	if restart, ok := obj.(*apiv1.AppRestart); ok && restart.Container != "" {
+		if _, ok := app.Status.AppSpec.Containers[restart.Container]; !ok {
+			return nil, apierrors.NewBadRequest(fmt.Sprintf("app %s has no container %s", app.Name, restart.Container))
+		}
+		containers = []string{restart.Container}
+	}
+
+	return obj, RestartContainers(ctx, s.client, app, containers)
+}
+
+// RestartContainers restarts the given containers of the app by setting the restart annotation on the pod templates of
+// their deployments. Containers without a deployment, for example because the app is stopped, are skipped.
+func RestartContainers(ctx context.Context, c client.Client, app *v1.AppInstance, containers []string) error {
+	restartedAt := time.Now().Format(time.RFC3339)
+	for _, container := range containers {
+		err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+			dep := &appsv1.Deployment{}
+			if err := c.Get(ctx, router.Key(app.Status.Namespace, container), dep); err != nil {
+				return err
+			}
+			if dep.Spec.Template.Annotations == nil {
+				dep.Spec.Template.Annotations = map[string]string{}
+			}
+			dep.Spec.Template.Annotations[labels.AcornRestartedAt] = restartedAt
+			return c.Update(ctx, dep)
+		})
+		if apierrors.IsNotFound(err) {
+			// The container isn't running, for example because the app is stopped, so there is nothing to restart
+			continue
+		} else if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *restartStrategy) New() types.Object {
+	return &apiv1.AppRestart{}
+}
+
+// getAppInstance returns the app with the given name, or with the given public name if there is no app with that name.
+func getAppInstance(ctx context.Context, c client.Client, namespace, name string) (*v1.AppInstance, error) {
+	app := &v1.AppInstance{}
+	err := c.Get(ctx, kclient.ObjectKey{Namespace: namespace, Name: name}, app)
+	if apierrors.IsNotFound(err) {
+		// See if this is a public name
+		appList := &v1.AppInstanceList{}
+		listErr := c.List(ctx, appList, client.MatchingLabels{labels.AcornPublicName: name}, client.InNamespace(namespace))
+		if listErr != nil {
+			return nil, listErr
+		}
+		if len(appList.Items) != 1 {
+			// return the NotFound error we got originally
+			return nil, err
+		}
+		return &appList.Items[0], nil
+	}
+	return app, err
+}
@@ -0,0 +1,90 @@
+package apps
+
+import (
+	"context"
+	"testing"
+
+	"github.com/acorn-io/baaah/pkg/router"
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/labels"
+	"github.com/acorn-io/runtime/pkg/scheme"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/utils/strings/slices"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestRestartStrategy(t *testing.T) {
+	tests := []struct {
+		name          string
+		container     string
+		wantRestarted []string
+		wantError     bool
+	}{
+		{
+			name:          "restart all containers",
+			wantRestarted: []string{"db", "web"},
+		},
+		{
+			name:          "restart one container",
+			container:     "web",
+			wantRestarted: []string{"web"},
+		},
+		{
+			name:      "error if the container does not exist",
+			container: "cache",
+			wantError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			app := &v1.AppInstance{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "my-app",
+					Namespace: "my-project",
+				},
+				Status: v1.AppInstanceStatus{
+					Namespace: "my-app-ns",
+					AppSpec: v1.AppSpec{
+						Containers: map[string]v1.Container{
+							"web":    {},
+							"db":     {},
+							"worker": {},
+						},
+					},
+				},
+			}
+			ctx := request.WithRequestInfo(context.Background(), &request.RequestInfo{
+				Name:      app.Name,
+				Namespace: app.Namespace,
+			})
+
+			// The worker has no deployment, like a container of a stopped app
+			c := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(
+				app,
+				&appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "my-app-ns"}},
+				&appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "db", Namespace: "my-app-ns"}},
+			).Build()
+
+			_, err := (&restartStrategy{client: c}).Create(ctx, &apiv1.AppRestart{Container: tt.container})
+			if tt.wantError {
+				assert.True(t, apierrors.IsBadRequest(err), "expected a bad request error, got %v", err)
+				return
+			}
+			require.NoError(t, err)
+
+			for _, name := range []string{"db", "web"} {
+				dep := &appsv1.Deployment{}
+				require.NoError(t, c.Get(ctx, router.Key("my-app-ns", name), dep))
+				_, restarted := dep.Spec.Template.Annotations[labels.AcornRestartedAt]
+				assert.Equal(t, slices.Contains(tt.wantRestarted, name), restarted, name)
+			}
+		})
+	}
+}
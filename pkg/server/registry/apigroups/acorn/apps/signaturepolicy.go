@@ -0,0 +1,81 @@
+package apps
+
+import (
+	"context"
+	"errors"
+
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/images"
+	"github.com/acorn-io/runtime/pkg/imageselector/signatures"
+	"github.com/acorn-io/runtime/pkg/tags"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"k8s.io/apimachinery/pkg/api/equality"
+)
+
+// checkSignaturePolicy verifies the app image and all of its nested images against the default signature policy of the
+// project and the signature policy of the app. The policy of an app can only add requirements, it never replaces the
+// default of the project. All images violating a policy are reported.
+func (s *Validator) checkSignaturePolicy(ctx context.Context, app *apiv1.App, project *v1.ProjectInstance, image string, details *apiv1.ImageDetails) error {
+	policies := signaturePolicies(app, project)
+	if len(policies) == 0 {
+		return nil
+	}
+
+	for _, policy := range policies {
+		if err := signatures.ValidateSignaturePolicy(policy); err != nil {
+			return err
+		}
+	}
+
+	remoteOpts, err := images.GetAuthenticationRemoteOptions(ctx, s.client, app.Namespace, remote.WithTransport(s.transport))
+	if err != nil {
+		return err
+	}
+
+	imageRef, err := images.GetImageReference(ctx, s.client, app.Namespace, image)
+	if err != nil {
+		return err
+	}
+
+	// Pin all images to the digests that are going to be deployed
+	toCheck := []string{imageRef.Context().Digest(details.AppImage.Digest).String()}
+	for _, nested := range details.NestedImages {
+		nestedRef := imageRef
+		if nested.ImageName != "" && !tags.IsImageDigest(nested.ImageName) {
+			// nested images that are not part of the app image are signed in their own repository
+			nestedRef, err = images.GetImageReference(ctx, s.client, app.Namespace, nested.ImageName)
+			if err != nil {
+				return err
+			}
+		}
+		toCheck = append(toCheck, nestedRef.Context().Digest(nested.Digest).String())
+	}
+
+	var violations []error
+	for _, img := range toCheck {
+		for _, policy := range policies {
+			if err := signatures.VerifySignaturePolicy(ctx, s.client, app.Namespace, img, policy, signatures.MatchImageSignatureOpts{}, remoteOpts...); err != nil {
+				if violation := (*signatures.PolicyViolation)(nil); !errors.As(err, &violation) {
+					return err
+				}
+				violations = append(violations, err)
+			}
+		}
+	}
+
+	return errors.Join(violations...)
+}
+
+// signaturePolicies returns the signature policies the images of the app have to meet, the default of the project
+// followed by the policy of the app unless it is the same
+func signaturePolicies(app *apiv1.App, project *v1.ProjectInstance) []v1.SignaturePolicy {
+	var result []v1.SignaturePolicy
+	if project.Spec.DefaultSignaturePolicy != nil {
+		result = append(result, *project.Spec.DefaultSignaturePolicy)
+	}
+	if app.Spec.SignaturePolicy != nil && (len(result) == 0 || !equality.Semantic.DeepEqual(result[0], *app.Spec.SignaturePolicy)) {
+		result = append(result, *app.Spec.SignaturePolicy)
+	}
+	return result
+}
@@ -0,0 +1,163 @@
+package apps
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	acornsign "github.com/acorn-io/runtime/pkg/cosign"
+	"github.com/acorn-io/runtime/pkg/scheme"
+	"github.com/acorn-io/runtime/pkg/system"
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/registry"
+	"github.com/google/go-containerregistry/pkg/v1/random"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/sigstore/cosign/v2/pkg/oci/mutate"
+	ociremote "github.com/sigstore/cosign/v2/pkg/oci/remote"
+	"github.com/sigstore/cosign/v2/pkg/oci/static"
+	sigsig "github.com/sigstore/sigstore/pkg/signature"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestCheckSignaturePolicy(t *testing.T) {
+	s := httptest.NewServer(registry.New(registry.Logger(log.New(io.Discard, "", 0))))
+	defer s.Close()
+	u, err := url.Parse(s.URL)
+	require.NoError(t, err)
+	repo := fmt.Sprintf("%s/library/hello-world", u.Host)
+
+	// The image is signed with the key of signer only, and the signature has the annotation tag=ok
+	signer, signerKey := newTestSigner(t)
+	_, otherKey := newTestSigner(t)
+	imgDigest := pushSignedTestImage(t, repo+":latest", signer, map[string]any{"tag": "ok"})
+
+	// Signatures are cached in the internal registry, which is the test registry as well
+	cacheConfig := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: system.ConfigName, Namespace: system.Namespace},
+		Data:       map[string]string{"config": fmt.Sprintf(`{"internalRegistryPrefix":"%s/cache/"}`, u.Host)},
+	}
+
+	signedBy := &v1.SignaturePolicy{Key: signerKey}
+	signedByOther := &v1.SignaturePolicy{Key: otherKey}
+	annotated := &v1.SignaturePolicy{Key: signerKey, Annotations: v1.SignatureAnnotations{Match: map[string]string{"tag": "ok"}}}
+	misannotated := &v1.SignaturePolicy{Key: signerKey, Annotations: v1.SignatureAnnotations{Match: map[string]string{"tag": "prod"}}}
+
+	tests := []struct {
+		name          string
+		projectPolicy *v1.SignaturePolicy
+		appPolicy     *v1.SignaturePolicy
+		wantErr       string
+	}{
+		{
+			name: "no policy",
+		},
+		{
+			name:          "project default met",
+			projectPolicy: signedBy,
+		},
+		{
+			name:          "project default violated",
+			projectPolicy: signedByOther,
+			wantErr:       "not signed by the key of the signature policy",
+		},
+		{
+			name:      "app policy met",
+			appPolicy: annotated,
+		},
+		{
+			name:          "app policy adds a requirement that is met",
+			projectPolicy: signedBy,
+			appPolicy:     annotated,
+		},
+		{
+			name:          "app policy adds a requirement that is violated",
+			projectPolicy: signedBy,
+			appPolicy:     misannotated,
+			wantErr:       "signature annotations do not match the signature policy",
+		},
+		{
+			name:          "app policy does not replace the project default",
+			projectPolicy: signedByOther,
+			appPolicy:     signedBy,
+			wantErr:       "not signed by the key of the signature policy",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			validator := &Validator{
+				client:    fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(cacheConfig).Build(),
+				transport: http.DefaultTransport,
+			}
+			app := &apiv1.App{
+				ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "acorn"},
+				Spec:       v1.AppInstanceSpec{SignaturePolicy: tt.appPolicy},
+			}
+			project := &v1.ProjectInstance{Spec: v1.ProjectInstanceSpec{DefaultSignaturePolicy: tt.projectPolicy}}
+			details := &apiv1.ImageDetails{AppImage: v1.AppImage{Digest: imgDigest}}
+
+			err := validator.checkSignaturePolicy(context.Background(), app, project, repo+":latest", details)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+// newTestSigner returns a new signer and its PEM encoded public key
+func newTestSigner(t *testing.T) (sigsig.SignerVerifier, string) {
+	t.Helper()
+
+	priv, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	sv, err := sigsig.LoadECDSASignerVerifier(priv, crypto.SHA256)
+	require.NoError(t, err)
+	pem, _, err := acornsign.PemEncodeCryptoPublicKey(priv.Public())
+	require.NoError(t, err)
+	return sv, string(pem)
+}
+
+// pushSignedTestImage pushes a random image to ref, signs it with signer and returns its digest
+func pushSignedTestImage(t *testing.T, ref string, signer sigsig.SignerVerifier, annotations map[string]any) string {
+	t.Helper()
+
+	img, err := random.Image(64, 1)
+	require.NoError(t, err)
+	parsed, err := name.ParseReference(ref)
+	require.NoError(t, err)
+	require.NoError(t, remote.Write(parsed, img))
+
+	digest, err := img.Digest()
+	require.NoError(t, err)
+	digestRef := parsed.Context().Digest(digest.String())
+
+	payload, signature, err := sigsig.SignImage(signer, digestRef, annotations)
+	require.NoError(t, err)
+	sig, err := static.NewSignature(payload, base64.StdEncoding.EncodeToString(signature))
+	require.NoError(t, err)
+
+	entity, err := ociremote.SignedEntity(digestRef)
+	require.NoError(t, err)
+	entity, err = mutate.AttachSignatureToEntity(entity, sig)
+	require.NoError(t, err)
+	require.NoError(t, ociremote.WriteSignatures(parsed.Context(), entity))
+
+	return digest.String()
+}
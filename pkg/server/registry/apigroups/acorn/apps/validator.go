@@ -26,6 +26,7 @@ import (
 	"github.com/acorn-io/runtime/pkg/imagesystem"
 	"github.com/acorn-io/runtime/pkg/labels"
 	"github.com/acorn-io/runtime/pkg/pullsecret"
+	"github.com/acorn-io/runtime/pkg/services"
 	"github.com/acorn-io/runtime/pkg/tags"
 	"github.com/acorn-io/runtime/pkg/volume"
 	"github.com/acorn-io/z"
@@ -34,8 +35,11 @@ import (
 	"golang.org/x/exp/slices"
 	"golang.org/x/sync/errgroup"
 	authv1 "k8s.io/api/authorization/v1"
+	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
+	storagev1 "k8s.io/api/storage/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/validation"
@@ -123,6 +127,21 @@ func (s *Validator) Validate(ctx context.Context, obj runtime.Object) (result fi
 		return
 	}
 
+	if errs := validateScale(app.Spec.Scale); len(errs) > 0 {
+		result = append(result, errs...)
+		return
+	}
+
+	if errs := validateLinks(app.Spec.Links); len(errs) > 0 {
+		result = append(result, errs...)
+		return
+	}
+
+	if err := s.checkServiceGrants(ctx, app); err != nil {
+		result = append(result, field.Forbidden(field.NewPath("spec", "services"), err.Error()))
+		return
+	}
+
 	if err := imagesystem.IsNotInternalRepo(ctx, s.client, app.Namespace, app.Spec.Image); err != nil {
 		result = append(result, field.Invalid(field.NewPath("spec", "image"), app.Spec.Image, err.Error()))
 		return
@@ -191,6 +210,11 @@ func (s *Validator) Validate(ctx context.Context, obj runtime.Object) (result fi
 				return
 			}
 
+			if err := s.checkSignaturePolicy(ctx, app, project, image, imageDetails); err != nil {
+				result = append(result, field.Forbidden(field.NewPath("spec", "signaturePolicy"), fmt.Sprintf("%s not allowed to run by signature policy:\n%s", app.Spec.Image, err.Error())))
+				return
+			}
+
 			workloadsFromImage, err := s.getWorkloads(imageDetails)
 			if err != nil {
 				result = append(result, field.Invalid(field.NewPath("spec", "image"), app.Spec.Image, err.Error()))
@@ -216,6 +240,11 @@ func (s *Validator) Validate(ctx context.Context, obj runtime.Object) (result fi
 			}
 		}
 
+		if errs := validateAddressLinkTargets(app.Spec.Links, imageDetails.AppSpec); len(errs) > 0 {
+			result = append(result, errs...)
+			return
+		}
+
 		if err := validateVolumeClasses(ctx, s.client, app.Namespace, app.Spec, imageDetails.AppSpec, project); err != nil {
 			result = append(result, err)
 			return
@@ -263,6 +292,11 @@ func (s *Validator) ValidateUpdate(ctx context.Context, obj, old runtime.Object)
 		return result
 	}
 
+	if err := validateVolumeResize(ctx, s.client, newParams, oldParams); err != nil {
+		result = append(result, err)
+		return result
+	}
+
 	return s.Validate(ctx, newParams)
 }
 
@@ -285,9 +319,74 @@ func (s *Validator) validateRegion(app *apiv1.App, project *v1.ProjectInstance)
 	}
 
 	if !project.HasRegion(app.Spec.Region) {
-		return fmt.Errorf("region %s is not supported for project %s", app.Spec.Region, app.Namespace)
+		return fmt.Errorf("region %s is not supported for project %s, valid regions are: %s", app.Spec.Region, app.Namespace, strings.Join(project.GetSupportedRegions(), ", "))
+	}
+
+	return nil
+}
+
+func validateScale(scale map[string]int32) (result field.ErrorList) {
+	for _, container := range typed.SortedKeys(scale) {
+		if scale[container] < 0 {
+			result = append(result, field.Invalid(field.NewPath("spec", "scale", container), scale[container], "replicas must not be negative"))
+		}
+	}
+	return
+}
+
+// validateLinks checks that every link points either at a service of the project or at a valid external address.
+func validateLinks(links []v1.ServiceBinding) (result field.ErrorList) {
+	for i, link := range links {
+		path := field.NewPath("spec", "services").Index(i)
+		switch {
+		case link.Address != "" && link.Service != "":
+			result = append(result, field.Invalid(path, link, "a link can not point at both a service and an address"))
+		case link.Address != "":
+			if err := v1.ValidateLinkAddress(link.Address); err != nil {
+				result = append(result, field.Invalid(path.Child("address"), link.Address, err.Error()))
+			}
+		}
 	}
+	return
+}
+
+// validateAddressLinkTargets checks that the targets of links to external addresses are declared by the image, since
+// the address takes the place of the declared service, container, function or job, including its ports.
+func validateAddressLinkTargets(links []v1.ServiceBinding, appSpec *v1.AppSpec) (result field.ErrorList) {
+	for i, link := range links {
+		if link.Address == "" {
+			continue
+		}
+		if _, ok := appSpec.Services[link.Target]; ok {
+			continue
+		}
+		if _, ok := appSpec.Containers[link.Target]; ok {
+			continue
+		}
+		if _, ok := appSpec.Functions[link.Target]; ok {
+			continue
+		}
+		if _, ok := appSpec.Jobs[link.Target]; ok {
+			continue
+		}
+		result = append(result, field.Invalid(field.NewPath("spec", "services").Index(i).Child("target"), link.Target,
+			fmt.Sprintf("%s is not a service, container, function or job declared by the image", link.Target)))
+	}
+	return
+}
 
+// checkServiceGrants returns an error for the first link of the app to the services of an app that didn't grant the
+// app access to them.
+func (s *Validator) checkServiceGrants(ctx context.Context, app *apiv1.App) error {
+	for _, link := range app.Spec.Links {
+		if link.Address != "" {
+			// External addresses aren't apps of the project, there is nobody to grant the link
+			continue
+		}
+		if err := services.CheckServiceGrant(ctx, s.client, app.Namespace, app.Name, link.Service); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -574,6 +673,7 @@ func (s *RBACValidator) CheckPermissionsForPrivilegeEscalation(ctx context.Conte
 func (s *Validator) checkScheduling(ctx context.Context, params *apiv1.App, project *v1.ProjectInstance, workloads map[string]v1.Container, specMemDefault, specMemMaximum *int64) []*field.Error {
 	var (
 		memory        = params.Spec.Memory
+		cpu           = params.Spec.CPU
 		computeClass  = params.Spec.ComputeClasses
 		defaultRegion = project.GetRegion()
 	)
@@ -588,6 +688,7 @@ func (s *Validator) checkScheduling(ctx context.Context, params *apiv1.App, proj
 	if err != nil {
 		validationErrors = append(validationErrors, err...)
 	}
+	validationErrors = append(validationErrors, validateCPURunFlags(cpu, workloads)...)
 
 	for workload, container := range workloads {
 		cc, err := getClassForWorkload(computeClasses, computeClass, container, workload)
@@ -643,6 +744,13 @@ func (s *Validator) checkScheduling(ctx context.Context, params *apiv1.App, proj
 				validationErrors = append(validationErrors, field.Invalid(field.NewPath("unknown"), "", err.Error()))
 			}
 		}
+
+		if milliCPU := cpu.ForWorkload(workload); milliCPU != nil {
+			cpuQuantity := resource.NewMilliQuantity(*milliCPU, resource.DecimalSI)
+			if err := computeclasses.ValidateCPU(*cc, *cpuQuantity); err != nil {
+				validationErrors = append(validationErrors, field.Invalid(field.NewPath("spec", "cpu", workload), cpuQuantity.String(), err.Error()))
+			}
+		}
 	}
 	return validationErrors
 }
@@ -661,7 +769,13 @@ func getClassForWorkload(computeClassList *apiv1.ComputeClassList, computeClasse
 		return nil, nil
 	}
 
-	return nil, fmt.Errorf("computeclass %s not found", ccName)
+	names := make([]string, 0, len(computeClassList.Items))
+	for _, cc := range computeClassList.Items {
+		names = append(names, cc.Name)
+	}
+	sort.Strings(names)
+
+	return nil, fmt.Errorf("computeclass %s not found, valid computeclasses are: [%s]", ccName, strings.Join(names, ", "))
 }
 
 func validateMemoryRunFlags(memory v1.MemoryMap, workloads map[string]v1.Container) []*field.Error {
@@ -678,6 +792,19 @@ func validateMemoryRunFlags(memory v1.MemoryMap, workloads map[string]v1.Contain
 	return validationErrors
 }
 
+func validateCPURunFlags(cpu v1.CPUMap, workloads map[string]v1.Container) []*field.Error {
+	var validationErrors []*field.Error
+	for key := range cpu {
+		if key == "" {
+			continue
+		}
+		if _, ok := workloads[key]; !ok {
+			validationErrors = append(validationErrors, field.Invalid(field.NewPath("spec", "cpu"), key, v1.ErrInvalidWorkload.Error()))
+		}
+	}
+	return validationErrors
+}
+
 func validateVolumeClasses(ctx context.Context, c kclient.Client, namespace string, appInstanceSpec v1.AppInstanceSpec, appSpec *v1.AppSpec, project *v1.ProjectInstance) *field.Error {
 	if len(appInstanceSpec.Volumes) == 0 && len(appSpec.Volumes) == 0 {
 		return nil
@@ -743,6 +870,65 @@ func validateVolumeClasses(ctx context.Context, c kclient.Client, namespace stri
 	return nil
 }
 
+// validateVolumeResize checks the volume bindings whose size changed against the existing claims of the app. A volume
+// can only grow, and only if its storage class allows volume expansion.
+func validateVolumeResize(ctx context.Context, c kclient.Client, app, oldApp *apiv1.App) *field.Error {
+	if app.Status.Namespace == "" {
+		return nil
+	}
+
+	oldSizes := make(map[string]v1.Quantity, len(oldApp.Spec.Volumes))
+	for _, binding := range oldApp.Spec.Volumes {
+		oldSizes[binding.Target] = binding.Size
+	}
+
+	for i, binding := range app.Spec.Volumes {
+		if binding.Size == "" || binding.Size == oldSizes[binding.Target] {
+			continue
+		}
+
+		path := field.NewPath("spec", "volumes").Index(i).Child("size")
+		requested, err := resource.ParseQuantity(string(binding.Size))
+		if err != nil {
+			return field.Invalid(path, binding.Size, err.Error())
+		}
+
+		pvcs := new(corev1.PersistentVolumeClaimList)
+		if err := c.List(ctx, pvcs, kclient.InNamespace(app.Status.Namespace), kclient.MatchingLabels{
+			labels.AcornAppName:      app.Name,
+			labels.AcornAppNamespace: app.Namespace,
+			labels.AcornVolumeName:   binding.Target,
+			labels.AcornManaged:      "true",
+		}); err != nil {
+			return field.InternalError(path, err)
+		}
+
+		for _, pvc := range pvcs.Items {
+			current := pvc.Spec.Resources.Requests[corev1.ResourceStorage]
+			if cmp := requested.Cmp(current); cmp < 0 {
+				return field.Invalid(path, binding.Size, fmt.Sprintf("cannot shrink volume %s from %s", binding.Target, current.String()))
+			} else if cmp == 0 {
+				continue
+			}
+
+			storageClassName := z.Dereference(pvc.Spec.StorageClassName)
+			if storageClassName == "" {
+				return field.Invalid(path, binding.Size, fmt.Sprintf("cannot resize volume %s, it has no storage class", binding.Target))
+			}
+
+			storageClass := new(storagev1.StorageClass)
+			if err := c.Get(ctx, kclient.ObjectKey{Name: storageClassName}, storageClass); err != nil {
+				return field.InternalError(path, err)
+			}
+			if !z.Dereference(storageClass.AllowVolumeExpansion) {
+				return field.Invalid(path, binding.Size, fmt.Sprintf("cannot resize volume %s, storage class %s does not allow volume expansion", binding.Target, storageClassName))
+			}
+		}
+	}
+
+	return nil
+}
+
 func (s *Validator) imageSAR(ns, imageName, imageDigest string, perms []v1.Permissions) (result []sarRequest, _ error) {
 	sar := &authv1.SubjectAccessReview{
 		Spec: authv1.SubjectAccessReviewSpec{
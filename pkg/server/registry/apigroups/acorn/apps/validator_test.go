@@ -8,8 +8,15 @@ import (
 	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
 	internalv1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
 	"github.com/acorn-io/runtime/pkg/labels"
+	"github.com/acorn-io/runtime/pkg/scheme"
+	"github.com/acorn-io/z"
 	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 )
 
 func TestValidateAppName(t *testing.T) {
@@ -144,3 +151,199 @@ func TestCannotUpdateNestedAcorn(t *testing.T) {
 		assert.True(t, strings.Contains(err[0].Error(), "update the parent Acorn"))
 	}
 }
+
+func TestValidateVolumeResize(t *testing.T) {
+	pvc := func(storageClassName string) *corev1.PersistentVolumeClaim {
+		return &corev1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "data",
+				Namespace: "app-namespace",
+				Labels: map[string]string{
+					labels.AcornAppName:      "myapp",
+					labels.AcornAppNamespace: "acorn",
+					labels.AcornVolumeName:   "data",
+					labels.AcornManaged:      "true",
+				},
+			},
+			Spec: corev1.PersistentVolumeClaimSpec{
+				StorageClassName: &storageClassName,
+				Resources: corev1.VolumeResourceRequirements{
+					Requests: corev1.ResourceList{
+						corev1.ResourceStorage: resource.MustParse("10G"),
+					},
+				},
+			},
+		}
+	}
+	storageClasses := []kclient.Object{
+		&storagev1.StorageClass{
+			ObjectMeta:           metav1.ObjectMeta{Name: "expandable"},
+			AllowVolumeExpansion: z.Pointer(true),
+		},
+		&storagev1.StorageClass{
+			ObjectMeta: metav1.ObjectMeta{Name: "fixed"},
+		},
+	}
+	app := func(size internalv1.Quantity) *apiv1.App {
+		return &apiv1.App{
+			ObjectMeta: metav1.ObjectMeta{Name: "myapp", Namespace: "acorn"},
+			Spec: internalv1.AppInstanceSpec{
+				Volumes: []internalv1.VolumeBinding{{Target: "data", Size: size}},
+			},
+			Status: internalv1.AppInstanceStatus{Namespace: "app-namespace"},
+		}
+	}
+
+	tests := []struct {
+		name             string
+		storageClassName string
+		size             internalv1.Quantity
+		wantErr          string
+	}{
+		{
+			name:             "grow with expandable storage class",
+			storageClassName: "expandable",
+			size:             "20G",
+		},
+		{
+			name:             "same size with fixed storage class",
+			storageClassName: "fixed",
+			size:             "10G",
+		},
+		{
+			name:             "grow with fixed storage class",
+			storageClassName: "fixed",
+			size:             "20G",
+			wantErr:          "cannot resize volume data, storage class fixed does not allow volume expansion",
+		},
+		{
+			name:             "shrink",
+			storageClassName: "expandable",
+			size:             "5G",
+			wantErr:          "cannot shrink volume data from 10G",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(append(storageClasses, pvc(tt.storageClassName))...).Build()
+			err := validateVolumeResize(context.Background(), c, app(tt.size), app("1G"))
+			if tt.wantErr == "" {
+				assert.Nil(t, err)
+			} else if assert.NotNil(t, err) {
+				assert.Equal(t, tt.wantErr, err.Detail)
+			}
+		})
+	}
+}
+
+func TestCheckServiceGrants(t *testing.T) {
+	publisher := func(grants *internalv1.ServiceGrants) *internalv1.AppInstance {
+		return &internalv1.AppInstance{
+			ObjectMeta: metav1.ObjectMeta{Name: "db", Namespace: "acorn"},
+			Spec: internalv1.AppInstanceSpec{
+				ServiceGrants: grants,
+			},
+		}
+	}
+	consumer := &apiv1.App{
+		ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "acorn"},
+		Spec: internalv1.AppInstanceSpec{
+			Links: []internalv1.ServiceBinding{{Target: "db", Service: "db.mysql"}},
+		},
+	}
+
+	tests := []struct {
+		name    string
+		grants  *internalv1.ServiceGrants
+		wantErr string
+	}{
+		{
+			name: "no grants",
+		},
+		{
+			name:   "granted",
+			grants: &internalv1.ServiceGrants{Consumers: []string{"api", "web"}},
+		},
+		{
+			name:    "not granted",
+			grants:  &internalv1.ServiceGrants{Consumers: []string{"api"}},
+			wantErr: `app web is not authorized to link to the services of app db, missing grant from db to web (run "acorn app grant db --to web")`,
+		},
+		{
+			name:    "all grants revoked",
+			grants:  &internalv1.ServiceGrants{},
+			wantErr: `app web is not authorized to link to the services of app db, missing grant from db to web (run "acorn app grant db --to web")`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			validator := &Validator{
+				client: fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(publisher(tt.grants)).Build(),
+			}
+			err := validator.checkServiceGrants(context.Background(), consumer)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateAddressLinks(t *testing.T) {
+	appSpec := &internalv1.AppSpec{
+		Services:   map[string]internalv1.Service{"db": {}},
+		Containers: map[string]internalv1.Container{"cache": {}},
+	}
+
+	tests := []struct {
+		name    string
+		link    internalv1.ServiceBinding
+		wantErr string
+	}{
+		{
+			name: "service of the project",
+			link: internalv1.ServiceBinding{Target: "other", Service: "mysql"},
+		},
+		{
+			name: "hostname for a declared service",
+			link: internalv1.ServiceBinding{Target: "db", Address: "db.prod.example.com"},
+		},
+		{
+			name: "ip address for a declared container",
+			link: internalv1.ServiceBinding{Target: "cache", Address: "10.0.0.5"},
+		},
+		{
+			name:    "invalid address",
+			link:    internalv1.ServiceBinding{Target: "db", Address: "https://db.example.com"},
+			wantErr: "spec.services[0].address: Invalid value: \"https://db.example.com\": address https://db.example.com must be an IP address or a hostname",
+		},
+		{
+			name:    "service and address",
+			link:    internalv1.ServiceBinding{Target: "db", Service: "mysql", Address: "db.example.com"},
+			wantErr: "spec.services[0]: Invalid value",
+		},
+		{
+			name:    "undeclared target",
+			link:    internalv1.ServiceBinding{Target: "queue", Address: "queue.example.com"},
+			wantErr: "spec.services[0].target: Invalid value: \"queue\": queue is not a service, container, function or job declared by the image",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			links := []internalv1.ServiceBinding{tt.link}
+			errs := validateLinks(links)
+			if len(errs) == 0 {
+				errs = validateAddressLinkTargets(links, appSpec)
+			}
+			if tt.wantErr == "" {
+				assert.Empty(t, errs)
+			} else if assert.Len(t, errs, 1) {
+				assert.Contains(t, errs[0].Error(), tt.wantErr)
+			}
+		})
+	}
+}
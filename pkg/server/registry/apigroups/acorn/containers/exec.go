@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net/http"
 	"net/http/httputil"
+	"strings"
 	"time"
 
 	"github.com/acorn-io/baaah/pkg/name"
@@ -89,12 +90,12 @@ func (c *ContainerExec) connect(podName, podNamespace, containerName string, exe
 			Name(podName).
 			SubResource("exec").
 			VersionedParams(&corev1.PodExecOptions{
-				Stdin:     true,
+				Stdin:     execOpt.Stdin,
 				Stdout:    true,
 				Stderr:    true,
 				TTY:       execOpt.TTY,
 				Container: containerName,
-				Command:   command(execOpt.Command),
+				Command:   command(execOpt),
 			}, scheme.ParameterCodec)
 		request.URL = req.URL()
 		c.proxy.ServeHTTP(writer, request)
@@ -104,6 +105,10 @@ func (c *ContainerExec) connect(podName, podNamespace, containerName string, exe
 func (c *ContainerExec) Connect(ctx context.Context, id string, options runtime.Object, r registryrest.Responder) (http.Handler, error) {
 	execOpt := options.(*apiv1.ContainerReplicaExecOptions)
 
+	if err := validateEnv(execOpt.Env); err != nil {
+		return nil, err
+	}
+
 	container := &apiv1.ContainerReplica{}
 	ns, _ := request.NamespaceFrom(ctx)
 
@@ -157,11 +162,42 @@ func (c *ContainerExec) ConnectMethods() []string {
 	return []string{"GET"}
 }
 
-func command(args []string) []string {
-	if len(args) == 0 {
-		return defaultExecCmd
+// validateEnv ensures that all environment variables are in the KEY=VALUE format, so that env doesn't take them for
+// the command to run
+func validateEnv(env []string) error {
+	for _, e := range env {
+		if k, _, ok := strings.Cut(e, "="); !ok || k == "" {
+			return apierror.NewBadRequest(fmt.Sprintf("invalid environment variable %q, expected KEY=VALUE", e))
+		}
 	}
-	return args
+	return nil
+}
+
+func command(execOpt *apiv1.ContainerReplicaExecOptions) []string {
+	cmd := defaultExecCmd
+	if len(execOpt.Command) > 0 {
+		cmd = execOpt.Command
+	} else if execOpt.TTY && execOpt.Rows > 0 && execOpt.Cols > 0 {
+		// Size the terminal before the shell starts, so that it doesn't start at the default 80x24
+		cmd = []string{
+			defaultExecCmd[0],
+			defaultExecCmd[1],
+			fmt.Sprintf("stty rows %d cols %d 2>/dev/null; %s", execOpt.Rows, execOpt.Cols, defaultExecCmd[2]),
+		}
+	}
+
+	if execOpt.WorkingDir != "" {
+		// The exec API has no notion of a working directory either, so change into it with a shell. If that fails,
+		// the shell exits with an error instead of running the command.
+		cmd = append([]string{"/bin/sh", "-c", `cd "$0" && exec "$@"`, execOpt.WorkingDir}, cmd...)
+	}
+
+	if len(execOpt.Env) > 0 {
+		// The exec API has no notion of environment variables, so let env set them for the command
+		cmd = append(append([]string{"env"}, execOpt.Env...), cmd...)
+	}
+
+	return cmd
 }
 
 func (c *ContainerExec) execEphemeral(ctx context.Context, container *apiv1.ContainerReplica, containerName string, execOpts *apiv1.ContainerReplicaExecOptions) (http.Handler, error) {
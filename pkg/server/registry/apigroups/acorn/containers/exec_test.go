@@ -0,0 +1,84 @@
+package containers
+
+import (
+	"testing"
+
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	"github.com/stretchr/testify/assert"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+func TestCommand(t *testing.T) {
+	tests := []struct {
+		name    string
+		execOpt apiv1.ContainerReplicaExecOptions
+		want    []string
+	}{
+		{
+			name:    "default shell",
+			execOpt: apiv1.ContainerReplicaExecOptions{},
+			want:    defaultExecCmd,
+		},
+		{
+			name:    "custom command",
+			execOpt: apiv1.ContainerReplicaExecOptions{Command: []string{"ls", "-l"}},
+			want:    []string{"ls", "-l"},
+		},
+		{
+			name:    "default shell with terminal size",
+			execOpt: apiv1.ContainerReplicaExecOptions{TTY: true, Rows: 50, Cols: 120},
+			want:    []string{"/bin/sh", "-c", "stty rows 50 cols 120 2>/dev/null; " + defaultExecCmd[2]},
+		},
+		{
+			name:    "terminal size is ignored for custom commands",
+			execOpt: apiv1.ContainerReplicaExecOptions{TTY: true, Rows: 50, Cols: 120, Command: []string{"htop"}},
+			want:    []string{"htop"},
+		},
+		{
+			name:    "env and working directory",
+			execOpt: apiv1.ContainerReplicaExecOptions{Command: []string{"ls"}, Env: []string{"DEBUG=1"}, WorkingDir: "/app"},
+			want:    []string{"env", "DEBUG=1", "/bin/sh", "-c", `cd "$0" && exec "$@"`, "/app", "ls"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, command(&tt.execOpt))
+		})
+	}
+}
+
+func TestValidateEnv(t *testing.T) {
+	tests := []struct {
+		name    string
+		env     []string
+		wantErr bool
+	}{
+		{
+			name: "no env",
+		},
+		{
+			name: "key value pairs",
+			env:  []string{"DEBUG=1", "EMPTY=", "URL=https://example.com/?a=b"},
+		},
+		{
+			name:    "missing value",
+			env:     []string{"DEBUG=1", "VERBOSE"},
+			wantErr: true,
+		},
+		{
+			name:    "missing key",
+			env:     []string{"=1"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateEnv(tt.env)
+			if tt.wantErr {
+				assert.True(t, apierrors.IsBadRequest(err), "expected a bad request error, got %v", err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -17,6 +17,8 @@ import (
 	"github.com/acorn-io/z"
 	"github.com/sirupsen/logrus"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/selection"
 	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/apiserver/pkg/endpoints/request"
 	"k8s.io/apiserver/pkg/storage"
@@ -107,6 +109,9 @@ type query struct {
 
 	// until excludes events observed after it when not nil.
 	until *apiv1.MicroTime
+
+	// fields are the requirements on event fields that events must match to be included in query results.
+	fields fields.Requirements
 }
 
 // filterChannel applies the query to every event received from unfiltered and forwards the result to filtered, if any.
@@ -193,10 +198,11 @@ func (q query) filter(events ...apiv1.Event) []apiv1.Event {
 			break
 		}
 
-		if q.beforeWindow(observed) || !q.prefix.matches(event) {
+		if q.beforeWindow(observed) || !q.prefix.matches(event) || !q.matchesFields(event) {
 			// Exclude events:
 			// - observed before the observation window starts
 			// - that don't match the given prefix
+			// - that don't match the given field requirements
 			continue
 		}
 
@@ -215,10 +221,89 @@ func (q query) filter(events ...apiv1.Event) []apiv1.Event {
 	return results[len(results)-tail:]
 }
 
+// matchesFields returns true if the event meets all field requirements of the query.
+func (q query) matchesFields(e apiv1.Event) bool {
+	for _, r := range q.fields {
+		value, want := eventFieldValue(e, r.Field), r.Value
+		if r.Field == "involved.kind" {
+			value, want = normalizeKind(value), normalizeKind(want)
+		}
+
+		switch r.Operator {
+		case selection.Equals, selection.DoubleEquals:
+			if value != want {
+				return false
+			}
+		case selection.NotEquals:
+			if value == want {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// eventFields are the field labels that can be used to select events, in addition to the metadata fields.
+var eventFields = map[string]struct{}{
+	"involved.kind": {},
+	"involved.name": {},
+	"involved.uid":  {},
+	"type":          {},
+	"severity":      {},
+	"actor":         {},
+	"appName":       {},
+}
+
+// isEventField returns true if label is a field label of an event that the events API filters on.
+func isEventField(label string) bool {
+	_, ok := eventFields[label]
+	return ok
+}
+
+func eventFieldValue(e apiv1.Event, field string) string {
+	switch field {
+	case "involved.kind":
+		return z.Dereference(e.Resource).Kind
+	case "involved.name":
+		return z.Dereference(e.Resource).Name
+	case "involved.uid":
+		return string(z.Dereference(e.Resource).UID)
+	case "type":
+		return e.Type
+	case "severity":
+		return string(e.Severity)
+	case "actor":
+		return e.Actor
+	case "appName":
+		return e.AppName
+	}
+	return ""
+}
+
+// normalizeKind makes kinds comparable regardless of case, and maps the internal names of apps to their public kind.
+func normalizeKind(kind string) string {
+	kind = strings.ToLower(kind)
+	if kind == "appinstance" {
+		return "app"
+	}
+	return kind
+}
+
 // stripQuery extracts the query from the given options, returning the query and new options sans the query.
 func stripQuery(opts storage.ListOptions) (q query, stripped storage.ListOptions, err error) {
 	stripped = opts
 
+	if stripped.Predicate.Field == nil {
+		stripped.Predicate.Field = fields.Everything()
+	}
+
+	for _, r := range stripped.Predicate.Field.Requirements() {
+		if isEventField(r.Field) {
+			q.fields = append(q.fields, r)
+		}
+	}
+
 	now := internalv1.NowMicro()
 	stripped.Predicate.Field, err = stripped.Predicate.Field.Transform(func(f, v string) (string, string, error) {
 		var err error
@@ -234,6 +319,10 @@ func stripQuery(opts storage.ListOptions) (q query, stripped storage.ListOptions
 		case "prefix":
 			q.prefix = prefix(v)
 		default:
+			if isEventField(f) {
+				// Already collected with its operator above
+				return "", "", nil
+			}
 			return f, v, nil
 		}
 
@@ -8,6 +8,10 @@ import (
 	internalv1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
 	"github.com/acorn-io/z"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apiserver/pkg/storage"
 )
 
 func TestParseTimeBound(t *testing.T) {
@@ -159,3 +163,43 @@ func TestQueryFilter(t *testing.T) {
 		})
 	}
 }
+
+func TestStripQueryFieldSelector(t *testing.T) {
+	ts := internalv1.NowMicro()
+	selector, err := fields.ParseSelector("involved.kind=AppInstance,involved.name!=bar,metadata.name=baz,prefix=app")
+	require.NoError(t, err)
+
+	q, stripped, err := stripQuery(storage.ListOptions{
+		Predicate: storage.SelectionPredicate{
+			Field: selector,
+		},
+	})
+	require.NoError(t, err)
+
+	// Only the selectors the lower-level strategies support are passed on
+	assert.Equal(t, "metadata.name=baz", stripped.Predicate.Field.String())
+	assert.Equal(t, prefix("app"), q.prefix)
+
+	foo := apiv1.Event{
+		ObjectMeta: metav1.ObjectMeta{Name: "foo"},
+		Resource:   &apiv1.EventResource{Kind: "app", Name: "foo"},
+		Observed:   ts,
+	}
+	bar := apiv1.Event{
+		ObjectMeta: metav1.ObjectMeta{Name: "bar"},
+		Resource:   &apiv1.EventResource{Kind: "app", Name: "bar"},
+		Observed:   ts,
+	}
+	volume := apiv1.Event{
+		ObjectMeta: metav1.ObjectMeta{Name: "volume"},
+		Resource:   &apiv1.EventResource{Kind: "volume", Name: "foo"},
+		Observed:   ts,
+	}
+	unrelated := apiv1.Event{
+		ObjectMeta: metav1.ObjectMeta{Name: "unrelated"},
+		Observed:   ts,
+	}
+
+	q.prefix = ""
+	assert.Equal(t, []apiv1.Event{foo}, q.filter(foo, bar, volume, unrelated))
+}
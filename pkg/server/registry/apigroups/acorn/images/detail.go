@@ -71,7 +71,9 @@ func (s *ImageDetailStrategy) Create(ctx context.Context, obj types.Object) (typ
 		DeployArgs:    details.DeployArgs.GetData(),
 		Nested:        details.NestedDigest,
 		NoDefaultReg:  details.NoDefaultRegistry,
+		Local:         details.Local,
 		IncludeNested: details.IncludeNested,
+		ListImages:    details.ListImages,
 		RemoteOpts:    opts,
 	})
 
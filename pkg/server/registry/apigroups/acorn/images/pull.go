@@ -201,5 +201,5 @@ func (i *ImagePull) recordImage(ctx context.Context, hash ggcrv1.Hash, namespace
 		return err
 	}
 
-	return i.clientFactory.Namespace("", namespace).ImageTag(ctx, hash.Hex, imageName)
+	return i.clientFactory.Namespace("", namespace).ImageTag(ctx, hash.Hex, imageName, nil)
 }
@@ -1,7 +1,10 @@
 package images
 
 import (
+	"bytes"
 	"context"
+	"encoding/base64"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"strings"
@@ -12,11 +15,18 @@ import (
 	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
 	acornsign "github.com/acorn-io/runtime/pkg/cosign"
 	"github.com/acorn-io/runtime/pkg/images"
+	"github.com/google/go-containerregistry/pkg/name"
 	"github.com/google/go-containerregistry/pkg/v1/remote"
+	cbundle "github.com/sigstore/cosign/v2/pkg/cosign/bundle"
 	cremote "github.com/sigstore/cosign/v2/pkg/cosign/remote"
+	"github.com/sigstore/cosign/v2/pkg/oci"
+	"github.com/sigstore/cosign/v2/pkg/oci/empty"
 	"github.com/sigstore/cosign/v2/pkg/oci/mutate"
 	ociremote "github.com/sigstore/cosign/v2/pkg/oci/remote"
 	"github.com/sigstore/cosign/v2/pkg/oci/static"
+	ctypes "github.com/sigstore/cosign/v2/pkg/types"
+	"github.com/sigstore/sigstore/pkg/signature"
+	"github.com/sigstore/sigstore/pkg/signature/payload"
 	"github.com/sirupsen/logrus"
 	"k8s.io/apiserver/pkg/endpoints/request"
 	"k8s.io/apiserver/pkg/registry/rest"
@@ -53,11 +63,18 @@ func (t *ImageSignStrategy) Create(ctx context.Context, obj types.Object) (types
 
 	isig.Name = strings.ReplaceAll(isig.Name, "+", "/")
 
-	isig.SignatureDigest, err = t.ImageSign(ctx, ns, *isig)
+	isig.SignatureDigest, isig.Duplicate, err = t.ImageSign(ctx, ns, *isig)
 	if err != nil {
 		return nil, err
 	}
 
+	if len(isig.Attestation) > 0 {
+		isig.AttestationDigest, err = t.ImageAttest(ctx, ns, *isig)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return isig, nil
 }
 
@@ -65,19 +82,147 @@ func (t *ImageSignStrategy) New() types.Object {
 	return &apiv1.ImageSignature{}
 }
 
-func (t *ImageSignStrategy) ImageSign(ctx context.Context, namespace string, signature apiv1.ImageSignature) (string, error) {
+// signedImage returns the reference of the image to sign and the options to access its registry with
+func (t *ImageSignStrategy) signedImage(ctx context.Context, namespace string, signature apiv1.ImageSignature) (name.Reference, []remote.Option, error) {
 	ref, err := images.GetImageReference(ctx, t.client, namespace, signature.Name)
 	if err != nil {
-		return "", err
+		return nil, nil, err
 	}
 
 	remoteOpts, err := images.GetAuthenticationRemoteOptionsWithLocalAuth(ctx, ref.Context(), signature.Auth, t.client, namespace, t.transportOpt)
+	if err != nil {
+		return nil, nil, err
+	}
+	return ref, remoteOpts, nil
+}
+
+func (t *ImageSignStrategy) ImageSign(ctx context.Context, namespace string, signature apiv1.ImageSignature) (string, bool, error) {
+	ref, remoteOpts, err := t.signedImage(ctx, namespace, signature)
+	if err != nil {
+		return "", false, err
+	}
+
+	// Duplicates can only be detected for signatures created with a key, keyless signatures are never identical
+	var dupeDetector mutate.DupeDetector
+
+	if signature.PublicKey != "" {
+		verifiers, err := acornsign.VerifiersFromPublicKeyRef(ctx, signature.PublicKey, "sha256")
+		if err != nil {
+			return "", false, err
+		}
+		if len(verifiers) != 1 {
+			return "", false, fmt.Errorf("expected exactly one verifier from public key %s, got %d", signature.PublicKey, len(verifiers))
+		}
+
+		dupeDetector = &identityDupeDetector{verifier: verifiers[0]}
+	}
+
+	targetEntity, err := ociremote.SignedEntity(ref, ociremote.WithRemoteOptions(remoteOpts...))
+	if err != nil {
+		return "", false, fmt.Errorf("accessing entity: %w", err)
+	}
+
+	var staticOpts []static.Option
+	if len(signature.Certificate) > 0 {
+		staticOpts = append(staticOpts, static.WithCertChain(signature.Certificate, signature.CertificateChain))
+	}
+	if len(signature.RekorBundle) > 0 {
+		rekorBundle := &cbundle.RekorBundle{}
+		if err := json.Unmarshal(signature.RekorBundle, rekorBundle); err != nil {
+			return "", false, fmt.Errorf("failed to parse transparency log bundle: %w", err)
+		}
+		staticOpts = append(staticOpts, static.WithBundle(rekorBundle))
+	}
+
+	signatureOCI, err := static.NewSignature(signature.Payload, signature.SignatureB64, staticOpts...)
+	if err != nil {
+		return "", false, err
+	}
+
+	existingSigs, err := targetEntity.Signatures()
+	if err != nil {
+		return "", false, err
+	}
+
+	var duplicate oci.Signature
+	if dupeDetector != nil {
+		duplicate, err = dupeDetector.Find(existingSigs, signatureOCI)
+		if err != nil {
+			return "", false, err
+		}
+	}
+
+	var signedEntity oci.SignedEntity
+	switch {
+	case duplicate != nil && !signature.Force:
+		// Don't create a redundant signatures artifact, just report the existing one
+		sigDigest, err := existingSigs.Digest()
+		if err != nil {
+			return "", false, err
+		}
+		logrus.Infof("Identical signature already exists in signatures artifact %s in %s, skipping", sigDigest, ref.Context().Name())
+		return sigDigest.String(), true, nil
+	case duplicate != nil:
+		replacedSigs, err := replaceSignature(existingSigs, dupeDetector, signatureOCI)
+		if err != nil {
+			return "", false, err
+		}
+		signedEntity = &replacedSignaturesEntity{SignedEntity: targetEntity, signatures: replacedSigs}
+	default:
+		signedEntity, err = mutate.AttachSignatureToEntity(targetEntity, signatureOCI)
+		if err != nil {
+			return "", false, err
+		}
+	}
+
+	targetRepo := ref.Context()
+
+	if err := ociremote.WriteSignatures(targetRepo, signedEntity, ociremote.WithRemoteOptions(remoteOpts...)); err != nil {
+		return "", false, err
+	}
+
+	// Get the digest of the signature artifact we just wrote
+	se, err := signedEntity.Signatures()
+	if err != nil {
+		return "", false, err
+	}
+	sigDigest, err := se.Digest()
+	if err != nil {
+		return "", false, err
+	}
+	logrus.Infof("Wrote signatures artifact %s to %s", sigDigest, targetRepo.Name())
+
+	return sigDigest.String(), duplicate != nil, nil
+}
+
+// ImageAttest attaches the signed attestation of the signature to the image like cosign attest does and returns the
+// digest of the attestations artifact. An identical attestation is not added again, with Force the attestations of the
+// same predicate type are replaced by the new one.
+func (t *ImageSignStrategy) ImageAttest(ctx context.Context, namespace string, signature apiv1.ImageSignature) (string, error) {
+	ref, remoteOpts, err := t.signedImage(ctx, namespace, signature)
+	if err != nil {
+		return "", err
+	}
+
+	predicateType, err := acornsign.AttestationPredicateType(signature.Attestation)
 	if err != nil {
 		return "", err
 	}
 
-	var mutateOpts []mutate.SignOption
+	staticOpts := []static.Option{
+		static.WithLayerMediaType(ctypes.DssePayloadType),
+		static.WithAnnotations(map[string]string{"predicateType": predicateType}),
+	}
+	if len(signature.Certificate) > 0 {
+		staticOpts = append(staticOpts, static.WithCertChain(signature.Certificate, signature.CertificateChain))
+	}
+
+	att, err := static.NewAttestation(signature.Attestation, staticOpts...)
+	if err != nil {
+		return "", err
+	}
 
+	var signOpts []mutate.SignOption
 	if signature.PublicKey != "" {
 		verifiers, err := acornsign.VerifiersFromPublicKeyRef(ctx, signature.PublicKey, "sha256")
 		if err != nil {
@@ -86,10 +231,10 @@ func (t *ImageSignStrategy) ImageSign(ctx context.Context, namespace string, sig
 		if len(verifiers) != 1 {
 			return "", fmt.Errorf("expected exactly one verifier from public key %s, got %d", signature.PublicKey, len(verifiers))
 		}
-
-		dupeDetector := cremote.NewDupeDetector(verifiers[0])
-
-		mutateOpts = append(mutateOpts, mutate.WithDupeDetector(dupeDetector))
+		signOpts = append(signOpts, mutate.WithDupeDetector(cremote.NewDupeDetector(verifiers[0])))
+	}
+	if signature.Force {
+		signOpts = append(signOpts, mutate.WithReplaceOp(cremote.NewReplaceOp(predicateType)))
 	}
 
 	targetEntity, err := ociremote.SignedEntity(ref, ociremote.WithRemoteOptions(remoteOpts...))
@@ -97,32 +242,114 @@ func (t *ImageSignStrategy) ImageSign(ctx context.Context, namespace string, sig
 		return "", fmt.Errorf("accessing entity: %w", err)
 	}
 
-	signatureOCI, err := static.NewSignature(signature.Payload, signature.SignatureB64)
+	attestedEntity, err := mutate.AttachAttestationToEntity(targetEntity, att, signOpts...)
 	if err != nil {
 		return "", err
 	}
 
-	signedEntity, err := mutate.AttachSignatureToEntity(targetEntity, signatureOCI, mutateOpts...)
+	if err := ociremote.WriteAttestations(ref.Context(), attestedEntity, ociremote.WithRemoteOptions(remoteOpts...)); err != nil {
+		return "", err
+	}
+
+	atts, err := attestedEntity.Attestations()
+	if err != nil {
+		return "", err
+	}
+	attDigest, err := atts.Digest()
 	if err != nil {
 		return "", err
 	}
+	logrus.Infof("Wrote attestations artifact %s to %s", attDigest, ref.Context().Name())
 
-	targetRepo := ref.Context()
+	return attDigest.String(), nil
+}
 
-	if err := ociremote.WriteSignatures(targetRepo, signedEntity, ociremote.WithRemoteOptions(remoteOpts...)); err != nil {
-		return "", err
+// identityDupeDetector finds signatures with the same acornsign.SignatureIdentity as a new signature, i.e. signatures
+// of the same digest, created with the key of verifier and with the same annotations. Unlike the cosign dupe detector,
+// it doesn't require the payloads to be byte for byte identical, so signatures stay deduplicated when the payload is
+// formatted differently, e.g. by another client.
+type identityDupeDetector struct {
+	verifier signature.Verifier
+}
+
+func (d *identityDupeDetector) Find(sigs oci.Signatures, newSig oci.Signature) (oci.Signature, error) {
+	identity, err := d.identity(newSig)
+	if err != nil {
+		// A signature that isn't created with the key can't be a duplicate of one that is
+		logrus.Debugf("Not checking for identical signatures: %v", err)
+		return nil, nil
 	}
 
-	// Get the digest of the signature artifact we just wrote
-	se, err := signedEntity.Signatures()
+	existing, err := sigs.Get()
+	if err != nil {
+		return nil, err
+	}
+	for _, sig := range existing {
+		if other, err := d.identity(sig); err == nil && other == identity {
+			return sig, nil
+		}
+	}
+	return nil, nil
+}
+
+// identity returns the identity of sig, failing if it wasn't created with the key of the verifier
+func (d *identityDupeDetector) identity(sig oci.Signature) (string, error) {
+	sigB64, err := sig.Base64Signature()
 	if err != nil {
 		return "", err
 	}
-	sigDigest, err := se.Digest()
+	rawSig, err := base64.StdEncoding.DecodeString(sigB64)
 	if err != nil {
 		return "", err
 	}
-	logrus.Infof("Wrote signatures artifact %s to %s", sigDigest, targetRepo.Name())
+	pld, err := sig.Payload()
+	if err != nil {
+		return "", err
+	}
+	if err := d.verifier.VerifySignature(bytes.NewReader(rawSig), bytes.NewReader(pld)); err != nil {
+		return "", err
+	}
+
+	var sci payload.SimpleContainerImage
+	if err := json.Unmarshal(pld, &sci); err != nil {
+		return "", fmt.Errorf("decoding the signature payload: %w", err)
+	}
+	publicKey, err := d.verifier.PublicKey()
+	if err != nil {
+		return "", err
+	}
+	return acornsign.SignatureIdentity(sci.Critical.Image.DockerManifestDigest, publicKey, sci.Optional)
+}
+
+// replaceSignature drops all signatures from base that are identical to sig and appends sig instead
+func replaceSignature(base oci.Signatures, dupeDetector mutate.DupeDetector, sig oci.Signature) (oci.Signatures, error) {
+	sigs, err := base.Get()
+	if err != nil {
+		return nil, err
+	}
+
+	kept := make([]oci.Signature, 0, len(sigs)+1)
+	for _, existing := range sigs {
+		single, err := mutate.AppendSignatures(empty.Signatures(), existing)
+		if err != nil {
+			return nil, err
+		}
+		if duplicate, err := dupeDetector.Find(single, sig); err != nil {
+			return nil, err
+		} else if duplicate == nil {
+			kept = append(kept, existing)
+		}
+	}
+
+	return mutate.AppendSignatures(empty.Signatures(), append(kept, sig)...)
+}
+
+// replacedSignaturesEntity is a signed entity with its signatures replaced
+type replacedSignaturesEntity struct {
+	oci.SignedEntity
+	signatures oci.Signatures
+}
 
-	return sigDigest.String(), nil
+func (r *replacedSignaturesEntity) Signatures() (oci.Signatures, error) {
+	return r.signatures, nil
 }
@@ -0,0 +1,79 @@
+package images
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/base64"
+	"testing"
+
+	"github.com/sigstore/cosign/v2/pkg/oci"
+	"github.com/sigstore/cosign/v2/pkg/oci/empty"
+	"github.com/sigstore/cosign/v2/pkg/oci/mutate"
+	"github.com/sigstore/cosign/v2/pkg/oci/static"
+	"github.com/sigstore/sigstore/pkg/signature"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReplaceSignature(t *testing.T) {
+	priv, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	signer, err := signature.LoadECDSASignerVerifier(priv, crypto.SHA256)
+	require.NoError(t, err)
+
+	sign := func(payload string) oci.Signature {
+		sig, err := signer.SignMessage(bytes.NewReader([]byte(payload)))
+		require.NoError(t, err)
+		ociSig, err := static.NewSignature([]byte(payload), base64.StdEncoding.EncodeToString(sig))
+		require.NoError(t, err)
+		return ociSig
+	}
+
+	other := sign(`{"critical":{"image":{"docker-manifest-digest":"sha256:1234"}},"optional":{"env":"dev"}}`)
+	base, err := mutate.AppendSignatures(empty.Signatures(), sign(`{"critical":{"image":{"docker-manifest-digest":"sha256:1234"}},"optional":{"env":"prod"}}`), other)
+	require.NoError(t, err)
+
+	// The payload is formatted differently, but has the same digest and annotations
+	newSig := sign(`{"optional": {"env": "prod"}, "critical": {"image": {"docker-manifest-digest": "sha256:1234"}}}`)
+	dupeDetector := &identityDupeDetector{verifier: signer}
+
+	duplicate, err := dupeDetector.Find(base, newSig)
+	require.NoError(t, err)
+	require.NotNil(t, duplicate, "identical signature should be detected as duplicate")
+
+	for name, notDuplicate := range map[string]oci.Signature{
+		"other digest":      sign(`{"critical":{"image":{"docker-manifest-digest":"sha256:5678"}},"optional":{"env":"prod"}}`),
+		"extra annotation":  sign(`{"critical":{"image":{"docker-manifest-digest":"sha256:1234"}},"optional":{"env":"prod","team":"a"}}`),
+		"fewer annotations": sign(`{"critical":{"image":{"docker-manifest-digest":"sha256:1234"}}}`),
+	} {
+		duplicate, err := dupeDetector.Find(base, notDuplicate)
+		require.NoError(t, err)
+		assert.Nil(t, duplicate, name)
+	}
+
+	otherKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	otherVerifier, err := signature.LoadECDSAVerifier(&otherKey.PublicKey, crypto.SHA256)
+	require.NoError(t, err)
+	duplicate, err = (&identityDupeDetector{verifier: otherVerifier}).Find(base, newSig)
+	require.NoError(t, err)
+	assert.Nil(t, duplicate, "signatures of another key are never duplicates")
+
+	replaced, err := replaceSignature(base, dupeDetector, newSig)
+	require.NoError(t, err)
+
+	sigs, err := replaced.Get()
+	require.NoError(t, err)
+	require.Len(t, sigs, 2)
+
+	for i, want := range []oci.Signature{other, newSig} {
+		wantB64, err := want.Base64Signature()
+		require.NoError(t, err)
+		gotB64, err := sigs[i].Base64Signature()
+		require.NoError(t, err)
+		assert.Equal(t, wantB64, gotB64)
+	}
+}
@@ -0,0 +1,207 @@
+package images
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/acorn-io/mink/pkg/stores"
+	"github.com/acorn-io/mink/pkg/types"
+	"github.com/acorn-io/mink/pkg/validator"
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	acornsign "github.com/acorn-io/runtime/pkg/cosign"
+	"github.com/acorn-io/runtime/pkg/imagedetails"
+	"github.com/acorn-io/runtime/pkg/images"
+	ggcrv1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/sigstore/cosign/v2/pkg/cosign"
+	"github.com/sigstore/cosign/v2/pkg/oci"
+	ociremote "github.com/sigstore/cosign/v2/pkg/oci/remote"
+	"github.com/sigstore/sigstore/pkg/cryptoutils"
+	"github.com/sigstore/sigstore/pkg/signature"
+	"github.com/sigstore/sigstore/pkg/signature/payload"
+	"github.com/sirupsen/logrus"
+	"k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/apiserver/pkg/registry/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func NewImageSignatures(c client.WithWatch, transport http.RoundTripper) rest.Storage {
+	return stores.NewBuilder(c.Scheme(), &apiv1.ImageSignatures{}).
+		WithValidateName(validator.NoValidation).
+		WithCreate(&ImageSignaturesStrategy{
+			client:       c,
+			transportOpt: remote.WithTransport(transport),
+		}).Build()
+}
+
+type ImageSignaturesStrategy struct {
+	client       client.WithWatch
+	transportOpt remote.Option
+}
+
+func (t *ImageSignaturesStrategy) Create(ctx context.Context, obj types.Object) (types.Object, error) {
+	var (
+		isigs = obj.(*apiv1.ImageSignatures)
+		err   error
+	)
+
+	if isigs.Name == "" {
+		ri, ok := request.RequestInfoFrom(ctx)
+		if ok {
+			isigs.Name = ri.Name
+		}
+	}
+	ns, _ := request.NamespaceFrom(ctx)
+
+	isigs.Name = strings.ReplaceAll(isigs.Name, "+", "/")
+
+	isigs.Signatures, err = t.ImageSignatures(ctx, ns, *isigs)
+	if err != nil {
+		return nil, err
+	}
+
+	return isigs, nil
+}
+
+func (t *ImageSignaturesStrategy) New() types.Object {
+	return &apiv1.ImageSignatures{}
+}
+
+func (t *ImageSignaturesStrategy) ImageSignatures(ctx context.Context, namespace string, input apiv1.ImageSignatures) ([]apiv1.ImageSignatureEntry, error) {
+	ref, err := images.GetImageReference(ctx, t.client, namespace, input.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	remoteOpts, err := images.GetAuthenticationRemoteOptionsWithLocalAuth(ctx, ref.Context(), input.Auth, t.client, namespace, t.transportOpt)
+	if err != nil {
+		return nil, err
+	}
+
+	// imageDetails to get image and signature digests
+	imageDetails, err := imagedetails.GetImageDetails(ctx, t.client, namespace, input.Name, imagedetails.GetImageDetailsOptions{
+		RemoteOpts: remoteOpts,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if imageDetails.SignatureDigest == "" {
+		return nil, nil
+	}
+
+	ref, err = images.GetImageReference(ctx, t.client, namespace, imageDetails.AppImage.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	imgDigestHash, err := ggcrv1.NewHash(imageDetails.AppImage.Digest)
+	if err != nil {
+		return nil, err
+	}
+
+	var verifiers []signature.Verifier
+	if input.PublicKey != "" {
+		verifiers, err = acornsign.VerifiersFromPublicKeyRef(ctx, input.PublicKey, "sha256")
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	sigs, err := ociremote.Signatures(ref.Context().Digest(imageDetails.SignatureDigest), ociremote.WithRemoteOptions(remoteOpts...))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get signatures: %w", err)
+	}
+
+	sl, err := sigs.Get()
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]apiv1.ImageSignatureEntry, 0, len(sl))
+	for _, sig := range sl {
+		entry, err := signatureEntry(ctx, sig, imgDigestHash, verifiers)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, entry)
+	}
+
+	return result, nil
+}
+
+func signatureEntry(ctx context.Context, sig oci.Signature, imgDigestHash ggcrv1.Hash, verifiers []signature.Verifier) (apiv1.ImageSignatureEntry, error) {
+	var entry apiv1.ImageSignatureEntry
+
+	digest, err := sig.Digest()
+	if err != nil {
+		return entry, err
+	}
+	entry.Digest = digest.String()
+
+	pld, err := sig.Payload()
+	if err != nil {
+		return entry, fmt.Errorf("failed to get payload of signature %s: %w", entry.Digest, err)
+	}
+
+	sci := payload.SimpleContainerImage{}
+	if err := json.Unmarshal(pld, &sci); err != nil {
+		return entry, fmt.Errorf("error decoding the payload of signature %s: %w", entry.Digest, err)
+	}
+
+	if len(sci.Optional) > 0 {
+		entry.Annotations = make(map[string]string, len(sci.Optional))
+		for k, v := range sci.Optional {
+			if v != nil {
+				entry.Annotations[k] = fmt.Sprint(v)
+			}
+		}
+	}
+
+	cert, err := sig.Cert()
+	if err != nil {
+		return entry, fmt.Errorf("failed to get certificate of signature %s: %w", entry.Digest, err)
+	}
+
+	var pubKey any
+	if cert != nil {
+		// Keyless signature: the certificate identifies the signer
+		certPEM, err := cryptoutils.MarshalCertificateToPEM(cert)
+		if err != nil {
+			return entry, err
+		}
+		entry.Certificate = string(certPEM)
+		pubKey = cert.PublicKey
+	} else {
+		// Key-based signature: the public key is not part of the signature, so we can only identify it by the given keys
+		for _, v := range verifiers {
+			if _, err := cosign.VerifyImageSignature(ctx, sig, imgDigestHash, &cosign.CheckOpts{
+				SigVerifier:   v,
+				ClaimVerifier: cosign.SimpleClaimVerifier,
+				IgnoreTlog:    true,
+			}); err != nil {
+				logrus.Debugf("signature %s was not created by verifier: %v", entry.Digest, err)
+				continue
+			}
+			pubKey, err = v.PublicKey()
+			if err != nil {
+				return entry, err
+			}
+			break
+		}
+	}
+
+	if pubKey != nil {
+		pem, fingerprint, err := acornsign.PemEncodeCryptoPublicKey(pubKey)
+		if err != nil {
+			return entry, err
+		}
+		entry.PublicKey = string(pem)
+		entry.Fingerprint = fingerprint
+	}
+
+	return entry, nil
+}
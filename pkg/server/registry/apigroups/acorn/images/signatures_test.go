@@ -0,0 +1,139 @@
+package images
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/base64"
+	"math/big"
+	"testing"
+	"time"
+
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	acornsign "github.com/acorn-io/runtime/pkg/cosign"
+	ggcrv1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/sigstore/cosign/v2/pkg/oci/static"
+	"github.com/sigstore/sigstore/pkg/cryptoutils"
+	"github.com/sigstore/sigstore/pkg/signature"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSignatureEntry(t *testing.T) {
+	imgDigest := "sha256:0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"
+	imgDigestHash, err := ggcrv1.NewHash(imgDigest)
+	require.NoError(t, err)
+	payload := []byte(`{"critical":{"identity":{"docker-reference":"ghcr.io/acorn-io/test"},"image":{"docker-manifest-digest":"` + imgDigest + `"},"type":"cosign container image signature"},"optional":{"team":"platform","revision":3}}`)
+
+	newKey := func() (*ecdsa.PrivateKey, signature.SignerVerifier, string, string) {
+		priv, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		require.NoError(t, err)
+		sv, err := signature.LoadECDSASignerVerifier(priv, crypto.SHA256)
+		require.NoError(t, err)
+		pem, fingerprint, err := acornsign.PemEncodeCryptoPublicKey(priv.Public())
+		require.NoError(t, err)
+		return priv, sv, string(pem), fingerprint
+	}
+	_, signer, signerPEM, signerFingerprint := newKey()
+	_, other, _, _ := newKey()
+	verifiers := []signature.Verifier{other, signer}
+
+	keylessPriv, keyless, keylessPEM, keylessFingerprint := newKey()
+	template := &x509.Certificate{
+		SerialNumber:   big.NewInt(1),
+		NotBefore:      time.Now().Add(-time.Minute),
+		NotAfter:       time.Now().Add(10 * time.Minute),
+		EmailAddresses: []string{"me@example.com"},
+	}
+	certDER, err := x509.CreateCertificate(rand.Reader, template, template, keylessPriv.Public(), keylessPriv)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(certDER)
+	require.NoError(t, err)
+	certPEM, err := cryptoutils.MarshalCertificateToPEM(cert)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name      string
+		signer    signature.Signer
+		payload   []byte
+		opts      []static.Option
+		verifiers []signature.Verifier
+		want      apiv1.ImageSignatureEntry
+		wantErr   string
+	}{
+		{
+			name:      "key-based signature identified by a given key",
+			signer:    signer,
+			payload:   payload,
+			verifiers: verifiers,
+			want: apiv1.ImageSignatureEntry{
+				PublicKey:   signerPEM,
+				Fingerprint: signerFingerprint,
+				Annotations: map[string]string{"team": "platform", "revision": "3"},
+			},
+		},
+		{
+			name:      "key-based signature of an unknown key",
+			signer:    signer,
+			payload:   payload,
+			verifiers: []signature.Verifier{other},
+			want: apiv1.ImageSignatureEntry{
+				Annotations: map[string]string{"team": "platform", "revision": "3"},
+			},
+		},
+		{
+			name:    "key-based signature for another image",
+			signer:  signer,
+			payload: bytes.ReplaceAll(payload, []byte("0123456789abcdef"), []byte("fedcba9876543210")),
+			// The signature is valid, but doesn't sign this image, so it must not be attributed to the key
+			verifiers: verifiers,
+			want: apiv1.ImageSignatureEntry{
+				Annotations: map[string]string{"team": "platform", "revision": "3"},
+			},
+		},
+		{
+			name:    "keyless signature identified by its certificate",
+			signer:  keyless,
+			payload: payload,
+			opts:    []static.Option{static.WithCertChain(certPEM, nil)},
+			want: apiv1.ImageSignatureEntry{
+				PublicKey:   keylessPEM,
+				Fingerprint: keylessFingerprint,
+				Certificate: string(certPEM),
+				Annotations: map[string]string{"team": "platform", "revision": "3"},
+			},
+		},
+		{
+			name:      "malformed payload",
+			signer:    signer,
+			payload:   []byte("not json"),
+			verifiers: verifiers,
+			wantErr:   "error decoding the payload of signature",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sig, err := tt.signer.SignMessage(bytes.NewReader(tt.payload))
+			require.NoError(t, err)
+			ociSig, err := static.NewSignature(tt.payload, base64.StdEncoding.EncodeToString(sig), tt.opts...)
+			require.NoError(t, err)
+			digest, err := ociSig.Digest()
+			require.NoError(t, err)
+
+			entry, err := signatureEntry(context.Background(), ociSig, imgDigestHash, tt.verifiers)
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+
+			tt.want.Digest = digest.String()
+			assert.Equal(t, tt.want, entry)
+		})
+	}
+}
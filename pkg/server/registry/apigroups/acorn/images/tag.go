@@ -3,6 +3,7 @@ package images
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"strings"
 
 	"github.com/acorn-io/baaah/pkg/router"
@@ -11,7 +12,13 @@ import (
 	"github.com/acorn-io/mink/pkg/validator"
 	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
 	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	acornsign "github.com/acorn-io/runtime/pkg/cosign"
+	"github.com/acorn-io/runtime/pkg/images"
+	"github.com/acorn-io/runtime/pkg/imagesystem"
 	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/sirupsen/logrus"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apiserver/pkg/registry/rest"
@@ -19,16 +26,18 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
-func NewTagStorage(c client.WithWatch) rest.Storage {
+func NewTagStorage(c client.WithWatch, transport http.RoundTripper) rest.Storage {
 	return stores.NewBuilder(c.Scheme(), &apiv1.ImageTag{}).
 		WithValidateName(validator.NoValidation).
 		WithCreate(&TagStrategy{
-			client: c,
+			client:       c,
+			transportOpt: remote.WithTransport(transport),
 		}).Build()
 }
 
 type TagStrategy struct {
-	client client.WithWatch
+	client       client.WithWatch
+	transportOpt remote.Option
 }
 
 func (t *TagStrategy) Create(ctx context.Context, obj types.Object) (types.Object, error) {
@@ -46,12 +55,19 @@ func (t *TagStrategy) Create(ctx context.Context, obj types.Object) (types.Objec
 		return nil, err
 	}
 
+	if opts.CopySignatures {
+		if err := t.copySignatures(ctx, image, opts.Tag); err != nil {
+			return nil, err
+		}
+	}
+
 	return &apiv1.ImageTag{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      image.Name,
 			Namespace: image.Namespace,
 		},
-		Tag: opts.Tag,
+		Tag:            opts.Tag,
+		CopySignatures: opts.CopySignatures,
 	}, nil
 }
 
@@ -134,6 +150,54 @@ func (t *TagStrategy) ImageTag(ctx context.Context, namespace, imageName string,
 	return image, t.client.Update(ctx, image)
 }
 
+// copySignatures copies the cosign signatures of image to the repository of tag. Signatures are stored under a tag
+// derived from the image digest, which doesn't change when tagging, so the copies stay valid.
+func (t *TagStrategy) copySignatures(ctx context.Context, image *v1.ImageInstance, tag string) error {
+	source, err := imagesystem.GetInternalRepoForNamespaceAndID(ctx, t.client, image.Namespace, image.Name)
+	if err != nil {
+		return err
+	}
+
+	target, err := signatureTargetRepo(source.Context(), tag)
+	if err != nil {
+		return err
+	}
+	if target.Name() == source.Context().Name() {
+		// The signatures are in the same repository already
+		return nil
+	}
+
+	opts, err := images.GetAuthenticationRemoteOptions(ctx, t.client, image.Namespace, t.transportOpt)
+	if err != nil {
+		return err
+	}
+
+	sigTag, sig, err := acornsign.FindSignatureImage(source, opts...)
+	if err != nil {
+		return err
+	}
+	if sig == nil {
+		logrus.Debugf("No signatures to copy for image %s", image.Name)
+		return nil
+	}
+
+	logrus.Infof("Copying signatures of image %s to %s", image.Name, target.Tag(sigTag.TagStr()))
+	return remote.Write(target.Tag(sigTag.TagStr()), sig, opts...)
+}
+
+// signatureTargetRepo returns the repository of tag in the registry of source. Tags without a registry belong to the
+// registry of source, tags in any other registry are rejected.
+func signatureTargetRepo(source name.Repository, tag string) (name.Repository, error) {
+	tagRef, err := name.ParseReference(tag, name.WithDefaultRegistry(""))
+	if err != nil {
+		return name.Repository{}, err
+	}
+	if registry := tagRef.Context().RegistryStr(); registry != "" && registry != source.RegistryStr() {
+		return name.Repository{}, apierrors.NewBadRequest(fmt.Sprintf("signatures can only be copied to tags in registry %s, where the image is stored, not %s", source.RegistryStr(), registry))
+	}
+	return source.Registry.Repo(tagRef.Context().RepositoryStr()), nil
+}
+
 func normalizeTags(tags []string, implicitLatestTag bool) ([]string, error) {
 	var result []string
 	for _, tag := range tags {
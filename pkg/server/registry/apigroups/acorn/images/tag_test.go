@@ -0,0 +1,40 @@
+package images
+
+import (
+	"testing"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSignatureTargetRepo(t *testing.T) {
+	source, err := name.NewRepository("127.0.0.1:5000/acorn/my-project")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		tag     string
+		want    string
+		wantErr bool
+	}{
+		{name: "tag without registry", tag: "my-image:v1", want: "127.0.0.1:5000/my-image"},
+		{name: "tag without registry or tag", tag: "foo/bar", want: "127.0.0.1:5000/foo/bar"},
+		{name: "tag in the same registry", tag: "127.0.0.1:5000/foo/bar:v2", want: "127.0.0.1:5000/foo/bar"},
+		{name: "tag in the same repository", tag: "127.0.0.1:5000/acorn/my-project:v2", want: "127.0.0.1:5000/acorn/my-project"},
+		{name: "tag in another registry", tag: "ghcr.io/acorn-io/foo:v1", wantErr: true},
+		{name: "invalid tag", tag: "foo@@:bad", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := signatureTargetRepo(source, tt.tag)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got.Name())
+		})
+	}
+}
@@ -0,0 +1,173 @@
+package images
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/acorn-io/mink/pkg/stores"
+	"github.com/acorn-io/mink/pkg/types"
+	"github.com/acorn-io/mink/pkg/validator"
+	api "github.com/acorn-io/runtime/pkg/apis/api.acorn.io"
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/imagedetails"
+	"github.com/acorn-io/runtime/pkg/images"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/sigstore/cosign/v2/pkg/oci"
+	"github.com/sigstore/cosign/v2/pkg/oci/empty"
+	"github.com/sigstore/cosign/v2/pkg/oci/mutate"
+	ociremote "github.com/sigstore/cosign/v2/pkg/oci/remote"
+	"github.com/sirupsen/logrus"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/apiserver/pkg/registry/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func NewImageUnsign(c client.WithWatch, transport http.RoundTripper) rest.Storage {
+	return stores.NewBuilder(c.Scheme(), &apiv1.ImageUnsign{}).
+		WithValidateName(validator.NoValidation).
+		WithCreate(&ImageUnsignStrategy{
+			client:       c,
+			transportOpt: remote.WithTransport(transport),
+		}).Build()
+}
+
+type ImageUnsignStrategy struct {
+	client       client.WithWatch
+	transportOpt remote.Option
+}
+
+func (t *ImageUnsignStrategy) Create(ctx context.Context, obj types.Object) (types.Object, error) {
+	iunsign := obj.(*apiv1.ImageUnsign)
+
+	if iunsign.Name == "" {
+		ri, ok := request.RequestInfoFrom(ctx)
+		if ok {
+			iunsign.Name = ri.Name
+		}
+	}
+	ns, _ := request.NamespaceFrom(ctx)
+
+	iunsign.Name = strings.ReplaceAll(iunsign.Name, "+", "/")
+
+	if err := t.ImageUnsign(ctx, ns, *iunsign); err != nil {
+		return nil, err
+	}
+
+	return iunsign, nil
+}
+
+func (t *ImageUnsignStrategy) New() types.Object {
+	return &apiv1.ImageUnsign{}
+}
+
+// ImageUnsign removes the signature with the given digest from the signature artifact of the image.
+// The signature artifact is rewritten without that signature, or deleted if it was the last one.
+func (t *ImageUnsignStrategy) ImageUnsign(ctx context.Context, namespace string, input apiv1.ImageUnsign) error {
+	if input.SignatureDigest == "" {
+		return apierrors.NewBadRequest("signature digest is required")
+	}
+
+	ref, err := images.GetImageReference(ctx, t.client, namespace, input.Name)
+	if err != nil {
+		return err
+	}
+
+	remoteOpts, err := images.GetAuthenticationRemoteOptionsWithLocalAuth(ctx, ref.Context(), input.Auth, t.client, namespace, t.transportOpt)
+	if err != nil {
+		return err
+	}
+
+	// imageDetails to get image and signature digests
+	imageDetails, err := imagedetails.GetImageDetails(ctx, t.client, namespace, input.Name, imagedetails.GetImageDetailsOptions{
+		RemoteOpts: remoteOpts,
+	})
+	if err != nil {
+		return err
+	}
+
+	if imageDetails.SignatureDigest == "" {
+		return apierrors.NewNotFound(schema.GroupResource{Group: api.Group, Resource: "signatures"}, input.SignatureDigest)
+	}
+
+	ref, err = images.GetImageReference(ctx, t.client, namespace, imageDetails.AppImage.ID)
+	if err != nil {
+		return err
+	}
+
+	sigArtifactRef := ref.Context().Digest(imageDetails.SignatureDigest)
+
+	sigs, err := ociremote.Signatures(sigArtifactRef, ociremote.WithRemoteOptions(remoteOpts...))
+	if err != nil {
+		return fmt.Errorf("failed to get signatures: %w", err)
+	}
+
+	newSigs, err := withoutSignature(sigs, input.SignatureDigest)
+	if err != nil {
+		return err
+	}
+
+	if newSigs == nil {
+		logrus.Infof("Deleting signatures artifact %s", sigArtifactRef)
+		return remote.Delete(sigArtifactRef, remoteOpts...)
+	}
+
+	sigTag, err := ociremote.SignatureTag(ref.Context().Digest(imageDetails.AppImage.Digest))
+	if err != nil {
+		return err
+	}
+
+	if err := remote.Write(sigTag, newSigs, remoteOpts...); err != nil {
+		return err
+	}
+
+	newDigest, err := newSigs.Digest()
+	if err != nil {
+		return err
+	}
+	logrus.Infof("Wrote signatures artifact %s to %s without signature %s", newDigest, sigTag, input.SignatureDigest)
+
+	// Clean up the old signature artifact, which is no longer referenced by the signature tag
+	if err := remote.Delete(sigArtifactRef, remoteOpts...); err != nil {
+		logrus.Debugf("failed to delete old signatures artifact %s: %v", sigArtifactRef, err)
+	}
+
+	return nil
+}
+
+// withoutSignature returns the signatures of sigs except for the one with the given digest, or nil if it was the only one
+func withoutSignature(sigs oci.Signatures, sigDigest string) (oci.Signatures, error) {
+	sl, err := sigs.Get()
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		remaining []oci.Signature
+		found     bool
+	)
+	for _, sig := range sl {
+		digest, err := sig.Digest()
+		if err != nil {
+			return nil, err
+		}
+		if digest.String() == sigDigest {
+			found = true
+			continue
+		}
+		remaining = append(remaining, sig)
+	}
+
+	if !found {
+		return nil, apierrors.NewNotFound(schema.GroupResource{Group: api.Group, Resource: "signatures"}, sigDigest)
+	}
+
+	if len(remaining) == 0 {
+		return nil, nil
+	}
+
+	return mutate.AppendSignatures(empty.Signatures(), remaining...)
+}
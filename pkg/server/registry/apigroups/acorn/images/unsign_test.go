@@ -0,0 +1,101 @@
+package images
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/base64"
+	"testing"
+
+	"github.com/sigstore/cosign/v2/pkg/oci"
+	"github.com/sigstore/cosign/v2/pkg/oci/empty"
+	"github.com/sigstore/cosign/v2/pkg/oci/mutate"
+	"github.com/sigstore/cosign/v2/pkg/oci/static"
+	"github.com/sigstore/sigstore/pkg/signature"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+func TestWithoutSignature(t *testing.T) {
+	priv, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	signer, err := signature.LoadECDSASignerVerifier(priv, crypto.SHA256)
+	require.NoError(t, err)
+
+	sign := func(payload string) oci.Signature {
+		sig, err := signer.SignMessage(bytes.NewReader([]byte(payload)))
+		require.NoError(t, err)
+		ociSig, err := static.NewSignature([]byte(payload), base64.StdEncoding.EncodeToString(sig))
+		require.NoError(t, err)
+		return ociSig
+	}
+	digestOf := func(sig oci.Signature) string {
+		digest, err := sig.Digest()
+		require.NoError(t, err)
+		return digest.String()
+	}
+
+	prod := sign(`{"critical":{"image":{"docker-manifest-digest":"sha256:1234"}},"optional":{"env":"prod"}}`)
+	dev := sign(`{"critical":{"image":{"docker-manifest-digest":"sha256:1234"}},"optional":{"env":"dev"}}`)
+	staging := sign(`{"critical":{"image":{"docker-manifest-digest":"sha256:1234"}},"optional":{"env":"staging"}}`)
+
+	tests := []struct {
+		name         string
+		sigs         []oci.Signature
+		remove       string
+		want         []oci.Signature
+		wantNotFound bool
+	}{
+		{
+			name:   "remove one of several signatures",
+			sigs:   []oci.Signature{prod, dev, staging},
+			remove: digestOf(dev),
+			want:   []oci.Signature{prod, staging},
+		},
+		{
+			name:   "remove the only signature",
+			sigs:   []oci.Signature{prod},
+			remove: digestOf(prod),
+		},
+		{
+			name:         "unknown signature",
+			sigs:         []oci.Signature{prod, staging},
+			remove:       digestOf(dev),
+			wantNotFound: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sigs, err := mutate.AppendSignatures(empty.Signatures(), tt.sigs...)
+			require.NoError(t, err)
+
+			got, err := withoutSignature(sigs, tt.remove)
+			if tt.wantNotFound {
+				assert.True(t, apierrors.IsNotFound(err), "expected a not found error, got %v", err)
+				return
+			}
+			require.NoError(t, err)
+
+			if tt.want == nil {
+				assert.Nil(t, got, "the signatures artifact must be deleted with its last signature")
+				return
+			}
+
+			sl, err := got.Get()
+			require.NoError(t, err)
+			require.Len(t, sl, len(tt.want))
+			for i, want := range tt.want {
+				assert.Equal(t, digestOf(want), digestOf(sl[i]))
+				wantB64, err := want.Base64Signature()
+				require.NoError(t, err)
+				gotB64, err := sl[i].Base64Signature()
+				require.NoError(t, err)
+				assert.Equal(t, wantB64, gotB64)
+			}
+		})
+	}
+}
@@ -14,7 +14,9 @@ import (
 	"github.com/acorn-io/runtime/pkg/imagedetails"
 	"github.com/acorn-io/runtime/pkg/images"
 	signatureannotations "github.com/acorn-io/runtime/pkg/imageselector/signatures/annotations"
+	ggcrv1 "github.com/google/go-containerregistry/pkg/v1"
 	"github.com/google/go-containerregistry/pkg/v1/remote"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apiserver/pkg/endpoints/request"
 	"k8s.io/apiserver/pkg/registry/rest"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -39,8 +41,8 @@ func (t *ImageVerifyStrategy) Create(ctx context.Context, obj types.Object) (typ
 		isig = obj.(*apiv1.ImageSignature)
 	)
 
-	if isig.PublicKey == "" {
-		return nil, fmt.Errorf("public key is required for verification")
+	if isig.PublicKey == "" && isig.CertIdentity == "" && isig.CertOidcIssuer == "" {
+		return nil, fmt.Errorf("public key or certificate identity is required for verification")
 	}
 
 	if isig.Name == "" {
@@ -53,22 +55,37 @@ func (t *ImageVerifyStrategy) Create(ctx context.Context, obj types.Object) (typ
 
 	isig.Name = strings.ReplaceAll(isig.Name, "+", "/")
 
-	return isig, t.ImageVerify(ctx, ns, *isig)
+	if isig.PredicateType != "" {
+		predicate, err := t.AttestationVerify(ctx, ns, *isig)
+		if err != nil {
+			return nil, err
+		}
+		isig.AttestationPredicate = predicate
+		return isig, nil
+	}
+
+	verified, err := t.ImageVerify(ctx, ns, *isig)
+	if err != nil {
+		return nil, err
+	}
+	isig.VerifiedSignature = verified
+
+	return isig, nil
 }
 
 func (t *ImageVerifyStrategy) New() types.Object {
 	return &apiv1.ImageSignature{}
 }
 
-func (t *ImageVerifyStrategy) ImageVerify(ctx context.Context, namespace string, signature apiv1.ImageSignature) error {
+func (t *ImageVerifyStrategy) ImageVerify(ctx context.Context, namespace string, signature apiv1.ImageSignature) (*apiv1.ImageSignatureEntry, error) {
 	ref, err := images.GetImageReference(ctx, t.client, namespace, signature.Name)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	remoteOpts, err := images.GetAuthenticationRemoteOptionsWithLocalAuth(ctx, ref.Context(), signature.Auth, t.client, namespace, t.transportOpt)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	// imageDetails to get image and signature digests
@@ -76,16 +93,16 @@ func (t *ImageVerifyStrategy) ImageVerify(ctx context.Context, namespace string,
 		RemoteOpts: remoteOpts,
 	})
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	ref, err = images.GetImageReference(ctx, t.client, namespace, imageDetails.AppImage.ID)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	if imageDetails.SignatureDigest == "" {
-		return acornsign.NewVerificationFailure(&acornsign.ErrNoSignaturesFound{Err: fmt.Errorf("no signatures found for image %s", signature.Name)})
+		return nil, acornsign.NewVerificationFailure(&acornsign.ErrNoSignaturesFound{Err: fmt.Errorf("no signatures found for image %s", signature.Name)})
 	}
 
 	if !signature.NoVerifyName {
@@ -99,21 +116,91 @@ func (t *ImageVerifyStrategy) ImageVerify(ctx context.Context, namespace string,
 
 	sel, err := signatureannotations.GenerateSelector(signature.Annotations, signatureannotations.DefaultAnnotationOpts)
 	if err != nil {
-		return fmt.Errorf("failed to parse annotation rule: %w", err)
+		return nil, fmt.Errorf("failed to parse annotation rule: %w", err)
 	}
 
 	verifyOpts := &acornsign.VerifyOpts{
 		AnnotationRules:    sel,
 		SignatureAlgorithm: "sha256",
-		Key:                signature.PublicKey,
+		CertIdentity:       signature.CertIdentity,
+		CertOidcIssuer:     signature.CertOidcIssuer,
+		RequireTlog:        signature.RequireTlog,
 		NoCache:            false,
 		ImageRef:           ref.Context().Digest(imageDetails.AppImage.Digest),
 		SignatureRef:       ref.Context().Digest(imageDetails.SignatureDigest),
 	}
 
 	if err := verifyOpts.WithRemoteOpts(ctx, t.client, namespace, remoteOpts...); err != nil {
-		return err
+		return nil, err
+	}
+
+	// load the verifiers up front, so that we can identify the matched signature by them
+	if signature.PublicKey != "" {
+		verifyOpts.Verifiers, err = acornsign.VerifiersFromPublicKeyRef(ctx, signature.PublicKey, verifyOpts.SignatureAlgorithm)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load key: %w", err)
+		}
+	}
+
+	sig, err := acornsign.MatchSignature(ctx, *verifyOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	imgDigestHash, err := ggcrv1.NewHash(imageDetails.AppImage.Digest)
+	if err != nil {
+		return nil, err
+	}
+
+	entry, err := signatureEntry(ctx, sig, imgDigestHash, verifyOpts.Verifiers)
+	if err != nil {
+		return nil, err
+	}
+
+	return &entry, nil
+}
+
+// AttestationVerify verifies the attestations of the image like ImageVerify verifies its signatures and returns the
+// predicate of the attestation of signature.PredicateType.
+func (t *ImageVerifyStrategy) AttestationVerify(ctx context.Context, namespace string, signature apiv1.ImageSignature) ([]byte, error) {
+	if _, err := acornsign.PredicateTypeURI(signature.PredicateType); err != nil {
+		return nil, apierrors.NewBadRequest(err.Error())
+	}
+
+	ref, err := images.GetImageReference(ctx, t.client, namespace, signature.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	remoteOpts, err := images.GetAuthenticationRemoteOptionsWithLocalAuth(ctx, ref.Context(), signature.Auth, t.client, namespace, t.transportOpt)
+	if err != nil {
+		return nil, err
+	}
+
+	imageDetails, err := imagedetails.GetImageDetails(ctx, t.client, namespace, signature.Name, imagedetails.GetImageDetailsOptions{
+		RemoteOpts: remoteOpts,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ref, err = images.GetImageReference(ctx, t.client, namespace, imageDetails.AppImage.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	verifyOpts := &acornsign.VerifyOpts{
+		SignatureAlgorithm: "sha256",
+		Key:                signature.PublicKey,
+		CertIdentity:       signature.CertIdentity,
+		CertOidcIssuer:     signature.CertOidcIssuer,
+		RequireTlog:        signature.RequireTlog,
+		ImageRef:           ref.Context().Digest(imageDetails.AppImage.Digest),
+	}
+
+	if err := verifyOpts.WithRemoteOpts(ctx, t.client, namespace, remoteOpts...); err != nil {
+		return nil, err
 	}
 
-	return acornsign.VerifySignature(ctx, *verifyOpts)
+	return acornsign.MatchAttestation(ctx, *verifyOpts, signature.PredicateType)
 }
@@ -6,6 +6,10 @@ import (
 	"strings"
 
 	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/computeclasses"
+	"github.com/acorn-io/runtime/pkg/imageselector/signatures"
+	"github.com/acorn-io/runtime/pkg/volume"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/validation/field"
@@ -22,7 +26,7 @@ type Validator struct {
 	Client kclient.Client
 }
 
-func (v *Validator) Validate(_ context.Context, obj runtime.Object) field.ErrorList {
+func (v *Validator) Validate(ctx context.Context, obj runtime.Object) field.ErrorList {
 	var result field.ErrorList
 	project := obj.(*apiv1.Project)
 
@@ -30,6 +34,31 @@ func (v *Validator) Validate(_ context.Context, obj runtime.Object) field.ErrorL
 		return append(result, field.Invalid(field.NewPath("spec", "defaultRegion"), project.Spec.DefaultRegion, "default region is not in the supported regions list"))
 	}
 
+	if project.Spec.DefaultSignaturePolicy != nil {
+		if err := signatures.ValidateSignaturePolicy(*project.Spec.DefaultSignaturePolicy); err != nil {
+			return append(result, field.Invalid(field.NewPath("spec", "defaultSignaturePolicy"), project.Spec.DefaultSignaturePolicy, err.Error()))
+		}
+	}
+
+	if project.Spec.DefaultComputeClass != "" {
+		if _, err := computeclasses.GetAsProjectComputeClassInstance(ctx, v.Client, project.Name, project.Spec.DefaultComputeClass); apierrors.IsNotFound(err) {
+			return append(result, field.NotFound(field.NewPath("spec", "defaultComputeClass"), project.Spec.DefaultComputeClass))
+		} else if err != nil {
+			return append(result, field.InternalError(field.NewPath("spec", "defaultComputeClass"), err))
+		}
+	}
+
+	if project.Spec.DefaultVolumeClass != "" {
+		volumeClasses, _, err := volume.GetVolumeClassInstances(ctx, v.Client, project.Name)
+		if err != nil {
+			return append(result, field.InternalError(field.NewPath("spec", "defaultVolumeClass"), err))
+		} else if vc, ok := volumeClasses[project.Spec.DefaultVolumeClass]; !ok {
+			return append(result, field.NotFound(field.NewPath("spec", "defaultVolumeClass"), project.Spec.DefaultVolumeClass))
+		} else if vc.Inactive {
+			return append(result, field.Invalid(field.NewPath("spec", "defaultVolumeClass"), project.Spec.DefaultVolumeClass, "volume class is inactive"))
+		}
+	}
+
 	return nil
 }
 
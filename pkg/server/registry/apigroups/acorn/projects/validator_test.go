@@ -6,6 +6,7 @@ import (
 
 	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
 	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	adminv1 "github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1"
 	"github.com/acorn-io/runtime/pkg/scheme"
 	"github.com/stretchr/testify/assert"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -93,6 +94,60 @@ func TestProjectCreateValidation(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "Create project with default signature policy requiring a key",
+			project: apiv1.Project{
+				Spec: v1.ProjectInstanceSpec{
+					DefaultSignaturePolicy: &v1.SignaturePolicy{
+						Key: "ac://acorn-test",
+					},
+				},
+			},
+		},
+		{
+			name: "Create project with default signature policy requiring a certificate identity",
+			project: apiv1.Project{
+				Spec: v1.ProjectInstanceSpec{
+					DefaultSignaturePolicy: &v1.SignaturePolicy{
+						CertIdentity:   "ci@acorn.io",
+						CertOidcIssuer: "https://accounts.google.com",
+					},
+				},
+			},
+		},
+		{
+			name:      "Create project with default signature policy without signer should fail",
+			wantError: true,
+			project: apiv1.Project{
+				Spec: v1.ProjectInstanceSpec{
+					DefaultSignaturePolicy: &v1.SignaturePolicy{},
+				},
+			},
+		},
+		{
+			name:      "Create project with default signature policy requiring key and certificate identity should fail",
+			wantError: true,
+			project: apiv1.Project{
+				Spec: v1.ProjectInstanceSpec{
+					DefaultSignaturePolicy: &v1.SignaturePolicy{
+						Key:            "ac://acorn-test",
+						CertIdentity:   "ci@acorn.io",
+						CertOidcIssuer: "https://accounts.google.com",
+					},
+				},
+			},
+		},
+		{
+			name:      "Create project with default signature policy requiring certificate identity without issuer should fail",
+			wantError: true,
+			project: apiv1.Project{
+				Spec: v1.ProjectInstanceSpec{
+					DefaultSignaturePolicy: &v1.SignaturePolicy{
+						CertIdentity: "ci@acorn.io",
+					},
+				},
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -645,3 +700,63 @@ func TestProjectUpdateValidation(t *testing.T) {
 		})
 	}
 }
+
+func TestProjectDefaultClassesValidation(t *testing.T) {
+	validator := &Validator{
+		Client: fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(
+			&adminv1.ClusterComputeClassInstance{
+				ObjectMeta: metav1.ObjectMeta{Name: "cluster-compute-class"},
+			},
+			&adminv1.ClusterVolumeClassInstance{
+				ObjectMeta: metav1.ObjectMeta{Name: "cluster-volume-class"},
+			},
+			&adminv1.ClusterVolumeClassInstance{
+				ObjectMeta: metav1.ObjectMeta{Name: "inactive-volume-class"},
+				Inactive:   true,
+			},
+		).Build(),
+	}
+
+	tests := []struct {
+		name      string
+		spec      v1.ProjectInstanceSpec
+		wantError bool
+	}{
+		{
+			name: "Existing compute and volume classes",
+			spec: v1.ProjectInstanceSpec{
+				DefaultComputeClass: "cluster-compute-class",
+				DefaultVolumeClass:  "cluster-volume-class",
+			},
+		},
+		{
+			name:      "Compute class does not exist",
+			spec:      v1.ProjectInstanceSpec{DefaultComputeClass: "dne"},
+			wantError: true,
+		},
+		{
+			name:      "Volume class does not exist",
+			spec:      v1.ProjectInstanceSpec{DefaultVolumeClass: "dne"},
+			wantError: true,
+		},
+		{
+			name:      "Volume class is inactive",
+			spec:      v1.ProjectInstanceSpec{DefaultVolumeClass: "inactive-volume-class"},
+			wantError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validator.Validate(context.Background(), &apiv1.Project{
+				ObjectMeta: metav1.ObjectMeta{Name: "my-project"},
+				Spec:       tt.spec,
+			})
+			if tt.wantError {
+				assert.NotEmpty(t, err)
+			} else {
+				assert.Empty(t, err)
+			}
+		})
+	}
+}
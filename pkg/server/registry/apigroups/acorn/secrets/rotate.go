@@ -0,0 +1,190 @@
+package secrets
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/acorn-io/baaah/pkg/typed"
+	"github.com/acorn-io/mink/pkg/stores"
+	"github.com/acorn-io/mink/pkg/types"
+	"github.com/acorn-io/mink/pkg/validator"
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/labels"
+	sec "github.com/acorn-io/runtime/pkg/secrets"
+	"github.com/acorn-io/runtime/pkg/server/registry/apigroups/acorn/apps"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/apiserver/pkg/registry/rest"
+	"k8s.io/client-go/util/retry"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func NewRotate(c kclient.WithWatch) rest.Storage {
+	return stores.NewBuilder(c.Scheme(), &apiv1.SecretRotate{}).
+		WithCreate(&rotateStrategy{client: c}).
+		WithValidateName(validator.NoValidation).
+		Build()
+}
+
+type rotateStrategy struct {
+	client kclient.WithWatch
+}
+
+func (s *rotateStrategy) New() types.Object {
+	return &apiv1.SecretRotate{}
+}
+
+// Create regenerates the generated keys of a token or basic secret that an app generated. Containers that use the
+// secret with the default onChange of redeploy roll out the new value on their own once the app is updated, the
+// containers that use it with onChange noAction are restarted here so that they pick it up too.
+func (s *rotateStrategy) Create(ctx context.Context, obj types.Object) (types.Object, error) {
+	ri, _ := request.RequestInfoFrom(ctx)
+
+	if ri.Name == "" || ri.Namespace == "" {
+		return obj, nil
+	}
+
+	namespace, name, err := (&Translator{c: s.client}).FromPublicName(ctx, ri.Namespace, ri.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		secret = &corev1.Secret{}
+		keys   []string
+	)
+	err = retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		if err := s.client.Get(ctx, kclient.ObjectKey{Namespace: namespace, Name: name}, secret); err != nil {
+			return err
+		}
+
+		secretRef, err := s.generatedSecretRef(ctx, ri.Name, secret)
+		if err != nil {
+			return err
+		}
+
+		var data map[string][]byte
+		data, keys, err = sec.Rotate(secretRef, secret.Data)
+		if err != nil {
+			return apierrors.NewBadRequest(err.Error())
+		} else if len(keys) == 0 {
+			return apierrors.NewBadRequest(fmt.Sprintf("app %s sets all keys of secret %s, there is nothing to rotate",
+				secret.Labels[labels.AcornAppName], ri.Name))
+		}
+
+		secret.Data = data
+		return s.client.Update(ctx, secret)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	affected, err := s.restartDependentApps(ctx, secret)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &apiv1.SecretRotate{
+		Keys: keys,
+		Apps: affected,
+	}
+	result.Name = ri.Name
+	result.Namespace = ri.Namespace
+	return result, nil
+}
+
+// generatedSecretRef returns the definition of the secret in the app that generated it
+func (s *rotateStrategy) generatedSecretRef(ctx context.Context, name string, secret *corev1.Secret) (v1.Secret, error) {
+	appName, secretName := secret.Labels[labels.AcornAppName], secret.Labels[labels.AcornSecretName]
+	if secret.Labels[labels.AcornSecretGenerated] != "true" || appName == "" || secretName == "" {
+		return v1.Secret{}, apierrors.NewBadRequest(fmt.Sprintf("secret %s was not generated by an app, rotation only applies to generated secrets of type token or basic", name))
+	}
+
+	app := &v1.AppInstance{}
+	if err := s.client.Get(ctx, kclient.ObjectKey{Namespace: secret.Namespace, Name: appName}, app); err != nil {
+		return v1.Secret{}, err
+	}
+
+	secretRef, ok := app.Status.AppSpec.Secrets[secretName]
+	if !ok {
+		return v1.Secret{}, apierrors.NewBadRequest(fmt.Sprintf("app %s no longer defines secret %s", appName, secretName))
+	}
+	return secretRef, nil
+}
+
+// restartDependentApps restarts the containers that use the secret with onChange noAction in the app that generated
+// it and in the apps that it is bound to, and returns the names of all of these apps.
+func (s *rotateStrategy) restartDependentApps(ctx context.Context, secret *corev1.Secret) ([]string, error) {
+	appList := &v1.AppInstanceList{}
+	if err := s.client.List(ctx, appList, kclient.InNamespace(secret.Namespace)); err != nil {
+		return nil, err
+	}
+
+	var affected []string
+	for i := range appList.Items {
+		app := &appList.Items[i]
+		targets := secretTargets(app, secret)
+		if targets.Len() == 0 {
+			continue
+		}
+		affected = append(affected, app.Name)
+
+		if err := apps.RestartContainers(ctx, s.client, app, noActionContainers(app, targets)); err != nil {
+			return nil, err
+		}
+	}
+	return affected, nil
+}
+
+// secretTargets returns the names the app uses for the secret, which is the name of the secret definition for the app
+// that generated it and the target of the bindings of it otherwise
+func secretTargets(app *v1.AppInstance, secret *corev1.Secret) sets.Set[string] {
+	targets := sets.New[string]()
+	if app.Name == secret.Labels[labels.AcornAppName] {
+		targets.Insert(secret.Labels[labels.AcornSecretName])
+	}
+	for _, binding := range app.Spec.Secrets {
+		if binding.Secret == secret.Name || binding.Secret == secret.Labels[labels.AcornPublicName] {
+			targets.Insert(binding.Target)
+		}
+	}
+	return targets
+}
+
+// noActionContainers returns the containers of the app that use one of the secrets with onChange noAction, which
+// don't roll out a changed secret on their own
+func noActionContainers(app *v1.AppInstance, secretNames sets.Set[string]) (result []string) {
+	for _, name := range typed.SortedKeys(app.Status.AppSpec.Containers) {
+		if usesSecretWithoutRedeploy(app.Status.AppSpec.Containers[name], secretNames) {
+			result = append(result, name)
+		}
+	}
+	return
+}
+
+func usesSecretWithoutRedeploy(container v1.Container, secretNames sets.Set[string]) bool {
+	for _, env := range container.Environment {
+		if secretNames.Has(env.Secret.Name) && env.Secret.OnChange == v1.ChangeTypeNoAction {
+			return true
+		}
+	}
+	for _, file := range container.Files {
+		if secretNames.Has(file.Secret.Name) && file.Secret.OnChange == v1.ChangeTypeNoAction {
+			return true
+		}
+	}
+	for _, dir := range container.Dirs {
+		if secretNames.Has(dir.Secret.Name) && dir.Secret.OnChange == v1.ChangeTypeNoAction {
+			return true
+		}
+	}
+	for _, sidecar := range container.Sidecars {
+		if usesSecretWithoutRedeploy(sidecar, secretNames) {
+			return true
+		}
+	}
+	return false
+}
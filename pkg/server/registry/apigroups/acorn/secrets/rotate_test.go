@@ -0,0 +1,122 @@
+package secrets
+
+import (
+	"context"
+	"testing"
+
+	"github.com/acorn-io/baaah/pkg/router"
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/labels"
+	"github.com/acorn-io/runtime/pkg/scheme"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apiserver/pkg/endpoints/request"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestRotateStrategy(t *testing.T) {
+	generated := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "db-abcde",
+			Namespace: "my-project",
+			Labels: map[string]string{
+				labels.AcornAppName:         "my-app",
+				labels.AcornSecretName:      "db",
+				labels.AcornSecretGenerated: "true",
+				labels.AcornPublicName:      "my-app.db",
+			},
+		},
+		Type: v1.SecretTypeBasic,
+		Data: map[string][]byte{"username": []byte("admin"), "password": []byte("old")},
+	}
+	manual := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "manual", Namespace: "my-project"},
+		Type:       v1.SecretTypeBasic,
+		Data:       map[string][]byte{"username": []byte("admin"), "password": []byte("old")},
+	}
+	app := &v1.AppInstance{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-app", Namespace: "my-project"},
+		Status: v1.AppInstanceStatus{
+			Namespace: "my-app-ns",
+			AppSpec: v1.AppSpec{
+				Secrets: map[string]v1.Secret{"db": {Type: "basic", Data: map[string]string{"username": "admin"}}},
+				Containers: map[string]v1.Container{
+					"db": {Environment: []v1.EnvVar{{Name: "PASSWORD", Secret: v1.SecretReference{Name: "db", Key: "password", OnChange: v1.ChangeTypeRedeploy}}}},
+					"web": {Files: map[string]v1.File{
+						"/etc/db": {Secret: v1.SecretReference{Name: "db", Key: "password", OnChange: v1.ChangeTypeNoAction}},
+					}},
+				},
+			},
+		},
+	}
+	consumer := &v1.AppInstance{
+		ObjectMeta: metav1.ObjectMeta{Name: "consumer", Namespace: "my-project"},
+		Spec: v1.AppInstanceSpec{
+			Secrets: []v1.SecretBinding{{Secret: "my-app.db", Target: "upstream"}},
+		},
+		Status: v1.AppInstanceStatus{
+			Namespace: "consumer-ns",
+			AppSpec: v1.AppSpec{
+				Containers: map[string]v1.Container{
+					"client": {Environment: []v1.EnvVar{{Name: "PASSWORD", Secret: v1.SecretReference{Name: "upstream", Key: "password", OnChange: v1.ChangeTypeNoAction}}}},
+				},
+			},
+		},
+	}
+	unrelated := &v1.AppInstance{
+		ObjectMeta: metav1.ObjectMeta{Name: "unrelated", Namespace: "my-project"},
+	}
+
+	newClient := func() *fake.ClientBuilder {
+		return fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(
+			generated.DeepCopy(), manual.DeepCopy(), app.DeepCopy(), consumer.DeepCopy(), unrelated.DeepCopy(),
+			&appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "db", Namespace: "my-app-ns"}},
+			&appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "my-app-ns"}},
+			&appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "client", Namespace: "consumer-ns"}},
+		)
+	}
+
+	t.Run("rotate a generated secret", func(t *testing.T) {
+		c := newClient().Build()
+		ctx := request.WithRequestInfo(context.Background(), &request.RequestInfo{Name: "my-app.db", Namespace: "my-project"})
+
+		obj, err := (&rotateStrategy{client: c}).Create(ctx, &apiv1.SecretRotate{})
+		require.NoError(t, err)
+		result := obj.(*apiv1.SecretRotate)
+		assert.Equal(t, []string{"password"}, result.Keys)
+		assert.Equal(t, []string{"consumer", "my-app"}, result.Apps)
+
+		secret := &corev1.Secret{}
+		require.NoError(t, c.Get(ctx, router.Key("my-project", "db-abcde"), secret))
+		assert.Equal(t, "admin", string(secret.Data["username"]))
+		assert.NotEqual(t, "old", string(secret.Data["password"]))
+
+		for _, tt := range []struct {
+			namespace, name string
+			restarted       bool
+		}{
+			{namespace: "my-app-ns", name: "db"},
+			{namespace: "my-app-ns", name: "web", restarted: true},
+			{namespace: "consumer-ns", name: "client", restarted: true},
+		} {
+			dep := &appsv1.Deployment{}
+			require.NoError(t, c.Get(ctx, router.Key(tt.namespace, tt.name), dep))
+			_, restarted := dep.Spec.Template.Annotations[labels.AcornRestartedAt]
+			assert.Equal(t, tt.restarted, restarted, tt.name)
+		}
+	})
+
+	t.Run("reject a secret that was not generated", func(t *testing.T) {
+		c := newClient().Build()
+		ctx := request.WithRequestInfo(context.Background(), &request.RequestInfo{Name: "manual", Namespace: "my-project"})
+
+		_, err := (&rotateStrategy{client: c}).Create(ctx, &apiv1.SecretRotate{})
+		assert.True(t, apierrors.IsBadRequest(err), "expected a bad request error, got %v", err)
+		assert.ErrorContains(t, err, "rotation only applies to generated secrets of type token or basic")
+	})
+}
@@ -22,7 +22,9 @@ func NewStorage(c kclient.WithWatch, middlewares ...middleware.CompleteStrategy)
 	defaultSecret := &defaultSecretGenerateStrategy{
 		strategy: remoteResource,
 	}
-	validator := &Validator{}
+	validator := &Validator{
+		client: c,
+	}
 
 	return stores.NewBuilder(c.Scheme(), &apiv1.Secret{}).
 		WithCreate(defaultSecret).
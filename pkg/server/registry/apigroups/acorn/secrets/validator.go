@@ -2,16 +2,21 @@ package secrets
 
 import (
 	"context"
+	"fmt"
 	"strings"
 
+	"github.com/acorn-io/baaah/pkg/typed"
 	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
 	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/encryption/nacl"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 type Validator struct {
+	client kclient.Reader
 }
 
 func (v *Validator) Validate(ctx context.Context, obj runtime.Object) (result field.ErrorList) {
@@ -21,6 +26,21 @@ func (v *Validator) Validate(ctx context.Context, obj runtime.Object) (result fi
 			result = append(result, field.Invalid(field.NewPath("type"), sec.Type, "Invalid secret type"))
 		}
 	}
+	return append(result, v.validateEncryptedData(ctx, sec)...)
+}
+
+// validateEncryptedData decrypts the encrypted values of the secret with the keys of its project, so values encrypted
+// for another key are rejected now instead of failing when an app uses the secret. The values are stored encrypted.
+func (v *Validator) validateEncryptedData(ctx context.Context, sec *apiv1.Secret) (result field.ErrorList) {
+	for _, key := range typed.SortedKeys(sec.Data) {
+		if !nacl.IsAcornEncryptedData(sec.Data[key]) {
+			continue
+		}
+		if _, err := nacl.DecryptNamespacedData(ctx, v.client, sec.Data[key], sec.Namespace); err != nil {
+			result = append(result, field.Invalid(field.NewPath("data", key), "<encrypted>",
+				fmt.Sprintf("cannot be decrypted with the keys of project %s: %v", sec.Namespace, err)))
+		}
+	}
 	return
 }
 
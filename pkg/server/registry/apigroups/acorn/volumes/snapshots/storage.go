@@ -0,0 +1,27 @@
+package snapshots
+
+import (
+	"github.com/acorn-io/mink/pkg/stores"
+	"github.com/acorn-io/mink/pkg/strategy/remote"
+	"github.com/acorn-io/mink/pkg/strategy/translation"
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/tables"
+	snapshotv1 "github.com/kubernetes-csi/external-snapshotter/client/v6/apis/volumesnapshot/v1"
+	"k8s.io/apiserver/pkg/registry/rest"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func NewStorage(c kclient.WithWatch) rest.Storage {
+	remoteResource := translation.NewTranslationStrategy(&Translator{
+		c: c,
+	}, remote.NewRemote(&snapshotv1.VolumeSnapshot{}, c))
+
+	return stores.NewBuilder(c.Scheme(), &apiv1.VolumeSnapshot{}).
+		WithCreate(remoteResource).
+		WithGet(remoteResource).
+		WithList(remoteResource).
+		WithDelete(remoteResource).
+		WithWatch(remoteResource).
+		WithTableConverter(tables.VolumeSnapshotConverter).
+		Build()
+}
@@ -0,0 +1,283 @@
+package snapshots
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/acorn-io/baaah/pkg/router"
+	"github.com/acorn-io/mink/pkg/types"
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/labels"
+	"github.com/acorn-io/z"
+	snapshotv1 "github.com/kubernetes-csi/external-snapshotter/client/v6/apis/volumesnapshot/v1"
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	klabels "k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/selection"
+	"k8s.io/apiserver/pkg/storage"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const isDefaultClassAnnotation = "snapshot.storage.kubernetes.io/is-default-class"
+
+type Translator struct {
+	c kclient.Client
+}
+
+func (t *Translator) FromPublicName(ctx context.Context, namespace, name string) (string, string, error) {
+	snapshot, err := t.getByPublicName(ctx, namespace, name)
+	if apierrors.IsNotFound(err) {
+		return namespace, name, nil
+	} else if err != nil {
+		return "", "", err
+	}
+	return snapshot.Namespace, snapshot.Name, nil
+}
+
+// getByPublicName returns the CSI VolumeSnapshot with the given name that was taken of a volume in the project. The
+// snapshot is stored in the namespace of the app of the volume.
+func (t *Translator) getByPublicName(ctx context.Context, namespace, name string) (*snapshotv1.VolumeSnapshot, error) {
+	if err := t.checkSupported(); err != nil {
+		return nil, err
+	}
+
+	snapshots := &snapshotv1.VolumeSnapshotList{}
+	if err := t.c.List(ctx, snapshots, &kclient.ListOptions{
+		LabelSelector: klabels.SelectorFromSet(map[string]string{
+			labels.AcornManaged:      "true",
+			labels.AcornAppNamespace: namespace,
+		}),
+	}); err != nil {
+		return nil, err
+	}
+
+	for _, snapshot := range snapshots.Items {
+		if snapshot.Name == name {
+			return &snapshot, nil
+		}
+	}
+
+	return nil, apierrors.NewNotFound(schema.GroupResource{
+		Group:    apiv1.SchemeGroupVersion.Group,
+		Resource: "volumesnapshots",
+	}, name)
+}
+
+// checkSupported returns a BadRequest error if the CSI snapshot API isn't installed in the cluster
+func (t *Translator) checkSupported() error {
+	gvk := snapshotv1.SchemeGroupVersion.WithKind("VolumeSnapshot")
+	if _, err := t.c.RESTMapper().RESTMapping(gvk.GroupKind(), gvk.Version); meta.IsNoMatchError(err) {
+		return apierrors.NewBadRequest(fmt.Sprintf("volume snapshots are not supported by this cluster, the %s API is not installed", snapshotv1.GroupName))
+	} else if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (t *Translator) ListOpts(ctx context.Context, namespace string, opts storage.ListOptions) (string, storage.ListOptions, error) {
+	if err := t.checkSupported(); err != nil {
+		return "", opts, err
+	}
+
+	sel := opts.Predicate.Label
+	if sel == nil {
+		sel = klabels.Everything()
+	}
+	req, _ := klabels.NewRequirement(labels.AcornManaged, selection.Equals, []string{"true"})
+	sel = sel.Add(*req)
+
+	if namespace != "" {
+		req, _ := klabels.NewRequirement(labels.AcornAppNamespace, selection.Equals, []string{namespace})
+		sel = sel.Add(*req)
+	}
+	opts.Predicate.Label = sel
+	return "", opts, nil
+}
+
+func (t *Translator) ToPublic(_ context.Context, objs ...runtime.Object) (result []types.Object, _ error) {
+	for _, obj := range objs {
+		result = append(result, toPublic(obj.(*snapshotv1.VolumeSnapshot)))
+	}
+	return
+}
+
+func toPublic(snapshot *snapshotv1.VolumeSnapshot) *apiv1.VolumeSnapshot {
+	result := &apiv1.VolumeSnapshot{
+		ObjectMeta: snapshot.ObjectMeta,
+		Spec: apiv1.VolumeSnapshotSpec{
+			Volume: snapshot.Annotations[labels.AcornSnapshotVolume],
+		},
+		Status: apiv1.VolumeSnapshotStatus{
+			AppName:           snapshot.Labels[labels.AcornAppName],
+			VolumeName:        snapshot.Labels[labels.AcornVolumeName],
+			SnapshotNamespace: snapshot.Namespace,
+			SnapshotClass:     z.Dereference(snapshot.Spec.VolumeSnapshotClassName),
+		},
+	}
+	result.Namespace = snapshot.Labels[labels.AcornAppNamespace]
+
+	if status := snapshot.Status; status != nil {
+		result.Status.Ready = z.Dereference(status.ReadyToUse)
+		result.Status.Size = status.RestoreSize
+		if status.Error != nil {
+			result.Status.Error = z.Dereference(status.Error.Message)
+		}
+	}
+
+	return result
+}
+
+func (t *Translator) FromPublic(ctx context.Context, obj runtime.Object) (types.Object, error) {
+	snapshot := obj.(*apiv1.VolumeSnapshot)
+	if snapshot.Status.SnapshotNamespace != "" {
+		result := &snapshotv1.VolumeSnapshot{
+			ObjectMeta: snapshot.ObjectMeta,
+		}
+		result.Namespace = snapshot.Status.SnapshotNamespace
+		return result, nil
+	}
+	return t.newSnapshot(ctx, snapshot)
+}
+
+// newSnapshot returns a CSI VolumeSnapshot of the claim of the volume the public snapshot is requested for
+func (t *Translator) newSnapshot(ctx context.Context, snapshot *apiv1.VolumeSnapshot) (*snapshotv1.VolumeSnapshot, error) {
+	if snapshot.Spec.Volume == "" {
+		return nil, apierrors.NewBadRequest("the volume to snapshot is required")
+	}
+
+	if snapshot.Name != "" {
+		if _, err := t.getByPublicName(ctx, snapshot.Namespace, snapshot.Name); err == nil {
+			return nil, apierrors.NewAlreadyExists(schema.GroupResource{
+				Group:    apiv1.SchemeGroupVersion.Group,
+				Resource: "volumesnapshots",
+			}, snapshot.Name)
+		} else if !apierrors.IsNotFound(err) {
+			return nil, err
+		}
+	} else if err := t.checkSupported(); err != nil {
+		return nil, err
+	}
+
+	pv, err := t.getPV(ctx, snapshot.Namespace, snapshot.Spec.Volume)
+	if err != nil {
+		return nil, err
+	}
+
+	if pv.Spec.ClaimRef == nil || pv.Status.Phase != corev1.VolumeBound {
+		return nil, apierrors.NewBadRequest(fmt.Sprintf("volume %s is not bound to an app, only volumes in use can be snapshotted", snapshot.Spec.Volume))
+	}
+
+	pvc := &corev1.PersistentVolumeClaim{}
+	if err := t.c.Get(ctx, router.Key(pv.Spec.ClaimRef.Namespace, pv.Spec.ClaimRef.Name), pvc); err != nil {
+		return nil, err
+	}
+
+	snapshotClass, err := t.getSnapshotClass(ctx, snapshot.Spec.Volume, pv)
+	if err != nil {
+		return nil, err
+	}
+
+	volumeName := pv.Name
+	if publicName := pv.Labels[labels.AcornPublicName]; publicName != "" {
+		volumeName = publicName
+	}
+
+	result := &snapshotv1.VolumeSnapshot{
+		ObjectMeta: *snapshot.ObjectMeta.DeepCopy(),
+		Spec: snapshotv1.VolumeSnapshotSpec{
+			Source: snapshotv1.VolumeSnapshotSource{
+				PersistentVolumeClaimName: &pvc.Name,
+			},
+			VolumeSnapshotClassName: &snapshotClass,
+		},
+	}
+	result.Namespace = pvc.Namespace
+	result.Labels = labels.Merge(result.Labels, map[string]string{
+		labels.AcornManaged:      "true",
+		labels.AcornAppNamespace: snapshot.Namespace,
+		labels.AcornAppName:      pvc.Labels[labels.AcornAppName],
+		labels.AcornVolumeName:   pvc.Labels[labels.AcornVolumeName],
+	})
+	result.Annotations = labels.Merge(result.Annotations, map[string]string{
+		labels.AcornSnapshotVolume: volumeName,
+	})
+	return result, nil
+}
+
+// getPV returns the PersistentVolume of the volume in the project by its name or public name
+func (t *Translator) getPV(ctx context.Context, namespace, volume string) (*corev1.PersistentVolume, error) {
+	pv := &corev1.PersistentVolume{}
+	if err := t.c.Get(ctx, router.Key("", volume), pv); err == nil &&
+		pv.Labels[labels.AcornManaged] == "true" && pv.Labels[labels.AcornAppNamespace] == namespace {
+		return pv, nil
+	} else if err != nil && !apierrors.IsNotFound(err) {
+		return nil, err
+	}
+
+	pvs := &corev1.PersistentVolumeList{}
+	if err := t.c.List(ctx, pvs, &kclient.ListOptions{
+		LabelSelector: klabels.SelectorFromSet(map[string]string{
+			labels.AcornManaged:      "true",
+			labels.AcornAppNamespace: namespace,
+			labels.AcornPublicName:   volume,
+		}),
+	}); err != nil {
+		return nil, err
+	}
+
+	if len(pvs.Items) != 1 {
+		return nil, apierrors.NewNotFound(schema.GroupResource{
+			Group:    apiv1.SchemeGroupVersion.Group,
+			Resource: "volumes",
+		}, volume)
+	}
+	return &pvs.Items[0], nil
+}
+
+// getSnapshotClass returns the VolumeSnapshotClass for the CSI driver that provisioned pv. If there is more than one,
+// the default class is preferred.
+func (t *Translator) getSnapshotClass(ctx context.Context, volume string, pv *corev1.PersistentVolume) (string, error) {
+	if pv.Spec.StorageClassName == "" {
+		return "", apierrors.NewBadRequest(fmt.Sprintf("volume %s has no storage class, snapshots are only supported for volumes provisioned by a CSI driver", volume))
+	}
+
+	storageClass := &storagev1.StorageClass{}
+	if err := t.c.Get(ctx, router.Key("", pv.Spec.StorageClassName), storageClass); err != nil {
+		return "", err
+	}
+
+	snapshotClasses := &snapshotv1.VolumeSnapshotClassList{}
+	if err := t.c.List(ctx, snapshotClasses); err != nil {
+		return "", err
+	}
+
+	var result string
+	for _, snapshotClass := range snapshotClasses.Items {
+		if snapshotClass.Driver != storageClass.Provisioner {
+			continue
+		}
+		if snapshotClass.Annotations[isDefaultClassAnnotation] == "true" {
+			return snapshotClass.Name, nil
+		} else if result == "" {
+			result = snapshotClass.Name
+		}
+	}
+
+	if result == "" {
+		return "", apierrors.NewBadRequest(fmt.Sprintf("storage class %s of volume %s does not support snapshots, there is no VolumeSnapshotClass for its provisioner %s",
+			storageClass.Name, volume, storageClass.Provisioner))
+	}
+	return result, nil
+}
+
+func (t *Translator) NewPublic() types.Object {
+	return &apiv1.VolumeSnapshot{}
+}
+
+func (t *Translator) NewPublicList() types.ObjectList {
+	return &apiv1.VolumeSnapshotList{}
+}
@@ -0,0 +1,183 @@
+package snapshots
+
+import (
+	"context"
+	"testing"
+
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/labels"
+	"github.com/acorn-io/runtime/pkg/scheme"
+	"github.com/acorn-io/z"
+	snapshotv1 "github.com/kubernetes-csi/external-snapshotter/client/v6/apis/volumesnapshot/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func newTestClient(provisioner string) kclient.Client {
+	mapper := meta.NewDefaultRESTMapper(nil)
+	mapper.Add(corev1.SchemeGroupVersion.WithKind("PersistentVolume"), meta.RESTScopeRoot)
+	mapper.Add(corev1.SchemeGroupVersion.WithKind("PersistentVolumeClaim"), meta.RESTScopeNamespace)
+	mapper.Add(storagev1.SchemeGroupVersion.WithKind("StorageClass"), meta.RESTScopeRoot)
+	mapper.Add(snapshotv1.SchemeGroupVersion.WithKind("VolumeSnapshot"), meta.RESTScopeNamespace)
+	mapper.Add(snapshotv1.SchemeGroupVersion.WithKind("VolumeSnapshotClass"), meta.RESTScopeRoot)
+
+	return fake.NewClientBuilder().WithScheme(scheme.Scheme).WithRESTMapper(mapper).WithObjects(
+		&corev1.PersistentVolume{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "pvc-1234",
+				Labels: map[string]string{
+					labels.AcornManaged:      "true",
+					labels.AcornAppNamespace: "acorn",
+					labels.AcornPublicName:   "app.data",
+				},
+			},
+			Spec: corev1.PersistentVolumeSpec{
+				StorageClassName: "fast",
+				ClaimRef: &corev1.ObjectReference{
+					Namespace: "app-ns",
+					Name:      "data",
+				},
+			},
+			Status: corev1.PersistentVolumeStatus{
+				Phase: corev1.VolumeBound,
+			},
+		},
+		&corev1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "data",
+				Namespace: "app-ns",
+				Labels: map[string]string{
+					labels.AcornAppName:    "app",
+					labels.AcornVolumeName: "data",
+				},
+			},
+		},
+		&storagev1.StorageClass{
+			ObjectMeta:  metav1.ObjectMeta{Name: "fast"},
+			Provisioner: provisioner,
+		},
+		&snapshotv1.VolumeSnapshotClass{
+			ObjectMeta: metav1.ObjectMeta{Name: "other"},
+			Driver:     "csi.example.com",
+		},
+		&snapshotv1.VolumeSnapshotClass{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "default",
+				Annotations: map[string]string{
+					isDefaultClassAnnotation: "true",
+				},
+			},
+			Driver: "csi.example.com",
+		},
+	).Build()
+}
+
+func TestFromPublicCreatesSnapshot(t *testing.T) {
+	translator := &Translator{
+		c: newTestClient("csi.example.com"),
+	}
+
+	obj, err := translator.FromPublic(context.Background(), &apiv1.VolumeSnapshot{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "backup",
+			Namespace: "acorn",
+		},
+		Spec: apiv1.VolumeSnapshotSpec{
+			Volume: "app.data",
+		},
+	})
+	require.NoError(t, err)
+
+	snapshot := obj.(*snapshotv1.VolumeSnapshot)
+	assert.Equal(t, "backup", snapshot.Name)
+	assert.Equal(t, "app-ns", snapshot.Namespace)
+	assert.Equal(t, "data", z.Dereference(snapshot.Spec.Source.PersistentVolumeClaimName))
+	assert.Equal(t, "default", z.Dereference(snapshot.Spec.VolumeSnapshotClassName))
+	assert.Equal(t, map[string]string{
+		labels.AcornManaged:      "true",
+		labels.AcornAppNamespace: "acorn",
+		labels.AcornAppName:      "app",
+		labels.AcornVolumeName:   "data",
+	}, snapshot.Labels)
+	assert.Equal(t, "app.data", snapshot.Annotations[labels.AcornSnapshotVolume])
+}
+
+func TestFromPublicUnsupportedStorageClass(t *testing.T) {
+	translator := &Translator{
+		c: newTestClient("rancher.io/local-path"),
+	}
+
+	_, err := translator.FromPublic(context.Background(), &apiv1.VolumeSnapshot{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "backup",
+			Namespace: "acorn",
+		},
+		Spec: apiv1.VolumeSnapshotSpec{
+			Volume: "pvc-1234",
+		},
+	})
+	assert.True(t, apierrors.IsBadRequest(err))
+	assert.EqualError(t, err, "storage class fast of volume pvc-1234 does not support snapshots, there is no VolumeSnapshotClass for its provisioner rancher.io/local-path")
+}
+
+func TestSnapshotAPINotInstalled(t *testing.T) {
+	translator := &Translator{
+		c: fake.NewClientBuilder().WithScheme(scheme.Scheme).WithRESTMapper(meta.NewDefaultRESTMapper(nil)).Build(),
+	}
+
+	_, err := translator.FromPublic(context.Background(), &apiv1.VolumeSnapshot{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "backup",
+			Namespace: "acorn",
+		},
+		Spec: apiv1.VolumeSnapshotSpec{
+			Volume: "app.data",
+		},
+	})
+	assert.True(t, apierrors.IsBadRequest(err))
+	assert.ErrorContains(t, err, "volume snapshots are not supported by this cluster")
+}
+
+func TestToPublic(t *testing.T) {
+	size := resource.MustParse("10Gi")
+	snapshot := toPublic(&snapshotv1.VolumeSnapshot{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "backup",
+			Namespace: "app-ns",
+			Labels: map[string]string{
+				labels.AcornAppNamespace: "acorn",
+				labels.AcornAppName:      "app",
+				labels.AcornVolumeName:   "data",
+			},
+			Annotations: map[string]string{
+				labels.AcornSnapshotVolume: "app.data",
+			},
+		},
+		Spec: snapshotv1.VolumeSnapshotSpec{
+			VolumeSnapshotClassName: z.Pointer("default"),
+		},
+		Status: &snapshotv1.VolumeSnapshotStatus{
+			ReadyToUse:  z.Pointer(true),
+			RestoreSize: &size,
+		},
+	})
+
+	assert.Equal(t, "acorn", snapshot.Namespace)
+	assert.Equal(t, "app.data", snapshot.Spec.Volume)
+	assert.Equal(t, apiv1.VolumeSnapshotStatus{
+		AppName:           "app",
+		VolumeName:        "data",
+		SnapshotNamespace: "app-ns",
+		SnapshotClass:     "default",
+		Ready:             true,
+		Size:              &size,
+	}, snapshot.Status)
+}
@@ -100,6 +100,7 @@ func (t *Translator) pvToVolume(ctx context.Context, pv corev1.PersistentVolume)
 			AppNamespace:  pv.Labels[labels.AcornAppNamespace],
 			VolumeName:    pv.Labels[labels.AcornVolumeName],
 			Status:        strings.ToLower(string(pv.Status.Phase)),
+			ReclaimPolicy: strings.ToLower(string(pv.Spec.PersistentVolumeReclaimPolicy)),
 			Columns: apiv1.VolumeColumns{
 				AccessModes: strings.Join(shortAccessModes, ","),
 			},
@@ -59,6 +59,9 @@ func (s *Strategy) List(ctx context.Context, namespace string, options storage.L
 			Default:          pcc.Default,
 			Description:      pcc.Description,
 			SupportedRegions: pcc.SupportedRegions,
+			CPUScaler:        pcc.CPUScaler,
+			Affinity:         pcc.Affinity,
+			Tolerations:      pcc.Tolerations,
 		})
 		projectComputeClassesSeen[pcc.Name] = struct{}{}
 	}
@@ -77,6 +80,9 @@ func (s *Strategy) List(ctx context.Context, namespace string, options storage.L
 			Default:          ccc.Default,
 			Description:      ccc.Description,
 			SupportedRegions: ccc.SupportedRegions,
+			CPUScaler:        ccc.CPUScaler,
+			Affinity:         ccc.Affinity,
+			Tolerations:      ccc.Tolerations,
 		})
 	}
 
@@ -1,6 +1,9 @@
 package server
 
 import (
+	"context"
+	"time"
+
 	"github.com/acorn-io/baaah/pkg/restconfig"
 	"github.com/acorn-io/baaah/pkg/runtime/multi"
 	"github.com/acorn-io/mink/pkg/server"
@@ -10,17 +13,31 @@ import (
 	openapi "github.com/acorn-io/runtime/pkg/openapi/generated"
 	"github.com/acorn-io/runtime/pkg/scheme"
 	"github.com/acorn-io/runtime/pkg/server/registry"
+	"github.com/sirupsen/logrus"
 	apiserver "k8s.io/apiserver/pkg/server"
 	"k8s.io/apiserver/pkg/server/options"
 	"k8s.io/client-go/rest"
 	kclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
+// HTTPSListenPort is the port the api-server listens on, regardless of the --secure-port flag
+const HTTPSListenPort = 7443
+
 type Config struct {
 	Version            string
 	DefaultOpts        *options.RecommendedOptions
 	LocalRestConfig    *rest.Config
 	IgnoreStartFailure bool
+	// ShutdownGracePeriod is how long in-flight requests are given to finish once the server is asked to stop
+	ShutdownGracePeriod time.Duration
+}
+
+type Server struct {
+	*server.Server
+
+	drainer             *drainer
+	shutdownGracePeriod time.Duration
+	done                chan struct{}
 }
 
 func apiGroups(serverConfig Config) ([]*apiserver.APIGroupInfo, error) {
@@ -48,16 +65,18 @@ func apiGroups(serverConfig Config) ([]*apiserver.APIGroupInfo, error) {
 	return registry.APIGroups(c, restConfig, localCfg)
 }
 
-func New(cfg Config) (*server.Server, error) {
+func New(cfg Config) (*Server, error) {
 	apiGroups, err := apiGroups(cfg)
 	if err != nil {
 		return nil, err
 	}
 
-	return server.New(&server.Config{
+	d := &drainer{}
+
+	s, err := server.New(&server.Config{
 		Name:                  "Acorn",
 		Version:               cfg.Version,
-		HTTPSListenPort:       7443,
+		HTTPSListenPort:       HTTPSListenPort,
 		LongRunningVerbs:      []string{"watch", "proxy"},
 		LongRunningResources:  []string{"exec", "proxy", "log", "registryport", "port", "push", "pull", "portforward", "copy", "details"},
 		OpenAPIConfig:         openapi.GetOpenAPIDefinitions,
@@ -68,4 +87,43 @@ func New(cfg Config) (*server.Server, error) {
 		SupportAPIAggregation: cfg.LocalRestConfig == nil,
 		IgnoreStartFailure:    cfg.IgnoreStartFailure,
 	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Wrap the full handler chain, which serves both the HTTP and HTTPS listeners
+	s.GenericAPIServer.Handler.FullHandlerChain = d.middleware(s.GenericAPIServer.Handler.FullHandlerChain)
+
+	return &Server{
+		Server:              s,
+		drainer:             d,
+		shutdownGracePeriod: cfg.ShutdownGracePeriod,
+		done:                make(chan struct{}),
+	}, nil
+}
+
+// Run starts the server in the background. Once ctx is done, the server stops accepting new requests and gives the
+// in-flight requests up to the shutdown grace period to finish, before closing all connections.
+func (s *Server) Run(ctx context.Context) error {
+	serverCtx, cancel := context.WithCancel(context.Background())
+	if err := s.Server.Run(serverCtx); err != nil {
+		cancel()
+		return err
+	}
+
+	go func() {
+		defer close(s.done)
+		defer cancel()
+
+		<-ctx.Done()
+		remaining := s.drainer.drain(s.shutdownGracePeriod)
+		logrus.Infof("Shutting down api-server after a grace period of %s with %d in-flight requests remaining", s.shutdownGracePeriod, remaining)
+	}()
+
+	return nil
+}
+
+// Done is closed once the server has shut down after Run's context is done
+func (s *Server) Done() <-chan struct{} {
+	return s.done
 }
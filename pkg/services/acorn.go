@@ -436,12 +436,30 @@ func forLinkedServices(app *v1.AppInstance) (result []kclient.Object) {
 					labels.AcornLinkName, link.Service),
 			},
 		}
+		if link.Address != "" {
+			// The address has no service to take the ports from, so they are taken from the linked target of the app
+			newService.Spec.Address = link.Address
+			newService.Spec.Ports = linkedPorts(app, link.Target)
+		}
 		result = append(result, newService)
 	}
 
 	return
 }
 
+// linkedPorts returns the ports the app declares for the service, container, function or job name
+func linkedPorts(app *v1.AppInstance, name string) []v1.PortDef {
+	if service, ok := app.Status.AppSpec.Services[name]; ok {
+		return service.Ports
+	}
+	for _, containers := range []map[string]v1.Container{app.Status.AppSpec.Containers, app.Status.AppSpec.Functions, app.Status.AppSpec.Jobs} {
+		if container, ok := containers[name]; ok {
+			return ports2.CollectContainerPorts(&container, app.Status.GetDevMode())
+		}
+	}
+	return nil
+}
+
 func findDefaultServiceName(appInstance *v1.AppInstance) (string, error) {
 	// I don't like the behavior. It should be more explicit and not magically pick a default if one doesn't exist.
 	// But right now there's too much going on to change the behavior. Maybe we can do better in the future.
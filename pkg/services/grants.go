@@ -0,0 +1,49 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/acorn-io/baaah/pkg/router"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ErrServiceNotGranted is returned for a link to the services of an app that restricts the apps that can link to them,
+// and didn't grant the linking app access.
+type ErrServiceNotGranted struct {
+	Publisher string
+	Consumer  string
+}
+
+func (e *ErrServiceNotGranted) Error() string {
+	return fmt.Sprintf("app %s is not authorized to link to the services of app %s, missing grant from %s to %s (run \"acorn app grant %s --to %s\")",
+		e.Consumer, e.Publisher, e.Publisher, e.Consumer, e.Publisher, e.Consumer)
+}
+
+// CheckServiceGrant returns an ErrServiceNotGranted if the app consumer can't link to service. The service is named
+// like in a link, the app that publishes it is the first part of the name. Services of apps that don't exist aren't
+// restricted.
+func CheckServiceGrant(ctx context.Context, c kclient.Reader, namespace, consumer, service string) error {
+	publisher, _, _ := strings.Cut(service, ".")
+	if root, _, _ := strings.Cut(consumer, "."); publisher == root {
+		return nil
+	}
+
+	app := &v1.AppInstance{}
+	if err := c.Get(ctx, router.Key(namespace, publisher), app); apierrors.IsNotFound(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	if !app.Spec.AllowsServiceConsumer(consumer) {
+		return &ErrServiceNotGranted{
+			Publisher: publisher,
+			Consumer:  consumer,
+		}
+	}
+	return nil
+}
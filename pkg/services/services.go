@@ -2,6 +2,7 @@ package services
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net"
 	"strings"
@@ -266,14 +267,19 @@ func ToK8sService(req router.Request, service *v1.ServiceInstance) (result []kcl
 		}
 	}()
 
-	var waiting bool
+	var (
+		waiting    bool
+		notGranted error
+	)
 
 	defer func() {
 		if err != nil {
 			return
 		}
 		cond := condition.ForName(service, v1.ServiceInstanceConditionDefined)
-		if waiting {
+		if notGranted != nil {
+			cond.Error(notGranted)
+		} else if waiting {
 			if service.Spec.Job == "" {
 				cond.Unknown("waiting to be defined")
 			} else {
@@ -285,6 +291,13 @@ func ToK8sService(req router.Request, service *v1.ServiceInstance) (result []kcl
 	}()
 
 	if service.Spec.External != "" {
+		// A revoked grant takes the link down, not only new links are rejected
+		if err := CheckServiceGrant(req.Ctx, req.Client, service.Spec.AppNamespace, service.Spec.AppName, service.Spec.External); errors.As(err, new(*ErrServiceNotGranted)) {
+			notGranted = err
+			return nil, nil, nil
+		} else if err != nil {
+			return nil, nil, err
+		}
 		return toExternalService(req.Ctx, req.Client, cfg, service)
 	} else if service.Spec.Alias != "" {
 		return toAliasService(req.Ctx, req.Client, cfg, service)
@@ -3,8 +3,9 @@ package tables
 var (
 	CheckResult = [][]string{
 		{"Name", "Name"},
-		{"Passed", "Passed"},
+		{"Status", "Status"},
 		{"Message", "Message"},
+		{"Hint", "Hint"},
 	}
 
 	App = [][]string{
@@ -17,6 +18,33 @@ var (
 	}
 	AppConverter = MustConverter(App)
 
+	ServiceGrant = [][]string{
+		{"Name", "{{ . | name }}"},
+		{"Consumers", "{{ arrayNoSpace .Spec.ServiceGrants.Consumers }}"},
+	}
+
+	AppContainerEnv = [][]string{
+		{"Name", "Name"},
+		{"Value", "{{if .Masked}}********{{else}}{{.Value}}{{end}}"},
+		{"Source", "{{if .Source}}from {{.Source}}{{end}}"},
+	}
+
+	AppEndpoint = [][]string{
+		{"Target", "Target"},
+		{"Protocol", "Protocol"},
+		{"Hostname", "Hostname"},
+		{"Port", "Port"},
+		{"URL", "URL"},
+	}
+
+	ContainedImage = [][]string{
+		{"Name", "Name"},
+		{"Type", "Type"},
+		{"Repo", "Repo"},
+		{"Digest", "Digest"},
+		{"Size", "{{ byteSize .Size }}"},
+	}
+
 	Volume = [][]string{
 		{"Name", "{{ . | name }}"},
 		{"Bound-Volume", "Status.VolumeName"},
@@ -28,6 +56,25 @@ var (
 	}
 	VolumeConverter = MustConverter(Volume)
 
+	OrphanedVolume = [][]string{
+		{"Name", "{{ . | name }}"},
+		{"Capacity", "Spec.Capacity"},
+		{"Volume-Class", "{{ .Spec.Class }}"},
+		{"Last-App", "Status.AppPublicName"},
+		{"Reclaim-Policy", "Status.ReclaimPolicy"},
+		{"Status", "Status.Status"},
+		{"Created", "{{ago .CreationTimestamp}}"},
+	}
+
+	VolumeSnapshot = [][]string{
+		{"Name", "{{ . | name }}"},
+		{"Volume", "Spec.Volume"},
+		{"Ready", "{{ boolToStar .Status.Ready }}"},
+		{"Size", "{{ pointer .Status.Size }}"},
+		{"Created", "{{ago .CreationTimestamp}}"},
+	}
+	VolumeSnapshotConverter = MustConverter(VolumeSnapshot)
+
 	VolumeClass = [][]string{
 		{"Name", "{{ . | name }}"},
 		{"Default", "{{ boolToStar .Default }}"},
@@ -61,6 +108,35 @@ var (
 	}
 	ImageConverter = MustConverter(Image)
 
+	ImageScan = [][]string{
+		{"ID", "ID"},
+		{"Severity", "Severity"},
+		{"Package", "Package"},
+		{"Installed", "InstalledVersion"},
+		{"Fixed", "FixedVersion"},
+		{"Target", "Target"},
+	}
+
+	ImageHistory = [][]string{
+		{"Container", "Container"},
+		{"Created", "{{if not .Created.IsZero}}{{ago .Created}}{{end}}"},
+		{"Created By", "CreatedBy"},
+		{"Size", "{{byteSize .Size}}"},
+		{"Comment", "Comment"},
+	}
+
+	ImagePrune = [][]string{
+		{"Image-ID", "{{trunc .Name}}"},
+		{"Tags", "{{if .Tags}}{{else}}<none>{{end}}{{range $index, $v := .Tags}}{{if $index}},{{end}}{{$v}}{{end}}"},
+		{"Created", "{{ago .CreationTimestamp}}"},
+	}
+
+	ImageSignature = [][]string{
+		{"Digest", "{{trunc .Digest}}"},
+		{"Fingerprint", "{{if .Fingerprint}}{{trunc .Fingerprint}}{{else}}<unknown>{{end}}"},
+		{"Annotations", "{{annotations .Annotations}}"},
+	}
+
 	ImageContainer = [][]string{
 		{"Repository", "{{ .Repo }}"},
 		{"Tag", "{{ .Tag }}"},
@@ -161,6 +237,8 @@ var (
 		{"Name", "Name"},
 		{"Default", "{{ boolToStar .Default }}"},
 		{"Regions", "{{ arrayNoSpace .Regions }}"},
+		{"Compute Class", "{{ .DefaultComputeClass }}"},
+		{"Volume Class", "{{ .DefaultVolumeClass }}"},
 	}
 
 	Region = [][]string{
@@ -172,6 +250,16 @@ var (
 	}
 	RegionConverter = MustConverter(Region)
 
+	RegionClient = [][]string{
+		{"Name", "Name"},
+		{"Account", "{{ ownerName . }}"},
+		{"Region Name", "{{ .Spec.RegionName }}"},
+		{"Compute Classes", "{{ arrayNoSpace .ComputeClasses }}"},
+		{"Volume Classes", "{{ arrayNoSpace .VolumeClasses }}"},
+		{"Created", "{{ ago .CreationTimestamp }}"},
+		{"Description", "{{ .Spec.Description }}"},
+	}
+
 	RuleRequests = [][]string{
 		{"Service", "Service"},
 		{"Verbs/Actions", "Verbs"},
@@ -189,4 +277,13 @@ var (
 	}
 
 	EventConverter = MustConverter(Event)
+
+	AppEvent = [][]string{
+		{"Observed", "Observed"},
+		{"Severity", "{{ severity .Severity }}"},
+		{"Type", "Type"},
+		{"Resource", "Resource"},
+		{"Actor", "Actor"},
+		{"Description", "Description"},
+	}
 )
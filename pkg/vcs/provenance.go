@@ -0,0 +1,76 @@
+package vcs
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/go-git/go-git/v5"
+)
+
+// Provenance is the commit and branch an artifact was built from and the CI build that built it.
+type Provenance struct {
+	Commit   string
+	Branch   string
+	BuildURL string
+}
+
+// DetectProvenance reads the provenance from the environment variables of common CI systems (GitHub Actions, GitLab
+// CI, CircleCI, Buildkite and Jenkins). The commit and branch fall back to the ones of the git repository dir is in,
+// which covers builds outside of CI. Fields that can't be detected are empty.
+func DetectProvenance(dir string) Provenance {
+	result := Provenance{
+		Commit: firstEnv("GITHUB_SHA", "CI_COMMIT_SHA", "CIRCLE_SHA1", "BUILDKITE_COMMIT", "GIT_COMMIT"),
+		// GITHUB_HEAD_REF is the source branch of pull requests, in which GITHUB_REF_NAME is the merge ref
+		Branch:   firstEnv("GITHUB_HEAD_REF", "GITHUB_REF_NAME", "CI_COMMIT_REF_NAME", "CIRCLE_BRANCH", "BUILDKITE_BRANCH"),
+		BuildURL: firstEnv("CI_JOB_URL", "CIRCLE_BUILD_URL", "BUILDKITE_BUILD_URL", "BUILD_URL"),
+	}
+	if result.Branch == "" {
+		// Jenkins prefixes the branch with the remote
+		if branch := os.Getenv("GIT_BRANCH"); branch != "" {
+			_, result.Branch, _ = strings.Cut(branch, "/")
+			if result.Branch == "" {
+				result.Branch = branch
+			}
+		}
+	}
+	if result.BuildURL == "" && os.Getenv("GITHUB_RUN_ID") != "" {
+		result.BuildURL = strings.Join([]string{os.Getenv("GITHUB_SERVER_URL"), os.Getenv("GITHUB_REPOSITORY"), "actions/runs", os.Getenv("GITHUB_RUN_ID")}, "/")
+	}
+
+	if result.Commit != "" && result.Branch != "" {
+		return result
+	}
+
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return result
+	}
+	repo, err := git.PlainOpenWithOptions(dir, &git.PlainOpenOptions{
+		DetectDotGit: true,
+	})
+	if err != nil {
+		return result
+	}
+	head, err := repo.Head()
+	if err != nil {
+		return result
+	}
+	if result.Commit == "" {
+		result.Commit = head.Hash().String()
+	}
+	// A detached HEAD, like the checkouts of most CI systems, has no branch
+	if result.Branch == "" && head.Name().IsBranch() {
+		result.Branch = head.Name().Short()
+	}
+	return result
+}
+
+func firstEnv(names ...string) string {
+	for _, name := range names {
+		if value := os.Getenv(name); value != "" {
+			return value
+		}
+	}
+	return ""
+}
@@ -0,0 +1,90 @@
+package vcs
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var ciEnvVars = []string{
+	"GITHUB_SHA", "GITHUB_HEAD_REF", "GITHUB_REF_NAME", "GITHUB_SERVER_URL", "GITHUB_REPOSITORY", "GITHUB_RUN_ID",
+	"CI_COMMIT_SHA", "CI_COMMIT_REF_NAME", "CI_JOB_URL",
+	"CIRCLE_SHA1", "CIRCLE_BRANCH", "CIRCLE_BUILD_URL",
+	"BUILDKITE_COMMIT", "BUILDKITE_BRANCH", "BUILDKITE_BUILD_URL",
+	"GIT_COMMIT", "GIT_BRANCH", "BUILD_URL",
+}
+
+func TestDetectProvenance(t *testing.T) {
+	dir := t.TempDir()
+	repo, err := git.PlainInit(dir, false)
+	require.NoError(t, err)
+	w, err := repo.Worktree()
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "Acornfile"), []byte("containers: {}\n"), 0644))
+	_, err = w.Add("Acornfile")
+	require.NoError(t, err)
+	commit, err := w.Commit("initial", &git.CommitOptions{
+		Author: &object.Signature{Name: "test", Email: "test@example.com", When: time.Now()},
+	})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		dir      string
+		env      map[string]string
+		expected Provenance
+	}{
+		{
+			name:     "git repository",
+			dir:      dir,
+			expected: Provenance{Commit: commit.String(), Branch: "master"},
+		},
+		{
+			name: "github actions pull request",
+			dir:  dir,
+			env: map[string]string{
+				"GITHUB_SHA":        "1234",
+				"GITHUB_HEAD_REF":   "feature",
+				"GITHUB_REF_NAME":   "42/merge",
+				"GITHUB_SERVER_URL": "https://github.com",
+				"GITHUB_REPOSITORY": "acme/app",
+				"GITHUB_RUN_ID":     "99",
+			},
+			expected: Provenance{Commit: "1234", Branch: "feature", BuildURL: "https://github.com/acme/app/actions/runs/99"},
+		},
+		{
+			name: "jenkins",
+			dir:  t.TempDir(),
+			env: map[string]string{
+				"GIT_COMMIT": "5678",
+				"GIT_BRANCH": "origin/release",
+				"BUILD_URL":  "https://jenkins.example.com/job/app/7/",
+			},
+			expected: Provenance{Commit: "5678", Branch: "release", BuildURL: "https://jenkins.example.com/job/app/7/"},
+		},
+		{
+			name:     "branch from the repository",
+			dir:      dir,
+			env:      map[string]string{"CI_JOB_URL": "https://gitlab.example.com/acme/app/-/jobs/1"},
+			expected: Provenance{Commit: commit.String(), Branch: "master", BuildURL: "https://gitlab.example.com/acme/app/-/jobs/1"},
+		},
+		{
+			name: "nothing",
+			dir:  t.TempDir(),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, name := range ciEnvVars {
+				t.Setenv(name, tt.env[name])
+			}
+			assert.Equal(t, tt.expected, DetectProvenance(tt.dir))
+		})
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/acorn-io/runtime/pkg/config"
 	corev1 "k8s.io/api/core/v1"
 	storagev1 "k8s.io/api/storage/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/kubectl/pkg/util/storage"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -76,7 +77,8 @@ func GetVolumeClassNames(ctx context.Context, c client.Client, namespace string,
 
 // GetVolumeClassInstances returns an array of all project and cluster volume classes available in the namespace. If a project
 // volume class is set to default, this ensures that no cluster volume classes are default to avoid conflicts.
-// The class determined to be default, if it exists, is also returned.
+// The class determined to be default, if it exists, is also returned. A default volume class set on the project overrides
+// the classes marked as default.
 func GetVolumeClassInstances(ctx context.Context, c client.Client, namespace string) (map[string]adminv1.ProjectVolumeClassInstance, *adminv1.ProjectVolumeClassInstance, error) {
 	volumeClasses := new(adminv1.ProjectVolumeClassInstanceList)
 	if err := c.List(ctx, volumeClasses, &client.ListOptions{Namespace: namespace}); err != nil {
@@ -123,6 +125,23 @@ func GetVolumeClassInstances(ctx context.Context, c client.Client, namespace str
 		volumeClasses.Items = append(volumeClasses.Items, adminv1.ProjectVolumeClassInstance(cvc))
 	}
 
+	// The default volume class set on the project takes precedence over the classes marked as default.
+	project := new(v1.ProjectInstance)
+	if err := c.Get(ctx, client.ObjectKey{Name: namespace}, project); err != nil && !apierrors.IsNotFound(err) {
+		return nil, nil, err
+	} else if project.Spec.DefaultVolumeClass != "" {
+		for i, vc := range volumeClasses.Items {
+			if vc.Name == project.Spec.DefaultVolumeClass && !vc.Inactive {
+				defaultVolumeClass = vc.DeepCopy()
+				defaultVolumeClass.Default = true
+				for j := range volumeClasses.Items {
+					volumeClasses.Items[j].Default = j == i
+				}
+				break
+			}
+		}
+	}
+
 	return SliceToMap(volumeClasses.Items, func(obj adminv1.ProjectVolumeClassInstance) string {
 			return obj.Name
 		}),
@@ -0,0 +1,166 @@
+package wait
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/acorn-io/baaah/pkg/typed"
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/client"
+)
+
+const (
+	// ConditionReady is met once the app is ready with its latest spec, like acorn run waits for
+	ConditionReady = "ready"
+	// ConditionHealthy is met once the app is ready and all replicas of its containers are ready and up to date,
+	// without errors in any container or job
+	ConditionHealthy = "healthy"
+	// ConditionEndpoint is met once the app has published endpoints and the hostnames of all of them resolve
+	ConditionEndpoint = "endpoint"
+)
+
+// Conditions are the conditions AppCondition can wait for
+var Conditions = []string{ConditionReady, ConditionHealthy, ConditionEndpoint}
+
+// conditionStates describe an app that meets the condition, for messages like "app x did not become healthy"
+var conditionStates = map[string]string{
+	ConditionReady:    "ready",
+	ConditionHealthy:  "healthy",
+	ConditionEndpoint: "reachable",
+}
+
+// AppCondition waits until the app meets condition, checking it every second, and gives up after timeout unless it
+// is zero. If the app doesn't meet the condition by then, the returned error says what it is still waiting for. Apps
+// that are stopped or being deleted and apps with a failed job never meet a condition, so they fail right away.
+func AppCondition(ctx context.Context, c client.Client, appName, condition string, timeout time.Duration) error {
+	if _, ok := conditionStates[condition]; !ok {
+		return fmt.Errorf("invalid condition %s, must be one of %s", condition, strings.Join(Conditions, ", "))
+	}
+
+	waitCtx := ctx
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		waitCtx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+
+	var reason string
+	for {
+		app, err := c.AppGet(waitCtx, appName)
+		if err != nil && waitCtx.Err() == nil {
+			return err
+		} else if err == nil && app == nil {
+			return fmt.Errorf("app %s does not exist", appName)
+		} else if err == nil {
+			if err := appFailed(app); err != nil {
+				return err
+			}
+			reason = appConditionReason(waitCtx, app, condition, net.DefaultResolver.LookupHost)
+			if reason == "" {
+				return nil
+			}
+		}
+
+		select {
+		case <-waitCtx.Done():
+			if ctx.Err() != nil || !errors.Is(waitCtx.Err(), context.DeadlineExceeded) {
+				return waitCtx.Err()
+			}
+			return fmt.Errorf("app %s did not become %s within %s: %s", appName, conditionStates[condition], timeout, reason)
+		case <-ticker.C:
+		}
+	}
+}
+
+// appFailed returns an error if the app can't meet any condition without being changed
+func appFailed(app *apiv1.App) error {
+	if !app.DeletionTimestamp.IsZero() {
+		return fmt.Errorf("app %s is being deleted", app.Name)
+	}
+	if app.GetStopped() {
+		return fmt.Errorf("app %s is stopped", app.Name)
+	}
+	return failedJob(app)
+}
+
+// appConditionReason returns what keeps the app from meeting condition, or an empty string if it meets it
+func appConditionReason(ctx context.Context, app *apiv1.App, condition string, lookupHost func(ctx context.Context, host string) ([]string, error)) string {
+	switch condition {
+	case ConditionEndpoint:
+		return endpointReason(ctx, app, lookupHost)
+	case ConditionHealthy:
+		if reason := readyReason(app); reason != "" {
+			return reason
+		}
+		return healthyReason(app)
+	default:
+		return readyReason(app)
+	}
+}
+
+func readyReason(app *apiv1.App) string {
+	if app.Generation != app.Status.ObservedGeneration {
+		return "the latest changes of the app have not been processed yet"
+	}
+	if app.Status.Ready {
+		return ""
+	}
+	if workloads := unreadyWorkloads(app); len(workloads) > 0 {
+		return strings.Join(workloads, "; ")
+	}
+	if msg := app.Status.Columns.Message; msg != "" && msg != "OK" {
+		return msg
+	}
+	return "the app is not ready"
+}
+
+func healthyReason(app *apiv1.App) string {
+	var reasons []string
+	for _, name := range typed.SortedKeys(app.Status.AppStatus.Containers) {
+		container := app.Status.AppStatus.Containers[name]
+		if len(container.ErrorMessages) > 0 {
+			reasons = append(reasons, fmt.Sprintf("container %s: %s", name, strings.Join(container.ErrorMessages, ", ")))
+		} else if container.ReadyReplicaCount < container.DesiredReplicaCount || container.UpToDateReplicaCount < container.DesiredReplicaCount {
+			reasons = append(reasons, fmt.Sprintf("container %s: %d/%d ready, %d/%d up to date", name,
+				container.ReadyReplicaCount, container.DesiredReplicaCount, container.UpToDateReplicaCount, container.DesiredReplicaCount))
+		}
+	}
+	for _, name := range typed.SortedKeys(app.Status.AppStatus.Jobs) {
+		job := app.Status.AppStatus.Jobs[name]
+		if len(job.ErrorMessages) > 0 {
+			reasons = append(reasons, fmt.Sprintf("job %s: %s", name, strings.Join(job.ErrorMessages, ", ")))
+		}
+	}
+	return strings.Join(reasons, "; ")
+}
+
+func endpointReason(ctx context.Context, app *apiv1.App, lookupHost func(ctx context.Context, host string) ([]string, error)) string {
+	if len(app.Status.AppStatus.Endpoints) == 0 {
+		return "the app has no published endpoints"
+	}
+
+	var reasons []string
+	for _, ep := range app.Status.AppStatus.Endpoints {
+		name := fmt.Sprintf("%s:%d", ep.Target, ep.TargetPort)
+		host, _, _ := strings.Cut(ep.Address, ":")
+		if ep.Pending || host == "" || strings.HasPrefix(host, "<") {
+			// The load balancer didn't assign an address yet, like "<Pending Ingress>:5432"
+			reasons = append(reasons, fmt.Sprintf("endpoint %s is pending", name))
+			continue
+		}
+		if net.ParseIP(host) != nil {
+			continue
+		}
+		if _, err := lookupHost(ctx, host); err != nil {
+			reasons = append(reasons, fmt.Sprintf("endpoint %s: %s does not resolve", name, host))
+		}
+	}
+	return strings.Join(reasons, "; ")
+}
@@ -2,14 +2,22 @@ package wait
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"sort"
+	"strings"
 	"sync"
+	"time"
 
-	objwatcher "github.com/acorn-io/baaah/pkg/watcher"
+	"github.com/acorn-io/baaah/pkg/typed"
 	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
 	"github.com/acorn-io/runtime/pkg/client"
 	"github.com/acorn-io/runtime/pkg/dev"
 	"github.com/acorn-io/runtime/pkg/log"
+	"github.com/pterm/pterm"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/exp/slices"
 )
 
 func App(ctx context.Context, c client.Client, appName string, quiet bool) error {
@@ -42,21 +50,195 @@ func App(ctx context.Context, c client.Client, appName string, quiet bool) error
 	return nil
 }
 
+// AppTimeout waits for the app like App, but gives up after timeout. If the app isn't ready by then, the workloads that
+// aren't ready and the last events of the app are printed and an error is returned.
+func AppTimeout(ctx context.Context, c client.Client, appName string, quiet bool, timeout time.Duration) error {
+	waitCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	err := App(waitCtx, c, appName, quiet)
+	if err == nil || ctx.Err() != nil || !errors.Is(waitCtx.Err(), context.DeadlineExceeded) {
+		return err
+	}
+
+	app, err := c.AppGet(ctx, appName)
+	if err != nil {
+		return err
+	}
+
+	pterm.Println()
+	pterm.Error.Printf("%s is not ready after %s\n", appName, timeout)
+	if workloads := unreadyWorkloads(app); len(workloads) > 0 {
+		pterm.Println("Not ready:")
+		for _, workload := range workloads {
+			pterm.Println("  " + workload)
+		}
+	}
+
+	events, err := lastAppEvents(ctx, c, appName, lastEventsCount)
+	if err != nil {
+		logrus.Debugf("failed to get events of app %s: %v", appName, err)
+	} else if len(events) > 0 {
+		pterm.Println("Last events:")
+		for _, event := range events {
+			pterm.Printf("  %s  %s  %s\n", event.Observed.Time.Format(time.RFC3339), event.Type, event.Description)
+		}
+	}
+
+	return fmt.Errorf("app %s did not become ready within %s", appName, timeout)
+}
+
+const lastEventsCount = 5
+
+// unreadyWorkloads describes each container and job of app that isn't ready, sorted by name.
+func unreadyWorkloads(app *apiv1.App) (result []string) {
+	for _, name := range typed.SortedKeys(app.Status.AppStatus.Containers) {
+		container := app.Status.AppStatus.Containers[name]
+		if container.Ready {
+			continue
+		}
+		result = append(result, fmt.Sprintf("container %s: %d/%d ready%s", name, container.ReadyReplicaCount,
+			container.DesiredReplicaCount, workloadMessages(container.CommonStatus)))
+	}
+	for _, name := range typed.SortedKeys(app.Status.AppStatus.Jobs) {
+		job := app.Status.AppStatus.Jobs[name]
+		if job.Ready {
+			continue
+		}
+		result = append(result, fmt.Sprintf("job %s: %s%s", name, job.State, workloadMessages(job.CommonStatus)))
+	}
+	return result
+}
+
+func workloadMessages(status v1.CommonStatus) string {
+	messages := append(append([]string{}, status.ErrorMessages...), status.TransitioningMessages...)
+	if len(messages) == 0 {
+		return ""
+	}
+	return " (" + strings.Join(messages, ", ") + ")"
+}
+
+// lastAppEvents returns the last count events of the app, oldest first.
+func lastAppEvents(ctx context.Context, c client.Client, appName string, count int) ([]apiv1.Event, error) {
+	eventCh, err := c.EventStream(ctx, &client.EventStreamOptions{
+		Tail:          count,
+		FieldSelector: "appName=" + appName,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var events []apiv1.Event
+	for event := range eventCh {
+		events = append(events, event)
+	}
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].Observed.Time.Before(events[j].Observed.Time)
+	})
+	if len(events) > count {
+		events = events[len(events)-count:]
+	}
+	return events, nil
+}
+
 func waitForApp(ctx context.Context, c client.Client, app *apiv1.App) (*apiv1.App, error) {
-	wc, err := c.GetClient()
+	apps, err := c.AppStatusStream(ctx, app.Name)
 	if err != nil {
 		return nil, err
 	}
-	w := objwatcher.New[*apiv1.App](wc)
-	return w.ByObject(ctx, app, func(app *apiv1.App) (bool, error) {
+	for app := range apps {
 		if app.Status.Ready && app.Generation == app.Status.ObservedGeneration {
-			return true, nil
+			return &app, nil
+		}
+		if err := failedJob(&app); err != nil {
+			return nil, err
 		}
-		for name, job := range app.Status.AppStatus.Jobs {
-			if !job.Ready && job.RunningCount == 0 && job.ErrorCount > 2 && len(job.ErrorMessages) > 0 {
-				return false, fmt.Errorf("job %s failed: %s", name, job.ErrorMessages)
-			}
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return nil, fmt.Errorf("stopped watching app %s before it became ready", app.Name)
+}
+
+// failedJob returns an error for the first job of the app, by name, that keeps failing without being retried
+func failedJob(app *apiv1.App) error {
+	for _, name := range typed.SortedKeys(app.Status.AppStatus.Jobs) {
+		job := app.Status.AppStatus.Jobs[name]
+		if !job.Ready && job.RunningCount == 0 && job.ErrorCount > 2 && len(job.ErrorMessages) > 0 {
+			return fmt.Errorf("job %s failed: %s", name, job.ErrorMessages)
 		}
-		return false, nil
+	}
+	return nil
+}
+
+// AppScale waits until the container of the app has the given number of replicas and all of them are ready. It returns
+// the app with the status of the container.
+func AppScale(ctx context.Context, c client.Client, appName, container string, replicas int32) (*apiv1.App, error) {
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+
+	for {
+		app, err := c.AppGet(ctx, appName)
+		if err != nil {
+			return nil, err
+		}
+
+		status := app.Status.AppStatus.Containers[container]
+		if app.Generation == app.Status.ObservedGeneration && status.DesiredReplicaCount == replicas &&
+			status.ReadyReplicaCount == replicas && status.UpToDateReplicaCount == replicas {
+			return app, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// AppRestart waits until the replicas of the containers that existed before the app was restarted, given by their
+// names, are replaced and the containers are ready again. It returns the app with the status of the containers.
+func AppRestart(ctx context.Context, c client.Client, appName string, containers, oldReplicas []string) (*apiv1.App, error) {
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+
+	for {
+		app, done, err := appRestarted(ctx, c, appName, containers, oldReplicas)
+		if err != nil || done {
+			return app, err
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+func appRestarted(ctx context.Context, c client.Client, appName string, containers, oldReplicas []string) (*apiv1.App, bool, error) {
+	replicas, err := c.ContainerReplicaList(ctx, &client.ContainerReplicaListOptions{
+		App: appName,
 	})
+	if err != nil {
+		return nil, false, err
+	}
+	for _, replica := range replicas {
+		if slices.Contains(oldReplicas, replica.Name) {
+			return nil, false, nil
+		}
+	}
+
+	app, err := c.AppGet(ctx, appName)
+	if err != nil {
+		return nil, false, err
+	}
+	for _, container := range containers {
+		status := app.Status.AppStatus.Containers[container]
+		if status.ReadyReplicaCount < status.DesiredReplicaCount || status.UpToDateReplicaCount < status.DesiredReplicaCount {
+			return app, false, nil
+		}
+	}
+	return app, true, nil
 }
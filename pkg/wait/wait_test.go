@@ -0,0 +1,96 @@
+package wait
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnreadyWorkloads(t *testing.T) {
+	app := &apiv1.App{
+		Status: v1.AppInstanceStatus{
+			AppStatus: v1.AppStatus{
+				Containers: map[string]v1.ContainerStatus{
+					"web": {
+						CommonStatus: v1.CommonStatus{
+							State:                 "pending",
+							TransitioningMessages: []string{"waiting for pod"},
+						},
+						DesiredReplicaCount: 2,
+						ReadyReplicaCount:   1,
+					},
+					"db": {
+						CommonStatus: v1.CommonStatus{
+							State:         "failing",
+							ErrorMessages: []string{"CrashLoopBackOff"},
+						},
+						DesiredReplicaCount: 1,
+					},
+					"cache": {
+						CommonStatus:        v1.CommonStatus{Ready: true},
+						DesiredReplicaCount: 1,
+						ReadyReplicaCount:   1,
+					},
+				},
+				Jobs: map[string]v1.JobStatus{
+					"migrate": {
+						CommonStatus: v1.CommonStatus{State: "running"},
+					},
+					"seed": {
+						CommonStatus: v1.CommonStatus{Ready: true},
+					},
+				},
+			},
+		},
+	}
+
+	assert.Equal(t, []string{
+		"container db: 0/1 ready (CrashLoopBackOff)",
+		"container web: 1/2 ready (waiting for pod)",
+		"job migrate: running",
+	}, unreadyWorkloads(app))
+
+	assert.Empty(t, unreadyWorkloads(&apiv1.App{}))
+}
+
+func TestAppConditionReason(t *testing.T) {
+	lookupHost := func(ctx context.Context, host string) ([]string, error) {
+		if host == "web.example.com" {
+			return []string{"10.0.0.1"}, nil
+		}
+		return nil, fmt.Errorf("no such host %s", host)
+	}
+
+	app := &apiv1.App{
+		Status: v1.AppInstanceStatus{
+			Ready: true,
+			AppStatus: v1.AppStatus{
+				Containers: map[string]v1.ContainerStatus{
+					"web": {
+						DesiredReplicaCount:  2,
+						ReadyReplicaCount:    2,
+						UpToDateReplicaCount: 1,
+					},
+				},
+				Endpoints: []v1.Endpoint{
+					{Target: "web", TargetPort: 80, Address: "web.example.com"},
+					{Target: "api", TargetPort: 8080, Address: "api.example.com"},
+					{Target: "db", TargetPort: 5432, Address: "<Pending Ingress>:5432"},
+				},
+			},
+		},
+	}
+
+	assert.Empty(t, appConditionReason(context.Background(), app, ConditionReady, lookupHost))
+	assert.Equal(t, "container web: 2/2 ready, 1/2 up to date", appConditionReason(context.Background(), app, ConditionHealthy, lookupHost))
+	assert.Equal(t, "endpoint api:8080: api.example.com does not resolve; endpoint db:5432 is pending",
+		appConditionReason(context.Background(), app, ConditionEndpoint, lookupHost))
+
+	app.Generation = 2
+	assert.Equal(t, "the latest changes of the app have not been processed yet", appConditionReason(context.Background(), app, ConditionReady, lookupHost))
+	assert.Equal(t, "the app has no published endpoints", appConditionReason(context.Background(), &apiv1.App{}, ConditionEndpoint, lookupHost))
+}